@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	bitbucketv1 "github.com/gfleury/go-bitbucket-v1"
 	log "github.com/sirupsen/logrus"
@@ -19,7 +20,10 @@ type BitbucketService struct {
 	// labels         []string
 }
 
-var _ PullRequestService = (*BitbucketService)(nil)
+var (
+	_ PullRequestService = (*BitbucketService)(nil)
+	_ PullRequestCreator = (*BitbucketService)(nil)
+)
 
 func NewBitbucketServiceBasicAuth(ctx context.Context, username, password, url, projectKey, repositorySlug string, scmRootCAPath string, insecure bool, caCerts []byte) (PullRequestService, error) {
 	bitbucketConfig := bitbucketv1.NewConfiguration(url)
@@ -100,3 +104,78 @@ func (b *BitbucketService) List(_ context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+// CreateOrUpdate opens a pull request from branch into targetBranch, or updates the title and description of the open
+// pull request between the two branches if one exists.
+func (b *BitbucketService) CreateOrUpdate(_ context.Context, branch, targetBranch, title, body string) (*PullRequest, error) {
+	response, err := b.client.DefaultApi.GetPullRequestsPage(b.projectKey, b.repositorySlug, map[string]any{
+		"at":        "refs/heads/" + branch,
+		"direction": "OUTGOING",
+		"state":     "OPEN",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing pull requests for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+	}
+	pulls, err := bitbucketv1.GetPullRequestsResponse(response)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing pull request response for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+	}
+
+	var existing *bitbucketv1.PullRequest
+	for i := range pulls {
+		if pulls[i].ToRef.DisplayID == targetBranch {
+			existing = &pulls[i]
+			break
+		}
+	}
+
+	if existing != nil {
+		response, err = b.client.DefaultApi.UpdatePullRequest(b.projectKey, b.repositorySlug, &bitbucketv1.EditPullRequestOptions{
+			Version:         strconv.Itoa(int(existing.Version)),
+			ID:              int64(existing.ID),
+			Title:           title,
+			Description:     body,
+			TargetBranchRef: existing.ToRef,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error updating pull request #%d for %s/%s: %w", existing.ID, b.projectKey, b.repositorySlug, err)
+		}
+	} else {
+		repository := bitbucketv1.Repository{
+			Slug:    b.repositorySlug,
+			Project: &bitbucketv1.Project{Key: b.projectKey},
+		}
+		response, err = b.client.DefaultApi.CreatePullRequest(b.projectKey, b.repositorySlug, bitbucketv1.PullRequest{
+			Title:       title,
+			Description: body,
+			State:       "OPEN",
+			Open:        true,
+			FromRef:     bitbucketv1.PullRequestRef{ID: "refs/heads/" + branch, Repository: repository},
+			ToRef:       bitbucketv1.PullRequestRef{ID: "refs/heads/" + targetBranch, Repository: repository},
+			Reviewers:   []bitbucketv1.UserWithMetadata{},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating pull request for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+		}
+	}
+
+	pull, err := bitbucketv1.GetPullRequestResponse(response)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing pull request response for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+	}
+	pullRequest := &PullRequest{
+		Number:       pull.ID,
+		Title:        pull.Title,
+		Branch:       pull.FromRef.DisplayID,
+		TargetBranch: pull.ToRef.DisplayID,
+		HeadSHA:      pull.FromRef.LatestCommit,
+		Labels:       []string{},
+	}
+	if pull.Author != nil {
+		pullRequest.Author = pull.Author.User.Name
+	}
+	if len(pull.Links.Self) > 0 {
+		pullRequest.URL = pull.Links.Self[0].Href
+	}
+	return pullRequest, nil
+}
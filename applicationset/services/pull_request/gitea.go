@@ -3,6 +3,7 @@ package pull_request
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 	"net/http"
 	"net/http/cookiejar"
 	"os"
@@ -17,7 +18,10 @@ type GiteaService struct {
 	labels []string
 }
 
-var _ PullRequestService = (*GiteaService)(nil)
+var (
+	_ PullRequestService = (*GiteaService)(nil)
+	_ PullRequestCreator = (*GiteaService)(nil)
+)
 
 func NewGiteaService(token, url, owner, repo string, labels []string, insecure bool) (PullRequestService, error) {
 	if token == "" {
@@ -80,6 +84,65 @@ func (g *GiteaService) List(ctx context.Context) ([]*PullRequest, error) {
 	return list, nil
 }
 
+// CreateOrUpdate opens a pull request from branch into targetBranch, or updates the title and body of the open pull
+// request between the two branches if one exists.
+func (g *GiteaService) CreateOrUpdate(ctx context.Context, branch, targetBranch, title, body string) (*PullRequest, error) {
+	g.client.SetContext(ctx)
+	prs, _, err := g.client.ListRepoPullRequests(g.owner, g.repo, gitea.ListPullRequestsOptions{
+		State: gitea.StateOpen,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing pull requests for %s/%s: %w", g.owner, g.repo, err)
+	}
+
+	var pr *gitea.PullRequest
+	for _, existing := range prs {
+		if existing.Head != nil && existing.Head.Ref == branch && existing.Base != nil && existing.Base.Ref == targetBranch {
+			pr = existing
+			break
+		}
+	}
+
+	if pr != nil {
+		index := pr.Index
+		pr, _, err = g.client.EditPullRequest(g.owner, g.repo, index, gitea.EditPullRequestOption{
+			Title: title,
+			Body:  &body,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error updating pull request #%d for %s/%s: %w", index, g.owner, g.repo, err)
+		}
+	} else {
+		pr, _, err = g.client.CreatePullRequest(g.owner, g.repo, gitea.CreatePullRequestOption{
+			Head:  branch,
+			Base:  targetBranch,
+			Title: title,
+			Body:  body,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating pull request for %s/%s: %w", g.owner, g.repo, err)
+		}
+	}
+
+	pullRequest := &PullRequest{
+		Number: int(pr.Index),
+		Title:  pr.Title,
+		Labels: getGiteaPRLabelNames(pr.Labels),
+		URL:    pr.HTMLURL,
+	}
+	if pr.Head != nil {
+		pullRequest.Branch = pr.Head.Ref
+		pullRequest.HeadSHA = pr.Head.Sha
+	}
+	if pr.Base != nil {
+		pullRequest.TargetBranch = pr.Base.Ref
+	}
+	if pr.Poster != nil {
+		pullRequest.Author = pr.Poster.UserName
+	}
+	return pullRequest, nil
+}
+
 // containLabels returns true if gotLabels contains expectedLabels
 func giteaContainLabels(expectedLabels []string, gotLabels []*gitea.Label) bool {
 	gotLabelNamesMap := make(map[string]bool)
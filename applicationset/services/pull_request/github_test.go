@@ -1,6 +1,7 @@
 package pull_request
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -115,3 +116,66 @@ func TestGitHubListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestGitHubCreateOrUpdateCreatesPullRequest(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v3/repos/argoproj/argo-cd/pulls", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			assert.Equal(t, "argoproj:env/dev-next", r.URL.Query().Get("head"))
+			assert.Equal(t, "env/dev", r.URL.Query().Get("base"))
+			_, _ = w.Write([]byte(`[]`))
+		case http.MethodPost:
+			var pull github.NewPullRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&pull))
+			assert.Equal(t, "env/dev-next", pull.GetHead())
+			assert.Equal(t, "env/dev", pull.GetBase())
+			assert.Equal(t, "title", pull.GetTitle())
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"number": 7, "title": "title", "html_url": "https://github.com/argoproj/argo-cd/pull/7", "head": {"ref": "env/dev-next"}, "base": {"ref": "env/dev"}}`))
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+	})
+
+	svc, err := NewGithubService("", server.URL, "argoproj", "argo-cd", nil, nil)
+	require.NoError(t, err)
+
+	pr, err := svc.(PullRequestCreator).CreateOrUpdate(t.Context(), "env/dev-next", "env/dev", "title", "body")
+	require.NoError(t, err)
+	assert.Equal(t, 7, pr.Number)
+	assert.Equal(t, "https://github.com/argoproj/argo-cd/pull/7", pr.URL)
+	assert.Equal(t, "env/dev-next", pr.Branch)
+	assert.Equal(t, "env/dev", pr.TargetBranch)
+}
+
+func TestGitHubCreateOrUpdateUpdatesExistingPullRequest(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v3/repos/argoproj/argo-cd/pulls", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = w.Write([]byte(`[{"number": 3}]`))
+	})
+	mux.HandleFunc("/api/v3/repos/argoproj/argo-cd/pulls/3", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		var pull github.PullRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&pull))
+		assert.Equal(t, "new title", pull.GetTitle())
+		assert.Equal(t, "new body", pull.GetBody())
+		_, _ = w.Write([]byte(`{"number": 3, "title": "new title", "html_url": "https://github.com/argoproj/argo-cd/pull/3"}`))
+	})
+
+	svc, err := NewGithubService("", server.URL, "argoproj", "argo-cd", nil, nil)
+	require.NoError(t, err)
+
+	pr, err := svc.(PullRequestCreator).CreateOrUpdate(t.Context(), "env/dev-next", "env/dev", "new title", "new body")
+	require.NoError(t, err)
+	assert.Equal(t, 3, pr.Number)
+	assert.Equal(t, "new title", pr.Title)
+	assert.Equal(t, "https://github.com/argoproj/argo-cd/pull/3", pr.URL)
+}
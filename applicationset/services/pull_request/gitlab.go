@@ -19,7 +19,10 @@ type GitLabService struct {
 	pullRequestState string
 }
 
-var _ PullRequestService = (*GitLabService)(nil)
+var (
+	_ PullRequestService = (*GitLabService)(nil)
+	_ PullRequestCreator = (*GitLabService)(nil)
+)
 
 func NewGitLabService(token, url, project string, labels []string, pullRequestState string, scmRootCAPath string, insecure bool, caCerts []byte) (PullRequestService, error) {
 	var clientOptionFns []gitlab.ClientOptionFunc
@@ -101,3 +104,52 @@ func (g *GitLabService) List(ctx context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+// CreateOrUpdate opens a merge request from branch into targetBranch, or updates the title and description of the open
+// merge request between the two branches if one exists.
+func (g *GitLabService) CreateOrUpdate(ctx context.Context, branch, targetBranch, title, body string) (*PullRequest, error) {
+	state := "opened"
+	mrs, _, err := g.client.MergeRequests.ListProjectMergeRequests(g.project, &gitlab.ListProjectMergeRequestsOptions{
+		State:        &state,
+		SourceBranch: &branch,
+		TargetBranch: &targetBranch,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error listing merge requests for project '%s': %w", g.project, err)
+	}
+
+	var mr *gitlab.MergeRequest
+	if len(mrs) > 0 {
+		mr, _, err = g.client.MergeRequests.UpdateMergeRequest(g.project, mrs[0].IID, &gitlab.UpdateMergeRequestOptions{
+			Title:       &title,
+			Description: &body,
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("error updating merge request !%d for project '%s': %w", mrs[0].IID, g.project, err)
+		}
+	} else {
+		mr, _, err = g.client.MergeRequests.CreateMergeRequest(g.project, &gitlab.CreateMergeRequestOptions{
+			Title:        &title,
+			Description:  &body,
+			SourceBranch: &branch,
+			TargetBranch: &targetBranch,
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("error creating merge request for project '%s': %w", g.project, err)
+		}
+	}
+
+	pullRequest := &PullRequest{
+		Number:       mr.IID,
+		Title:        mr.Title,
+		Branch:       mr.SourceBranch,
+		TargetBranch: mr.TargetBranch,
+		HeadSHA:      mr.SHA,
+		Labels:       mr.Labels,
+		URL:          mr.WebURL,
+	}
+	if mr.Author != nil {
+		pullRequest.Author = mr.Author.Username
+	}
+	return pullRequest, nil
+}
@@ -20,6 +20,8 @@ type PullRequest struct {
 	Labels []string
 	// Author is the author of the pull request.
 	Author string
+	// URL is the web URL of the pull request. It is only populated by PullRequestCreator.CreateOrUpdate.
+	URL string
 }
 
 type PullRequestService interface {
@@ -27,6 +29,13 @@ type PullRequestService interface {
 	List(ctx context.Context) ([]*PullRequest, error)
 }
 
+// PullRequestCreator is implemented by services which are able to open pull requests in addition to listing them.
+type PullRequestCreator interface {
+	// CreateOrUpdate opens a pull request from branch into targetBranch. If an open pull request between the two
+	// branches already exists, its title and body are updated instead.
+	CreateOrUpdate(ctx context.Context, branch, targetBranch, title, body string) (*PullRequest, error)
+}
+
 type Filter struct {
 	BranchMatch       *regexp.Regexp
 	TargetBranchMatch *regexp.Regexp
@@ -2,12 +2,14 @@ package commands
 
 import (
 	"fmt"
+	"math"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"sync"
 	"syscall"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -16,6 +18,7 @@ import (
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
 	"github.com/argoproj/argo-cd/v3/commitserver"
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/commitserver/commit"
 	"github.com/argoproj/argo-cd/v3/commitserver/metrics"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/util/askpass"
@@ -29,10 +32,12 @@ import (
 // NewCommand returns a new instance of an argocd-commit-server command
 func NewCommand() *cobra.Command {
 	var (
-		listenHost  string
-		listenPort  int
-		metricsPort int
-		metricsHost string
+		listenHost            string
+		listenPort            int
+		metricsPort           int
+		metricsHost           string
+		signingKeyPath        string
+		workingCopyExpiration time.Duration
 	)
 	command := &cobra.Command{
 		Use:   "argocd-commit-server",
@@ -50,18 +55,41 @@ func NewCommand() *cobra.Command {
 			cli.SetLogFormat(cmdutil.LogFormat)
 			cli.SetLogLevel(cmdutil.LogLevel)
 
+			commitSigner, cleanup, err := commit.NewCommitSigner(signingKeyPath, os.TempDir())
+			if err != nil {
+				return fmt.Errorf("failed to create commit signer: %w", err)
+			}
+			// The key material must also be removed when a background server fails and exits the process, which skips
+			// the deferred calls.
+			cleanupCommitSigner := sync.OnceFunc(cleanup)
+			defer cleanupCommitSigner()
+			checkError := func(err error) {
+				if err != nil {
+					cleanupCommitSigner()
+					errors.CheckError(err)
+				}
+			}
+			if commitSigner != nil {
+				log.Infof("Signing hydrated commits with %s key", commitSigner.Format)
+			}
+
 			metricsServer := metrics.NewMetricsServer()
 			http.Handle("/metrics", metricsServer.GetHandler())
-			go func() { errors.CheckError(http.ListenAndServe(fmt.Sprintf("%s:%d", metricsHost, metricsPort), nil)) }()
+			go func() { checkError(http.ListenAndServe(fmt.Sprintf("%s:%d", metricsHost, metricsPort), nil)) }()
 
 			askPassServer := askpass.NewServer(askpass.CommitServerSocketPath)
-			go func() { errors.CheckError(askPassServer.Run()) }()
+			go func() { checkError(askPassServer.Run()) }()
 
-			server := commitserver.NewServer(askPassServer, metricsServer)
+			server := commitserver.NewServer(askPassServer, metricsServer, commitSigner, workingCopyExpiration)
+			if err := server.Init(); err != nil {
+				return fmt.Errorf("failed to initialize commit server: %w", err)
+			}
 			grpc := server.CreateGRPC()
 
 			listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", listenHost, listenPort))
-			errors.CheckError(err)
+			if err != nil {
+				return fmt.Errorf("failed to listen on %s:%d: %w", listenHost, listenPort, err)
+			}
 
 			healthz.ServeHealthCheck(http.DefaultServeMux, func(r *http.Request) error {
 				if val, ok := r.URL.Query()["full"]; ok && len(val) > 0 && val[0] == "true" {
@@ -98,8 +126,9 @@ func NewCommand() *cobra.Command {
 			}()
 
 			log.Println("starting grpc server")
-			err = grpc.Serve(listener)
-			errors.CheckError(err)
+			if err := grpc.Serve(listener); err != nil {
+				return fmt.Errorf("failed to serve grpc: %w", err)
+			}
 			wg.Wait()
 			log.Println("clean shutdown")
 
@@ -112,6 +141,8 @@ func NewCommand() *cobra.Command {
 	command.Flags().IntVar(&listenPort, "port", common.DefaultPortCommitServer, "Listen on given port for incoming connections")
 	command.Flags().StringVar(&metricsHost, "metrics-address", env.StringFromEnv("ARGOCD_COMMIT_SERVER_METRICS_LISTEN_ADDRESS", common.DefaultAddressCommitServerMetrics), "Listen on given address for metrics")
 	command.Flags().IntVar(&metricsPort, "metrics-port", common.DefaultPortCommitServerMetrics, "Start metrics server on given port")
+	command.Flags().StringVar(&signingKeyPath, "signing-key-path", env.StringFromEnv("ARGOCD_COMMIT_SERVER_SIGNING_KEY_PATH", common.DefaultCommitServerSigningKeyPath), "Path to a GnuPG or SSH private key used to sign hydrated commits. Commits are not signed if the file does not exist")
+	command.Flags().DurationVar(&workingCopyExpiration, "working-copy-expiration", env.ParseDurationFromEnv("ARGOCD_COMMIT_SERVER_WORKING_COPY_EXPIRATION", 24*time.Hour, 0, math.MaxInt64), "Remove the working copy of a repository once it has not been used for the given duration")
 
 	return command
 }
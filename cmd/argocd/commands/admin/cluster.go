@@ -77,6 +77,11 @@ type ClusterWithInfo struct {
 	Shard int
 	// Namespaces holds list of namespaces managed by Argo CD in the cluster
 	Namespaces []string
+	// Load holds the load of the cluster used by the load-aware sharding algorithm
+	Load int64
+	// ClaimedShard holds the number of the controller shard that currently processes the cluster, which differs from
+	// Shard while the cluster is handed off between shards
+	ClaimedShard *int
 }
 
 func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClient versioned.Interface, replicas int, shardingAlgorithm string, namespace string, portForwardRedis bool, cacheSrc func() (*appstatecache.Cache, error), shard int, redisName string, redisHaProxyName string, redisCompressionStr string) ([]ClusterWithInfo, error) {
@@ -91,10 +96,6 @@ func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClien
 	if err != nil {
 		return nil, err
 	}
-	clusterShardingCache := sharding.NewClusterSharding(argoDB, shard, replicas, shardingAlgorithm)
-	clusterShardingCache.Init(clustersList, appItems)
-	clusterShards := clusterShardingCache.GetDistribution()
-
 	var cache *appstatecache.Cache
 	if portForwardRedis {
 		overrides := clientcmd.ConfigOverrides{}
@@ -123,6 +124,18 @@ func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClien
 		}
 	}
 
+	loads := make(map[string]int64, len(clustersList.Items))
+	for _, cluster := range clustersList.Items {
+		var load int64
+		if err := cache.GetClusterLoad(cluster.Server, &load); err == nil {
+			loads[cluster.Server] = load
+		}
+	}
+	clusterShardingCache := sharding.NewClusterSharding(argoDB, shard, replicas, shardingAlgorithm)
+	clusterShardingCache.UpdateClusterLoads(loads)
+	clusterShardingCache.Init(clustersList, appItems)
+	clusterShards := clusterShardingCache.GetDistribution()
+
 	apps := appItems.Items
 	clusters := make([]ClusterWithInfo, len(clustersList.Items))
 
@@ -161,7 +174,12 @@ func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClien
 				namespaces = append(namespaces, ns)
 			}
 			_ = cache.GetClusterInfo(cluster.Server, &cluster.Info)
-			clusters[batchStart+i] = ClusterWithInfo{cluster, clusterShard, namespaces}
+			var claimedShard *int
+			var claim appstatecache.ClusterShardClaim
+			if err := cache.GetClusterShardClaim(cluster.Server, &claim); err == nil {
+				claimedShard = ptr.To(claim.Shard)
+			}
+			clusters[batchStart+i] = ClusterWithInfo{cluster, clusterShard, namespaces, loads[cluster.Server], claimedShard}
 			return nil
 		})
 	}
@@ -222,7 +240,7 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
 	command.Flags().IntVar(&shard, "shard", -1, "Cluster shard filter")
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
-	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing, load-aware] ")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
 
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
@@ -237,18 +255,29 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 func printStatsSummary(clusters []ClusterWithInfo) {
 	totalResourcesCount := int64(0)
 	resourcesCountByShard := map[int]int64{}
+	totalLoad := int64(0)
+	loadByShard := map[int]int64{}
+	handoffsByShard := map[int]int{}
 	for _, c := range clusters {
+		if c.ClaimedShard != nil && *c.ClaimedShard != c.Shard {
+			handoffsByShard[c.Shard]++
+		}
 		totalResourcesCount += c.Info.CacheInfo.ResourcesCount
 		resourcesCountByShard[c.Shard] += c.Info.CacheInfo.ResourcesCount
+		totalLoad += c.Load
+		loadByShard[c.Shard] += c.Load
 	}
 
 	avgResourcesByShard := totalResourcesCount / int64(len(resourcesCountByShard))
+	avgLoadByShard := totalLoad / int64(len(loadByShard))
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintf(w, "SHARD\tRESOURCES COUNT\n")
+	_, _ = fmt.Fprintf(w, "SHARD\tRESOURCES COUNT\tLOAD\tHAND-OFFS\n")
 	for shard := 0; shard < len(resourcesCountByShard); shard++ {
 		cnt := resourcesCountByShard[shard]
 		percent := (float64(cnt) / float64(avgResourcesByShard)) * 100.0
-		_, _ = fmt.Fprintf(w, "%d\t%s\n", shard, fmt.Sprintf("%d (%.0f%%)", cnt, percent))
+		load := loadByShard[shard]
+		loadPercent := (float64(load) / float64(avgLoadByShard)) * 100.0
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%d\n", shard, fmt.Sprintf("%d (%.0f%%)", cnt, percent), fmt.Sprintf("%d (%.0f%%)", load, loadPercent), handoffsByShard[shard])
 	}
 	_ = w.Flush()
 }
@@ -509,7 +538,7 @@ argocd admin cluster stats target-cluster`,
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
 	command.Flags().IntVar(&shard, "shard", -1, "Cluster shard filter")
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
-	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing, load-aware] ")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
 
@@ -97,6 +97,7 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationWaitCommand(clientOpts))
 	command.AddCommand(NewApplicationManifestsCommand(clientOpts))
 	command.AddCommand(NewApplicationTerminateOpCommand(clientOpts))
+	command.AddCommand(NewApplicationCancelScheduledOpCommand(clientOpts))
 	command.AddCommand(NewApplicationEditCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchCommand(clientOpts))
 	command.AddCommand(NewApplicationGetResourceCommand(clientOpts))
@@ -108,6 +109,7 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationAddSourceCommand(clientOpts))
 	command.AddCommand(NewApplicationRemoveSourceCommand(clientOpts))
 	command.AddCommand(NewApplicationConfirmDeletionCommand(clientOpts))
+	command.AddCommand(NewApplicationHydrateCommand(clientOpts))
 	return command
 }
 
@@ -702,7 +704,8 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *ar
 			status = "Sync Allowed"
 		}
 		for _, w := range *windows {
-			s := w.Kind + ":" + w.Schedule + ":" + w.Duration
+			schedule, duration := formatSyncWindowSchedule(w)
+			s := w.Kind + ":" + schedule + ":" + duration
 			wds = append(wds, s)
 		}
 	} else {
@@ -723,6 +726,13 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *ar
 		syncPolicy = "Manual"
 	}
 	fmt.Printf(printOpFmtStr, "Sync Policy:", syncPolicy)
+	if app.ScheduledOperation != nil {
+		scheduled := app.ScheduledOperation.At.Format(time.RFC3339)
+		if username := app.ScheduledOperation.Operation.InitiatedBy.Username; username != "" {
+			scheduled += " (by " + username + ")"
+		}
+		fmt.Printf(printOpFmtStr, "Scheduled Sync:", scheduled)
+	}
 	syncStatusStr := string(app.Status.Sync.Status)
 	switch app.Status.Sync.Status {
 	case argoappv1.SyncStatusCodeSynced:
@@ -2098,6 +2108,8 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		output                  string
 		appNamespace            string
 		ignoreNormalizerOpts    normalizers.IgnoreNormalizerOpts
+		at                      string
+		timeZone                string
 	)
 	command := &cobra.Command{
 		Use:   "sync [APPNAME... | -l selector | --project project-name]",
@@ -2127,7 +2139,11 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   argocd app sync my-app --resource apps:Deployment:my-service --resource :Service:my-service
   argocd app sync my-app --resource '!*:Service:*'
   # Specify namespace if the application has resources with the same name in different namespaces
-  argocd app sync my-app --resource argoproj.io:Rollout:my-namespace/my-rollout`,
+  argocd app sync my-app --resource argoproj.io:Rollout:my-namespace/my-rollout
+
+  # Schedule a sync for the next 02:00 in the given time zone, or at a specific time
+  argocd app sync my-app --at 02:00 --time-zone Europe/Berlin
+  argocd app sync my-app --at 2025-01-31T02:00:00Z`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 			if len(args) == 0 && selector == "" && len(projects) == 0 {
@@ -2164,6 +2180,18 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 			}
 
+			var scheduledAt *metav1.Time
+			if at != "" {
+				if local != "" || dryRun {
+					log.Fatal("Cannot use --at together with --local or --dry-run")
+				}
+				t, err := parseSyncAt(at, timeZone, time.Now())
+				errors.CheckError(err)
+				scheduledAt = t
+			} else if timeZone != "" {
+				log.Fatal("--time-zone can only be used together with --at")
+			}
+
 			acdClient := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer utilio.Close(conn)
@@ -2345,6 +2373,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					SyncOptions:     syncOptionsFactory(),
 					Revisions:       revisions,
 					SourcePositions: sourcePositions,
+					ScheduledAt:     scheduledAt,
 				}
 
 				switch strategy {
@@ -2401,6 +2430,10 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 				_, err = appIf.Sync(ctx, &syncReq)
 				errors.CheckError(err)
+				if scheduledAt != nil {
+					fmt.Printf("Sync of application '%s' scheduled at %s\n", appQualifiedName, scheduledAt.Format(time.RFC3339))
+					continue
+				}
 
 				if !async {
 					app, opState, err := waitOnApplicationStatus(ctx, acdClient, appQualifiedName, timeout, watchOpts{operation: true}, selectedResources, output)
@@ -2451,9 +2484,41 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringArrayVar(&revisions, "revisions", []string{}, "Show manifests at specific revisions for source position in source-positions")
 	command.Flags().Int64SliceVar(&sourcePositions, "source-positions", []int64{}, "List of source positions. Default is empty array. Counting start at 1.")
 	command.Flags().StringArrayVar(&sourceNames, "source-names", []string{}, "List of source names. Default is an empty array.")
+	command.Flags().StringVar(&at, "at", "", "Schedule the sync to be started by the controller at the given time instead of syncing immediately. Either an RFC3339 timestamp, a date and time (e.g. '2025-01-31 02:00'), or a time of day (e.g. '02:00') for its next occurrence")
+	command.Flags().StringVar(&timeZone, "time-zone", "", "Time zone of --at values without an offset, e.g. 'Europe/Berlin'. Defaults to the local time zone")
 	return command
 }
 
+// parseSyncAt parses the value of the --at flag of the sync command. Values without an offset are interpreted in the
+// given time zone, and a time of day refers to its next occurrence after now.
+func parseSyncAt(at string, timeZone string, now time.Time) (*metav1.Time, error) {
+	if t, err := time.Parse(time.RFC3339, at); err == nil {
+		return &metav1.Time{Time: t}, nil
+	}
+	loc := time.Local
+	if timeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(timeZone); err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", timeZone, err)
+		}
+	}
+	for _, layout := range []string{"2006-01-02 15:04", "2006-01-02T15:04"} {
+		if t, err := time.ParseInLocation(layout, at, loc); err == nil {
+			return &metav1.Time{Time: t}, nil
+		}
+	}
+	timeOfDay, err := time.ParseInLocation("15:04", at, loc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time %q: expected an RFC3339 timestamp, a date and time (YYYY-MM-DD HH:MM) or a time of day (HH:MM)", at)
+	}
+	now = now.In(loc)
+	t := time.Date(now.Year(), now.Month(), now.Day(), timeOfDay.Hour(), timeOfDay.Minute(), 0, 0, loc)
+	if !t.After(now) {
+		t = t.AddDate(0, 0, 1)
+	}
+	return &metav1.Time{Time: t}, nil
+}
+
 func getAppNamesBySelector(ctx context.Context, appIf application.ApplicationServiceClient, selector string) ([]string, error) {
 	appNames := []string{}
 	if selector != "" {
@@ -3032,6 +3097,7 @@ func findRevisionHistory(application *argoappv1.Application, historyId int64) (*
 func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
 		prune        bool
+		acknowledge  bool
 		timeout      uint
 		output       string
 		appNamespace string
@@ -3039,6 +3105,16 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 	command := &cobra.Command{
 		Use:   "rollback APPNAME [ID]",
 		Short: "Rollback application to a previous deployed version by History ID, omitted will Rollback to the previous version",
+		Example: templates.Examples(`
+  # Rollback the application "my-app" to the previous version
+  argocd app rollback my-app
+
+  # Rollback the application "my-app" to the version with History ID 3
+  argocd app rollback my-app 3
+
+  # Acknowledge the automated rollback of the application "my-app", which re-enables automated sync
+  argocd app rollback my-app --acknowledge
+  		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 			if len(args) == 0 {
@@ -3049,12 +3125,25 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 			var err error
 			depID := -1
 			if len(args) > 1 {
+				if acknowledge {
+					errors.Fatal(errors.ErrorGeneric, "--acknowledge cannot be used with a History ID")
+				}
 				depID, err = strconv.Atoi(args[1])
 				errors.CheckError(err)
 			}
 			acdClient := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer utilio.Close(conn)
+
+			if acknowledge {
+				_, err = appIf.AcknowledgeRollback(ctx, &application.ApplicationRollbackAcknowledgeRequest{
+					Name:         &appName,
+					AppNamespace: &appNs,
+				})
+				errors.CheckError(err)
+				fmt.Printf("Application '%s' rollback acknowledged\n", appName)
+				return
+			}
 			app, err := appIf.Get(ctx, &application.ApplicationQuery{
 				Name:         &appName,
 				AppNamespace: &appNs,
@@ -3079,6 +3168,7 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 		},
 	}
 	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources")
+	command.Flags().BoolVar(&acknowledge, "acknowledge", false, "Acknowledge the automated rollback of the application, which re-enables automated sync")
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|tree|tree=detailed")
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Rollback application in namespace")
@@ -3101,6 +3191,9 @@ func printOperationResult(opState *argoappv1.OperationState) {
 		} else {
 			fmt.Printf(printOpFmtStr, "Sync Revision:", opState.SyncResult.Revision)
 		}
+		if progress := opState.SyncResult.WaveProgress; progress != nil {
+			fmt.Printf(printOpFmtStr, "Sync Wave:", fmt.Sprintf("%d (%s)", progress.Wave, progress.Phase))
+		}
 	}
 	fmt.Printf(printOpFmtStr, "Phase:", opState.Phase)
 	fmt.Printf(printOpFmtStr, "Start:", opState.StartedAt)
@@ -3304,6 +3397,32 @@ func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *c
 	return command
 }
 
+// NewApplicationCancelScheduledOpCommand returns a new instance of an `argocd app cancel-scheduled-op` command
+func NewApplicationCancelScheduledOpCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "cancel-scheduled-op APPNAME",
+		Short: "Cancel the scheduled operation of an application",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+			_, err := appIf.CancelScheduledOperation(ctx, &application.ScheduledOperationCancelRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Application '%s' scheduled operation canceled\n", appName)
+		},
+	}
+	return command
+}
+
 func NewApplicationEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var appNamespace string
 	command := &cobra.Command{
@@ -3611,6 +3730,131 @@ func NewApplicationConfirmDeletionCommand(clientOpts *argocdclient.ClientOptions
 	return command
 }
 
+// NewApplicationHydrateCommand returns a new instance of an `argocd app hydrate` command
+func NewApplicationHydrateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		appNamespace string
+		dryRun       bool
+		approve      bool
+		revision     string
+		all          bool
+		output       string
+	)
+	command := &cobra.Command{
+		Use:   "hydrate APPNAME",
+		Short: "Hydrate an application which uses the source hydrator",
+		Example: templates.Examples(`
+  # Request hydration of the application "my-app"
+  argocd app hydrate my-app
+
+  # Show the changes hydrating the application would commit to the sync branch, without committing them
+  argocd app hydrate my-app --dry-run
+
+  # Show the changes hydrating a specific revision of the dry source would commit
+  argocd app hydrate my-app --dry-run --revision 0.0.1
+
+  # Show the changes for all applications which are hydrated together with the application
+  argocd app hydrate my-app --dry-run --all
+
+  # Approve the pending hydration of the application "my-app"
+  argocd app hydrate my-app --approve
+  		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if !dryRun && (revision != "" || all) {
+				errors.Fatal(errors.ErrorGeneric, "--revision and --all can only be used with --dry-run")
+			}
+			if dryRun && approve {
+				errors.Fatal(errors.ErrorGeneric, "--approve cannot be used with --dry-run")
+			}
+
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+
+			if approve {
+				_, err := appIf.ApproveHydration(ctx, &application.ApplicationHydrateApprovalRequest{
+					Name:         &appName,
+					AppNamespace: &appNs,
+				})
+				errors.CheckError(err)
+				fmt.Printf("Application '%s' hydration approved\n", appName)
+				return
+			}
+			if !dryRun {
+				// Refreshing an application also requests its hydration.
+				_, err := appIf.Get(ctx, &application.ApplicationQuery{
+					Name:         &appName,
+					Refresh:      getRefreshType(true, false),
+					AppNamespace: &appNs,
+				})
+				errors.CheckError(err)
+				fmt.Printf("Application '%s' hydration requested\n", appName)
+				return
+			}
+
+			preview, err := appIf.HydratePreview(ctx, &application.ApplicationHydratePreviewRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Revision:     &revision,
+				All:          &all,
+			})
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(preview, output)
+				errors.CheckError(err)
+			case "diff":
+				printHydratePreviewDiff(preview)
+			case "wide", "":
+				printHydratePreviewTable(preview)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only hydrate application in namespace")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Show the changes hydration would commit to the sync branch, without committing them")
+	command.Flags().BoolVar(&approve, "approve", false, "Approve the hydration of the application, which is awaiting approval")
+	command.Flags().StringVar(&revision, "revision", "", "Revision of the dry source to render, defaults to the dry source's target revision")
+	command.Flags().BoolVar(&all, "all", false, "Include all applications which are hydrated together with the application")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|diff|json|yaml")
+	return command
+}
+
+// printHydratePreviewTable prints the status of each file hydration would change
+func printHydratePreviewTable(preview *application.ApplicationHydratePreviewResponse) {
+	fmt.Printf("Dry SHA:     %s\n", preview.GetDrySha())
+	fmt.Printf("Sync Branch: %s\n\n", preview.GetSyncBranch())
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "APPLICATION\tPATH\tFILE\tSTATUS\n")
+	for _, app := range preview.Applications {
+		for _, file := range app.Files {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", app.GetName(), app.GetPath(), file.GetPath(), file.GetStatus())
+		}
+	}
+	_ = w.Flush()
+}
+
+// printHydratePreviewDiff prints the diff of each file hydration would change
+func printHydratePreviewDiff(preview *application.ApplicationHydratePreviewResponse) {
+	for _, app := range preview.Applications {
+		for _, file := range app.Files {
+			if file.GetDiff() == "" {
+				continue
+			}
+			fmt.Printf("===== %s: %s ======\n", app.GetName(), file.GetPath())
+			fmt.Println(file.GetDiff())
+		}
+	}
+}
+
 // prepareObjectsForDiff prepares objects for diffing using the switch statement
 // to handle different diff options and building the objKeyLiveTarget items
 func prepareObjectsForDiff(ctx context.Context, app *argoappv1.Application, proj *argoappv1.AppProject, resources *application.ManagedResourcesResponse, argoSettings *settings.Settings, diffOptions *DifferenceOption) ([]objKeyLiveTarget, error) {
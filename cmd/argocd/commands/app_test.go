@@ -27,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
 
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
@@ -2200,6 +2201,22 @@ func (c *fakeAppServiceClient) ManagedResources(_ context.Context, _ *applicatio
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) ApproveHydration(_ context.Context, _ *applicationpkg.ApplicationHydrateApprovalRequest, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) AcknowledgeRollback(_ context.Context, _ *applicationpkg.ApplicationRollbackAcknowledgeRequest, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) CancelScheduledOperation(_ context.Context, _ *applicationpkg.ScheduledOperationCancelRequest, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) HydratePreview(_ context.Context, _ *applicationpkg.ApplicationHydratePreviewRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationHydratePreviewResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) ResourceTree(_ context.Context, _ *applicationpkg.ResourcesQuery, _ ...grpc.CallOption) (*v1alpha1.ApplicationTree, error) {
 	return nil, nil
 }
@@ -2386,3 +2403,78 @@ func (c *fakeAcdClient) WatchApplicationWithRetry(_ context.Context, _ string, _
 	}()
 	return appEventsCh
 }
+
+func TestPrintHydratePreview(t *testing.T) {
+	preview := &applicationpkg.ApplicationHydratePreviewResponse{
+		DrySha:     ptr.To("abc123"),
+		SyncBranch: ptr.To("env/prod"),
+		Applications: []*applicationpkg.HydratePreviewApplication{{
+			Name: ptr.To("guestbook"),
+			Path: ptr.To("prod/guestbook"),
+			Files: []*applicationpkg.HydratePreviewFile{
+				{Path: ptr.To("prod/guestbook/README.md"), Status: ptr.To("unchanged")},
+				{Path: ptr.To("prod/guestbook/manifest.yaml"), Status: ptr.To("modified"), Diff: ptr.To("-a: b\n+a: c\n")},
+			},
+		}},
+	}
+
+	output, err := captureOutput(func() error {
+		printHydratePreviewTable(preview)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `Dry SHA:     abc123
+Sync Branch: env/prod
+
+APPLICATION  PATH            FILE                          STATUS
+guestbook    prod/guestbook  prod/guestbook/README.md      unchanged
+guestbook    prod/guestbook  prod/guestbook/manifest.yaml  modified
+`, output)
+
+	output, err = captureOutput(func() error {
+		printHydratePreviewDiff(preview)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "===== guestbook: prod/guestbook/manifest.yaml ======\n-a: b\n+a: c\n\n", output)
+}
+
+func TestParseSyncAt(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	now := time.Date(2025, 1, 30, 22, 30, 0, 0, berlin)
+
+	t.Run("RFC3339", func(t *testing.T) {
+		at, err := parseSyncAt("2025-01-31T02:00:00Z", "Europe/Berlin", now)
+		require.NoError(t, err)
+		assert.True(t, at.Time.Equal(time.Date(2025, 1, 31, 2, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("date and time in time zone", func(t *testing.T) {
+		at, err := parseSyncAt("2025-01-31 02:00", "Europe/Berlin", now)
+		require.NoError(t, err)
+		assert.True(t, at.Time.Equal(time.Date(2025, 1, 31, 2, 0, 0, 0, berlin)))
+	})
+
+	t.Run("time of day later today", func(t *testing.T) {
+		at, err := parseSyncAt("23:15", "Europe/Berlin", now)
+		require.NoError(t, err)
+		assert.True(t, at.Time.Equal(time.Date(2025, 1, 30, 23, 15, 0, 0, berlin)))
+	})
+
+	t.Run("time of day tomorrow", func(t *testing.T) {
+		at, err := parseSyncAt("02:00", "Europe/Berlin", now)
+		require.NoError(t, err)
+		assert.True(t, at.Time.Equal(time.Date(2025, 1, 31, 2, 0, 0, 0, berlin)))
+	})
+
+	t.Run("invalid time zone", func(t *testing.T) {
+		_, err := parseSyncAt("02:00", "Nowhere/Invalid", now)
+		require.ErrorContains(t, err, "invalid time zone")
+	})
+
+	t.Run("invalid time", func(t *testing.T) {
+		_, err := parseSyncAt("tonight", "", now)
+		require.ErrorContains(t, err, "invalid time")
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -14,6 +15,7 @@ import (
 	"github.com/mattn/go-isatty"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -42,6 +44,8 @@ const (
 	clusterFieldLabel = "labels"
 	// cluster field is 'annotations'
 	clusterFieldAnnotation = "annotations"
+	// cluster field is 'maintenance'
+	clusterFieldMaintenance = "maintenance"
 	// indicates managing all namespaces
 	allNamespaces = "*"
 )
@@ -238,13 +242,23 @@ func NewClusterSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 		clusterName    string
 		labels         []string
 		annotations    []string
+		maintenance    bool
+		maintenanceMsg string
+		maintenanceFor time.Duration
+		pauseWatch     bool
 	)
 	command := &cobra.Command{
 		Use:   "set NAME",
 		Short: "Set cluster information",
 		Example: `  # Set cluster information
   argocd cluster set CLUSTER_NAME --name new-cluster-name --namespace '*'
-  argocd cluster set CLUSTER_NAME --name new-cluster-name --namespace namespace-one --namespace namespace-two`,
+  argocd cluster set CLUSTER_NAME --name new-cluster-name --namespace namespace-one --namespace namespace-two
+
+  # Pause automated syncs of all applications deployed to the cluster for the next two hours
+  argocd cluster set CLUSTER_NAME --maintenance --maintenance-message "Upgrading to 1.32" --maintenance-duration 2h
+
+  # End maintenance mode
+  argocd cluster set CLUSTER_NAME --maintenance=false`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 			if len(args) != 1 {
@@ -257,6 +271,19 @@ func NewClusterSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 			defer utilio.Close(conn)
 			// checks the fields that needs to be updated
 			updatedFields := checkFieldsToUpdate(clusterOptions, labels, annotations)
+			var clusterMaintenance *argoappv1.ClusterMaintenance
+			if c.Flags().Changed("maintenance") {
+				updatedFields = append(updatedFields, clusterFieldMaintenance)
+				if maintenance {
+					clusterMaintenance = &argoappv1.ClusterMaintenance{Message: maintenanceMsg, PauseWatch: pauseWatch}
+					if maintenanceFor > 0 {
+						clusterMaintenance.ExpiresAt = &metav1.Time{Time: time.Now().Add(maintenanceFor)}
+					}
+				}
+			}
+			if !maintenance && (maintenanceMsg != "" || maintenanceFor != 0 || pauseWatch) {
+				errors.Fatal(errors.ErrorGeneric, "--maintenance-message, --maintenance-duration and --maintenance-pause-watch require --maintenance")
+			}
 			namespaces := clusterOptions.Namespaces
 			// check if all namespaces have to be considered
 			if len(namespaces) == 1 && strings.EqualFold(namespaces[0], allNamespaces) {
@@ -275,6 +302,7 @@ func NewClusterSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 						Namespaces:  namespaces,
 						Labels:      labelsMap,
 						Annotations: annotationsMap,
+						Maintenance: clusterMaintenance,
 					},
 					UpdatedFields: updatedFields,
 					Id: &clusterpkg.ClusterID{
@@ -299,6 +327,10 @@ func NewClusterSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 	command.Flags().StringArrayVar(&clusterOptions.Namespaces, "namespace", nil, "List of namespaces which are allowed to manage. Specify '*' to manage all namespaces")
 	command.Flags().StringArrayVar(&labels, "label", nil, "Set metadata labels (e.g. --label key=value)")
 	command.Flags().StringArrayVar(&annotations, "annotation", nil, "Set metadata annotations (e.g. --annotation key=value)")
+	command.Flags().BoolVar(&maintenance, "maintenance", false, "Put the cluster into maintenance mode, which pauses automated syncs of the applications deployed to it. Use --maintenance=false to end maintenance mode")
+	command.Flags().StringVar(&maintenanceMsg, "maintenance-message", "", "Reason the cluster is in maintenance mode")
+	command.Flags().DurationVar(&maintenanceFor, "maintenance-duration", 0, "End maintenance mode automatically after the given duration (e.g. 2h)")
+	command.Flags().BoolVar(&pauseWatch, "maintenance-pause-watch", false, "Stop watching the live state of the cluster while it is in maintenance mode")
 	return command
 }
 
@@ -375,6 +407,37 @@ func formatNamespaces(cluster argoappv1.Cluster) string {
 	return strings.Join(cluster.Namespaces, ", ")
 }
 
+func formatMaintenance(cluster argoappv1.Cluster) string {
+	if !cluster.IsInMaintenance(time.Now()) {
+		return "no"
+	}
+	details := []string{"yes"}
+	if cluster.Maintenance.Message != "" {
+		details = append(details, cluster.Maintenance.Message)
+	}
+	if cluster.Maintenance.ExpiresAt != nil {
+		details = append(details, "until "+cluster.Maintenance.ExpiresAt.Format(time.RFC3339))
+	}
+	if cluster.Maintenance.PauseWatch {
+		details = append(details, "watch paused")
+	}
+	return strings.Join(details, ", ")
+}
+
+func formatConnectionState(state argoappv1.ConnectionState) string {
+	details := []string{strWithDefault(state.Status, "-")}
+	if state.Message != "" {
+		details = append(details, state.Message)
+	}
+	if state.ConsecutiveFailures > 0 {
+		details = append(details, fmt.Sprintf("%d consecutive failures", state.ConsecutiveFailures))
+	}
+	if state.QuarantinedUntil != nil {
+		details = append(details, "quarantined until "+state.QuarantinedUntil.Format(time.RFC3339))
+	}
+	return strings.Join(details, ", ")
+}
+
 func printClusterDetails(clusters []argoappv1.Cluster) {
 	for _, cluster := range clusters {
 		fmt.Printf("Cluster information\n\n")
@@ -383,6 +446,18 @@ func printClusterDetails(clusters []argoappv1.Cluster) {
 		//nolint:staticcheck
 		fmt.Printf("  Server Version:        %s\n", cluster.ServerVersion)
 		fmt.Printf("  Namespaces:        	 %s\n", formatNamespaces(cluster))
+		fmt.Printf("  Maintenance:           %s\n", formatMaintenance(cluster))
+		fmt.Printf("  Connection:            %s\n", formatConnectionState(cluster.Info.ConnectionState))
+		if history := cluster.Info.ConnectionState.History; len(history) > 0 {
+			fmt.Printf("\nConnection history\n\n")
+			for _, entry := range history {
+				modifiedAt := "-"
+				if entry.ModifiedAt != nil {
+					modifiedAt = entry.ModifiedAt.Format(time.RFC3339)
+				}
+				fmt.Printf("  %-22s %-11s %s\n", modifiedAt, entry.Status, entry.Message)
+			}
+		}
 		fmt.Printf("\nTLS configuration\n\n")
 		fmt.Printf("  Client cert:           %v\n", len(cluster.Config.CertData) != 0)
 		fmt.Printf("  Cert validation:       %v\n", !cluster.Config.Insecure)
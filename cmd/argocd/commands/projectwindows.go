@@ -1,13 +1,16 @@
 package commands
 
 import (
+	stderrors "errors"
 	"fmt"
 	"os"
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
@@ -151,6 +154,10 @@ func NewProjectWindowsAddWindowCommand(clientOpts *argocdclient.ClientOptions) *
 		timeZone     string
 		andOperator  bool
 		description  string
+		start        string
+		end          string
+		calendar     string
+		dates        []string
 	)
 	command := &cobra.Command{
 		Use:   "add PROJECT",
@@ -174,6 +181,22 @@ argocd proj windows add PROJECT \
     --clusters "prod,staging" \
     --manual-sync \
     --description "Ticket 123"
+
+#Add a one-off deny sync window for a year-end freeze
+argocd proj windows add PROJECT \
+    --kind deny \
+    --start 2024-12-20T00:00:00Z \
+    --end 2025-01-03T00:00:00Z \
+    --applications "*" \
+    --description "Year-end freeze"
+
+#Add a deny sync window which is active during whole days of a calendar
+argocd proj windows add PROJECT \
+    --kind deny \
+    --calendar public-holidays \
+    --dates 2024-12-25,2025-01-01 \
+    --time-zone "Europe/Berlin" \
+    --applications "*"
 	`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -189,8 +212,22 @@ argocd proj windows add PROJECT \
 			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
 			errors.CheckError(err)
 
-			err = proj.Spec.AddWindow(kind, schedule, duration, applications, namespaces, clusters, manualSync, timeZone, andOperator, description)
-			errors.CheckError(err)
+			if start != "" || end != "" || calendar != "" || len(dates) > 0 {
+				window, err := newOneOffOrCalendarWindow(kind, schedule, duration, start, end, calendar, dates)
+				errors.CheckError(err)
+				window.Applications = applications
+				window.Namespaces = namespaces
+				window.Clusters = clusters
+				window.ManualSync = manualSync
+				window.TimeZone = timeZone
+				window.UseAndOperator = andOperator
+				window.Description = description
+				err = proj.Spec.AddSyncWindow(window)
+				errors.CheckError(err)
+			} else {
+				err = proj.Spec.AddWindow(kind, schedule, duration, applications, namespaces, clusters, manualSync, timeZone, andOperator, description)
+				errors.CheckError(err)
+			}
 
 			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
 			errors.CheckError(err)
@@ -206,10 +243,36 @@ argocd proj windows add PROJECT \
 	command.Flags().StringVar(&timeZone, "time-zone", "UTC", "Time zone of the sync window")
 	command.Flags().BoolVar(&andOperator, "use-and-operator", false, "Use AND operator for matching applications, namespaces and clusters instead of the default OR operator")
 	command.Flags().StringVar(&description, "description", "", `Sync window description`)
+	command.Flags().StringVar(&start, "start", "", "Start of a one-off sync window in RFC3339 format, used instead of a schedule and duration. (e.g. --start 2024-12-20T00:00:00Z)")
+	command.Flags().StringVar(&end, "end", "", "End of a one-off sync window in RFC3339 format. (e.g. --end 2025-01-03T00:00:00Z)")
+	command.Flags().StringVar(&calendar, "calendar", "", "Name of the calendar of the sync window, used instead of a schedule and duration together with --dates")
+	command.Flags().StringSliceVar(&dates, "dates", []string{}, "Dates the sync window is active on in YYYY-MM-DD format. Comma separated (e.g. --dates 2024-12-25,2025-01-01)")
 
 	return command
 }
 
+// newOneOffOrCalendarWindow returns a sync window with an absolute start and end, or with a calendar of dates
+func newOneOffOrCalendarWindow(kind, schedule, duration, start, end, calendar string, dates []string) (*v1alpha1.SyncWindow, error) {
+	if kind == "" {
+		return nil, stderrors.New("cannot create window: require kind")
+	}
+	if schedule != "" || duration != "" {
+		return nil, stderrors.New("cannot create window: --schedule and --duration cannot be used with --start, --end, --calendar or --dates")
+	}
+	window := &v1alpha1.SyncWindow{Kind: kind}
+	if calendar != "" || len(dates) > 0 {
+		window.Calendar = &v1alpha1.SyncWindowCalendar{Name: calendar, Dates: dates}
+	}
+	var err error
+	if window.Start, err = parseSyncWindowTime(start); err != nil {
+		return nil, err
+	}
+	if window.End, err = parseSyncWindowTime(end); err != nil {
+		return nil, err
+	}
+	return window, nil
+}
+
 // NewProjectWindowsDeleteCommand returns a new instance of an `argocd proj windows delete` command
 func NewProjectWindowsDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
@@ -368,12 +431,17 @@ func printSyncWindows(proj *v1alpha1.AppProject) {
 	if proj.Spec.SyncWindows.HasWindows() {
 		for i, window := range proj.Spec.SyncWindows {
 			isActive, _ := window.Active()
+			status := formatBoolOutput(isActive)
+			if window.Expired() {
+				status = "Expired"
+			}
+			schedule, duration := formatSyncWindowSchedule(window)
 			vals := []any{
 				strconv.Itoa(i),
-				formatBoolOutput(isActive),
+				status,
 				window.Kind,
-				window.Schedule,
-				window.Duration,
+				schedule,
+				duration,
 				formatListOutput(window.Applications),
 				formatListOutput(window.Namespaces),
 				formatListOutput(window.Clusters),
@@ -387,6 +455,29 @@ func printSyncWindows(proj *v1alpha1.AppProject) {
 	_ = w.Flush()
 }
 
+func parseSyncWindowTime(value string) (*metav1.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse time '%s': %w", value, err)
+	}
+	return &metav1.Time{Time: parsed}, nil
+}
+
+// formatSyncWindowSchedule returns the schedule and duration columns of a sync window, which show the start and end of
+// one-off windows and the calendar of calendar windows
+func formatSyncWindowSchedule(window *v1alpha1.SyncWindow) (string, string) {
+	switch {
+	case window.Calendar != nil:
+		return fmt.Sprintf("calendar:%s (%s)", window.Calendar.Name, strings.Join(window.Calendar.Dates, ",")), "1d"
+	case window.Start != nil && window.End != nil:
+		return window.Start.UTC().Format(time.RFC3339) + " - " + window.End.UTC().Format(time.RFC3339), window.End.Sub(window.Start.Time).String()
+	}
+	return window.Schedule, window.Duration
+}
+
 func formatListOutput(list []string) string {
 	var o string
 	if len(list) == 0 {
@@ -91,6 +91,8 @@ type AppOptions struct {
 	retryBackoffMaxDuration         time.Duration
 	retryBackoffFactor              int64
 	retryRefresh                    bool
+	syncWaveMaxParallelism          int64
+	syncWaveDelay                   time.Duration
 	ref                             string
 	SourceName                      string
 	drySourceRepo                   string
@@ -98,6 +100,7 @@ type AppOptions struct {
 	drySourcePath                   string
 	syncSourceBranch                string
 	syncSourcePath                  string
+	syncSourceRepo                  string
 	hydrateToBranch                 string
 }
 
@@ -112,6 +115,7 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().StringVar(&opts.drySourcePath, "dry-source-path", "", "Path in repository to the app directory for the dry source")
 	command.Flags().StringVar(&opts.syncSourceBranch, "sync-source-branch", "", "The branch from which the app will sync")
 	command.Flags().StringVar(&opts.syncSourcePath, "sync-source-path", "", "The path in the repository from which the app will sync")
+	command.Flags().StringVar(&opts.syncSourceRepo, "sync-source-repo", "", "OCI repository URL to push hydrated manifests to instead of the dry source repository (e.g. oci://registry.example.com/hydrated)")
 	command.Flags().StringVar(&opts.hydrateToBranch, "hydrate-to-branch", "", "The branch to hydrate the app to")
 	command.Flags().IntVar(&opts.revisionHistoryLimit, "revision-history-limit", argoappv1.RevisionHistoryLimit, "How many items to keep in revision history")
 	command.Flags().StringVar(&opts.destServer, "dest-server", "", "K8s cluster URL (e.g. https://kubernetes.default.svc)")
@@ -170,10 +174,29 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().DurationVar(&opts.retryBackoffMaxDuration, "sync-retry-backoff-max-duration", argoappv1.DefaultSyncRetryMaxDuration, "Max sync retry backoff duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().Int64Var(&opts.retryBackoffFactor, "sync-retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed sync retry")
 	command.Flags().BoolVar(&opts.retryRefresh, "sync-retry-refresh", false, "Indicates if the latest revision should be used on retry instead of the initial one")
+	command.Flags().Int64Var(&opts.syncWaveMaxParallelism, "sync-wave-max-parallelism", 0, "Max number of resources of a sync wave which are applied concurrently (0 for no limit)")
+	command.Flags().DurationVar(&opts.syncWaveDelay, "sync-wave-delay", 0, "Amount of time to wait after all resources of a sync wave are healthy before the next wave is applied. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().StringVar(&opts.ref, "ref", "", "Ref is reference to another source within sources field")
 	command.Flags().StringVar(&opts.SourceName, "source-name", "", "Name of the source from the list of sources of the app.")
 }
 
+// setSyncWavesStrategy updates the sync waves strategy of the application spec, and removes it if it's empty
+func setSyncWavesStrategy(spec *argoappv1.ApplicationSpec, update func(waves *argoappv1.SyncWavesStrategy)) {
+	if spec.SyncPolicy == nil {
+		spec.SyncPolicy = &argoappv1.SyncPolicy{}
+	}
+	if spec.SyncPolicy.Waves == nil {
+		spec.SyncPolicy.Waves = &argoappv1.SyncWavesStrategy{}
+	}
+	update(spec.SyncPolicy.Waves)
+	if *spec.SyncPolicy.Waves == (argoappv1.SyncWavesStrategy{}) {
+		spec.SyncPolicy.Waves = nil
+	}
+	if spec.SyncPolicy.IsZero() {
+		spec.SyncPolicy = nil
+	}
+}
+
 func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, appOpts *AppOptions, sourcePosition int) int {
 	visited := 0
 	if flags == nil {
@@ -282,6 +305,20 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 				spec.SyncPolicy.Retry = &argoappv1.RetryStrategy{}
 			}
 			spec.SyncPolicy.Retry.Refresh = appOpts.retryRefresh
+		case "sync-wave-max-parallelism":
+			if appOpts.syncWaveMaxParallelism < 0 {
+				log.Fatalf("Invalid sync-wave-max-parallelism [%d]", appOpts.syncWaveMaxParallelism)
+			}
+			setSyncWavesStrategy(spec, func(waves *argoappv1.SyncWavesStrategy) {
+				waves.MaxParallelism = appOpts.syncWaveMaxParallelism
+			})
+		case "sync-wave-delay":
+			setSyncWavesStrategy(spec, func(waves *argoappv1.SyncWavesStrategy) {
+				waves.Delay = ""
+				if appOpts.syncWaveDelay > 0 {
+					waves.Delay = appOpts.syncWaveDelay.String()
+				}
+			})
 		}
 	})
 	if flags.Changed("auto-prune") {
@@ -828,6 +865,9 @@ func constructSourceHydrator(h *argoappv1.SourceHydrator, appOpts AppOptions, fl
 		case "sync-source-path":
 			ensureNotNil(appOpts.syncSourcePath != "")
 			h.SyncSource.Path = appOpts.syncSourcePath
+		case "sync-source-repo":
+			ensureNotNil(appOpts.syncSourceRepo != "")
+			h.SyncSource.RepoURL = appOpts.syncSourceRepo
 		case "hydrate-to-branch":
 			ensureNotNil(appOpts.hydrateToBranch != "")
 			if appOpts.hydrateToBranch == "" {
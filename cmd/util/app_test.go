@@ -281,6 +281,15 @@ func Test_setAppSpecOptions(t *testing.T) {
 		require.NoError(t, f.SetFlag("sync-retry-refresh", "false"))
 		assert.False(t, f.spec.SyncPolicy.Retry.Refresh)
 	})
+	t.Run("SyncWaves", func(t *testing.T) {
+		require.NoError(t, f.SetFlag("sync-wave-max-parallelism", "3"))
+		require.NoError(t, f.SetFlag("sync-wave-delay", "2m"))
+		assert.Equal(t, &v1alpha1.SyncWavesStrategy{MaxParallelism: 3, Delay: "2m0s"}, f.spec.SyncPolicy.Waves)
+
+		require.NoError(t, f.SetFlag("sync-wave-max-parallelism", "0"))
+		require.NoError(t, f.SetFlag("sync-wave-delay", "0s"))
+		assert.Nil(t, f.spec.SyncPolicy.Waves)
+	})
 	t.Run("Kustomize", func(t *testing.T) {
 		require.NoError(t, f.SetFlag("kustomize-replica", "my-deployment=2"))
 		require.NoError(t, f.SetFlag("kustomize-replica", "my-statefulset=4"))
@@ -328,6 +337,9 @@ func Test_setAppSpecOptions(t *testing.T) {
 		require.NoError(t, f.SetFlag("sync-source-path", "apps"))
 		assert.Equal(t, "apps", f.spec.SourceHydrator.SyncSource.Path)
 
+		require.NoError(t, f.SetFlag("sync-source-repo", "oci://registry.example.com/hydrated"))
+		assert.Equal(t, "oci://registry.example.com/hydrated", f.spec.SourceHydrator.SyncSource.RepoURL)
+
 		require.NoError(t, f.SetFlag("hydrate-to-branch", "env/test-next"))
 		assert.Equal(t, "env/test-next", f.spec.SourceHydrator.HydrateTo.TargetBranch)
 
@@ -43,10 +43,16 @@ type CommitHydratedManifestsRequest struct {
 	// Paths contains the paths to write hydrated manifests to, along with the manifests and commands to execute.
 	Paths []*PathDetails `protobuf:"bytes,6,rep,name=paths,proto3" json:"paths,omitempty"`
 	// DryCommitMetadata contains metadata about the DRY commit, such as the author and committer.
-	DryCommitMetadata    *v1alpha1.RevisionMetadata `protobuf:"bytes,7,opt,name=dryCommitMetadata,proto3" json:"dryCommitMetadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	DryCommitMetadata *v1alpha1.RevisionMetadata `protobuf:"bytes,7,opt,name=dryCommitMetadata,proto3" json:"dryCommitMetadata,omitempty"`
+	// PullRequest, if set, configures the commit server to open or update a pull request from the target branch into the
+	// sync branch after pushing. It is ignored when the target branch is the sync branch.
+	PullRequest *v1alpha1.HydratePullRequest `protobuf:"bytes,8,opt,name=pullRequest,proto3" json:"pullRequest,omitempty"`
+	// DryRepoURL is the URL of the dry source repository. It is recorded in the hydrator metadata when the manifests are
+	// pushed to an OCI repository, in which case Repo is the OCI repository.
+	DryRepoURL           string   `protobuf:"bytes,9,opt,name=dryRepoURL,proto3" json:"dryRepoURL,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CommitHydratedManifestsRequest) Reset()         { *m = CommitHydratedManifestsRequest{} }
@@ -131,6 +137,20 @@ func (m *CommitHydratedManifestsRequest) GetDryCommitMetadata() *v1alpha1.Revisi
 	return nil
 }
 
+func (m *CommitHydratedManifestsRequest) GetPullRequest() *v1alpha1.HydratePullRequest {
+	if m != nil {
+		return m.PullRequest
+	}
+	return nil
+}
+
+func (m *CommitHydratedManifestsRequest) GetDryRepoURL() string {
+	if m != nil {
+		return m.DryRepoURL
+	}
+	return ""
+}
+
 // PathDetails holds information about hydrated manifests to be written to a particular path in the hydrated manifests
 // commit.
 type PathDetails struct {
@@ -139,7 +159,16 @@ type PathDetails struct {
 	// Manifests contains the manifests to write to the path.
 	Manifests []*HydratedManifestDetails `protobuf:"bytes,2,rep,name=manifests,proto3" json:"manifests,omitempty"`
 	// Commands contains the commands executed when hydrating the manifests.
-	Commands             []string `protobuf:"bytes,3,rep,name=commands,proto3" json:"commands,omitempty"`
+	Commands []string `protobuf:"bytes,3,rep,name=commands,proto3" json:"commands,omitempty"`
+	// DrySources contains the additional dry sources the manifests were hydrated from, along with their resolved
+	// revisions.
+	DrySources []*DrySourceRevision `protobuf:"bytes,4,rep,name=drySources,proto3" json:"drySources,omitempty"`
+	// ApprovedBy is the user who approved the hydration of the path, if approval was required.
+	ApprovedBy string `protobuf:"bytes,5,opt,name=approvedBy,proto3" json:"approvedBy,omitempty"`
+	// Layout is the way the manifests are split into files within the path, e.g. PerResource. Defaults to SingleFile.
+	Layout string `protobuf:"bytes,6,opt,name=layout,proto3" json:"layout,omitempty"`
+	// Kustomization specifies whether a kustomization.yaml file listing the manifest files is written to the path.
+	Kustomization        bool     `protobuf:"varint,7,opt,name=kustomization,proto3" json:"kustomization,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -199,6 +228,119 @@ func (m *PathDetails) GetCommands() []string {
 	return nil
 }
 
+func (m *PathDetails) GetDrySources() []*DrySourceRevision {
+	if m != nil {
+		return m.DrySources
+	}
+	return nil
+}
+
+func (m *PathDetails) GetApprovedBy() string {
+	if m != nil {
+		return m.ApprovedBy
+	}
+	return ""
+}
+
+func (m *PathDetails) GetLayout() string {
+	if m != nil {
+		return m.Layout
+	}
+	return ""
+}
+
+func (m *PathDetails) GetKustomization() bool {
+	if m != nil {
+		return m.Kustomization
+	}
+	return false
+}
+
+// DrySourceRevision identifies an additional dry source and the revision it was hydrated from.
+type DrySourceRevision struct {
+	// RepoURL is the URL of the dry source repository.
+	RepoURL string `protobuf:"bytes,1,opt,name=repoURL,proto3" json:"repoURL,omitempty"`
+	// Path is the path of the dry source within the repository, if any.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Chart is the Helm chart name of the dry source, if any.
+	Chart string `protobuf:"bytes,3,opt,name=chart,proto3" json:"chart,omitempty"`
+	// Ref is the name by which other dry sources refer to this source, if any.
+	Ref string `protobuf:"bytes,4,opt,name=ref,proto3" json:"ref,omitempty"`
+	// Revision is the resolved revision, i.e. the commit SHA or chart version, of the dry source.
+	Revision             string   `protobuf:"bytes,5,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DrySourceRevision) Reset()         { *m = DrySourceRevision{} }
+func (m *DrySourceRevision) String() string { return proto.CompactTextString(m) }
+func (*DrySourceRevision) ProtoMessage()    {}
+func (*DrySourceRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf3a3abbc35e3069, []int{2}
+}
+func (m *DrySourceRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrySourceRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrySourceRevision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DrySourceRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrySourceRevision.Merge(m, src)
+}
+func (m *DrySourceRevision) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrySourceRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrySourceRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrySourceRevision proto.InternalMessageInfo
+
+func (m *DrySourceRevision) GetRepoURL() string {
+	if m != nil {
+		return m.RepoURL
+	}
+	return ""
+}
+
+func (m *DrySourceRevision) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *DrySourceRevision) GetChart() string {
+	if m != nil {
+		return m.Chart
+	}
+	return ""
+}
+
+func (m *DrySourceRevision) GetRef() string {
+	if m != nil {
+		return m.Ref
+	}
+	return ""
+}
+
+func (m *DrySourceRevision) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
 // ManifestDetails contains the hydrated manifests.
 type HydratedManifestDetails struct {
 	// ManifestJSON is the hydrated manifest as JSON.
@@ -212,7 +354,7 @@ func (m *HydratedManifestDetails) Reset()         { *m = HydratedManifestDetails
 func (m *HydratedManifestDetails) String() string { return proto.CompactTextString(m) }
 func (*HydratedManifestDetails) ProtoMessage()    {}
 func (*HydratedManifestDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf3a3abbc35e3069, []int{2}
+	return fileDescriptor_cf3a3abbc35e3069, []int{3}
 }
 func (m *HydratedManifestDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,7 +393,19 @@ func (m *HydratedManifestDetails) GetManifestJSON() string {
 // ManifestsResponse is the response to the ManifestsRequest.
 type CommitHydratedManifestsResponse struct {
 	// HydratedSha is the commit SHA of the hydrated manifests commit.
-	HydratedSha          string   `protobuf:"bytes,1,opt,name=hydratedSha,proto3" json:"hydratedSha,omitempty"`
+	HydratedSha string `protobuf:"bytes,1,opt,name=hydratedSha,proto3" json:"hydratedSha,omitempty"`
+	// PullRequest contains information about the pull request opened or updated for the target branch, if one was
+	// requested.
+	PullRequest *PullRequestDetails `protobuf:"bytes,2,opt,name=pullRequest,proto3" json:"pullRequest,omitempty"`
+	// ManifestsUnchanged is true if the manifests for every path were identical to the ones already on the target branch.
+	// In that case no commit was made, and HydratedSha is the SHA of the existing commit on the target branch.
+	ManifestsUnchanged bool `protobuf:"varint,3,opt,name=manifestsUnchanged,proto3" json:"manifestsUnchanged,omitempty"`
+	// PathRevisions maps each path to the digest of the OCI artifact its manifests were pushed as. It is only set when the
+	// manifests are pushed to an OCI repository, in which case HydratedSha is empty.
+	PathRevisions map[string]string `protobuf:"bytes,4,rep,name=pathRevisions,proto3" json:"pathRevisions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// PullRequestError is the error which occurred while opening or updating the requested pull request. The hydrated
+	// manifests were pushed regardless, so HydratedSha is set.
+	PullRequestError     string   `protobuf:"bytes,5,opt,name=pullRequestError,proto3" json:"pullRequestError,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -261,7 +415,7 @@ func (m *CommitHydratedManifestsResponse) Reset()         { *m = CommitHydratedM
 func (m *CommitHydratedManifestsResponse) String() string { return proto.CompactTextString(m) }
 func (*CommitHydratedManifestsResponse) ProtoMessage()    {}
 func (*CommitHydratedManifestsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf3a3abbc35e3069, []int{3}
+	return fileDescriptor_cf3a3abbc35e3069, []int{4}
 }
 func (m *CommitHydratedManifestsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -297,47 +451,154 @@ func (m *CommitHydratedManifestsResponse) GetHydratedSha() string {
 	return ""
 }
 
+func (m *CommitHydratedManifestsResponse) GetPullRequest() *PullRequestDetails {
+	if m != nil {
+		return m.PullRequest
+	}
+	return nil
+}
+
+func (m *CommitHydratedManifestsResponse) GetManifestsUnchanged() bool {
+	if m != nil {
+		return m.ManifestsUnchanged
+	}
+	return false
+}
+
+func (m *CommitHydratedManifestsResponse) GetPathRevisions() map[string]string {
+	if m != nil {
+		return m.PathRevisions
+	}
+	return nil
+}
+
+func (m *CommitHydratedManifestsResponse) GetPullRequestError() string {
+	if m != nil {
+		return m.PullRequestError
+	}
+	return ""
+}
+
+// PullRequestDetails contains information about a pull request opened by the commit server.
+type PullRequestDetails struct {
+	// Number is the number of the pull request.
+	Number int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// URL is the web URL of the pull request.
+	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PullRequestDetails) Reset()         { *m = PullRequestDetails{} }
+func (m *PullRequestDetails) String() string { return proto.CompactTextString(m) }
+func (*PullRequestDetails) ProtoMessage()    {}
+func (*PullRequestDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf3a3abbc35e3069, []int{5}
+}
+func (m *PullRequestDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullRequestDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullRequestDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PullRequestDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullRequestDetails.Merge(m, src)
+}
+func (m *PullRequestDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *PullRequestDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullRequestDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PullRequestDetails proto.InternalMessageInfo
+
+func (m *PullRequestDetails) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *PullRequestDetails) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CommitHydratedManifestsRequest)(nil), "CommitHydratedManifestsRequest")
 	proto.RegisterType((*PathDetails)(nil), "PathDetails")
+	proto.RegisterType((*DrySourceRevision)(nil), "DrySourceRevision")
 	proto.RegisterType((*HydratedManifestDetails)(nil), "HydratedManifestDetails")
 	proto.RegisterType((*CommitHydratedManifestsResponse)(nil), "CommitHydratedManifestsResponse")
+	proto.RegisterMapType((map[string]string)(nil), "CommitHydratedManifestsResponse.PathRevisionsEntry")
+	proto.RegisterType((*PullRequestDetails)(nil), "PullRequestDetails")
 }
 
 func init() { proto.RegisterFile("commitserver/commit/commit.proto", fileDescriptor_cf3a3abbc35e3069) }
 
 var fileDescriptor_cf3a3abbc35e3069 = []byte{
-	// 479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x95, 0xeb, 0x34, 0x90, 0x49, 0x7b, 0x60, 0x0f, 0xd4, 0xca, 0xc1, 0xb5, 0x2c, 0x0e, 0xb9,
-	0xb0, 0x56, 0x13, 0xc1, 0x8d, 0x4b, 0xc3, 0xa1, 0x42, 0xb4, 0x20, 0xe7, 0x86, 0x2a, 0xa1, 0xa9,
-	0xbd, 0xd8, 0x4b, 0x63, 0xef, 0xb2, 0xbb, 0xb1, 0x64, 0x89, 0xaf, 0xe1, 0x6b, 0x38, 0xf2, 0x09,
-	0x28, 0x5f, 0x82, 0xbc, 0xb6, 0x69, 0x02, 0x0a, 0x39, 0x70, 0xf2, 0xce, 0xbc, 0xf1, 0x7b, 0xb3,
-	0xf3, 0x76, 0x20, 0x48, 0x44, 0x51, 0x70, 0xa3, 0x99, 0xaa, 0x98, 0x8a, 0xda, 0xa0, 0xfb, 0x50,
-	0xa9, 0x84, 0x11, 0x93, 0xb7, 0x19, 0x37, 0xf9, 0xfa, 0x8e, 0x26, 0xa2, 0x88, 0x50, 0x65, 0x42,
-	0x2a, 0xf1, 0xd9, 0x1e, 0x9e, 0x27, 0x69, 0x54, 0xcd, 0x23, 0x79, 0x9f, 0x45, 0x28, 0xb9, 0x8e,
-	0x50, 0xca, 0x15, 0x4f, 0xd0, 0x70, 0x51, 0x46, 0xd5, 0x05, 0xae, 0x64, 0x8e, 0x17, 0x51, 0xc6,
-	0x4a, 0xa6, 0xd0, 0xb0, 0xb4, 0x65, 0x0b, 0xbf, 0xb9, 0xe0, 0x2f, 0x2c, 0xfd, 0x55, 0x9d, 0x5a,
-	0xe0, 0x1a, 0x4b, 0xfe, 0x89, 0x69, 0xa3, 0x63, 0xf6, 0x65, 0xcd, 0xb4, 0x21, 0xb7, 0x30, 0x50,
-	0x4c, 0x0a, 0xcf, 0x09, 0x9c, 0xe9, 0x78, 0x76, 0x45, 0x1f, 0xf4, 0x69, 0xaf, 0x6f, 0x0f, 0x1f,
-	0x93, 0x94, 0x56, 0x73, 0x2a, 0xef, 0x33, 0xda, 0xe8, 0xd3, 0x2d, 0x7d, 0xda, 0xeb, 0xd3, 0x98,
-	0x49, 0xa1, 0xb9, 0x11, 0xaa, 0x8e, 0x2d, 0x2b, 0xf1, 0x01, 0x74, 0x5d, 0x26, 0x97, 0x0a, 0xcb,
-	0x24, 0xf7, 0x8e, 0x02, 0x67, 0x3a, 0x8a, 0xb7, 0x32, 0x24, 0x84, 0x13, 0x83, 0x2a, 0x63, 0xa6,
-	0xab, 0x70, 0x6d, 0xc5, 0x4e, 0x8e, 0x3c, 0x85, 0x61, 0xaa, 0xea, 0x65, 0x8e, 0xde, 0xc0, 0xa2,
-	0x5d, 0x44, 0x9e, 0xc1, 0x69, 0x3b, 0xba, 0x6b, 0xa6, 0x35, 0x66, 0xcc, 0x3b, 0xb6, 0xf0, 0x6e,
-	0x92, 0x84, 0x70, 0x2c, 0xd1, 0xe4, 0xda, 0x1b, 0x06, 0xee, 0x74, 0x3c, 0x3b, 0xa1, 0xef, 0xd1,
-	0xe4, 0xaf, 0x99, 0x41, 0xbe, 0xd2, 0x71, 0x0b, 0x91, 0xaf, 0xf0, 0x24, 0x55, 0xf5, 0xa2, 0xfb,
-	0xcf, 0x60, 0x8a, 0x06, 0xbd, 0x47, 0x76, 0x20, 0x37, 0xff, 0x3b, 0x90, 0x8a, 0x6b, 0x2e, 0xca,
-	0x9e, 0x35, 0xfe, 0x5b, 0x28, 0x5c, 0xc3, 0x78, 0xab, 0x27, 0x42, 0x60, 0xd0, 0x74, 0x65, 0x0d,
-	0x19, 0xc5, 0xf6, 0x4c, 0x5e, 0xc2, 0xa8, 0xe8, 0x8d, 0xf3, 0x8e, 0xec, 0x45, 0x3c, 0xfa, 0xa7,
-	0xa5, 0xfd, 0xa5, 0x1e, 0x4a, 0xc9, 0x04, 0x1e, 0x37, 0xd3, 0xc0, 0x32, 0xd5, 0x9e, 0x1b, 0xb8,
-	0xd3, 0x51, 0xfc, 0x3b, 0x0e, 0x5f, 0xc1, 0xd9, 0x1e, 0x86, 0xc6, 0x95, 0x9e, 0xe3, 0xcd, 0xf2,
-	0xdd, 0x4d, 0xd7, 0xca, 0x4e, 0x2e, 0x5c, 0xc0, 0xf9, 0xde, 0x97, 0xa5, 0xa5, 0x28, 0x35, 0x23,
-	0x01, 0x8c, 0xf3, 0x0e, 0x6c, 0xdc, 0x6b, 0x59, 0xb6, 0x53, 0xb3, 0x02, 0x4e, 0x5b, 0x92, 0x25,
-	0x53, 0x15, 0x4f, 0x18, 0xb9, 0x85, 0xb3, 0x3d, 0xac, 0xe4, 0x9c, 0xfe, 0xfb, 0x25, 0x4f, 0x02,
-	0x7a, 0xa0, 0xa1, 0xcb, 0xc5, 0xf7, 0x8d, 0xef, 0xfc, 0xd8, 0xf8, 0xce, 0xcf, 0x8d, 0xef, 0x7c,
-	0x78, 0x71, 0x60, 0xd5, 0x76, 0x76, 0x15, 0x25, 0x4f, 0x56, 0x9c, 0x95, 0xe6, 0x6e, 0x68, 0x57,
-	0x6b, 0xfe, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x21, 0x3b, 0xa8, 0x3c, 0xcc, 0x03, 0x00, 0x00,
+	// 761 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xdf, 0x6a, 0xe3, 0x46,
+	0x14, 0xc6, 0x91, 0xed, 0x38, 0xf6, 0x38, 0x81, 0x64, 0x5a, 0x1a, 0xe1, 0x0b, 0xc7, 0x88, 0x5e,
+	0x98, 0x42, 0x47, 0xc4, 0x26, 0xa5, 0x14, 0x5a, 0x4a, 0xfe, 0x40, 0x28, 0x49, 0x6a, 0x64, 0x72,
+	0xd1, 0x12, 0x28, 0x13, 0x69, 0x22, 0xa9, 0x96, 0x35, 0xd3, 0x99, 0x91, 0x40, 0xa5, 0xb7, 0xa5,
+	0x6f, 0xd4, 0x67, 0xd8, 0xcb, 0x7d, 0x84, 0x25, 0xaf, 0xb0, 0x2f, 0xb0, 0xcc, 0x68, 0x64, 0x4b,
+	0xeb, 0xcd, 0xe6, 0x22, 0x57, 0x9e, 0x73, 0xce, 0xf8, 0x1c, 0xcd, 0xef, 0xfb, 0xa4, 0x01, 0x63,
+	0x9f, 0xae, 0x56, 0xb1, 0x14, 0x84, 0xe7, 0x84, 0xbb, 0x65, 0x60, 0x7e, 0x10, 0xe3, 0x54, 0xd2,
+	0xe1, 0x75, 0x18, 0xcb, 0x28, 0x7b, 0x40, 0x3e, 0x5d, 0xb9, 0x98, 0x87, 0x94, 0x71, 0xfa, 0xa7,
+	0x5e, 0x7c, 0xeb, 0x07, 0x6e, 0x3e, 0x73, 0xd9, 0x32, 0x74, 0x31, 0x8b, 0x85, 0x8b, 0x19, 0x4b,
+	0x62, 0x1f, 0xcb, 0x98, 0xa6, 0x6e, 0x7e, 0x82, 0x13, 0x16, 0xe1, 0x13, 0x37, 0x24, 0x29, 0xe1,
+	0x58, 0x92, 0xa0, 0xec, 0xe6, 0xfc, 0xdf, 0x01, 0xa3, 0x73, 0xdd, 0xfe, 0xaa, 0x08, 0x74, 0xe1,
+	0x06, 0xa7, 0xf1, 0x23, 0x11, 0x52, 0x78, 0xe4, 0xaf, 0x8c, 0x08, 0x09, 0xef, 0x41, 0x87, 0x13,
+	0x46, 0x6d, 0x6b, 0x6c, 0x4d, 0x06, 0xd3, 0x2b, 0xb4, 0x99, 0x8f, 0xaa, 0xf9, 0x7a, 0xf1, 0x87,
+	0x1f, 0xa0, 0x7c, 0x86, 0xd8, 0x32, 0x44, 0x6a, 0x3e, 0xaa, 0xcd, 0x47, 0xd5, 0x7c, 0xe4, 0x11,
+	0x46, 0x45, 0x2c, 0x29, 0x2f, 0x3c, 0xdd, 0x15, 0x8e, 0x00, 0x10, 0x45, 0xea, 0x9f, 0x71, 0x9c,
+	0xfa, 0x91, 0xdd, 0x1a, 0x5b, 0x93, 0xbe, 0x57, 0xcb, 0x40, 0x07, 0xec, 0x49, 0xcc, 0x43, 0x22,
+	0xcd, 0x8e, 0xb6, 0xde, 0xd1, 0xc8, 0xc1, 0xaf, 0x40, 0x37, 0xe0, 0xc5, 0x22, 0xc2, 0x76, 0x47,
+	0x57, 0x4d, 0x04, 0xbf, 0x06, 0xfb, 0x25, 0xba, 0x1b, 0x22, 0x04, 0x0e, 0x89, 0xbd, 0xa3, 0xcb,
+	0xcd, 0x24, 0x74, 0xc0, 0x0e, 0xc3, 0x32, 0x12, 0x76, 0x77, 0xdc, 0x9e, 0x0c, 0xa6, 0x7b, 0x68,
+	0x8e, 0x65, 0x74, 0x41, 0x24, 0x8e, 0x13, 0xe1, 0x95, 0x25, 0xf8, 0x0f, 0x38, 0x0c, 0x78, 0x71,
+	0x6e, 0xfe, 0x27, 0x71, 0x80, 0x25, 0xb6, 0x77, 0x35, 0x90, 0xdb, 0xd7, 0x02, 0xc9, 0x63, 0x11,
+	0xd3, 0xb4, 0xea, 0xea, 0x6d, 0x0f, 0x82, 0x1c, 0x0c, 0x58, 0x96, 0x24, 0x46, 0x10, 0xbb, 0xa7,
+	0xe7, 0xce, 0x5f, 0x37, 0xd7, 0xc8, 0x3d, 0xdf, 0xf4, 0xf5, 0xea, 0x43, 0x94, 0x2e, 0x01, 0x2f,
+	0x94, 0x5c, 0x77, 0xde, 0xb5, 0xdd, 0x2f, 0x75, 0xd9, 0x64, 0x9c, 0xff, 0x5a, 0x60, 0x50, 0x03,
+	0x05, 0x21, 0xe8, 0x28, 0x54, 0xda, 0x25, 0x7d, 0x4f, 0xaf, 0xe1, 0x77, 0xa0, 0xbf, 0xaa, 0xdc,
+	0x64, 0xb7, 0x34, 0x5d, 0x1b, 0x7d, 0xec, 0xb3, 0x8a, 0xf4, 0x66, 0x2b, 0x1c, 0x82, 0x9e, 0x92,
+	0x08, 0xa7, 0x81, 0xb0, 0xdb, 0xe3, 0xf6, 0xa4, 0xef, 0xad, 0x63, 0x38, 0xd5, 0xcf, 0xb5, 0xa0,
+	0x19, 0xf7, 0x89, 0xb0, 0x3b, 0xba, 0x29, 0x44, 0x17, 0x55, 0xaa, 0xc2, 0xe9, 0xd5, 0x76, 0xa9,
+	0xb3, 0x60, 0xc6, 0x38, 0xcd, 0x49, 0x70, 0x56, 0x18, 0x13, 0xd4, 0x32, 0xca, 0x3f, 0x09, 0x2e,
+	0x68, 0x26, 0xed, 0x6e, 0xe9, 0x9f, 0x32, 0x52, 0xfe, 0x59, 0x66, 0x42, 0xd2, 0x55, 0xfc, 0xb7,
+	0xa6, 0xa7, 0x15, 0xef, 0x79, 0xcd, 0xa4, 0xf3, 0xaf, 0x05, 0x0e, 0xb7, 0xe6, 0x43, 0x1b, 0xec,
+	0x72, 0x03, 0xaf, 0x44, 0x52, 0x85, 0x6b, 0x52, 0xad, 0x1a, 0xa9, 0x2f, 0xc1, 0x8e, 0x1f, 0x61,
+	0x2e, 0x8d, 0xbd, 0xcb, 0x00, 0x1e, 0x80, 0x36, 0x27, 0x8f, 0xc6, 0xd4, 0x6a, 0xa9, 0xc8, 0x70,
+	0x33, 0xc1, 0x9c, 0x63, 0x1d, 0x3b, 0x3f, 0x82, 0xa3, 0x67, 0xd8, 0xaa, 0x97, 0xa8, 0xa2, 0xfb,
+	0xcb, 0xe2, 0xd7, 0x5b, 0xf3, 0x44, 0x8d, 0x9c, 0xf3, 0xbe, 0x05, 0x8e, 0x9f, 0xfd, 0x12, 0x08,
+	0x46, 0x53, 0x41, 0xe0, 0x18, 0x0c, 0x22, 0x53, 0x54, 0x6f, 0x5b, 0xd9, 0xa6, 0x9e, 0x82, 0xa7,
+	0x4d, 0xab, 0xb6, 0xb4, 0x55, 0xbf, 0x40, 0x35, 0x9b, 0x55, 0x7a, 0x37, 0xdc, 0x86, 0x00, 0x5c,
+	0xcb, 0x7f, 0x97, 0xfa, 0x11, 0x4e, 0x43, 0x12, 0x68, 0x18, 0x3d, 0xef, 0x13, 0x15, 0xf8, 0x1b,
+	0xd8, 0x57, 0xdc, 0x2a, 0xda, 0x95, 0x11, 0x66, 0xe8, 0x85, 0x13, 0xa0, 0x79, 0xfd, 0x5f, 0x97,
+	0xa9, 0xe4, 0x85, 0xd7, 0xec, 0x04, 0xbf, 0x01, 0x07, 0xb5, 0x27, 0xbb, 0xe4, 0x9c, 0x72, 0x83,
+	0x7a, 0x2b, 0x3f, 0xfc, 0x19, 0xc0, 0xed, 0x86, 0x4a, 0xb6, 0x25, 0x29, 0x0c, 0x1d, 0xb5, 0x54,
+	0xf2, 0xe6, 0x38, 0xc9, 0x88, 0xd1, 0xbc, 0x0c, 0x7e, 0x68, 0x7d, 0x6f, 0x39, 0x3f, 0x01, 0xb8,
+	0xcd, 0x46, 0x19, 0x32, 0xcd, 0x56, 0x0f, 0x84, 0xeb, 0x26, 0x6d, 0xcf, 0x44, 0xaa, 0x73, 0xc6,
+	0x13, 0xd3, 0x45, 0x2d, 0xa7, 0x2b, 0xb0, 0x5f, 0x1e, 0x79, 0x41, 0x78, 0x1e, 0xfb, 0x04, 0xde,
+	0x83, 0xa3, 0x67, 0x18, 0xc0, 0x63, 0xf4, 0xf9, 0x2f, 0xfd, 0x70, 0xfc, 0x12, 0xbe, 0xb3, 0xf3,
+	0x37, 0x4f, 0x23, 0xeb, 0xed, 0xd3, 0xc8, 0x7a, 0xf7, 0x34, 0xb2, 0x7e, 0x3f, 0x7d, 0xe1, 0x2a,
+	0x6a, 0xdc, 0x65, 0x98, 0xc5, 0x7e, 0x12, 0x93, 0x54, 0x3e, 0x74, 0xf5, 0xd5, 0x33, 0xfb, 0x30,
+	0x00, 0x42, 0xf7, 0x84, 0x72, 0xec, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -446,6 +707,25 @@ func (m *CommitHydratedManifestsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DryRepoURL) > 0 {
+		i -= len(m.DryRepoURL)
+		copy(dAtA[i:], m.DryRepoURL)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.DryRepoURL)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.DryCommitMetadata != nil {
 		{
 			size, err := m.DryCommitMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -539,6 +819,44 @@ func (m *PathDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Kustomization {
+		i--
+		if m.Kustomization {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Layout) > 0 {
+		i -= len(m.Layout)
+		copy(dAtA[i:], m.Layout)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.Layout)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ApprovedBy) > 0 {
+		i -= len(m.ApprovedBy)
+		copy(dAtA[i:], m.ApprovedBy)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.ApprovedBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DrySources) > 0 {
+		for iNdEx := len(m.DrySources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DrySources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Commands) > 0 {
 		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Commands[iNdEx])
@@ -572,6 +890,68 @@ func (m *PathDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DrySourceRevision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrySourceRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrySourceRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Revision) > 0 {
+		i -= len(m.Revision)
+		copy(dAtA[i:], m.Revision)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.Revision)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Ref) > 0 {
+		i -= len(m.Ref)
+		copy(dAtA[i:], m.Ref)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.Ref)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Chart) > 0 {
+		i -= len(m.Chart)
+		copy(dAtA[i:], m.Chart)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.Chart)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RepoURL) > 0 {
+		i -= len(m.RepoURL)
+		copy(dAtA[i:], m.RepoURL)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.RepoURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HydratedManifestDetails) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -630,6 +1010,54 @@ func (m *CommitHydratedManifestsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PullRequestError) > 0 {
+		i -= len(m.PullRequestError)
+		copy(dAtA[i:], m.PullRequestError)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.PullRequestError)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PathRevisions) > 0 {
+		for k := range m.PathRevisions {
+			v := m.PathRevisions[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintCommit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintCommit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintCommit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ManifestsUnchanged {
+		i--
+		if m.ManifestsUnchanged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.HydratedSha) > 0 {
 		i -= len(m.HydratedSha)
 		copy(dAtA[i:], m.HydratedSha)
@@ -640,27 +1068,66 @@ func (m *CommitHydratedManifestsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintCommit(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCommit(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PullRequestDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CommitHydratedManifestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovCommit(uint64(l))
-	}
+
+func (m *PullRequestDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullRequestDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Number != 0 {
+		i = encodeVarintCommit(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCommit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCommit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CommitHydratedManifestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
 	l = len(m.SyncBranch)
 	if l > 0 {
 		n += 1 + l + sovCommit(uint64(l))
@@ -687,6 +1154,14 @@ func (m *CommitHydratedManifestsRequest) Size() (n int) {
 		l = m.DryCommitMetadata.Size()
 		n += 1 + l + sovCommit(uint64(l))
 	}
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.DryRepoURL)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -715,6 +1190,55 @@ func (m *PathDetails) Size() (n int) {
 			n += 1 + l + sovCommit(uint64(l))
 		}
 	}
+	if len(m.DrySources) > 0 {
+		for _, e := range m.DrySources {
+			l = e.Size()
+			n += 1 + l + sovCommit(uint64(l))
+		}
+	}
+	l = len(m.ApprovedBy)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.Layout)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if m.Kustomization {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DrySourceRevision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RepoURL)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.Chart)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.Ref)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -747,6 +1271,44 @@ func (m *CommitHydratedManifestsResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCommit(uint64(l))
 	}
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if m.ManifestsUnchanged {
+		n += 2
+	}
+	if len(m.PathRevisions) > 0 {
+		for k, v := range m.PathRevisions {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCommit(uint64(len(k))) + 1 + len(v) + sovCommit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCommit(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.PullRequestError)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullRequestDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovCommit(uint64(m.Number))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1022,6 +1584,74 @@ func (m *CommitHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequest == nil {
+				m.PullRequest = &v1alpha1.HydratePullRequest{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DryRepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommit(dAtA[iNdEx:])
@@ -1171,60 +1801,43 @@ func (m *PathDetails) Unmarshal(dAtA []byte) error {
 			}
 			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCommit(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySources", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCommit
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HydratedManifestDetails) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCommit
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DrySources = append(m.DrySources, &DrySourceRevision{})
+			if err := m.DrySources[len(m.DrySources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HydratedManifestDetails: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HydratedManifestDetails: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManifestJSON", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1252,23 +1865,369 @@ func (m *HydratedManifestDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ManifestJSON = string(dAtA[iNdEx:postIndex])
+			m.ApprovedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCommit(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCommit
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Layout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomization", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Kustomization = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrySourceRevision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrySourceRevision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrySourceRevision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chart = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ref = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HydratedManifestDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HydratedManifestDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HydratedManifestDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestJSON", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestJSON = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
 
 	if iNdEx > l {
@@ -1337,6 +2296,323 @@ func (m *CommitHydratedManifestsResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.HydratedSha = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequest == nil {
+				m.PullRequest = &PullRequestDetails{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestsUnchanged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ManifestsUnchanged = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathRevisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PathRevisions == nil {
+				m.PathRevisions = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCommit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCommit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCommit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCommit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCommit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthCommit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PathRevisions[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PullRequestError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullRequestDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullRequestDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullRequestDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommit(dAtA[iNdEx:])
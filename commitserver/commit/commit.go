@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/argoproj/argo-cd/v3/controller/hydrator"
@@ -16,21 +17,40 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/io"
-	"github.com/argoproj/argo-cd/v3/util/io/files"
 )
 
+// workingCopiesRootDir is the directory in which the working copies of repositories are kept.
+const workingCopiesRootDir = "/tmp/_commit-service"
+
 // Service is the service that handles commit requests.
 type Service struct {
-	metricsServer     *metrics.Server
-	repoClientFactory RepoClientFactory
+	metricsServer             *metrics.Server
+	repoClientFactory         RepoClientFactory
+	pullRequestServiceFactory PullRequestServiceFactory
+	ociPusherFactory          OCIPusherFactory
+	workingCopies             *workingCopies
 }
 
-// NewService returns a new instance of the commit service.
-func NewService(gitCredsStore git.CredsStore, metricsServer *metrics.Server) *Service {
+// NewService returns a new instance of the commit service. If commitSigner is not nil, hydrated commits are signed.
+// Working copies of repositories are kept on disk until they have not been used for workingCopyExpiration.
+func NewService(gitCredsStore git.CredsStore, metricsServer *metrics.Server, commitSigner *git.CommitSigner, workingCopyExpiration time.Duration) *Service {
 	return &Service{
-		metricsServer:     metricsServer,
-		repoClientFactory: NewRepoClientFactory(gitCredsStore, metricsServer),
+		metricsServer:             metricsServer,
+		repoClientFactory:         NewRepoClientFactory(gitCredsStore, metricsServer, commitSigner),
+		pullRequestServiceFactory: NewPullRequestServiceFactory(),
+		ociPusherFactory:          NewOCIPusherFactory(),
+		workingCopies:             newWorkingCopies(workingCopiesRootDir, workingCopyExpiration),
+	}
+}
+
+// Init restores the working copies left on disk by a previous run and starts removing expired working copies in the
+// background.
+func (s *Service) Init() error {
+	if err := s.workingCopies.restore(); err != nil {
+		return fmt.Errorf("failed to restore working copies: %w", err)
 	}
+	go s.workingCopies.runGC()
+	return nil
 }
 
 type hydratorMetadataFile struct {
@@ -59,6 +79,18 @@ git checkout {{ .DrySHA }}
 {{ range $command := .Commands -}}
 {{ $command }}
 {{ end -}}` + "```" + `
+{{ if .DrySources -}}
+
+## Additional Dry Sources
+
+{{ range $source := .DrySources -}}
+* {{ $source.RepoURL }}{{ if $source.Path }} ({{ $source.Path }}){{ else if $source.Chart }} ({{ $source.Chart }}){{ end }}{{ if $source.Ref }} as ` + "`${{ $source.Ref }}`" + `{{ end }} at {{ $source.DrySHA }}
+{{ end -}}
+{{ end -}}
+{{ if .ApprovedBy -}}
+
+Hydration of these manifests was approved by {{ .ApprovedBy }}.
+{{ end -}}
 {{ if .References -}}
 
 ## References
@@ -72,8 +104,9 @@ git checkout {{ .DrySHA }}
 
 // CommitHydratedManifests handles a commit request. It clones the repository, checks out the sync branch, checks out
 // the target branch, clears the repository contents, writes the manifests to the repository, commits the changes, and
-// pushes the changes. It returns the hydrated revision SHA and an error if one occurred.
-func (s *Service) CommitHydratedManifests(_ context.Context, r *apiclient.CommitHydratedManifestsRequest) (*apiclient.CommitHydratedManifestsResponse, error) {
+// pushes the changes. If a pull request is requested, it opens or updates a pull request from the target branch into
+// the sync branch. It returns the hydrated revision SHA and an error if one occurred.
+func (s *Service) CommitHydratedManifests(ctx context.Context, r *apiclient.CommitHydratedManifestsRequest) (*apiclient.CommitHydratedManifestsResponse, error) {
 	// This method is intentionally short. It's a wrapper around handleCommitRequest that adds metrics and logging.
 	// Keep logic here minimal and put most of the logic in handleCommitRequest.
 	startTime := time.Now()
@@ -99,7 +132,7 @@ func (s *Service) CommitHydratedManifests(_ context.Context, r *apiclient.Commit
 
 	logCtx := log.WithFields(log.Fields{"branch": r.TargetBranch, "drySHA": r.DrySha})
 
-	out, sha, err := s.handleCommitRequest(logCtx, r)
+	out, resp, err := s.handleCommitRequest(ctx, logCtx, r)
 	if err != nil {
 		logCtx.WithError(err).WithField("output", out).Error("failed to handle commit request")
 
@@ -108,39 +141,47 @@ func (s *Service) CommitHydratedManifests(_ context.Context, r *apiclient.Commit
 	}
 
 	logCtx.Info("Successfully handled commit request")
-	return &apiclient.CommitHydratedManifestsResponse{
-		HydratedSha: sha,
-	}, nil
+	return resp, nil
 }
 
 // handleCommitRequest handles the commit request. It clones the repository, checks out the sync branch, checks out the
-// target branch, clears the repository contents, writes the manifests to the repository, commits the changes, and pushes
-// the changes. It returns the output of the git commands and an error if one occurred.
-func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, string, error) {
+// target branch, clears the repository contents, writes the manifests to the repository, commits the changes, pushes
+// the changes, and opens a pull request if one was requested. If the manifests for every path are already on the target
+// branch, no commit is made. If the repository is an OCI repository, the manifests are pushed as OCI artifacts instead.
+// It returns the output of the git commands, the response to send back to the client, and an error if one occurred.
+func (s *Service) handleCommitRequest(ctx context.Context, logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, *apiclient.CommitHydratedManifestsResponse, error) {
 	if r.Repo == nil {
-		return "", "", errors.New("repo is required")
+		return "", nil, errors.New("repo is required")
 	}
 	if r.Repo.Repo == "" {
-		return "", "", errors.New("repo URL is required")
+		return "", nil, errors.New("repo URL is required")
 	}
 	if r.TargetBranch == "" {
-		return "", "", errors.New("target branch is required")
+		return "", nil, errors.New("target branch is required")
 	}
 	if r.SyncBranch == "" {
-		return "", "", errors.New("sync branch is required")
+		return "", nil, errors.New("sync branch is required")
 	}
 
 	logCtx = logCtx.WithField("repo", r.Repo.Repo)
+	if isOCIRequest(r) {
+		resp, err := s.handleOCIPushRequest(ctx, logCtx, r)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to push manifests to OCI repository: %w", err)
+		}
+		return "", resp, nil
+	}
+
 	logCtx.Debug("Initiating git client")
 	gitClient, dirPath, cleanup, err := s.initGitClient(logCtx, r)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to init git client: %w", err)
+		return "", nil, fmt.Errorf("failed to init git client: %w", err)
 	}
 	defer cleanup()
 
 	root, err := os.OpenRoot(dirPath)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to open root dir: %w", err)
+		return "", nil, fmt.Errorf("failed to open root dir: %w", err)
 	}
 	defer io.Close(root)
 
@@ -148,13 +189,24 @@ func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydr
 	var out string
 	out, err = gitClient.CheckoutOrOrphan(r.SyncBranch, false)
 	if err != nil {
-		return out, "", fmt.Errorf("failed to checkout sync branch: %w", err)
+		return out, nil, fmt.Errorf("failed to checkout sync branch: %w", err)
 	}
 
 	logCtx.Debugf("Checking out target branch %s", r.TargetBranch)
 	out, err = gitClient.CheckoutOrNew(r.TargetBranch, r.SyncBranch, false)
 	if err != nil {
-		return out, "", fmt.Errorf("failed to checkout target branch: %w", err)
+		return out, nil, fmt.Errorf("failed to checkout target branch: %w", err)
+	}
+
+	unchanged, err := manifestsUnchanged(root, r.Paths)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to compare manifests: %w", err)
+	}
+	// The target branch only exists locally if it was just created from the sync branch, in which case it still needs
+	// to be pushed.
+	if unchanged && gitClient.IsRevisionPresent("origin/"+r.TargetBranch) {
+		logCtx.Info("Manifests are unchanged, skipping commit")
+		return s.finishCommitRequest(ctx, logCtx, gitClient, r, true)
 	}
 
 	logCtx.Debug("Clearing and preparing paths")
@@ -174,44 +226,103 @@ func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydr
 		logCtx.Debugf("Clearing paths: %v", pathsToClear)
 		out, err := gitClient.RemoveContents(pathsToClear)
 		if err != nil {
-			return out, "", fmt.Errorf("failed to clear paths %v: %w", pathsToClear, err)
+			return out, nil, fmt.Errorf("failed to clear paths %v: %w", pathsToClear, err)
 		}
 	}
 
 	logCtx.Debug("Writing manifests")
 	err = WriteForPaths(root, r.Repo.Repo, r.DrySha, r.DryCommitMetadata, r.Paths)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to write manifests: %w", err)
+		return "", nil, fmt.Errorf("failed to write manifests: %w", err)
 	}
 
 	logCtx.Debug("Committing and pushing changes")
 	out, err = gitClient.CommitAndPush(r.TargetBranch, r.CommitMessage)
 	if err != nil {
-		return out, "", fmt.Errorf("failed to commit and push: %w", err)
+		return out, nil, fmt.Errorf("failed to commit and push: %w", err)
 	}
 
+	return s.finishCommitRequest(ctx, logCtx, gitClient, r, false)
+}
+
+// finishCommitRequest builds the response for the commit request from the commit checked out in the working copy, and
+// opens a pull request if one was requested. If the manifests were unchanged, the metadata of the request is recorded
+// in a note on the existing commit instead, since its hydrator.metadata files still describe the previous hydration.
+// Since the manifests were already pushed, failing to open the pull request does not fail the request: the error is
+// reported in the response instead, so that the push is not retried.
+func (s *Service) finishCommitRequest(ctx context.Context, logCtx *log.Entry, gitClient git.Client, r *apiclient.CommitHydratedManifestsRequest, manifestsUnchanged bool) (string, *apiclient.CommitHydratedManifestsResponse, error) {
 	logCtx.Debug("Getting commit SHA")
 	sha, err := gitClient.CommitSHA()
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get commit SHA: %w", err)
+		return "", nil, fmt.Errorf("failed to get commit SHA: %w", err)
+	}
+	resp := &apiclient.CommitHydratedManifestsResponse{HydratedSha: sha, ManifestsUnchanged: manifestsUnchanged}
+
+	if manifestsUnchanged {
+		// The note only records metadata, so failing to push it does not fail the request.
+		if err := addMetadataNote(gitClient, sha, r); err != nil {
+			logCtx.WithError(err).Warn("failed to add hydrator metadata note")
+		}
+	}
+
+	if r.PullRequest != nil && r.TargetBranch != r.SyncBranch {
+		logCtx.Debugf("Opening pull request from %s into %s", r.TargetBranch, r.SyncBranch)
+		resp.PullRequest, err = s.openPullRequest(ctx, r)
+		if err != nil {
+			logCtx.WithError(err).Error("failed to open pull request")
+			resp.PullRequestError = err.Error()
+		}
+	}
+
+	return "", resp, nil
+}
+
+// addMetadataNote records the hydrator metadata of the request in the hydrator.metadata note of the given commit.
+func addMetadataNote(gitClient git.Client, sha string, r *apiclient.CommitHydratedManifestsRequest) error {
+	note, err := renderMetadataNote(r.Repo.Repo, r.DrySha, r.DryCommitMetadata, r.Paths)
+	if err != nil {
+		return fmt.Errorf("failed to render hydrator metadata note: %w", err)
+	}
+	out, err := gitClient.AddAndPushNote(sha, hydratorMetadataNotesNamespace, note)
+	if err != nil {
+		return fmt.Errorf("failed to add note: %s: %w", out, err)
 	}
+	return nil
+}
 
-	return "", sha, nil
+// openPullRequest opens a pull request from the target branch into the sync branch, or updates the existing one. The
+// pull request title and body are taken from the subject and body of the commit message.
+func (s *Service) openPullRequest(ctx context.Context, r *apiclient.CommitHydratedManifestsRequest) (*apiclient.PullRequestDetails, error) {
+	svc, err := s.pullRequestServiceFactory.NewPullRequestService(ctx, r.Repo, r.PullRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull request service: %w", err)
+	}
+	title, body, _ := strings.Cut(r.CommitMessage, "\n")
+	pr, err := svc.CreateOrUpdate(ctx, r.TargetBranch, r.SyncBranch, title, strings.TrimSpace(body))
+	if err != nil {
+		return nil, err
+	}
+	return &apiclient.PullRequestDetails{
+		Number: int64(pr.Number),
+		Url:    pr.URL,
+	}, nil
 }
 
 // initGitClient initializes a git client for the given repository and returns the client, the path to the directory where
-// the repository is cloned, a cleanup function that should be called when the directory is no longer needed, and an error
-// if one occurred.
+// the repository is checked out, a cleanup function that should be called when the directory is no longer needed, and
+// an error if one occurred. The working copy of the repository is reused across requests, so only the commits pushed
+// since the previous request need to be fetched.
 func (s *Service) initGitClient(logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (git.Client, string, func(), error) {
-	dirPath, err := files.CreateTempDir("/tmp/_commit-service")
+	wc, err := s.workingCopies.acquire(r.Repo.Repo)
 	if err != nil {
-		return nil, "", nil, fmt.Errorf("failed to create temp dir: %w", err)
+		return nil, "", nil, fmt.Errorf("failed to acquire working copy: %w", err)
 	}
-	// Call cleanupOrLog in this function if an error occurs to ensure the temp dir is cleaned up.
+	dirPath := wc.path
+	// Call cleanupOrLog in this function if an error occurs to ensure a working copy in an unknown state is not reused.
 	cleanupOrLog := func() {
-		err := os.RemoveAll(dirPath)
+		err := s.workingCopies.discard(wc)
 		if err != nil {
-			logCtx.WithError(err).Error("failed to cleanup temp dir")
+			logCtx.WithError(err).Error("failed to discard working copy")
 		}
 	}
 
@@ -235,6 +346,14 @@ func (s *Service) initGitClient(logCtx *log.Entry, r *apiclient.CommitHydratedMa
 		return nil, "", nil, fmt.Errorf("failed to clone repo: %w", err)
 	}
 
+	// Branches checked out by a previous request may be behind their remote, or contain commits which failed to push.
+	logCtx.Debug("Deleting local branches")
+	out, err := gitClient.DeleteLocalBranches()
+	if err != nil {
+		cleanupOrLog()
+		return nil, "", nil, fmt.Errorf("failed to delete local branches: %s: %w", out, err)
+	}
+
 	// FIXME: make it work for GHE
 	// logCtx.Debugf("Getting user info for repo credentials")
 	// gitCreds := r.Repo.GetGitCreds(s.gitCredsStore)
@@ -262,5 +381,8 @@ func (s *Service) initGitClient(logCtx *log.Entry, r *apiclient.CommitHydratedMa
 		return nil, "", nil, fmt.Errorf("failed to set author: %w", err)
 	}
 
-	return gitClient, dirPath, cleanupOrLog, nil
+	release := func() {
+		s.workingCopies.release(wc)
+	}
+	return gitClient, dirPath, release, nil
 }
@@ -1,12 +1,17 @@
 package commit
 
 import (
+	"context"
+	"encoding/json"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
 	"github.com/argoproj/argo-cd/v3/commitserver/commit/mocks"
 	"github.com/argoproj/argo-cd/v3/commitserver/metrics"
@@ -96,6 +101,7 @@ func Test_CommitHydratedManifests(t *testing.T) {
 		mockGitClient := gitmocks.NewClient(t)
 		mockGitClient.On("Init").Return(nil).Once()
 		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("DeleteLocalBranches").Return("", nil).Once()
 		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
 		mockGitClient.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
 		mockGitClient.On("CheckoutOrNew", "main", "env/test", false).Return("", nil).Once()
@@ -116,6 +122,7 @@ func Test_CommitHydratedManifests(t *testing.T) {
 		mockGitClient := gitmocks.NewClient(t)
 		mockGitClient.On("Init").Return(nil).Once()
 		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("DeleteLocalBranches").Return("", nil).Once()
 		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
 		mockGitClient.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
 		mockGitClient.On("CheckoutOrNew", "main", "env/test", false).Return("", nil).Once()
@@ -163,6 +170,7 @@ func Test_CommitHydratedManifests(t *testing.T) {
 		mockGitClient := gitmocks.NewClient(t)
 		mockGitClient.On("Init").Return(nil).Once()
 		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("DeleteLocalBranches").Return("", nil).Once()
 		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
 		mockGitClient.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
 		mockGitClient.On("CheckoutOrNew", "main", "env/test", false).Return("", nil).Once()
@@ -203,6 +211,7 @@ func Test_CommitHydratedManifests(t *testing.T) {
 		mockGitClient := gitmocks.NewClient(t)
 		mockGitClient.On("Init").Return(nil).Once()
 		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("DeleteLocalBranches").Return("", nil).Once()
 		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
 		mockGitClient.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
 		mockGitClient.On("CheckoutOrNew", "main", "env/test", false).Return("", nil).Once()
@@ -259,6 +268,7 @@ func Test_CommitHydratedManifests(t *testing.T) {
 		mockGitClient := gitmocks.NewClient(t)
 		mockGitClient.On("Init").Return(nil).Once()
 		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("DeleteLocalBranches").Return("", nil).Once()
 		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
 		mockGitClient.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
 		mockGitClient.On("CheckoutOrNew", "main", "env/test", false).Return("", nil).Once()
@@ -280,6 +290,302 @@ func Test_CommitHydratedManifests(t *testing.T) {
 		require.NotNil(t, resp)
 		assert.Equal(t, "it-worked!", resp.HydratedSha)
 	})
+
+	t.Run("manifests unchanged", func(t *testing.T) {
+		t.Parallel()
+
+		paths := []*apiclient.PathDetails{
+			{
+				Path: "apps/staging",
+				Manifests: []*apiclient.HydratedManifestDetails{
+					{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test"}}`},
+				},
+			},
+		}
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.On("Init").Return(nil).Once()
+		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("DeleteLocalBranches").Return("", nil).Once()
+		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
+		var rootPath string
+		mockGitClient.On("CheckoutOrNew", "main", "env/test", false).Run(func(_ mock.Arguments) {
+			// Simulate a target branch which already contains the hydrated manifests.
+			root, err := os.OpenRoot(rootPath)
+			require.NoError(t, err)
+			defer root.Close()
+			require.NoError(t, WriteForPaths(root, "https://github.com/argoproj/argocd-example-apps.git", "previous-dry-sha", nil, paths))
+		}).Return("", nil).Once()
+		mockGitClient.On("IsRevisionPresent", "origin/main").Return(true).Once()
+		mockGitClient.On("CommitSHA").Return("existing-sha", nil).Once()
+		var note string
+		mockGitClient.On("AddAndPushNote", "existing-sha", "hydrator.metadata", mock.Anything).Run(func(args mock.Arguments) {
+			note = args.String(2)
+		}).Return("", nil).Once()
+		mockRepoClientFactory.On("NewClient", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			rootPath = args.String(1)
+		}).Return(mockGitClient, nil).Once()
+
+		request := &apiclient.CommitHydratedManifestsRequest{
+			Repo: &v1alpha1.Repository{
+				Repo: "https://github.com/argoproj/argocd-example-apps.git",
+			},
+			DrySha:        "new-dry-sha",
+			TargetBranch:  "main",
+			SyncBranch:    "env/test",
+			CommitMessage: "test commit message",
+			Paths:         paths,
+		}
+
+		resp, err := service.CommitHydratedManifests(t.Context(), request)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, "existing-sha", resp.HydratedSha)
+		assert.True(t, resp.ManifestsUnchanged)
+		mockGitClient.AssertNotCalled(t, "CommitAndPush", mock.Anything, mock.Anything)
+
+		// The hydrator.metadata files still describe the previous hydration, the note describes the current one.
+		var metadata hydratorMetadataNote
+		require.NoError(t, json.Unmarshal([]byte(note), &metadata))
+		assert.Equal(t, "new-dry-sha", metadata.DrySHA)
+		assert.Equal(t, "new-dry-sha", metadata.Paths["apps/staging"].DrySHA)
+	})
+
+	t.Run("manifests unchanged on new target branch", func(t *testing.T) {
+		t.Parallel()
+
+		paths := []*apiclient.PathDetails{
+			{
+				Path: "apps/staging",
+				Manifests: []*apiclient.HydratedManifestDetails{
+					{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test"}}`},
+				},
+			},
+		}
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.On("Init").Return(nil).Once()
+		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("DeleteLocalBranches").Return("", nil).Once()
+		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
+		var rootPath string
+		mockGitClient.On("CheckoutOrNew", "env/test-next", "env/test", false).Run(func(_ mock.Arguments) {
+			// Simulate a target branch which was just created from the sync branch.
+			root, err := os.OpenRoot(rootPath)
+			require.NoError(t, err)
+			defer root.Close()
+			require.NoError(t, WriteForPaths(root, "https://github.com/argoproj/argocd-example-apps.git", "previous-dry-sha", nil, paths))
+		}).Return("", nil).Once()
+		mockGitClient.On("IsRevisionPresent", "origin/env/test-next").Return(false).Once()
+		mockGitClient.On("RemoveContents", []string{"apps/staging"}).Return("", nil).Once()
+		mockGitClient.On("CommitAndPush", "env/test-next", "test commit message").Return("", nil).Once()
+		mockGitClient.On("CommitSHA").Return("new-sha", nil).Once()
+		mockRepoClientFactory.On("NewClient", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			rootPath = args.String(1)
+		}).Return(mockGitClient, nil).Once()
+
+		request := &apiclient.CommitHydratedManifestsRequest{
+			Repo: &v1alpha1.Repository{
+				Repo: "https://github.com/argoproj/argocd-example-apps.git",
+			},
+			DrySha:        "new-dry-sha",
+			TargetBranch:  "env/test-next",
+			SyncBranch:    "env/test",
+			CommitMessage: "test commit message",
+			Paths:         paths,
+		}
+
+		resp, err := service.CommitHydratedManifests(t.Context(), request)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, "new-sha", resp.HydratedSha)
+		assert.False(t, resp.ManifestsUnchanged)
+	})
+
+	t.Run("pull request", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.On("Init").Return(nil).Once()
+		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("DeleteLocalBranches").Return("", nil).Once()
+		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
+		mockGitClient.On("CheckoutOrNew", "env/test-next", "env/test", false).Return("", nil).Once()
+		mockGitClient.On("CommitAndPush", "env/test-next", "subject\n\nbody").Return("", nil).Once()
+		mockGitClient.On("CommitSHA").Return("it-worked!", nil).Once()
+		mockRepoClientFactory.On("NewClient", mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		creator := &fakePullRequestCreator{pr: &pull_request.PullRequest{Number: 42, URL: "https://github.com/argoproj/argocd-example-apps/pull/42"}}
+		mockPullRequestServiceFactory := mocks.NewPullRequestServiceFactory(t)
+		mockPullRequestServiceFactory.On("NewPullRequestService", mock.Anything, mock.Anything, mock.Anything).Return(creator, nil).Once()
+		service.pullRequestServiceFactory = mockPullRequestServiceFactory
+
+		request := &apiclient.CommitHydratedManifestsRequest{
+			Repo: &v1alpha1.Repository{
+				Repo: "https://github.com/argoproj/argocd-example-apps.git",
+			},
+			TargetBranch:  "env/test-next",
+			SyncBranch:    "env/test",
+			CommitMessage: "subject\n\nbody",
+			PullRequest:   &v1alpha1.HydratePullRequest{Provider: v1alpha1.HydratePullRequestProviderGitHub},
+		}
+
+		resp, err := service.CommitHydratedManifests(t.Context(), request)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, "it-worked!", resp.HydratedSha)
+		require.NotNil(t, resp.PullRequest)
+		assert.Equal(t, int64(42), resp.PullRequest.Number)
+		assert.Equal(t, "https://github.com/argoproj/argocd-example-apps/pull/42", resp.PullRequest.Url)
+		assert.Equal(t, "env/test-next", creator.branch)
+		assert.Equal(t, "env/test", creator.targetBranch)
+		assert.Equal(t, "subject", creator.title)
+		assert.Equal(t, "body", creator.body)
+	})
+
+	t.Run("pull request not opened when target branch is the sync branch", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.On("Init").Return(nil).Once()
+		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("DeleteLocalBranches").Return("", nil).Once()
+		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
+		mockGitClient.On("CheckoutOrNew", "env/test", "env/test", false).Return("", nil).Once()
+		mockGitClient.On("CommitAndPush", "env/test", "test commit message").Return("", nil).Once()
+		mockGitClient.On("CommitSHA").Return("it-worked!", nil).Once()
+		mockRepoClientFactory.On("NewClient", mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+		service.pullRequestServiceFactory = mocks.NewPullRequestServiceFactory(t)
+
+		request := &apiclient.CommitHydratedManifestsRequest{
+			Repo: &v1alpha1.Repository{
+				Repo: "https://github.com/argoproj/argocd-example-apps.git",
+			},
+			TargetBranch:  "env/test",
+			SyncBranch:    "env/test",
+			CommitMessage: "test commit message",
+			PullRequest:   &v1alpha1.HydratePullRequest{Provider: v1alpha1.HydratePullRequestProviderGitHub},
+		}
+
+		resp, err := service.CommitHydratedManifests(t.Context(), request)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Nil(t, resp.PullRequest)
+	})
+
+	t.Run("failed to open pull request", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.On("Init").Return(nil).Once()
+		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("DeleteLocalBranches").Return("", nil).Once()
+		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
+		mockGitClient.On("CheckoutOrNew", "env/test-next", "env/test", false).Return("", nil).Once()
+		mockGitClient.On("CommitAndPush", "env/test-next", "test commit message").Return("", nil).Once()
+		mockGitClient.On("CommitSHA").Return("it-worked!", nil).Once()
+		mockRepoClientFactory.On("NewClient", mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		mockPullRequestServiceFactory := mocks.NewPullRequestServiceFactory(t)
+		mockPullRequestServiceFactory.On("NewPullRequestService", mock.Anything, mock.Anything, mock.Anything).Return(&fakePullRequestCreator{err: assert.AnError}, nil).Once()
+		service.pullRequestServiceFactory = mockPullRequestServiceFactory
+
+		request := &apiclient.CommitHydratedManifestsRequest{
+			Repo: &v1alpha1.Repository{
+				Repo: "https://github.com/argoproj/argocd-example-apps.git",
+			},
+			TargetBranch:  "env/test-next",
+			SyncBranch:    "env/test",
+			CommitMessage: "test commit message",
+			PullRequest:   &v1alpha1.HydratePullRequest{Provider: v1alpha1.HydratePullRequestProviderGitHub},
+		}
+
+		// the manifests were pushed, so the hydrated SHA is returned and the error is only reported
+		resp, err := service.CommitHydratedManifests(t.Context(), request)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, "it-worked!", resp.HydratedSha)
+		assert.Nil(t, resp.PullRequest)
+		assert.Equal(t, assert.AnError.Error(), resp.PullRequestError)
+	})
+}
+
+func Test_CommitHydratedManifests_WorkingCopies(t *testing.T) {
+	t.Parallel()
+
+	request := &apiclient.CommitHydratedManifestsRequest{
+		Repo: &v1alpha1.Repository{
+			Repo: "https://github.com/argoproj/argocd-example-apps.git",
+		},
+		TargetBranch:  "main",
+		SyncBranch:    "env/test",
+		CommitMessage: "test commit message",
+	}
+
+	t.Run("working copy is reused", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.On("Init").Return(nil).Twice()
+		mockGitClient.On("Fetch", "").Return(nil).Twice()
+		mockGitClient.On("DeleteLocalBranches").Return("", nil).Twice()
+		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Twice()
+		mockGitClient.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Twice()
+		mockGitClient.On("CheckoutOrNew", "main", "env/test", false).Return("", nil).Twice()
+		mockGitClient.On("CommitAndPush", "main", "test commit message").Return("", nil).Twice()
+		mockGitClient.On("CommitSHA").Return("it-worked!", nil).Twice()
+		var rootPaths []string
+		mockRepoClientFactory.On("NewClient", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			rootPaths = append(rootPaths, args.String(1))
+		}).Return(mockGitClient, nil).Twice()
+
+		_, err := service.CommitHydratedManifests(t.Context(), request)
+		require.NoError(t, err)
+		_, err = service.CommitHydratedManifests(t.Context(), request)
+		require.NoError(t, err)
+
+		require.Len(t, rootPaths, 2)
+		assert.Equal(t, rootPaths[0], rootPaths[1])
+		assert.DirExists(t, rootPaths[0])
+	})
+
+	t.Run("working copy is discarded when fetch fails", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.On("Init").Return(nil).Once()
+		mockGitClient.On("Fetch", "").Return(assert.AnError).Once()
+		var rootPath string
+		mockRepoClientFactory.On("NewClient", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			rootPath = args.String(1)
+		}).Return(mockGitClient, nil).Once()
+
+		_, err := service.CommitHydratedManifests(t.Context(), request)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.NoDirExists(t, rootPath)
+	})
+}
+
+type fakePullRequestCreator struct {
+	pr  *pull_request.PullRequest
+	err error
+
+	branch, targetBranch, title, body string
+}
+
+func (f *fakePullRequestCreator) CreateOrUpdate(_ context.Context, branch, targetBranch, title, body string) (*pull_request.PullRequest, error) {
+	f.branch, f.targetBranch, f.title, f.body = branch, targetBranch, title, body
+	return f.pr, f.err
 }
 
 func newServiceWithMocks(t *testing.T) (*Service, *mocks.RepoClientFactory) {
@@ -287,9 +593,10 @@ func newServiceWithMocks(t *testing.T) (*Service, *mocks.RepoClientFactory) {
 
 	metricsServer := metrics.NewMetricsServer()
 	mockCredsStore := git.NoopCredsStore{}
-	service := NewService(mockCredsStore, metricsServer)
+	service := NewService(mockCredsStore, metricsServer, nil, time.Hour)
 	mockRepoClientFactory := mocks.NewRepoClientFactory(t)
 	service.repoClientFactory = mockRepoClientFactory
+	service.workingCopies = newWorkingCopies(t.TempDir(), time.Hour)
 
 	return service, mockRepoClientFactory
 }
@@ -1,11 +1,18 @@
 package commit
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io/fs"
+	"maps"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
+	"strings"
 	"text/template"
+	"unicode"
 
 	"github.com/Masterminds/sprig/v3"
 	log "github.com/sirupsen/logrus"
@@ -16,7 +23,6 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/hydrator"
-	"github.com/argoproj/argo-cd/v3/util/io"
 )
 
 var sprigFuncMap = sprig.GenericFuncMap() // a singleton for better performance
@@ -38,6 +44,12 @@ func WriteForPaths(root *os.Root, repoUrl, drySha string, dryCommitMetadata *app
 	if err != nil {
 		return fmt.Errorf("failed to retrieve hydrator metadata: %w", err)
 	}
+	// The top-level metadata lists the additional dry sources of all paths.
+	var allDrySources []*apiclient.DrySourceRevision
+	for _, p := range paths {
+		allDrySources = append(allDrySources, p.DrySources...)
+	}
+	hydratorMetadata.DrySources = drySourcesMetadata(allDrySources)
 
 	// Write the top-level readme.
 	err = writeMetadata(root, "", hydratorMetadata)
@@ -66,17 +78,13 @@ func WriteForPaths(root *os.Root, repoUrl, drySha string, dryCommitMetadata *app
 		}
 
 		// Write the manifests
-		err = writeManifests(root, hydratePath, p.Manifests)
+		err = writeManifests(root, hydratePath, p.Manifests, pathLayout(p))
 		if err != nil {
 			return fmt.Errorf("failed to write manifests: %w", err)
 		}
 
 		// Write hydrator.metadata containing information about the hydration process.
-		hydratorMetadata := hydrator.HydratorCommitMetadata{
-			Commands: p.Commands,
-			DrySHA:   drySha,
-			RepoURL:  repoUrl,
-		}
+		hydratorMetadata := pathMetadata(repoUrl, drySha, p)
 		err = writeMetadata(root, hydratePath, hydratorMetadata)
 		if err != nil {
 			return fmt.Errorf("failed to write hydrator metadata: %w", err)
@@ -91,47 +99,152 @@ func WriteForPaths(root *os.Root, repoUrl, drySha string, dryCommitMetadata *app
 	return nil
 }
 
+// hydratorMetadataNotesNamespace is the git notes namespace in which the hydrator metadata of a hydration which did not
+// push a commit, because the manifests were unchanged, is recorded on the existing hydrated commit.
+const hydratorMetadataNotesNamespace = "hydrator.metadata"
+
+// hydratorMetadataNote is the content of the hydrator.metadata note.
+type hydratorMetadataNote struct {
+	hydrator.HydratorCommitMetadata
+	// Paths maps each hydrated path to the metadata which would have been written to its hydrator.metadata file.
+	Paths map[string]hydrator.HydratorCommitMetadata `json:"paths,omitempty"`
+}
+
+// renderMetadataNote renders the hydrator metadata of the given paths as the content of the hydrator.metadata note. It
+// contains the same metadata as the hydrator.metadata files written by WriteForPaths, which are left unchanged when no
+// commit is pushed.
+func renderMetadataNote(repoURL, drySha string, dryCommitMetadata *appv1.RevisionMetadata, paths []*apiclient.PathDetails) (string, error) {
+	metadata, err := hydrator.GetCommitMetadata(repoURL, drySha, dryCommitMetadata)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve hydrator metadata: %w", err)
+	}
+	note := hydratorMetadataNote{HydratorCommitMetadata: metadata, Paths: map[string]hydrator.HydratorCommitMetadata{}}
+	var allDrySources []*apiclient.DrySourceRevision
+	for _, p := range paths {
+		allDrySources = append(allDrySources, p.DrySources...)
+		note.Paths[p.Path] = pathMetadata(repoURL, drySha, p)
+	}
+	note.DrySources = drySourcesMetadata(allDrySources)
+	data, err := renderMetadata(note)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// pathMetadata returns the hydrator metadata which is written to the hydrated path.
+func pathMetadata(repoURL, drySha string, p *apiclient.PathDetails) hydrator.HydratorCommitMetadata {
+	return hydrator.HydratorCommitMetadata{
+		Commands:   p.Commands,
+		DrySHA:     drySha,
+		RepoURL:    repoURL,
+		DrySources: drySourcesMetadata(p.DrySources),
+		ApprovedBy: p.ApprovedBy,
+	}
+}
+
+// pathLayout returns the layout of the manifest files of the path.
+func pathLayout(p *apiclient.PathDetails) *appv1.HydratedManifestLayout {
+	return &appv1.HydratedManifestLayout{
+		Type:          appv1.HydratedManifestLayoutType(p.Layout),
+		Kustomization: p.Kustomization,
+	}
+}
+
+// RenderPathFiles returns the contents of the manifest, hydrator.metadata and README.md files which are written to the
+// hydrated path, keyed by their path relative to the hydrated path. It allows previewing a hydration without
+// committing it.
+func RenderPathFiles(repoURL, drySha string, p *apiclient.PathDetails) (map[string][]byte, error) {
+	files, err := renderManifestFiles(p.Manifests, pathLayout(p))
+	if err != nil {
+		return nil, err
+	}
+	metadata := pathMetadata(repoURL, drySha, p)
+	files["hydrator.metadata"], err = renderMetadata(metadata)
+	if err != nil {
+		return nil, err
+	}
+	files["README.md"], err = renderReadme(metadata)
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// drySourcesMetadata converts the additional dry sources to their metadata representation. Duplicate sources are
+// only listed once.
+func drySourcesMetadata(drySources []*apiclient.DrySourceRevision) []hydrator.DrySourceMetadata {
+	var metadata []hydrator.DrySourceMetadata
+	for _, drySource := range drySources {
+		m := hydrator.DrySourceMetadata{
+			RepoURL: drySource.RepoURL,
+			Path:    drySource.Path,
+			Chart:   drySource.Chart,
+			Ref:     drySource.Ref,
+			DrySHA:  drySource.Revision,
+		}
+		if !slices.Contains(metadata, m) {
+			metadata = append(metadata, m)
+		}
+	}
+	return metadata
+}
+
 // writeMetadata writes the metadata to the hydrator.metadata file.
 func writeMetadata(root *os.Root, dirPath string, metadata hydrator.HydratorCommitMetadata) error {
+	data, err := renderMetadata(metadata)
+	if err != nil {
+		return err
+	}
 	hydratorMetadataPath := filepath.Join(dirPath, "hydrator.metadata")
-	f, err := root.Create(hydratorMetadataPath)
+	err = root.WriteFile(hydratorMetadataPath, data, 0o644)
 	if err != nil {
-		return fmt.Errorf("failed to create hydrator metadata file: %w", err)
+		return fmt.Errorf("failed to write hydrator metadata file: %w", err)
 	}
-	defer io.Close(f)
-	e := json.NewEncoder(f)
+	return nil
+}
+
+// renderMetadata renders the metadata as indented JSON, the way it is written to hydrator.metadata.
+func renderMetadata(metadata any) ([]byte, error) {
+	var buf bytes.Buffer
+	e := json.NewEncoder(&buf)
 	e.SetIndent("", "  ")
 	// We don't need to escape HTML, because we're not embedding this JSON in HTML.
 	e.SetEscapeHTML(false)
-	err = e.Encode(metadata)
+	err := e.Encode(metadata)
 	if err != nil {
-		return fmt.Errorf("failed to encode hydrator metadata: %w", err)
+		return nil, fmt.Errorf("failed to encode hydrator metadata: %w", err)
 	}
-	return nil
+	return buf.Bytes(), nil
 }
 
 // writeReadme writes the readme to the README.md file.
 func writeReadme(root *os.Root, dirPath string, metadata hydrator.HydratorCommitMetadata) error {
-	readmeTemplate, err := template.New("readme").Funcs(sprigFuncMap).Parse(manifestHydrationReadmeTemplate)
+	data, err := renderReadme(metadata)
 	if err != nil {
-		return fmt.Errorf("failed to parse readme template: %w", err)
+		return err
 	}
-	// Create writer to template into
 	// No need to use SecureJoin here, as the path is already sanitized.
 	readmePath := filepath.Join(dirPath, "README.md")
-	readmeFile, err := root.Create(readmePath)
-	if err != nil && !os.IsExist(err) {
-		return fmt.Errorf("failed to create README file: %w", err)
+	err = root.WriteFile(readmePath, data, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to write README file: %w", err)
 	}
-	err = readmeTemplate.Execute(readmeFile, metadata)
-	closeErr := readmeFile.Close()
-	if closeErr != nil {
-		log.WithError(closeErr).Error("failed to close README file")
+	return nil
+}
+
+// renderReadme renders the readme template with the metadata.
+func renderReadme(metadata hydrator.HydratorCommitMetadata) ([]byte, error) {
+	readmeTemplate, err := template.New("readme").Funcs(sprigFuncMap).Parse(manifestHydrationReadmeTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse readme template: %w", err)
 	}
+	var buf bytes.Buffer
+	err = readmeTemplate.Execute(&buf, metadata)
 	if err != nil {
-		return fmt.Errorf("failed to execute readme template: %w", err)
+		return nil, fmt.Errorf("failed to execute readme template: %w", err)
 	}
-	return nil
+	return buf.Bytes(), nil
 }
 
 func writeGitAttributes(root *os.Root) error {
@@ -158,44 +271,267 @@ func writeGitAttributes(root *os.Root) error {
 	return nil
 }
 
-// writeManifests writes the manifests to the manifest.yaml file, truncating the file if it exists and appending the
-// manifests in the order they are provided.
-func writeManifests(root *os.Root, dirPath string, manifests []*apiclient.HydratedManifestDetails) error {
-	// If the file exists, truncate it.
-	// No need to use SecureJoin here, as the path is already sanitized.
-	manifestPath := filepath.Join(dirPath, "manifest.yaml")
-
-	file, err := root.OpenFile(manifestPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.ModePerm)
+// writeManifests writes the manifests to the files of the layout, truncating the files if they exist. Within a file,
+// the manifests are written in the order they are provided.
+func writeManifests(root *os.Root, dirPath string, manifests []*apiclient.HydratedManifestDetails, layout *appv1.HydratedManifestLayout) error {
+	files, err := renderManifestFiles(manifests, layout)
 	if err != nil {
-		return fmt.Errorf("failed to open manifest file: %w", err)
+		return err
 	}
-	defer func() {
-		err := file.Close()
+	for name, data := range files {
+		// No need to use SecureJoin here, as the path is already sanitized.
+		manifestPath := filepath.Join(dirPath, name)
+		if dir := filepath.Dir(name); dir != "." {
+			err = root.MkdirAll(filepath.Join(dirPath, dir), 0o755)
+			if err != nil {
+				return fmt.Errorf("failed to create manifest directory: %w", err)
+			}
+		}
+		err = root.WriteFile(manifestPath, data, os.ModePerm)
 		if err != nil {
-			log.WithError(err).Error("failed to close file")
+			return fmt.Errorf("failed to write manifest file: %w", err)
 		}
-	}()
+	}
+	return nil
+}
 
-	enc := yaml.NewEncoder(file)
-	defer func() {
-		err := enc.Close()
+// renderManifestFiles renders the manifests into the files of the layout, keyed by their path relative to the hydrated
+// path. With the SingleFile layout, all manifests are written to manifest.yaml. With the PerResource layout, each
+// manifest is written to <kind>-<name>.yaml, and with the PerKind layout to <kind>/<name>.yaml. If the names of two
+// manifests collide, the file names are qualified with the namespace and API group of the manifests. If requested, a
+// kustomization.yaml file listing all manifest files in sorted order is added.
+func renderManifestFiles(manifests []*apiclient.HydratedManifestDetails, layout *appv1.HydratedManifestLayout) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	switch layout.GetType() {
+	case appv1.HydratedManifestLayoutSingleFile:
+		data, err := renderManifests(manifests)
 		if err != nil {
-			log.WithError(err).Error("failed to close yaml encoder")
+			return nil, err
 		}
-	}()
+		files["manifest.yaml"] = data
+	case appv1.HydratedManifestLayoutPerResource, appv1.HydratedManifestLayoutPerKind:
+		names, err := manifestFileNames(manifests, layout.GetType())
+		if err != nil {
+			return nil, err
+		}
+		for i, m := range manifests {
+			data, err := renderManifests([]*apiclient.HydratedManifestDetails{m})
+			if err != nil {
+				return nil, err
+			}
+			files[names[i]] = data
+		}
+	default:
+		return nil, fmt.Errorf("unknown manifest layout %q", layout.GetType())
+	}
+
+	if layout != nil && layout.Kustomization {
+		data, err := renderKustomization(slices.Sorted(maps.Keys(files)))
+		if err != nil {
+			return nil, err
+		}
+		files["kustomization.yaml"] = data
+	}
+	return files, nil
+}
+
+// manifestFileNames returns the name of the file each manifest is written to with the given per-resource or per-kind
+// layout. The names are qualified with the namespace, and then the API group, of the manifests until they are unique.
+func manifestFileNames(manifests []*apiclient.HydratedManifestDetails, layoutType appv1.HydratedManifestLayoutType) ([]string, error) {
+	objs := make([]*unstructured.Unstructured, len(manifests))
+	for i, m := range manifests {
+		obj := &unstructured.Unstructured{}
+		err := json.Unmarshal([]byte(m.ManifestJSON), obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal manifest: %w", err)
+		}
+		objs[i] = obj
+	}
+
+	const maxQualification = 2
+	qualifications := make([]int, len(objs))
+	names := make([]string, len(objs))
+	for {
+		for i, obj := range objs {
+			names[i] = manifestFileName(obj, layoutType, qualifications[i])
+		}
+		indexes := map[string][]int{}
+		for i, name := range names {
+			indexes[name] = append(indexes[name], i)
+		}
+		collisions := false
+		for name, colliding := range indexes {
+			if len(colliding) < 2 {
+				continue
+			}
+			collisions = true
+			qualified := false
+			for _, i := range colliding {
+				if qualifications[i] < maxQualification {
+					qualifications[i]++
+					qualified = true
+				}
+			}
+			if !qualified {
+				return nil, fmt.Errorf("multiple manifests would be written to %q", name)
+			}
+		}
+		if !collisions {
+			return names, nil
+		}
+	}
+}
+
+// manifestFileName returns the name of the file the manifest is written to. A qualification of 1 adds the namespace,
+// and a qualification of 2 additionally adds the API group to the name.
+func manifestFileName(obj *unstructured.Unstructured, layoutType appv1.HydratedManifestLayoutType, qualification int) string {
+	parts := []string{obj.GetName()}
+	if qualification >= 1 && obj.GetNamespace() != "" {
+		parts = append([]string{obj.GetNamespace()}, parts...)
+	}
+	if qualification >= 2 && obj.GroupVersionKind().Group != "" {
+		parts = append([]string{obj.GroupVersionKind().Group}, parts...)
+	}
+	name := sanitizeFileName(strings.Join(parts, "-"))
+	kind := sanitizeFileName(obj.GetKind())
+	if layoutType == appv1.HydratedManifestLayoutPerKind {
+		return kind + "/" + name + ".yaml"
+	}
+	return kind + "-" + name + ".yaml"
+}
+
+// sanitizeFileName lower-cases s and replaces all characters which are not safe to use in a file name with an
+// underscore.
+func sanitizeFileName(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '.':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return unicode.ToLower(r)
+		default:
+			return '_'
+		}
+	}, s)
+}
+
+// renderKustomization renders a kustomization.yaml file listing the given manifest files as resources.
+func renderKustomization(resources []string) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	err := enc.Encode(map[string]any{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  resources,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode kustomization: %w", err)
+	}
+	err = enc.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to close yaml encoder: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// renderManifests renders the manifests as a multi-document YAML stream, the way they are written to manifest.yaml.
+func renderManifests(manifests []*apiclient.HydratedManifestDetails) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
 	enc.SetIndent(2)
 
 	for _, m := range manifests {
 		obj := &unstructured.Unstructured{}
-		err = json.Unmarshal([]byte(m.ManifestJSON), obj)
+		err := json.Unmarshal([]byte(m.ManifestJSON), obj)
 		if err != nil {
-			return fmt.Errorf("failed to unmarshal manifest: %w", err)
+			return nil, fmt.Errorf("failed to unmarshal manifest: %w", err)
 		}
 		err = enc.Encode(&obj.Object)
 		if err != nil {
-			return fmt.Errorf("failed to encode manifest: %w", err)
+			return nil, fmt.Errorf("failed to encode manifest: %w", err)
 		}
 	}
+	err := enc.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to close yaml encoder: %w", err)
+	}
+	return buf.Bytes(), nil
+}
 
-	return nil
+// manifestsUnchanged returns true if, for every path, the manifest files on disk are byte-identical to the files which
+// would be written for the path's manifests, and no other manifest files are left in the path. It returns false if
+// there are no paths.
+func manifestsUnchanged(root *os.Root, paths []*apiclient.PathDetails) (bool, error) {
+	if len(paths) == 0 {
+		return false, nil
+	}
+	for _, p := range paths {
+		hydratePath := p.Path
+		if hydratePath == "." {
+			hydratePath = ""
+		}
+		rendered, err := renderManifestFiles(p.Manifests, pathLayout(p))
+		if err != nil {
+			return false, err
+		}
+		for name, data := range rendered {
+			existing, err := root.ReadFile(filepath.Join(hydratePath, name))
+			if err != nil {
+				if os.IsNotExist(err) {
+					return false, nil
+				}
+				return false, fmt.Errorf("failed to read existing manifest file: %w", err)
+			}
+			if !bytes.Equal(existing, data) {
+				return false, nil
+			}
+		}
+		// The root path is not cleared before writing the manifests, so files left in it don't need to be removed.
+		if hydratePath == "" {
+			continue
+		}
+		stale, err := hasStaleManifestFiles(root, hydratePath, rendered)
+		if err != nil {
+			return false, err
+		}
+		if stale {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// hasStaleManifestFiles returns true if the hydrated path contains files, other than the hydrator.metadata and
+// README.md files, which are not among the rendered files, e.g. because a resource was removed or the layout changed.
+// Nested hydrated paths are skipped.
+func hasStaleManifestFiles(root *os.Root, hydratePath string, rendered map[string][]byte) (bool, error) {
+	stale := false
+	err := fs.WalkDir(root.FS(), filepath.ToSlash(hydratePath), func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := strings.TrimPrefix(filePath, filepath.ToSlash(hydratePath)+"/")
+		if d.IsDir() {
+			if filePath == filepath.ToSlash(hydratePath) {
+				return nil
+			}
+			if _, err := root.Stat(path.Join(filePath, "hydrator.metadata")); err == nil {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if name == "hydrator.metadata" || name == "README.md" {
+			return nil
+		}
+		if _, ok := rendered[name]; !ok {
+			stale = true
+			return fs.SkipAll
+		}
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to list existing manifest files: %w", err)
+	}
+	return stale, nil
 }
@@ -142,6 +142,83 @@ Argocd-reference-commit-sha: abc123
 	}
 }
 
+func TestWriteForPaths_AdditionalDrySources(t *testing.T) {
+	root := tempRoot(t)
+
+	valuesSource := &apiclient.DrySourceRevision{RepoURL: "https://github.com/example/values", Ref: "values", Revision: "def456"}
+	paths := []*apiclient.PathDetails{
+		{
+			Path:       "path1",
+			Manifests:  []*apiclient.HydratedManifestDetails{{ManifestJSON: `{"kind":"Pod","apiVersion":"v1"}`}},
+			DrySources: []*apiclient.DrySourceRevision{valuesSource},
+		},
+		{
+			Path:      "path2",
+			Manifests: []*apiclient.HydratedManifestDetails{{ManifestJSON: `{"kind":"Service","apiVersion":"v1"}`}},
+			DrySources: []*apiclient.DrySourceRevision{
+				valuesSource,
+				{RepoURL: "https://charts.example.com", Chart: "redis", Revision: "1.2.3"},
+			},
+		},
+		{
+			Path:      "path3",
+			Manifests: []*apiclient.HydratedManifestDetails{{ManifestJSON: `{"kind":"Deployment","apiVersion":"apps/v1"}`}},
+		},
+	}
+
+	err := WriteForPaths(root, "https://github.com/example/repo", "abc123", nil, paths)
+	require.NoError(t, err)
+
+	readMetadata := func(dirPath string) hydrator.HydratorCommitMetadata {
+		t.Helper()
+		metadataBytes, err := os.ReadFile(filepath.Join(root.Name(), dirPath, "hydrator.metadata"))
+		require.NoError(t, err)
+		var metadata hydrator.HydratorCommitMetadata
+		require.NoError(t, json.Unmarshal(metadataBytes, &metadata))
+		return metadata
+	}
+	valuesMetadata := hydrator.DrySourceMetadata{RepoURL: "https://github.com/example/values", Ref: "values", DrySHA: "def456"}
+	chartMetadata := hydrator.DrySourceMetadata{RepoURL: "https://charts.example.com", Chart: "redis", DrySHA: "1.2.3"}
+
+	// The top-level metadata lists the dry sources of all paths, without duplicates.
+	assert.Equal(t, []hydrator.DrySourceMetadata{valuesMetadata, chartMetadata}, readMetadata("").DrySources)
+	assert.Equal(t, []hydrator.DrySourceMetadata{valuesMetadata}, readMetadata("path1").DrySources)
+	assert.Equal(t, []hydrator.DrySourceMetadata{valuesMetadata, chartMetadata}, readMetadata("path2").DrySources)
+	assert.Empty(t, readMetadata("path3").DrySources)
+
+	readmeBytes, err := os.ReadFile(filepath.Join(root.Name(), "path2", "README.md"))
+	require.NoError(t, err)
+	assert.Contains(t, string(readmeBytes), `## Additional Dry Sources
+
+* https://github.com/example/values as `+"`$values`"+` at def456
+* https://charts.example.com (redis) at 1.2.3
+`)
+}
+
+func TestRenderPathFiles(t *testing.T) {
+	root := tempRoot(t)
+
+	p := &apiclient.PathDetails{
+		Path:       "path1",
+		Manifests:  []*apiclient.HydratedManifestDetails{{ManifestJSON: `{"kind":"Pod","apiVersion":"v1"}`}},
+		Commands:   []string{"kustomize build ."},
+		DrySources: []*apiclient.DrySourceRevision{{RepoURL: "https://github.com/example/values", Ref: "values", Revision: "def456"}},
+	}
+	err := WriteForPaths(root, "https://github.com/example/repo", "abc123", nil, []*apiclient.PathDetails{p})
+	require.NoError(t, err)
+
+	files, err := RenderPathFiles("https://github.com/example/repo", "abc123", p)
+	require.NoError(t, err)
+
+	// The rendered files are identical to the files which are committed.
+	require.Len(t, files, 3)
+	for name, content := range files {
+		written, err := os.ReadFile(filepath.Join(root.Name(), "path1", name))
+		require.NoError(t, err)
+		assert.Equal(t, string(written), string(content), name)
+	}
+}
+
 func TestWriteMetadata(t *testing.T) {
 	root := tempRoot(t)
 
@@ -209,6 +286,23 @@ git checkout abc123
 `, sha[:7]), string(readmeBytes))
 }
 
+func TestWriteReadme_ApprovedBy(t *testing.T) {
+	root := tempRoot(t)
+
+	metadata := hydrator.HydratorCommitMetadata{
+		RepoURL:    "https://github.com/example/repo",
+		DrySHA:     "abc123",
+		ApprovedBy: "alice",
+	}
+
+	err := writeReadme(root, "", metadata)
+	require.NoError(t, err)
+
+	readmeBytes, err := os.ReadFile(filepath.Join(root.Name(), "README.md"))
+	require.NoError(t, err)
+	assert.Contains(t, string(readmeBytes), "Hydration of these manifests was approved by alice.")
+}
+
 func TestWriteManifests(t *testing.T) {
 	root := tempRoot(t)
 
@@ -216,7 +310,7 @@ func TestWriteManifests(t *testing.T) {
 		{ManifestJSON: `{"kind":"Pod","apiVersion":"v1"}`},
 	}
 
-	err := writeManifests(root, "", manifests)
+	err := writeManifests(root, "", manifests, nil)
 	require.NoError(t, err)
 
 	manifestPath := path.Join(root.Name(), "manifest.yaml")
@@ -225,6 +319,157 @@ func TestWriteManifests(t *testing.T) {
 	assert.Contains(t, string(manifestBytes), "kind")
 }
 
+func TestManifestsUnchanged(t *testing.T) {
+	manifests := []*apiclient.HydratedManifestDetails{
+		{ManifestJSON: `{"kind":"Pod","apiVersion":"v1","metadata":{"name":"pod"}}`},
+		{ManifestJSON: `{"kind":"Service","apiVersion":"v1","metadata":{"name":"svc"}}`},
+	}
+	paths := []*apiclient.PathDetails{
+		{Path: ".", Manifests: manifests},
+		{Path: "apps/staging", Manifests: manifests},
+	}
+
+	t.Run("no paths", func(t *testing.T) {
+		unchanged, err := manifestsUnchanged(tempRoot(t), nil)
+		require.NoError(t, err)
+		assert.False(t, unchanged)
+	})
+
+	t.Run("manifests missing", func(t *testing.T) {
+		unchanged, err := manifestsUnchanged(tempRoot(t), paths)
+		require.NoError(t, err)
+		assert.False(t, unchanged)
+	})
+
+	t.Run("manifests identical", func(t *testing.T) {
+		root := tempRoot(t)
+		require.NoError(t, WriteForPaths(root, "https://github.com/argoproj/argocd-example-apps.git", "abc123", nil, paths))
+
+		unchanged, err := manifestsUnchanged(root, paths)
+		require.NoError(t, err)
+		assert.True(t, unchanged)
+	})
+
+	t.Run("manifests of one path changed", func(t *testing.T) {
+		root := tempRoot(t)
+		require.NoError(t, WriteForPaths(root, "https://github.com/argoproj/argocd-example-apps.git", "abc123", nil, paths))
+
+		changedPaths := []*apiclient.PathDetails{
+			{Path: ".", Manifests: manifests},
+			{Path: "apps/staging", Manifests: manifests[:1]},
+		}
+		unchanged, err := manifestsUnchanged(root, changedPaths)
+		require.NoError(t, err)
+		assert.False(t, unchanged)
+	})
+}
+
+func TestWriteManifests_Layout(t *testing.T) {
+	manifests := []*apiclient.HydratedManifestDetails{
+		{ManifestJSON: `{"kind":"Service","apiVersion":"v1","metadata":{"name":"guestbook","namespace":"default"}}`},
+		{ManifestJSON: `{"kind":"Deployment","apiVersion":"apps/v1","metadata":{"name":"guestbook","namespace":"default"}}`},
+	}
+
+	t.Run("per resource", func(t *testing.T) {
+		root := tempRoot(t)
+		err := writeManifests(root, "", manifests, &appsv1.HydratedManifestLayout{Type: appsv1.HydratedManifestLayoutPerResource})
+		require.NoError(t, err)
+
+		manifestBytes, err := os.ReadFile(filepath.Join(root.Name(), "deployment-guestbook.yaml"))
+		require.NoError(t, err)
+		assert.Contains(t, string(manifestBytes), "kind: Deployment")
+		assert.NotContains(t, string(manifestBytes), "kind: Service")
+		assert.FileExists(t, filepath.Join(root.Name(), "service-guestbook.yaml"))
+		assert.NoFileExists(t, filepath.Join(root.Name(), "manifest.yaml"))
+		assert.NoFileExists(t, filepath.Join(root.Name(), "kustomization.yaml"))
+	})
+
+	t.Run("per kind with kustomization", func(t *testing.T) {
+		root := tempRoot(t)
+		err := writeManifests(root, "", manifests, &appsv1.HydratedManifestLayout{Type: appsv1.HydratedManifestLayoutPerKind, Kustomization: true})
+		require.NoError(t, err)
+
+		assert.FileExists(t, filepath.Join(root.Name(), "deployment", "guestbook.yaml"))
+		assert.FileExists(t, filepath.Join(root.Name(), "service", "guestbook.yaml"))
+		kustomizationBytes, err := os.ReadFile(filepath.Join(root.Name(), "kustomization.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - deployment/guestbook.yaml
+  - service/guestbook.yaml
+`, string(kustomizationBytes))
+	})
+}
+
+func TestManifestFileNames(t *testing.T) {
+	t.Run("names are qualified until they are unique", func(t *testing.T) {
+		names, err := manifestFileNames([]*apiclient.HydratedManifestDetails{
+			{ManifestJSON: `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"config","namespace":"a"}}`},
+			{ManifestJSON: `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"config","namespace":"b"}}`},
+			{ManifestJSON: `{"kind":"Ingress","apiVersion":"networking.k8s.io/v1","metadata":{"name":"web","namespace":"a"}}`},
+			{ManifestJSON: `{"kind":"Ingress","apiVersion":"extensions/v1beta1","metadata":{"name":"web","namespace":"a"}}`},
+			{ManifestJSON: `{"kind":"ClusterRole","apiVersion":"rbac.authorization.k8s.io/v1","metadata":{"name":"system:Reader"}}`},
+		}, appsv1.HydratedManifestLayoutPerResource)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"configmap-a-config.yaml",
+			"configmap-b-config.yaml",
+			"ingress-networking.k8s.io-a-web.yaml",
+			"ingress-extensions-a-web.yaml",
+			"clusterrole-system_reader.yaml",
+		}, names)
+	})
+
+	t.Run("duplicate manifests", func(t *testing.T) {
+		manifest := &apiclient.HydratedManifestDetails{ManifestJSON: `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"config"}}`}
+		_, err := manifestFileNames([]*apiclient.HydratedManifestDetails{manifest, manifest}, appsv1.HydratedManifestLayoutPerKind)
+		assert.ErrorContains(t, err, `multiple manifests would be written to "configmap/config.yaml"`)
+	})
+}
+
+func TestManifestsUnchanged_Layout(t *testing.T) {
+	manifests := []*apiclient.HydratedManifestDetails{
+		{ManifestJSON: `{"kind":"Pod","apiVersion":"v1","metadata":{"name":"pod"}}`},
+		{ManifestJSON: `{"kind":"Service","apiVersion":"v1","metadata":{"name":"svc"}}`},
+	}
+	paths := []*apiclient.PathDetails{
+		{Path: "apps/staging", Manifests: manifests, Layout: string(appsv1.HydratedManifestLayoutPerKind)},
+		{Path: "apps/staging/nested", Manifests: manifests},
+	}
+
+	t.Run("manifests identical", func(t *testing.T) {
+		root := tempRoot(t)
+		require.NoError(t, WriteForPaths(root, "https://github.com/argoproj/argocd-example-apps.git", "abc123", nil, paths))
+
+		unchanged, err := manifestsUnchanged(root, paths)
+		require.NoError(t, err)
+		assert.True(t, unchanged)
+	})
+
+	t.Run("resource removed", func(t *testing.T) {
+		root := tempRoot(t)
+		require.NoError(t, WriteForPaths(root, "https://github.com/argoproj/argocd-example-apps.git", "abc123", nil, paths))
+
+		unchanged, err := manifestsUnchanged(root, []*apiclient.PathDetails{
+			{Path: "apps/staging", Manifests: manifests[:1], Layout: string(appsv1.HydratedManifestLayoutPerKind)},
+		})
+		require.NoError(t, err)
+		assert.False(t, unchanged)
+	})
+
+	t.Run("layout changed", func(t *testing.T) {
+		root := tempRoot(t)
+		require.NoError(t, WriteForPaths(root, "https://github.com/argoproj/argocd-example-apps.git", "abc123", nil, paths))
+
+		unchanged, err := manifestsUnchanged(root, []*apiclient.PathDetails{
+			{Path: "apps/staging", Manifests: manifests},
+		})
+		require.NoError(t, err)
+		assert.False(t, unchanged)
+	})
+}
+
 func TestWriteGitAttributes(t *testing.T) {
 	root := tempRoot(t)
 
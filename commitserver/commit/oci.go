@@ -0,0 +1,136 @@
+package commit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/controller/hydrator"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	hydratorutil "github.com/argoproj/argo-cd/v3/util/hydrator"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+	"github.com/argoproj/argo-cd/v3/util/oci"
+)
+
+// OCIPusherFactory is a factory for creating pushers for OCI repositories.
+type OCIPusherFactory interface {
+	NewPusher(repo *v1alpha1.Repository, repoURL string) (oci.Pusher, error)
+}
+
+type ociPusherFactory struct{}
+
+// NewOCIPusherFactory returns a new instance of the OCI pusher factory.
+func NewOCIPusherFactory() OCIPusherFactory {
+	return &ociPusherFactory{}
+}
+
+// NewPusher creates a pusher for the OCI repository at repoURL. The credentials, proxy and TLS settings of repo are
+// used, since repoURL is a repository below repo.
+func (f *ociPusherFactory) NewPusher(repo *v1alpha1.Repository, repoURL string) (oci.Pusher, error) {
+	return oci.NewPusher(repoURL, repo.GetOCICreds(), repo.Proxy, repo.NoProxy)
+}
+
+// isOCIRequest returns true if the hydrated manifests should be pushed to an OCI repository instead of a git branch.
+func isOCIRequest(r *apiclient.CommitHydratedManifestsRequest) bool {
+	return strings.HasPrefix(r.Repo.Repo, "oci://")
+}
+
+// handleOCIPushRequest pushes the hydrated manifests of each path as an OCI artifact to a repository named after the
+// path below the request's repository, tagged with the target branch. The artifacts contain the same manifest,
+// hydrator.metadata and README.md files which would be committed to a git branch. It returns the response with the
+// digest of each path's artifact.
+func (s *Service) handleOCIPushRequest(ctx context.Context, logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (*apiclient.CommitHydratedManifestsResponse, error) {
+	if r.TargetBranch != r.SyncBranch {
+		return nil, errors.New("hydrating to a separate target branch is not supported for OCI repositories")
+	}
+
+	hydratorMetadata, err := hydratorutil.GetCommitMetadata(r.DryRepoURL, r.DrySha, r.DryCommitMetadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve hydrator metadata: %w", err)
+	}
+
+	tempDir, err := files.CreateTempDir(os.TempDir())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(tempDir); err != nil {
+			logCtx.WithError(err).Error("failed to remove temp dir")
+		}
+	}()
+
+	resp := &apiclient.CommitHydratedManifestsResponse{PathRevisions: make(map[string]string, len(r.Paths))}
+	for _, p := range r.Paths {
+		if hydrator.IsRootPath(p.Path) {
+			return nil, fmt.Errorf("path %q resolves to the repository root, which cannot be pushed to an OCI repository", p.Path)
+		}
+
+		dir, err := files.CreateTempDir(tempDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create dir for path %q: %w", p.Path, err)
+		}
+		// Every artifact is self-contained, so its metadata includes the details of the dry commit.
+		pathMetadata := hydratorMetadata
+		pathMetadata.Commands = p.Commands
+		pathMetadata.DrySources = drySourcesMetadata(p.DrySources)
+		pathMetadata.ApprovedBy = p.ApprovedBy
+		err = writeOCIArtifactContents(dir, p, pathMetadata)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write manifests for path %q: %w", p.Path, err)
+		}
+
+		repoURL := v1alpha1.HydratedOCIRepoURL(r.Repo.Repo, p.Path)
+		pusher, err := s.ociPusherFactory.NewPusher(r.Repo, repoURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OCI pusher for %q: %w", repoURL, err)
+		}
+		logCtx.Debugf("Pushing manifests for path %s to %s:%s", p.Path, repoURL, r.TargetBranch)
+		digest, err := pusher.Push(ctx, dir, r.TargetBranch, ociAnnotations(r, p.Path, pathMetadata))
+		if err != nil {
+			return nil, fmt.Errorf("failed to push manifests for path %q to %q: %w", p.Path, repoURL, err)
+		}
+		resp.PathRevisions[p.Path] = digest
+	}
+	return resp, nil
+}
+
+// writeOCIArtifactContents writes the manifests of the path, the hydrator.metadata and the README.md file to dir.
+func writeOCIArtifactContents(dir string, p *apiclient.PathDetails, metadata hydratorutil.HydratorCommitMetadata) error {
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return fmt.Errorf("failed to open root dir: %w", err)
+	}
+	defer io.Close(root)
+
+	if err := writeManifests(root, "", p.Manifests, pathLayout(p)); err != nil {
+		return err
+	}
+	if err := writeMetadata(root, "", metadata); err != nil {
+		return err
+	}
+	return writeReadme(root, "", metadata)
+}
+
+// ociAnnotations returns the manifest annotations of the artifact for path, which identify the dry commit the
+// manifests were hydrated from.
+func ociAnnotations(r *apiclient.CommitHydratedManifestsRequest, path string, metadata hydratorutil.HydratorCommitMetadata) map[string]string {
+	annotations := map[string]string{
+		imagev1.AnnotationSource:   r.DryRepoURL,
+		imagev1.AnnotationRevision: r.DrySha,
+		imagev1.AnnotationTitle:    path,
+	}
+	if metadata.Author != "" {
+		annotations[imagev1.AnnotationAuthors] = metadata.Author
+	}
+	if r.CommitMessage != "" {
+		annotations[imagev1.AnnotationDescription] = r.CommitMessage
+	}
+	return annotations
+}
@@ -0,0 +1,125 @@
+package commit
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/commitserver/commit/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/hydrator"
+	ocimocks "github.com/argoproj/argo-cd/v3/util/oci/mocks"
+)
+
+func Test_CommitHydratedManifests_OCI(t *testing.T) {
+	t.Parallel()
+
+	newRequest := func(paths ...string) *apiclient.CommitHydratedManifestsRequest {
+		request := &apiclient.CommitHydratedManifestsRequest{
+			Repo: &v1alpha1.Repository{
+				Repo: "oci://registry.example.com/hydrated",
+				Type: "oci",
+			},
+			DryRepoURL:    "https://github.com/argoproj/argocd-example-apps.git",
+			DrySha:        "abc123",
+			TargetBranch:  "prod",
+			SyncBranch:    "prod",
+			CommitMessage: "test commit message",
+			DryCommitMetadata: &v1alpha1.RevisionMetadata{
+				Author: "test author <test@example.com>",
+			},
+		}
+		for _, path := range paths {
+			request.Paths = append(request.Paths, &apiclient.PathDetails{
+				Path:      path,
+				Manifests: []*apiclient.HydratedManifestDetails{{ManifestJSON: `{"kind":"ConfigMap","metadata":{"name":"` + path + `"}}`}},
+				Commands:  []string{"helm template ."},
+			})
+		}
+		return request
+	}
+
+	t.Run("pushes each path", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newServiceWithMocks(t)
+		mockOCIPusherFactory := mocks.NewOCIPusherFactory(t)
+		service.ociPusherFactory = mockOCIPusherFactory
+
+		for _, path := range []string{"guestbook", "helm-guestbook"} {
+			mockPusher := ocimocks.NewPusher(t)
+			mockOCIPusherFactory.On("NewPusher", mock.Anything, "oci://registry.example.com/hydrated/"+path).Return(mockPusher, nil).Once()
+			mockPusher.On("Push", mock.Anything, mock.Anything, "prod", map[string]string{
+				imagev1.AnnotationSource:      "https://github.com/argoproj/argocd-example-apps.git",
+				imagev1.AnnotationRevision:    "abc123",
+				imagev1.AnnotationTitle:       path,
+				imagev1.AnnotationAuthors:     "test author <test@example.com>",
+				imagev1.AnnotationDescription: "test commit message",
+			}).Run(func(args mock.Arguments) {
+				dir := args.String(1)
+				manifest, err := os.ReadFile(filepath.Join(dir, "manifest.yaml"))
+				require.NoError(t, err)
+				assert.Contains(t, string(manifest), "name: "+path)
+				assert.FileExists(t, filepath.Join(dir, "README.md"))
+
+				metadataBytes, err := os.ReadFile(filepath.Join(dir, "hydrator.metadata"))
+				require.NoError(t, err)
+				var metadata hydrator.HydratorCommitMetadata
+				require.NoError(t, json.Unmarshal(metadataBytes, &metadata))
+				assert.Equal(t, "https://github.com/argoproj/argocd-example-apps.git", metadata.RepoURL)
+				assert.Equal(t, "abc123", metadata.DrySHA)
+				assert.Equal(t, "test author <test@example.com>", metadata.Author)
+				assert.Equal(t, []string{"helm template ."}, metadata.Commands)
+			}).Return("sha256:"+path, nil).Once()
+		}
+
+		resp, err := service.CommitHydratedManifests(t.Context(), newRequest("guestbook", "helm-guestbook"))
+		require.NoError(t, err)
+		assert.Empty(t, resp.HydratedSha)
+		assert.Equal(t, map[string]string{
+			"guestbook":      "sha256:guestbook",
+			"helm-guestbook": "sha256:helm-guestbook",
+		}, resp.PathRevisions)
+	})
+
+	t.Run("push fails", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newServiceWithMocks(t)
+		mockOCIPusherFactory := mocks.NewOCIPusherFactory(t)
+		service.ociPusherFactory = mockOCIPusherFactory
+		mockPusher := ocimocks.NewPusher(t)
+		mockOCIPusherFactory.On("NewPusher", mock.Anything, mock.Anything).Return(mockPusher, nil).Once()
+		mockPusher.On("Push", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("", assert.AnError).Once()
+
+		_, err := service.CommitHydratedManifests(t.Context(), newRequest("guestbook"))
+		require.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("target branch differs from sync branch", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newServiceWithMocks(t)
+		request := newRequest("guestbook")
+		request.TargetBranch = "prod-next"
+
+		_, err := service.CommitHydratedManifests(t.Context(), request)
+		assert.ErrorContains(t, err, "not supported for OCI repositories")
+	})
+
+	t.Run("root path", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newServiceWithMocks(t)
+
+		_, err := service.CommitHydratedManifests(t.Context(), newRequest("."))
+		assert.ErrorContains(t, err, "resolves to the repository root")
+	})
+}
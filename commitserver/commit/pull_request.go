@@ -0,0 +1,109 @@
+package commit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/github_app_auth"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+// PullRequestServiceFactory is a factory for creating services which open pull requests against a repository.
+type PullRequestServiceFactory interface {
+	NewPullRequestService(ctx context.Context, repo *v1alpha1.Repository, pullRequest *v1alpha1.HydratePullRequest) (pull_request.PullRequestCreator, error)
+}
+
+type pullRequestServiceFactory struct{}
+
+// NewPullRequestServiceFactory returns a new instance of the pull request service factory.
+func NewPullRequestServiceFactory() PullRequestServiceFactory {
+	return &pullRequestServiceFactory{}
+}
+
+// NewPullRequestService creates a pull request service for the repository, using the SCM provider configured in
+// pullRequest. The repository's write credentials are used to authenticate against the provider's API.
+func (f *pullRequestServiceFactory) NewPullRequestService(ctx context.Context, repo *v1alpha1.Repository, pullRequest *v1alpha1.HydratePullRequest) (pull_request.PullRequestCreator, error) {
+	owner, name, err := parseRepoOwnerAndName(repo.Repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse repo URL %q: %w", repo.Repo, err)
+	}
+
+	var svc pull_request.PullRequestService
+	switch pullRequest.Provider {
+	case v1alpha1.HydratePullRequestProviderGitHub:
+		if repo.GithubAppPrivateKey != "" {
+			svc, err = pull_request.NewGithubAppService(github_app_auth.Authentication{
+				Id:                repo.GithubAppId,
+				InstallationId:    repo.GithubAppInstallationId,
+				EnterpriseBaseURL: repo.GitHubAppEnterpriseBaseURL,
+				PrivateKey:        repo.GithubAppPrivateKey,
+			}, pullRequest.API, owner, name, nil)
+		} else {
+			svc, err = pull_request.NewGithubService(repo.Password, pullRequest.API, owner, name, nil)
+		}
+	case v1alpha1.HydratePullRequestProviderGitLab:
+		svc, err = pull_request.NewGitLabService(repo.Password, pullRequest.API, owner+"/"+name, nil, "", "", pullRequest.Insecure, nil)
+	case v1alpha1.HydratePullRequestProviderGitea:
+		if pullRequest.API == "" {
+			return nil, errors.New("api is required for the gitea pull request provider")
+		}
+		svc, err = pull_request.NewGiteaService(repo.Password, pullRequest.API, owner, name, nil, pullRequest.Insecure)
+	case v1alpha1.HydratePullRequestProviderBitbucketServer:
+		if pullRequest.API == "" {
+			return nil, errors.New("api is required for the bitbucketServer pull request provider")
+		}
+		// Bitbucket Server serves HTTPS clones under /scm/<project>/<repo>.
+		projectKey := strings.TrimPrefix(owner, "scm/")
+		switch {
+		case repo.BearerToken != "":
+			svc, err = pull_request.NewBitbucketServiceBearerToken(ctx, repo.BearerToken, pullRequest.API, projectKey, name, "", pullRequest.Insecure, nil)
+		case repo.Username != "":
+			svc, err = pull_request.NewBitbucketServiceBasicAuth(ctx, repo.Username, repo.Password, pullRequest.API, projectKey, name, "", pullRequest.Insecure, nil)
+		default:
+			svc, err = pull_request.NewBitbucketServiceNoAuth(ctx, pullRequest.API, projectKey, name, "", pullRequest.Insecure, nil)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported pull request provider %q", pullRequest.Provider)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s pull request service: %w", pullRequest.Provider, err)
+	}
+
+	creator, ok := svc.(pull_request.PullRequestCreator)
+	if !ok {
+		return nil, fmt.Errorf("pull request provider %q does not support opening pull requests", pullRequest.Provider)
+	}
+	return creator, nil
+}
+
+// parseRepoOwnerAndName splits a git repository URL into the owner (everything in the path except for the last
+// element) and the repository name. Both HTTP(S) and SSH URLs are supported.
+func parseRepoOwnerAndName(repoURL string) (string, string, error) {
+	var repoPath string
+	if ok, _ := git.IsSSHURL(repoURL); ok && !strings.HasPrefix(repoURL, "ssh://") {
+		// SCP-like syntax, e.g. git@github.com:owner/repo.git
+		_, after, found := strings.Cut(repoURL, ":")
+		if !found {
+			return "", "", errors.New("invalid SSH URL")
+		}
+		repoPath = after
+	} else {
+		u, err := url.Parse(repoURL)
+		if err != nil {
+			return "", "", err
+		}
+		repoPath = u.Path
+	}
+
+	repoPath = strings.TrimSuffix(strings.Trim(repoPath, "/"), ".git")
+	idx := strings.LastIndex(repoPath, "/")
+	if idx <= 0 || idx == len(repoPath)-1 {
+		return "", "", fmt.Errorf("expected path of the form <owner>/<repo>, got %q", repoPath)
+	}
+	return repoPath[:idx], repoPath[idx+1:], nil
+}
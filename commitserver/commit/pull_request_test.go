@@ -0,0 +1,72 @@
+package commit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func Test_parseRepoOwnerAndName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		repoURL       string
+		expectedOwner string
+		expectedName  string
+		expectedErr   string
+	}{
+		{name: "https", repoURL: "https://github.com/argoproj/argo-cd.git", expectedOwner: "argoproj", expectedName: "argo-cd"},
+		{name: "https without .git suffix", repoURL: "https://github.com/argoproj/argo-cd", expectedOwner: "argoproj", expectedName: "argo-cd"},
+		{name: "nested group", repoURL: "https://gitlab.com/group/subgroup/project.git", expectedOwner: "group/subgroup", expectedName: "project"},
+		{name: "scp-like ssh", repoURL: "git@github.com:argoproj/argo-cd.git", expectedOwner: "argoproj", expectedName: "argo-cd"},
+		{name: "ssh scheme", repoURL: "ssh://git@bitbucket.example.com:7999/proj/repo.git", expectedOwner: "proj", expectedName: "repo"},
+		{name: "bitbucket server https", repoURL: "https://bitbucket.example.com/scm/proj/repo.git", expectedOwner: "scm/proj", expectedName: "repo"},
+		{name: "missing owner", repoURL: "https://github.com/argo-cd.git", expectedErr: "expected path of the form <owner>/<repo>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			owner, name, err := parseRepoOwnerAndName(tt.repoURL)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedOwner, owner)
+			assert.Equal(t, tt.expectedName, name)
+		})
+	}
+}
+
+func Test_NewPullRequestService(t *testing.T) {
+	t.Parallel()
+
+	repo := &v1alpha1.Repository{Repo: "https://example.com/argoproj/argo-cd.git", Password: "token"}
+
+	t.Run("unsupported provider", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewPullRequestServiceFactory().NewPullRequestService(t.Context(), repo, &v1alpha1.HydratePullRequest{Provider: "unknown"})
+		assert.ErrorContains(t, err, `unsupported pull request provider "unknown"`)
+	})
+
+	t.Run("gitea requires api", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewPullRequestServiceFactory().NewPullRequestService(t.Context(), repo, &v1alpha1.HydratePullRequest{Provider: v1alpha1.HydratePullRequestProviderGitea})
+		assert.ErrorContains(t, err, "api is required")
+	})
+
+	t.Run("github", func(t *testing.T) {
+		t.Parallel()
+
+		svc, err := NewPullRequestServiceFactory().NewPullRequestService(t.Context(), repo, &v1alpha1.HydratePullRequest{Provider: v1alpha1.HydratePullRequestProviderGitHub})
+		require.NoError(t, err)
+		assert.NotNil(t, svc)
+	})
+}
@@ -14,19 +14,25 @@ type RepoClientFactory interface {
 type repoClientFactory struct {
 	gitCredsStore git.CredsStore
 	metricsServer *metrics.Server
+	commitSigner  *git.CommitSigner
 }
 
-// NewRepoClientFactory returns a new instance of the repo client factory.
-func NewRepoClientFactory(gitCredsStore git.CredsStore, metricsServer *metrics.Server) RepoClientFactory {
+// NewRepoClientFactory returns a new instance of the repo client factory. If commitSigner is not nil, the clients
+// sign the commits they create.
+func NewRepoClientFactory(gitCredsStore git.CredsStore, metricsServer *metrics.Server, commitSigner *git.CommitSigner) RepoClientFactory {
 	return &repoClientFactory{
 		gitCredsStore: gitCredsStore,
 		metricsServer: metricsServer,
+		commitSigner:  commitSigner,
 	}
 }
 
 // NewClient creates a new git client for the repository.
 func (r *repoClientFactory) NewClient(repo *v1alpha1.Repository, rootPath string) (git.Client, error) {
 	gitCreds := repo.GetGitCreds(r.gitCredsStore)
-	opts := git.WithEventHandlers(metrics.NewGitClientEventHandlers(r.metricsServer))
-	return git.NewClientExt(repo.Repo, rootPath, gitCreds, repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, repo.NoProxy, opts)
+	opts := []git.ClientOpts{git.WithEventHandlers(metrics.NewGitClientEventHandlers(r.metricsServer))}
+	if r.commitSigner != nil {
+		opts = append(opts, git.WithCommitSigner(r.commitSigner))
+	}
+	return git.NewClientExt(repo.Repo, rootPath, gitCreds, repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, repo.NoProxy, opts...)
 }
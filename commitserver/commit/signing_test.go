@@ -0,0 +1,124 @@
+package commit
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+func Test_NewCommitSigner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing key", func(t *testing.T) {
+		t.Parallel()
+
+		signer, cleanup, err := NewCommitSigner(filepath.Join(t.TempDir(), "signing.key"), t.TempDir())
+		require.NoError(t, err)
+		assert.Nil(t, signer)
+		cleanup()
+	})
+
+	t.Run("empty key", func(t *testing.T) {
+		t.Parallel()
+
+		keyPath := filepath.Join(t.TempDir(), "signing.key")
+		require.NoError(t, os.WriteFile(keyPath, []byte("\n"), 0o644))
+
+		signer, cleanup, err := NewCommitSigner(keyPath, t.TempDir())
+		require.NoError(t, err)
+		assert.Nil(t, signer)
+		cleanup()
+	})
+
+	t.Run("unknown key format", func(t *testing.T) {
+		t.Parallel()
+
+		keyPath := filepath.Join(t.TempDir(), "signing.key")
+		require.NoError(t, os.WriteFile(keyPath, []byte("-----BEGIN PGP PUBLIC KEY BLOCK-----\n"), 0o644))
+
+		workDir := t.TempDir()
+		_, _, err := NewCommitSigner(keyPath, workDir)
+		require.ErrorContains(t, err, "neither an armored PGP private key nor an OpenSSH private key")
+		entries, err := os.ReadDir(workDir)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("ssh key", func(t *testing.T) {
+		t.Parallel()
+
+		keyDir := t.TempDir()
+		keyPath := filepath.Join(keyDir, "signing.key")
+		out, err := exec.Command("ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-f", keyPath).CombinedOutput()
+		require.NoError(t, err, string(out))
+		// Mounted secrets are world-readable by default, which ssh-keygen refuses to use.
+		require.NoError(t, os.Chmod(keyPath, 0o644))
+
+		signer, cleanup, err := NewCommitSigner(keyPath, t.TempDir())
+		require.NoError(t, err)
+		require.NotNil(t, signer)
+		assert.Equal(t, git.SigningFormatSSH, signer.Format)
+		assert.NotEqual(t, keyPath, signer.Key)
+		st, err := os.Stat(signer.Key)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), st.Mode().Perm())
+
+		cleanup()
+		assert.NoDirExists(t, filepath.Dir(signer.Key))
+	})
+
+	t.Run("openpgp key", func(t *testing.T) {
+		t.Parallel()
+
+		gnuPGHome := t.TempDir()
+		gpg := func(args ...string) string {
+			cmd := exec.Command("gpg", append([]string{"--batch", "--no-permission-warning"}, args...)...)
+			cmd.Env = append(os.Environ(), "GNUPGHOME="+gnuPGHome)
+			out, err := cmd.Output()
+			require.NoError(t, err)
+			return string(out)
+		}
+		gpg("--passphrase", "", "--quick-gen-key", "Argo CD <argo-cd@example.com>", "ed25519", "sign", "never")
+		fingerprint := primaryKeyFingerprint(gpg("--with-colons", "--list-secret-keys"))
+		require.NotEmpty(t, fingerprint)
+		keyPath := filepath.Join(t.TempDir(), "signing.key")
+		require.NoError(t, os.WriteFile(keyPath, []byte(gpg("--armor", "--export-secret-keys", fingerprint)), 0o644))
+		// Stop the agent started by key generation, so that the temp dir can be removed.
+		t.Cleanup(func() {
+			cmd := exec.Command("gpgconf", "--kill", "gpg-agent")
+			cmd.Env = append(os.Environ(), "GNUPGHOME="+gnuPGHome)
+			_ = cmd.Run()
+		})
+
+		workDir := t.TempDir()
+		signer, cleanup, err := NewCommitSigner(keyPath, workDir)
+		require.NoError(t, err)
+		require.NotNil(t, signer)
+		assert.Equal(t, git.SigningFormatOpenPGP, signer.Format)
+		assert.Equal(t, fingerprint, signer.Key)
+		assert.DirExists(t, signer.GnuPGHome)
+
+		cleanup()
+		assert.NoDirExists(t, filepath.Dir(signer.GnuPGHome))
+	})
+}
+
+func Test_primaryKeyFingerprint(t *testing.T) {
+	t.Parallel()
+
+	out := `sec:u:255:22:6C3E0F5C3F1A2B3C:1700000000:::u:::scESC:::+:::ed25519:::0:
+fpr:::::::::0123456789ABCDEF0123456789ABCDEF01234567:
+grp:::::::::AAAA:
+uid:u::::1700000000::HASH::Argo CD <argo-cd@example.com>::::::::::0:
+ssb:u:255:18:1111111111111111:1700000000::::::e:::+:::cv25519::
+fpr:::::::::FEDCBA9876543210FEDCBA9876543210FEDCBA98:
+`
+	assert.Equal(t, "0123456789ABCDEF0123456789ABCDEF01234567", primaryKeyFingerprint(out))
+	assert.Empty(t, primaryKeyFingerprint(""))
+}
@@ -0,0 +1,179 @@
+package commit
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	gogit "github.com/go-git/go-git/v5"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/util/git"
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+)
+
+// workingCopyGCInterval is how often working copies are checked for expiration.
+const workingCopyGCInterval = 5 * time.Minute
+
+// workingCopies keeps a working copy of each repository on disk between commit requests, so that subsequent requests
+// for the same repository only need to fetch the commits pushed since the previous request. A working copy is used by
+// at most one request at a time, and is removed once it has not been used for longer than the expiration.
+type workingCopies struct {
+	rootDir    string
+	expiration time.Duration
+
+	lock   sync.Mutex
+	byRepo map[string]*workingCopy
+}
+
+// workingCopy is a working copy of a repository. Its lock is held for as long as a request uses it.
+type workingCopy struct {
+	path string
+
+	lock     sync.Mutex
+	lastUsed time.Time
+	// removed is set when the working copy was deleted from disk while a request was waiting for its lock
+	removed bool
+}
+
+func newWorkingCopies(rootDir string, expiration time.Duration) *workingCopies {
+	return &workingCopies{
+		rootDir:    rootDir,
+		expiration: expiration,
+		byRepo:     map[string]*workingCopy{},
+	}
+}
+
+// restore registers the working copies left on disk by a previous run of the commit server. Directories which are not
+// git repositories are removed.
+func (c *workingCopies) restore() error {
+	if err := os.MkdirAll(c.rootDir, 0o700); err != nil {
+		return fmt.Errorf("failed to create working copies directory: %w", err)
+	}
+	entries, err := os.ReadDir(c.rootDir)
+	if err != nil {
+		return fmt.Errorf("failed to read working copies directory: %w", err)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	now := time.Now()
+	for _, entry := range entries {
+		fullPath := filepath.Join(c.rootDir, entry.Name())
+		if repoURL := remoteURL(fullPath); repoURL != "" {
+			c.byRepo[git.NormalizeGitURL(repoURL)] = &workingCopy{path: fullPath, lastUsed: now}
+			continue
+		}
+		if err := os.RemoveAll(fullPath); err != nil {
+			log.WithError(err).Warnf("Failed to remove stale working copy %s", fullPath)
+		}
+	}
+	return nil
+}
+
+// remoteURL returns the URL of the first remote of the repository at path, or an empty string if path is not a git
+// repository with a remote.
+func remoteURL(path string) string {
+	repo, err := gogit.PlainOpen(path)
+	if err != nil {
+		return ""
+	}
+	remotes, err := repo.Remotes()
+	if err != nil || len(remotes) == 0 || len(remotes[0].Config().URLs) == 0 {
+		return ""
+	}
+	return remotes[0].Config().URLs[0]
+}
+
+// acquire returns the working copy for the repository, creating an empty directory for it if there is none yet. It
+// blocks until no other request uses the working copy. The caller must call release or discard when done with it.
+func (c *workingCopies) acquire(repoURL string) (*workingCopy, error) {
+	key := git.NormalizeGitURL(repoURL)
+	for {
+		c.lock.Lock()
+		wc, ok := c.byRepo[key]
+		if !ok {
+			path, err := files.CreateTempDir(c.rootDir)
+			if err != nil {
+				c.lock.Unlock()
+				return nil, fmt.Errorf("failed to create working copy directory: %w", err)
+			}
+			wc = &workingCopy{path: path}
+			c.byRepo[key] = wc
+		}
+		c.lock.Unlock()
+
+		wc.lock.Lock()
+		if !wc.removed {
+			return wc, nil
+		}
+		// The working copy expired while waiting for it. Try again with a new one.
+		wc.lock.Unlock()
+	}
+}
+
+// release makes the working copy available to the next request.
+func (c *workingCopies) release(wc *workingCopy) {
+	wc.lastUsed = time.Now()
+	wc.lock.Unlock()
+}
+
+// discard removes the working copy from disk, e.g. because it could not be initialized, so that the next request for
+// the repository starts from scratch.
+func (c *workingCopies) discard(wc *workingCopy) error {
+	c.lock.Lock()
+	c.removeLocked(wc)
+	c.lock.Unlock()
+	wc.lock.Unlock()
+	if err := os.RemoveAll(wc.path); err != nil {
+		return fmt.Errorf("failed to remove working copy %s: %w", wc.path, err)
+	}
+	return nil
+}
+
+// removeLocked unregisters the working copy. Both the cache lock and the working copy lock must be held.
+func (c *workingCopies) removeLocked(wc *workingCopy) {
+	wc.removed = true
+	for key, registered := range c.byRepo {
+		if registered == wc {
+			delete(c.byRepo, key)
+			return
+		}
+	}
+}
+
+// gc removes the working copies which are not in use and have not been used for longer than the expiration.
+func (c *workingCopies) gc() {
+	var expired []string
+	c.lock.Lock()
+	for _, wc := range c.byRepo {
+		// Working copies which are in use are skipped, they are not expired by definition.
+		if !wc.lock.TryLock() {
+			continue
+		}
+		if time.Since(wc.lastUsed) > c.expiration {
+			c.removeLocked(wc)
+			expired = append(expired, wc.path)
+		}
+		wc.lock.Unlock()
+	}
+	c.lock.Unlock()
+
+	for _, path := range expired {
+		log.Debugf("Removing expired working copy %s", path)
+		if err := os.RemoveAll(path); err != nil {
+			log.WithError(err).Warnf("Failed to remove expired working copy %s", path)
+		}
+	}
+}
+
+// runGC removes expired working copies periodically.
+func (c *workingCopies) runGC() {
+	ticker := time.NewTicker(workingCopyGCInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		c.gc()
+	}
+}
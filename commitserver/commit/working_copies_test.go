@@ -0,0 +1,125 @@
+package commit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_workingCopies_acquire(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reuses working copy of the same repository", func(t *testing.T) {
+		t.Parallel()
+
+		c := newWorkingCopies(t.TempDir(), time.Hour)
+		wc, err := c.acquire("https://github.com/argoproj/argocd-example-apps.git")
+		require.NoError(t, err)
+		assert.DirExists(t, wc.path)
+		c.release(wc)
+
+		again, err := c.acquire("https://github.com/argoproj/argocd-example-apps")
+		require.NoError(t, err)
+		assert.Equal(t, wc.path, again.path)
+		c.release(again)
+
+		other, err := c.acquire("https://github.com/argoproj/argo-cd.git")
+		require.NoError(t, err)
+		assert.NotEqual(t, wc.path, other.path)
+		c.release(other)
+	})
+
+	t.Run("blocks while the working copy is in use", func(t *testing.T) {
+		t.Parallel()
+
+		c := newWorkingCopies(t.TempDir(), time.Hour)
+		wc, err := c.acquire("https://github.com/argoproj/argocd-example-apps.git")
+		require.NoError(t, err)
+
+		acquired := make(chan *workingCopy)
+		go func() {
+			again, err := c.acquire("https://github.com/argoproj/argocd-example-apps.git")
+			assert.NoError(t, err)
+			acquired <- again
+		}()
+
+		select {
+		case <-acquired:
+			t.Fatal("working copy was acquired while in use")
+		case <-time.After(50 * time.Millisecond):
+		}
+		c.release(wc)
+		again := <-acquired
+		assert.Equal(t, wc.path, again.path)
+		c.release(again)
+	})
+
+	t.Run("discarded working copy is removed", func(t *testing.T) {
+		t.Parallel()
+
+		c := newWorkingCopies(t.TempDir(), time.Hour)
+		wc, err := c.acquire("https://github.com/argoproj/argocd-example-apps.git")
+		require.NoError(t, err)
+		require.NoError(t, c.discard(wc))
+		assert.NoDirExists(t, wc.path)
+
+		again, err := c.acquire("https://github.com/argoproj/argocd-example-apps.git")
+		require.NoError(t, err)
+		assert.NotEqual(t, wc.path, again.path)
+		c.release(again)
+	})
+}
+
+func Test_workingCopies_gc(t *testing.T) {
+	t.Parallel()
+
+	c := newWorkingCopies(t.TempDir(), time.Minute)
+	expired, err := c.acquire("https://github.com/argoproj/argocd-example-apps.git")
+	require.NoError(t, err)
+	c.release(expired)
+	expired.lastUsed = time.Now().Add(-2 * time.Minute)
+
+	recent, err := c.acquire("https://github.com/argoproj/argo-cd.git")
+	require.NoError(t, err)
+	c.release(recent)
+
+	inUse, err := c.acquire("https://github.com/argoproj/gitops-engine.git")
+	require.NoError(t, err)
+	inUse.lastUsed = time.Now().Add(-2 * time.Minute)
+
+	c.gc()
+
+	assert.NoDirExists(t, expired.path)
+	assert.DirExists(t, recent.path)
+	assert.DirExists(t, inUse.path)
+	assert.Len(t, c.byRepo, 2)
+	c.release(inUse)
+}
+
+func Test_workingCopies_restore(t *testing.T) {
+	t.Parallel()
+
+	rootDir := t.TempDir()
+	repoPath := filepath.Join(rootDir, "repo")
+	repo, err := gogit.PlainInit(repoPath, false)
+	require.NoError(t, err)
+	_, err = repo.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{"https://github.com/argoproj/argocd-example-apps.git"}})
+	require.NoError(t, err)
+	stalePath := filepath.Join(rootDir, "stale")
+	require.NoError(t, os.Mkdir(stalePath, 0o755))
+
+	c := newWorkingCopies(rootDir, time.Hour)
+	require.NoError(t, c.restore())
+
+	assert.NoDirExists(t, stalePath)
+	wc, err := c.acquire("https://github.com/argoproj/argocd-example-apps")
+	require.NoError(t, err)
+	assert.Equal(t, repoPath, wc.path)
+	c.release(wc)
+}
@@ -1,6 +1,8 @@
 package commitserver
 
 import (
+	"time"
+
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
@@ -19,8 +21,13 @@ type ArgoCDCommitServer struct {
 }
 
 // NewServer returns a new instance of the commit server.
-func NewServer(gitCredsStore git.CredsStore, metricsServer *metrics.Server) *ArgoCDCommitServer {
-	return &ArgoCDCommitServer{commitService: commit.NewService(gitCredsStore, metricsServer)}
+func NewServer(gitCredsStore git.CredsStore, metricsServer *metrics.Server, commitSigner *git.CommitSigner, workingCopyExpiration time.Duration) *ArgoCDCommitServer {
+	return &ArgoCDCommitServer{commitService: commit.NewService(gitCredsStore, metricsServer, commitSigner, workingCopyExpiration)}
+}
+
+// Init prepares the commit server for handling requests.
+func (a *ArgoCDCommitServer) Init() error {
+	return a.commitService.Init()
 }
 
 // CreateGRPC creates a new gRPC server.
@@ -98,6 +98,8 @@ const (
 	DefaultPluginConfigFilePath = "/home/argocd/cmp-server/config"
 	// PluginConfigFileName is the Plugin Config File is a ConfigManagementPlugin manifest located inside the plugin container
 	PluginConfigFileName = "plugin.yaml"
+	// DefaultCommitServerSigningKeyPath is the Default path to the private key used by the commit server to sign commits
+	DefaultCommitServerSigningKeyPath = "/app/config/commit-signing/signing.key"
 )
 
 // consts for podrequests metrics in cache/info
@@ -142,6 +144,11 @@ const (
 	// cluster changes, this algorithm minimises the changes between shard and clusters assignments.
 	ConsistentHashingWithBoundedLoadsAlgorithm = "consistent-hashing"
 
+	// LoadAwareShardingAlgorithm uses an algorithm that weights clusters by the number of resources and APIs cached
+	// for them and moves clusters away from shards whose load is well above the average load. Small changes of the
+	// cluster loads do not cause any cluster to change shards.
+	LoadAwareShardingAlgorithm = "load-aware"
+
 	DefaultShardingAlgorithm = LegacyShardingAlgorithm
 )
 
@@ -204,6 +211,10 @@ const (
 	// AnnotationCompareOptions is a comma-separated list of options for comparison
 	AnnotationCompareOptions = "argocd.argoproj.io/compare-options"
 
+	// AnnotationDependsOn is a comma-separated list of resources of the same application which must be synced and
+	// healthy before the resource is synced
+	AnnotationDependsOn = "argocd.argoproj.io/depends-on"
+
 	// AnnotationClientSideApplyMigrationManager specifies a custom field manager for client-side apply migration
 	AnnotationClientSideApplyMigrationManager = "argocd.argoproj.io/client-side-apply-migration-manager"
 
@@ -229,6 +240,10 @@ const (
 	// AnnotationKeyAppSkipReconcile tells the Application to skip the Application controller reconcile.
 	// Skip reconcile when the value is "true" or any other string values that can be strconv.ParseBool() to be true.
 	AnnotationKeyAppSkipReconcile = "argocd.argoproj.io/skip-reconcile"
+	// AnnotationKeyApplicationSharding is the annotation of a cluster secret which, when set to "true", distributes the
+	// Applications of the cluster across the application controller shards by Application instead of assigning all
+	// of them to the shard of the cluster.
+	AnnotationKeyApplicationSharding = "argocd.argoproj.io/application-sharding"
 	// LabelKeyComponentRepoServer is the label key to identify the component as repo-server
 	LabelKeyComponentRepoServer = "app.kubernetes.io/component"
 	// LabelValueComponentRepoServer is the label value for the repo-server component
@@ -0,0 +1,254 @@
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+)
+
+// appDependencyWaitMessage starts the message of the DependencyWarning condition, and of a sync operation which waits
+// for the dependencies of its application.
+const appDependencyWaitMessage = "Waiting for dependencies to be Synced and Healthy"
+
+// getAppDependencyCondition returns a DependencyError condition if the dependencies of the application can't be
+// resolved or form a cycle, and a DependencyWarning condition if any of its dependencies is not Synced and Healthy. It
+// returns nil if the application can be synced.
+func (ctrl *ApplicationController) getAppDependencyCondition(app *appv1.Application) *appv1.ApplicationCondition {
+	if len(app.Spec.DependsOn) == 0 {
+		return nil
+	}
+	dependencies, waiting, err := resolveAppDependencies(ctrl.appLister, app)
+	if err != nil {
+		return &appv1.ApplicationCondition{
+			Type:    appv1.ApplicationConditionDependencyError,
+			Message: fmt.Sprintf("Failed to resolve dependencies: %v", err),
+		}
+	}
+	if cycle := findAppDependencyCycle(ctrl.appLister, app, dependencies); cycle != nil {
+		return &appv1.ApplicationCondition{
+			Type:    appv1.ApplicationConditionDependencyError,
+			Message: "Dependency cycle detected: " + strings.Join(cycle, " -> "),
+		}
+	}
+	for _, dependency := range dependencies {
+		syncStatus := dependency.Status.Sync.Status
+		if syncStatus == "" {
+			syncStatus = appv1.SyncStatusCodeUnknown
+		}
+		healthStatus := dependency.Status.Health.Status
+		if healthStatus == "" {
+			healthStatus = health.HealthStatusUnknown
+		}
+		if syncStatus != appv1.SyncStatusCodeSynced || healthStatus != health.HealthStatusHealthy {
+			waiting = append(waiting, fmt.Sprintf("Application '%s' is %s and %s", appDependencyKey(dependency), syncStatus, healthStatus))
+		}
+	}
+	if len(waiting) > 0 {
+		return &appv1.ApplicationCondition{
+			Type:    appv1.ApplicationConditionDependencyWarning,
+			Message: appDependencyWaitMessage + ": " + strings.Join(waiting, "; "),
+		}
+	}
+	return nil
+}
+
+// resolveAppDependencies returns the Applications the application depends on, sorted by namespace and name, along
+// with a message for each dependency which does not match any Application. The application itself is never returned
+// for a label selector.
+func resolveAppDependencies(appLister applisters.ApplicationLister, app *appv1.Application) ([]*appv1.Application, []string, error) {
+	var dependencies []*appv1.Application
+	var missing []string
+	seen := map[string]bool{}
+	for _, dependency := range app.Spec.DependsOn {
+		namespace := dependency.Namespace
+		if namespace == "" {
+			namespace = app.Namespace
+		}
+		var matches []*appv1.Application
+		switch {
+		case dependency.Name != "":
+			match, err := appLister.Applications(namespace).Get(dependency.Name)
+			if apierrors.IsNotFound(err) {
+				missing = append(missing, fmt.Sprintf("Application '%s/%s' does not exist", namespace, dependency.Name))
+				continue
+			}
+			if err != nil {
+				return nil, nil, fmt.Errorf("error getting application %s/%s: %w", namespace, dependency.Name, err)
+			}
+			matches = append(matches, match)
+		case dependency.Selector != nil:
+			selector, err := metav1.LabelSelectorAsSelector(dependency.Selector)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid dependency selector: %w", err)
+			}
+			apps, err := appLister.Applications(namespace).List(selector)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error listing applications in namespace %s: %w", namespace, err)
+			}
+			for _, match := range apps {
+				if appDependencyKey(match) != appDependencyKey(app) {
+					matches = append(matches, match)
+				}
+			}
+			if len(matches) == 0 {
+				missing = append(missing, fmt.Sprintf("no Application in namespace %s matches selector '%s'", namespace, selector))
+			}
+		}
+		for _, match := range matches {
+			key := appDependencyKey(match)
+			if !seen[key] {
+				seen[key] = true
+				dependencies = append(dependencies, match)
+			}
+		}
+	}
+	sort.Slice(dependencies, func(i, j int) bool {
+		return appDependencyKey(dependencies[i]) < appDependencyKey(dependencies[j])
+	})
+	return dependencies, missing, nil
+}
+
+// findAppDependencyCycle returns the namespaced names of the Applications forming a dependency cycle which starts and
+// ends with the application, or nil if the application is not part of a cycle. The given dependencies are the resolved
+// dependencies of the application.
+func findAppDependencyCycle(appLister applisters.ApplicationLister, app *appv1.Application, dependencies []*appv1.Application) []string {
+	start := appDependencyKey(app)
+	visited := map[string]bool{}
+	var visit func(dependencies []*appv1.Application, path []string) []string
+	visit = func(dependencies []*appv1.Application, path []string) []string {
+		for _, dependency := range dependencies {
+			name := appDependencyKey(dependency)
+			if name == start {
+				return append(slices.Clone(path), name)
+			}
+			if visited[name] {
+				continue
+			}
+			visited[name] = true
+			next, _, err := resolveAppDependencies(appLister, dependency)
+			if err != nil {
+				// Unresolvable dependencies are reported by the application which declares them.
+				continue
+			}
+			if cycle := visit(next, append(slices.Clone(path), name)); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+	return visit(dependencies, []string{start})
+}
+
+// appDependsOnIndexFunc indexes Applications by their dependencies. Dependencies by name are indexed by the namespaced
+// name of the Application they refer to, and dependencies by label selector by the namespace they select from.
+func appDependsOnIndexFunc(obj any) ([]string, error) {
+	app, ok := obj.(*appv1.Application)
+	if !ok {
+		return nil, nil
+	}
+	var keys []string
+	for _, dependency := range app.Spec.DependsOn {
+		namespace := dependency.Namespace
+		if namespace == "" {
+			namespace = app.Namespace
+		}
+		var key string
+		switch {
+		case dependency.Name != "":
+			key = namespace + "/" + dependency.Name
+		case dependency.Selector != nil:
+			key = appDependencySelectorIndexKey(namespace)
+		default:
+			continue
+		}
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// appDependencySelectorIndexKey returns the dependsOn index key of the Applications which select dependencies by label
+// in the given namespace.
+func appDependencySelectorIndexKey(namespace string) string {
+	return "selector:" + namespace
+}
+
+// requestDependentAppsRefresh requests a refresh of all Applications which depend on the given application, so that
+// they can be synced as soon as the application becomes Synced and Healthy.
+func (ctrl *ApplicationController) requestDependentAppsRefresh(app *appv1.Application) {
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	dependents, err := ctrl.appInformer.GetIndexer().ByIndex(dependsOnIndex, appDependencyKey(app))
+	if err != nil {
+		logCtx.Warnf("Failed to list applications depending on the application: %v", err)
+		return
+	}
+	bySelector, err := ctrl.appInformer.GetIndexer().ByIndex(dependsOnIndex, appDependencySelectorIndexKey(app.Namespace))
+	if err != nil {
+		logCtx.Warnf("Failed to list applications depending on the application: %v", err)
+		return
+	}
+	for _, obj := range bySelector {
+		dependent, ok := obj.(*appv1.Application)
+		if ok && appDependencyKey(dependent) != appDependencyKey(app) && selectsAppDependency(dependent, app) {
+			dependents = append(dependents, dependent)
+		}
+	}
+	requested := map[string]bool{}
+	for _, obj := range dependents {
+		dependent, ok := obj.(*appv1.Application)
+		if !ok || requested[dependent.QualifiedName()] || !ctrl.canProcessApp(dependent) {
+			continue
+		}
+		requested[dependent.QualifiedName()] = true
+		ctrl.requestAppRefresh(dependent.QualifiedName(), CompareWithRecent.Pointer(), nil)
+	}
+}
+
+// selectsAppDependency returns true if one of the label selectors of the dependencies of the dependent Application
+// matches the given application.
+func selectsAppDependency(dependent *appv1.Application, app *appv1.Application) bool {
+	for _, dependency := range dependent.Spec.DependsOn {
+		namespace := dependency.Namespace
+		if namespace == "" {
+			namespace = dependent.Namespace
+		}
+		if dependency.Selector == nil || dependency.Name != "" || namespace != app.Namespace {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(dependency.Selector)
+		if err == nil && selector.Matches(labels.Set(app.Labels)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isWaitingForAppDependencies returns true if the sync operation has not started since the dependencies of its
+// application were not Synced and Healthy.
+func isWaitingForAppDependencies(state *appv1.OperationState) bool {
+	return state.Phase == synccommon.OperationRunning && state.SyncResult == nil && strings.HasPrefix(state.Message, appDependencyWaitMessage)
+}
+
+// appDependencyStatusChanged returns true if the sync or health status of the application changed, which may unblock
+// the Applications depending on it.
+func appDependencyStatusChanged(oldApp, newApp *appv1.Application) bool {
+	return oldApp.Status.Sync.Status != newApp.Status.Sync.Status || oldApp.Status.Health.Status != newApp.Status.Health.Status
+}
+
+// appDependencyKey returns the namespaced name by which the application is referred to in dependency messages.
+func appDependencyKey(app *appv1.Application) string {
+	return app.Namespace + "/" + app.Name
+}
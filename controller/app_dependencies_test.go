@@ -0,0 +1,234 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubetesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newFakeDependencyApp(name string, syncStatus v1alpha1.SyncStatusCode, healthStatus health.HealthStatusCode, dependsOn ...v1alpha1.ApplicationDependency) *v1alpha1.Application {
+	app := newFakeApp()
+	app.Name = name
+	app.Labels = map[string]string{"tier": name}
+	app.Spec.DependsOn = dependsOn
+	app.Status.Sync.Status = syncStatus
+	app.Status.Health.Status = healthStatus
+	return app
+}
+
+func TestGetAppDependencyCondition(t *testing.T) {
+	t.Run("no dependencies", func(t *testing.T) {
+		app := newFakeDependencyApp("app", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing)
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		assert.Nil(t, ctrl.getAppDependencyCondition(app))
+	})
+
+	t.Run("dependencies are synced and healthy", func(t *testing.T) {
+		app := newFakeDependencyApp("app", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing,
+			v1alpha1.ApplicationDependency{Name: "database"},
+			v1alpha1.ApplicationDependency{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "cache"}}},
+		)
+		database := newFakeDependencyApp("database", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		cache := newFakeDependencyApp("cache", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, database, cache}}, nil)
+		assert.Nil(t, ctrl.getAppDependencyCondition(app))
+	})
+
+	t.Run("dependencies are not ready", func(t *testing.T) {
+		app := newFakeDependencyApp("app", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing,
+			v1alpha1.ApplicationDependency{Name: "database"},
+			v1alpha1.ApplicationDependency{Name: "missing"},
+			v1alpha1.ApplicationDependency{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "cache"}}},
+		)
+		database := newFakeDependencyApp("database", v1alpha1.SyncStatusCodeSynced, health.HealthStatusProgressing)
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, database}}, nil)
+
+		cond := ctrl.getAppDependencyCondition(app)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionDependencyWarning, cond.Type)
+		assert.Equal(t, "Waiting for dependencies to be Synced and Healthy: "+
+			"Application '"+test.FakeArgoCDNamespace+"/missing' does not exist; "+
+			"no Application in namespace "+test.FakeArgoCDNamespace+" matches selector 'tier=cache'; "+
+			"Application '"+test.FakeArgoCDNamespace+"/database' is Synced and Progressing", cond.Message)
+	})
+
+	t.Run("dependency cycle", func(t *testing.T) {
+		app := newFakeDependencyApp("app", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, v1alpha1.ApplicationDependency{Name: "database"})
+		database := newFakeDependencyApp("database", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy,
+			v1alpha1.ApplicationDependency{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "app"}}},
+		)
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, database}}, nil)
+
+		cond := ctrl.getAppDependencyCondition(app)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionDependencyError, cond.Type)
+		assert.Equal(t, "Dependency cycle detected: "+
+			test.FakeArgoCDNamespace+"/app -> "+test.FakeArgoCDNamespace+"/database -> "+test.FakeArgoCDNamespace+"/app", cond.Message)
+	})
+
+	t.Run("cycle which does not include the application", func(t *testing.T) {
+		app := newFakeDependencyApp("app", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, v1alpha1.ApplicationDependency{Name: "a"})
+		a := newFakeDependencyApp("a", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, v1alpha1.ApplicationDependency{Name: "b"})
+		b := newFakeDependencyApp("b", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, v1alpha1.ApplicationDependency{Name: "a"})
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, a, b}}, nil)
+
+		cond := ctrl.getAppDependencyCondition(app)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionDependencyWarning, cond.Type)
+	})
+
+	t.Run("selector does not match the application itself", func(t *testing.T) {
+		app := newFakeDependencyApp("app", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing,
+			v1alpha1.ApplicationDependency{Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: metav1.LabelSelectorOpExists}}}},
+		)
+		database := newFakeDependencyApp("database", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, database}}, nil)
+		assert.Nil(t, ctrl.getAppDependencyCondition(app))
+	})
+}
+
+func TestAutoSync_DependenciesNotReady(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.DependsOn = []v1alpha1.ApplicationDependency{{Name: "database"}}
+	database := newFakeDependencyApp("database", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, database}}, nil)
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, ctrl.getAppDependencyCondition(app))
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
+func TestProcessRequestedAppOperation_DependenciesNotReady(t *testing.T) {
+	newApp := func() *v1alpha1.Application {
+		app := newFakeApp()
+		app.Spec.Project = "default"
+		app.Spec.DependsOn = []v1alpha1.ApplicationDependency{{Name: "database"}}
+		app.Operation = &v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{},
+		}
+		return app
+	}
+	processOperation := func(t *testing.T, app *v1alpha1.Application, objects ...runtime.Object) map[string]any {
+		t.Helper()
+		ctrl := newFakeController(&fakeData{
+			apps:              append([]runtime.Object{app, &defaultProj}, objects...),
+			manifestResponses: []*apiclient.ManifestResponse{{Manifests: []string{}}},
+		}, nil)
+		ctrl.syncTimeout = time.Minute
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		receivedPatch := map[string]any{}
+		fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			if patchAction, ok := action.(kubetesting.PatchAction); ok {
+				require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+			}
+			return true, &v1alpha1.Application{}, nil
+		})
+		ctrl.processRequestedAppOperation(app)
+		return receivedPatch
+	}
+
+	t.Run("WaitsForDependencies", func(t *testing.T) {
+		database := newFakeDependencyApp("database", v1alpha1.SyncStatusCodeSynced, health.HealthStatusDegraded)
+		receivedPatch := processOperation(t, newApp(), database)
+
+		phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+		message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+		assert.Equal(t, string(synccommon.OperationRunning), phase)
+		assert.Equal(t, "Waiting for dependencies to be Synced and Healthy: Application '"+test.FakeArgoCDNamespace+"/database' is Synced and Degraded", message)
+		_, hasOperation := receivedPatch["operation"]
+		assert.False(t, hasOperation, "the operation must not be cleared while it waits for its dependencies")
+	})
+
+	t.Run("StartsOnceDependenciesAreReady", func(t *testing.T) {
+		app := newApp()
+		// The operation waited longer than the sync timeout for its dependencies.
+		app.Status.OperationState = &v1alpha1.OperationState{
+			Operation: *app.Operation,
+			Phase:     synccommon.OperationRunning,
+			Message:   "Waiting for dependencies to be Synced and Healthy: Application '" + test.FakeArgoCDNamespace + "/database' is Synced and Degraded",
+			StartedAt: metav1.NewTime(time.Now().Add(-time.Hour)),
+		}
+		database := newFakeDependencyApp("database", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		receivedPatch := processOperation(t, app, database)
+
+		phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+		startedAt, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "startedAt")
+		assert.Equal(t, string(synccommon.OperationSucceeded), phase)
+		started, err := time.Parse(time.RFC3339, startedAt)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now(), started, time.Minute)
+	})
+
+	t.Run("FailsOnDependencyCycle", func(t *testing.T) {
+		database := newFakeDependencyApp("database", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, v1alpha1.ApplicationDependency{Name: "my-app"})
+		receivedPatch := processOperation(t, newApp(), database)
+
+		phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+		message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+		assert.Equal(t, string(synccommon.OperationFailed), phase)
+		assert.Contains(t, message, "Dependency cycle detected")
+	})
+}
+
+func TestRequestDependentAppsRefresh(t *testing.T) {
+	database := newFakeDependencyApp("database", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+	dependent := newFakeDependencyApp("dependent", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, v1alpha1.ApplicationDependency{Name: "database"})
+	selecting := newFakeDependencyApp("selecting", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing,
+		v1alpha1.ApplicationDependency{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "database"}}},
+	)
+	unrelated := newFakeDependencyApp("unrelated", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, v1alpha1.ApplicationDependency{Name: "other"})
+	unselected := newFakeDependencyApp("unselected", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing,
+		v1alpha1.ApplicationDependency{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "cache"}}},
+	)
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{database, dependent, selecting, unrelated, unselected}}, nil)
+
+	ctrl.requestDependentAppsRefresh(database)
+
+	for _, app := range []*v1alpha1.Application{dependent, selecting} {
+		ok, level := ctrl.isRefreshRequested(ctrl.toAppKey(app.QualifiedName()))
+		assert.True(t, ok, app.Name)
+		assert.Equal(t, CompareWithRecent, level)
+	}
+	for _, app := range []*v1alpha1.Application{database, unrelated, unselected} {
+		ok, _ := ctrl.isRefreshRequested(ctrl.toAppKey(app.QualifiedName()))
+		assert.False(t, ok, app.Name)
+	}
+}
+
+func TestAppDependsOnIndexFunc(t *testing.T) {
+	app := newFakeDependencyApp("app", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing,
+		v1alpha1.ApplicationDependency{Name: "database"},
+		v1alpha1.ApplicationDependency{Name: "database", Namespace: "infra"},
+		v1alpha1.ApplicationDependency{Selector: &metav1.LabelSelector{}},
+		v1alpha1.ApplicationDependency{Selector: &metav1.LabelSelector{}, Namespace: "infra"},
+		v1alpha1.ApplicationDependency{Name: "database"},
+	)
+	keys, err := appDependsOnIndexFunc(app)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		test.FakeArgoCDNamespace + "/database",
+		"infra/database",
+		"selector:" + test.FakeArgoCDNamespace,
+		"selector:infra",
+	}, keys)
+}
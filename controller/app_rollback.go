@@ -0,0 +1,144 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// markRevisionHistoryHealthy records in the most recent revision history entry that the application was observed to be
+// Healthy while the revision was deployed, which makes the entry a candidate for automated rollbacks.
+func markRevisionHistoryHealthy(app *appv1.Application, healthStatus health.HealthStatusCode) {
+	if healthStatus != health.HealthStatusHealthy || app.Operation != nil || len(app.Status.History) == 0 {
+		return
+	}
+	opState := app.Status.OperationState
+	if opState == nil || opState.Phase != synccommon.OperationSucceeded || opState.SyncResult == nil {
+		return
+	}
+	latest := &app.Status.History[len(app.Status.History)-1]
+	if latest.Healthy || !deployedRevisionsEqual(*latest, opState.SyncResult) {
+		return
+	}
+	latest.Healthy = true
+}
+
+// autoRollback rolls the application back to the most recent Healthy revision history entry if the application
+// became Degraded within the rollback window of an automated sync. Automated sync stays disabled until the rollback is
+// acknowledged. It returns true if a rollback was initiated.
+func (ctrl *ApplicationController) autoRollback(app *appv1.Application, healthStatus health.HealthStatusCode) bool {
+	if healthStatus != health.HealthStatusDegraded || app.Spec.SyncPolicy == nil || !app.Spec.SyncPolicy.IsAutomatedSyncEnabled() || app.Spec.SyncPolicy.Automated.Rollback == nil {
+		return false
+	}
+	if app.Status.AutomatedRollback != nil || app.Operation != nil || (app.DeletionTimestamp != nil && !app.DeletionTimestamp.IsZero()) {
+		return false
+	}
+	opState := app.Status.OperationState
+	if opState == nil || !opState.Phase.Completed() || !opState.Operation.InitiatedBy.Automated || opState.FinishedAt == nil {
+		return false
+	}
+
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	window, err := app.Spec.SyncPolicy.Automated.Rollback.GetWindow()
+	if err != nil {
+		logCtx.Warnf("Skipping automated rollback: %v", err)
+		return false
+	}
+	if time.Since(opState.FinishedAt.Time) > window {
+		return false
+	}
+	target := findAutomatedRollbackTarget(app.Status.History, opState)
+	if target == nil {
+		logCtx.Warn("Skipping automated rollback: no Healthy revision to roll back to")
+		return false
+	}
+
+	op := argo.NewRollbackOperation(target, app.Spec.SyncPolicy.SyncOptions, app.Spec.SyncPolicy.Automated.Prune, false, appv1.OperationInitiator{Automated: true})
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	updatedApp, err := argo.SetAppOperation(appIf, app.Name, op)
+	if err != nil {
+		logCtx.Errorf("Failed to initiate automated rollback to %d: %v", target.ID, err)
+		return false
+	}
+	ctrl.writeBackToInformer(updatedApp)
+
+	message := fmt.Sprintf("Rolled back to revision %s (history ID %d) since the application became Degraded within %v of the automated sync to %s",
+		deployedRevisionsString(target.Revision, target.Revisions), target.ID, window, syncedRevisionsString(opState))
+	app.Status.AutomatedRollback = &appv1.AutomatedRollbackStatus{
+		RolledBackAt: metav1.Now(),
+		ID:           target.ID,
+		Message:      message,
+	}
+	ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: corev1.EventTypeWarning}, message)
+	logCtx.Warn(message)
+	return true
+}
+
+// getAutomatedRollbackCondition returns an AutomatedRollbackWarning condition if the application was rolled back
+// automatically and the rollback has not been acknowledged yet.
+func getAutomatedRollbackCondition(app *appv1.Application) *appv1.ApplicationCondition {
+	if app.Status.AutomatedRollback == nil {
+		return nil
+	}
+	return &appv1.ApplicationCondition{
+		Type:    appv1.ApplicationConditionAutomatedRollbackWarning,
+		Message: app.Status.AutomatedRollback.Message + ". Automated sync is disabled until the rollback is acknowledged",
+	}
+}
+
+// findAutomatedRollbackTarget returns the most recent Healthy revision history entry which was deployed before the
+// given sync operation started and differs from the revision it synced, or nil if there is none.
+func findAutomatedRollbackTarget(history appv1.RevisionHistories, opState *appv1.OperationState) *appv1.RevisionHistory {
+	for i := len(history) - 1; i >= 0; i-- {
+		info := history[i]
+		if !info.Healthy || !info.DeployedAt.Before(&opState.StartedAt) {
+			continue
+		}
+		if info.Source.IsZero() && info.Sources.IsZero() {
+			continue
+		}
+		if opState.SyncResult != nil && deployedRevisionsEqual(info, opState.SyncResult) {
+			continue
+		}
+		return &history[i]
+	}
+	return nil
+}
+
+// automatedRollbackAcknowledged returns true if the automated rollback of the application was acknowledged, which
+// re-enables automated sync.
+func automatedRollbackAcknowledged(oldApp *appv1.Application, newApp *appv1.Application) bool {
+	return oldApp.Status.AutomatedRollback != nil && newApp.Status.AutomatedRollback == nil
+}
+
+func deployedRevisionsEqual(info appv1.RevisionHistory, syncResult *appv1.SyncOperationResult) bool {
+	return info.Revision == syncResult.Revision && slices.Equal(info.Revisions, syncResult.Revisions)
+}
+
+func syncedRevisionsString(opState *appv1.OperationState) string {
+	if opState.SyncResult != nil {
+		return deployedRevisionsString(opState.SyncResult.Revision, opState.SyncResult.Revisions)
+	}
+	if opState.Operation.Sync != nil {
+		return deployedRevisionsString(opState.Operation.Sync.Revision, opState.Operation.Sync.Revisions)
+	}
+	return ""
+}
+
+func deployedRevisionsString(revision string, revisions []string) string {
+	if len(revisions) > 0 {
+		return fmt.Sprintf("%v", revisions)
+	}
+	return revision
+}
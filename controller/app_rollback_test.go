@@ -0,0 +1,146 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+// newFakeRollbackApp returns an application which was automatically synced to revision "ccc" a minute ago, after
+// revisions "aaa" and "bbb" were deployed.
+func newFakeRollbackApp() *v1alpha1.Application {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.Automated.Rollback = &v1alpha1.AutomatedRollback{}
+	source := app.Spec.GetSource()
+	startedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+	finishedAt := metav1.NewTime(startedAt.Add(10 * time.Second))
+	app.Status.History = v1alpha1.RevisionHistories{
+		{ID: 1, Revision: "aaa", Source: source, DeployedAt: metav1.NewTime(startedAt.Add(-2 * time.Hour)), Healthy: true},
+		{ID: 2, Revision: "bbb", Source: source, DeployedAt: metav1.NewTime(startedAt.Add(-time.Hour))},
+		{ID: 3, Revision: "ccc", Source: source, DeployedAt: finishedAt, Healthy: true},
+	}
+	app.Status.OperationState = &v1alpha1.OperationState{
+		Operation: v1alpha1.Operation{
+			Sync:        &v1alpha1.SyncOperation{Revision: "ccc"},
+			InitiatedBy: v1alpha1.OperationInitiator{Automated: true},
+		},
+		Phase:      synccommon.OperationSucceeded,
+		StartedAt:  startedAt,
+		FinishedAt: &finishedAt,
+		SyncResult: &v1alpha1.SyncOperationResult{Revision: "ccc", Source: source},
+	}
+	return app
+}
+
+func TestMarkRevisionHistoryHealthy(t *testing.T) {
+	t.Run("latest deployment is healthy", func(t *testing.T) {
+		app := newFakeRollbackApp()
+		app.Status.History[2].Healthy = false
+		markRevisionHistoryHealthy(app, health.HealthStatusHealthy)
+		assert.True(t, app.Status.History[2].Healthy)
+		assert.False(t, app.Status.History[1].Healthy)
+	})
+
+	t.Run("latest deployment is not healthy", func(t *testing.T) {
+		app := newFakeRollbackApp()
+		app.Status.History[2].Healthy = false
+		markRevisionHistoryHealthy(app, health.HealthStatusProgressing)
+		assert.False(t, app.Status.History[2].Healthy)
+	})
+
+	t.Run("last sync did not deploy the latest revision", func(t *testing.T) {
+		app := newFakeRollbackApp()
+		app.Status.History[2].Healthy = false
+		app.Status.OperationState.Phase = synccommon.OperationFailed
+		markRevisionHistoryHealthy(app, health.HealthStatusHealthy)
+		assert.False(t, app.Status.History[2].Healthy)
+	})
+}
+
+func TestAutoRollback(t *testing.T) {
+	t.Run("rolls back to the last healthy revision", func(t *testing.T) {
+		app := newFakeRollbackApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+
+		assert.True(t, ctrl.autoRollback(app, health.HealthStatusDegraded))
+
+		require.NotNil(t, app.Status.AutomatedRollback)
+		assert.Equal(t, int64(1), app.Status.AutomatedRollback.ID)
+		assert.Equal(t, "Rolled back to revision aaa (history ID 1) since the application became Degraded within 5m0s of the automated sync to ccc", app.Status.AutomatedRollback.Message)
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, updatedApp.Operation)
+		assert.Equal(t, "aaa", updatedApp.Operation.Sync.Revision)
+		assert.True(t, updatedApp.Operation.InitiatedBy.Automated)
+
+		cond := getAutomatedRollbackCondition(app)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionAutomatedRollbackWarning, cond.Type)
+	})
+
+	t.Run("application is not degraded", func(t *testing.T) {
+		app := newFakeRollbackApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		assert.False(t, ctrl.autoRollback(app, health.HealthStatusProgressing))
+	})
+
+	t.Run("rollback is not enabled", func(t *testing.T) {
+		app := newFakeRollbackApp()
+		app.Spec.SyncPolicy.Automated.Rollback = nil
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		assert.False(t, ctrl.autoRollback(app, health.HealthStatusDegraded))
+	})
+
+	t.Run("outside of the rollback window", func(t *testing.T) {
+		app := newFakeRollbackApp()
+		app.Spec.SyncPolicy.Automated.Rollback.Window = "30s"
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		assert.False(t, ctrl.autoRollback(app, health.HealthStatusDegraded))
+	})
+
+	t.Run("last sync was not automated", func(t *testing.T) {
+		app := newFakeRollbackApp()
+		app.Status.OperationState.Operation.InitiatedBy = v1alpha1.OperationInitiator{Username: "admin"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		assert.False(t, ctrl.autoRollback(app, health.HealthStatusDegraded))
+	})
+
+	t.Run("no healthy revision to roll back to", func(t *testing.T) {
+		app := newFakeRollbackApp()
+		app.Status.History[0].Healthy = false
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		assert.False(t, ctrl.autoRollback(app, health.HealthStatusDegraded))
+	})
+
+	t.Run("already rolled back", func(t *testing.T) {
+		app := newFakeRollbackApp()
+		app.Status.AutomatedRollback = &v1alpha1.AutomatedRollbackStatus{ID: 1}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		assert.False(t, ctrl.autoRollback(app, health.HealthStatusDegraded))
+	})
+}
+
+func TestAutoSync_AutomatedRollbackNotAcknowledged(t *testing.T) {
+	app := newFakeApp()
+	app.Status.AutomatedRollback = &v1alpha1.AutomatedRollbackStatus{ID: 1}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, nil)
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
@@ -78,6 +78,8 @@ const (
 	defaultDeploymentInformerResyncDuration = 10 * time.Second
 	// orphanedIndex contains application which monitor orphaned resources by namespace
 	orphanedIndex = "orphaned"
+	// dependsOnIndex contains applications by the applications they depend on
+	dependsOnIndex = "dependsOn"
 )
 
 type CompareWith int
@@ -136,7 +138,10 @@ type ApplicationController struct {
 	metricsServer                 *metrics.MetricsServer
 	metricsClusterLabels          []string
 	kubectlSemaphore              *semaphore.Weighted
+	syncLimiter                   *syncConcurrencyLimiter
 	clusterSharding               sharding.ClusterShardingCache
+	clusterHandoffs               *clusterHandoffs
+	resourceDiffCache             *argodiff.ResourceDiffCache
 	projByNameCache               sync.Map
 	applicationNamespaces         []string
 	ignoreNormalizerOpts          normalizers.IgnoreNormalizerOpts
@@ -181,6 +186,8 @@ func NewApplicationController(
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts,
 	enableK8sEvent []string,
 	hydratorEnabled bool,
+	clusterSyncConcurrencyLimit int,
+	projectSyncConcurrencyLimit int,
 ) (*ApplicationController, error) {
 	log.Infof("appResyncPeriod=%v, appHardResyncPeriod=%v, appResyncJitter=%v", appResyncPeriod, appHardResyncPeriod, appResyncJitter)
 	db := db.NewDB(namespace, settingsMgr, kubeClientset)
@@ -213,6 +220,7 @@ func NewApplicationController(
 		selfHealBackoffCooldown:           selfHealBackoffCooldown,
 		syncTimeout:                       syncTimeout,
 		clusterSharding:                   clusterSharding,
+		clusterHandoffs:                   newClusterHandoffs(),
 		projByNameCache:                   sync.Map{},
 		applicationNamespaces:             applicationNamespaces,
 		dynamicClusterDistributionEnabled: dynamicClusterDistributionEnabled,
@@ -225,6 +233,9 @@ func NewApplicationController(
 	if kubectlParallelismLimit > 0 {
 		ctrl.kubectlSemaphore = semaphore.NewWeighted(kubectlParallelismLimit)
 	}
+	ctrl.syncLimiter = newSyncConcurrencyLimiter(clusterSyncConcurrencyLimit, projectSyncConcurrencyLimit, func(slot syncSlot, count int) {
+		ctrl.metricsServer.SetSyncQueued(slot.cluster, slot.project, count)
+	})
 	kubectl.SetOnKubectlRun(ctrl.onKubectlRun)
 	appInformer, appLister := ctrl.newApplicationInformerAndLister()
 	indexers := cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}
@@ -328,8 +339,15 @@ func NewApplicationController(
 			return nil, err
 		}
 	}
-	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterSharding, argo.NewResourceTracking())
-	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.onKubectlRun, ctrl.settingsMgr, stateCache, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts)
+	ctrl.clusterHandoffs.onPhaseChanged = func(server string, phase clusterHandoffPhase) {
+		ctrl.metricsServer.SetClusterHandoffPhase(server, string(phase))
+	}
+	ctrl.clusterHandoffs.onHandoffCompleted = ctrl.metricsServer.IncClusterHandoff
+	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterSharding, argo.NewResourceTracking(), ctrl.clusterHandoffs.isClaimed)
+	if resourceDiffCacheEnabled {
+		ctrl.resourceDiffCache = argodiff.NewResourceDiffCache(resourceDiffCacheMaxResources, ctrl.metricsServer.IncResourceDiffCacheLookups)
+	}
+	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.onKubectlRun, ctrl.settingsMgr, stateCache, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts, ctrl.resourceDiffCache)
 	ctrl.appInformer = appInformer
 	ctrl.appLister = appLister
 	ctrl.projInformer = projInformer
@@ -924,6 +942,8 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	}
 
 	go func() { errors.CheckError(ctrl.stateCache.Run(ctx)) }()
+	ctrl.updateClusterHandoffs(ctx, time.Now())
+	go ctrl.runClusterHandoffs(ctx)
 	go func() { errors.CheckError(ctrl.metricsServer.ListenAndServe()) }()
 
 	for i := 0; i < statusProcessors; i++ {
@@ -1017,6 +1037,7 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 	}
 	if !exists {
 		// This happens after app was deleted, but the work queue still had an entry for it.
+		ctrl.releaseSyncSlot(appKey)
 		return
 	}
 	origApp, ok := obj.(*appv1.Application)
@@ -1048,8 +1069,22 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 	}
 	ts.AddCheckpoint("get_fresh_app_ms")
 
+	if app.Operation == nil {
+		// The operation was removed, so it no longer counts against the sync concurrency limits
+		ctrl.releaseSyncSlot(appKey)
+		if app.ScheduledOperation != nil && app.DeletionTimestamp == nil {
+			ctrl.processScheduledAppOperation(app)
+		}
+	}
+
 	if app.Operation != nil {
-		ctrl.processRequestedAppOperation(app)
+		// the operation is not processed while the cluster of the application is handed off to another shard
+		if done, ok := ctrl.startAppOperation(app); ok {
+			ctrl.processRequestedAppOperation(app)
+			done()
+		} else {
+			logCtx.Debug("Skipping operation of application, its cluster is handed off to another shard")
+		}
 		ts.AddCheckpoint("process_requested_app_operation_ms")
 	} else if app.DeletionTimestamp != nil {
 		if err = ctrl.finalizeApplicationDeletion(app, func(project string) ([]*appv1.Cluster, error) {
@@ -1206,6 +1241,7 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 	if err != nil {
 		logCtx.Warnf("Unable to get destination cluster: %v", err)
 		app.UnSetCascadedDeletion()
+		app.UnSetPreDeleteFinalizerAll()
 		app.UnSetPostDeleteFinalizerAll()
 		if err := ctrl.updateFinalizers(app); err != nil {
 			return err
@@ -1219,6 +1255,43 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 	}
 	config := metrics.AddMetricsTransportWrapper(ctrl.metricsServer, app, clusterRESTConfig)
 
+	// Pre-delete hooks must succeed before any resource of the application is deleted
+	if app.HasPreDeleteFinalizer() {
+		objsMap, err := ctrl.getPermittedAppLiveObjects(destCluster, app, proj, projectClusters)
+		if err != nil {
+			return err
+		}
+
+		done, message, err := ctrl.executeDeleteHooks(app, proj, objsMap, config, logCtx, preDeleteHookStage)
+		if err != nil {
+			return err
+		}
+		if !done {
+			ctrl.setAppCondition(app, appv1.ApplicationCondition{Type: appv1.ApplicationConditionDeletionWarning, Message: message})
+			return nil
+		}
+		ctrl.unsetAppCondition(app, appv1.ApplicationConditionDeletionWarning)
+		app.UnSetPreDeleteFinalizer()
+		return ctrl.updateFinalizers(app)
+	}
+
+	if app.HasPreDeleteFinalizer("cleanup") {
+		objsMap, err := ctrl.getPermittedAppLiveObjects(destCluster, app, proj, projectClusters)
+		if err != nil {
+			return err
+		}
+
+		done, err := ctrl.cleanupDeleteHooks(objsMap, config, logCtx, preDeleteHookStage)
+		if err != nil {
+			return err
+		}
+		if !done {
+			return nil
+		}
+		app.UnSetPreDeleteFinalizer("cleanup")
+		return ctrl.updateFinalizers(app)
+	}
+
 	if app.CascadedDeletion() {
 		deletionApproved := app.IsDeletionConfirmed(app.DeletionTimestamp.Time)
 
@@ -1287,7 +1360,7 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 			return err
 		}
 
-		done, err := ctrl.executePostDeleteHooks(app, proj, objsMap, config, logCtx)
+		done, _, err := ctrl.executeDeleteHooks(app, proj, objsMap, config, logCtx, postDeleteHookStage)
 		if err != nil {
 			return err
 		}
@@ -1304,7 +1377,7 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 			return err
 		}
 
-		done, err := ctrl.cleanupPostDeleteHooks(objsMap, config, logCtx)
+		done, err := ctrl.cleanupDeleteHooks(objsMap, config, logCtx, postDeleteHookStage)
 		if err != nil {
 			return err
 		}
@@ -1371,6 +1444,26 @@ func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condi
 	}
 }
 
+// unsetAppCondition removes the conditions of the given type from the application
+func (ctrl *ApplicationController) unsetAppCondition(app *appv1.Application, conditionType appv1.ApplicationConditionType) {
+	if len(app.Status.GetConditions(map[appv1.ApplicationConditionType]bool{conditionType: true})) == 0 {
+		return
+	}
+	app.Status.SetConditions([]appv1.ApplicationCondition{}, map[appv1.ApplicationConditionType]bool{conditionType: true})
+
+	patch, err := json.Marshal(map[string]any{
+		"status": map[string]any{
+			"conditions": app.Status.Conditions,
+		},
+	})
+	if err == nil {
+		_, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(context.Background(), app.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	}
+	if err != nil {
+		log.WithFields(applog.GetAppLogFields(app)).Errorf("Unable to unset application condition: %v", err)
+	}
+}
+
 func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Application) {
 	logCtx := log.WithFields(applog.GetAppLogFields(app))
 	var state *appv1.OperationState
@@ -1385,6 +1478,7 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 				state.Message = fmt.Sprintf("%v", r)
 			}
 			ctrl.setOperationState(app, state)
+			ctrl.releaseSyncSlot(ctrl.toAppKey(app.QualifiedName()))
 		}
 	}()
 	ts := stats.NewTimingStats()
@@ -1396,12 +1490,15 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		logCtx.Debug("Finished processing requested app operation")
 	}()
 	terminatingCause := ""
+	var revertOp *appv1.Operation
 	if isOperationInProgress(app) {
 		state = app.Status.OperationState.DeepCopy()
 		switch {
 		case state.Phase == synccommon.OperationTerminating:
 			logCtx.Infof("Resuming in-progress operation. phase: %s, message: %s", state.Phase, state.Message)
-		case ctrl.syncTimeout != time.Duration(0) && time.Now().After(state.StartedAt.Add(ctrl.syncTimeout)):
+		// Operations waiting for a sync slot don't time out, their timeout starts once they acquire a slot.
+		case ctrl.syncTimeout != time.Duration(0) && time.Now().After(state.StartedAt.Add(ctrl.syncTimeout)) &&
+			!ctrl.syncLimiter.isQueued(ctrl.toAppKey(app.QualifiedName())) && !isWaitingForAppDependencies(state):
 			state.Phase = synccommon.OperationTerminating
 			state.Message = "operation is terminating due to timeout"
 			terminatingCause = "controller sync timeout"
@@ -1456,8 +1553,31 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	terminating := state.Phase == synccommon.OperationTerminating
 	project, err := ctrl.getAppProj(app)
 	if err == nil {
-		// Start or resume the sync
-		ctrl.appStateManager.SyncAppState(app, project, state)
+		// A sync which has not started yet is held until the dependencies of the application are Synced and Healthy.
+		var dependencyCond *appv1.ApplicationCondition
+		if !terminating && state.SyncResult == nil {
+			dependencyCond = ctrl.getAppDependencyCondition(app)
+		}
+		switch {
+		case dependencyCond != nil && dependencyCond.Type == appv1.ApplicationConditionDependencyError:
+			state.Phase = synccommon.OperationFailed
+			state.Message = dependencyCond.Message
+		case dependencyCond != nil:
+			// The operation stays Running and is processed again once the application is refreshed, which happens as
+			// soon as the sync or health status of one of its dependencies changes
+			state.Message = dependencyCond.Message
+		default:
+			if isWaitingForAppDependencies(state) {
+				ctrl.restartOperation(ctrl.toAppKey(app.QualifiedName()), state)
+			}
+			if queuedMessage := ctrl.acquireSyncSlot(app, state); queuedMessage != "" {
+				// The operation stays Running and is requeued once one of the running operations completes
+				state.Message = queuedMessage
+			} else {
+				// Start or resume the sync
+				ctrl.appStateManager.SyncAppState(app, project, state)
+			}
+		}
 	} else {
 		state.Phase = synccommon.OperationError
 		state.Message = fmt.Sprintf("Failed to load application project: %v", err)
@@ -1478,6 +1598,9 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 				// cleanup (e.g. delete jobs, workflows, etc...)
 			}
 		}
+		if delay := syncWaveDelayRemaining(state, time.Now()); delay > 0 {
+			ctrl.appOperationQueue.AddAfter(ctrl.toAppKey(app.QualifiedName()), delay)
+		}
 	case synccommon.OperationFailed, synccommon.OperationError:
 		if !terminating && (state.RetryCount < state.Operation.Retry.Limit || state.Operation.Retry.Limit < 0) {
 			now := metav1.Now()
@@ -1499,11 +1622,25 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 			if state.RetryCount > 0 {
 				state.Message = fmt.Sprintf("%s (retried %d times).", state.Message, state.RetryCount)
 			}
+			// An atomic sync is reverted if it failed or timed out, but not if it was terminated by the user
+			timedOut := ctrl.syncTimeout != time.Duration(0) && time.Now().After(state.StartedAt.Add(ctrl.syncTimeout))
+			if !terminating || timedOut {
+				revertOp = newAtomicSyncRevertOperation(app, state)
+			}
+			if revertOp != nil {
+				state.Message = fmt.Sprintf("%s. Reverting %d changed resources to %s", state.Message, len(revertOp.Sync.Resources), atomicSyncRevertTargetString(revertOp))
+			}
 		}
 	}
 
 	ctrl.setOperationState(app, state)
 	ts.AddCheckpoint("final_set_operation_state")
+	if state.Phase.Completed() {
+		ctrl.releaseSyncSlot(ctrl.toAppKey(app.QualifiedName()))
+	}
+	if revertOp != nil {
+		ctrl.startAtomicSyncRevert(app, revertOp)
+	}
 	if state.Phase.Completed() && (app.Operation.Sync != nil && !app.Operation.Sync.DryRun) {
 		// if we just completed an operation, force a refresh so that UI will report up-to-date
 		// sync/health information
@@ -1517,6 +1654,16 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	ts.AddCheckpoint("request_app_refresh_ms")
 }
 
+// restartOperation resets the start time of an operation which waited before it could run, so that the time it waited
+// does not count against the sync timeout.
+func (ctrl *ApplicationController) restartOperation(appKey string, state *appv1.OperationState) {
+	state.StartedAt = metav1.Now()
+	if ctrl.syncTimeout != time.Duration(0) {
+		// Schedule a check during which the timeout would be checked.
+		ctrl.appOperationQueue.AddAfter(appKey, ctrl.syncTimeout)
+	}
+}
+
 func (ctrl *ApplicationController) setOperationState(app *appv1.Application, state *appv1.OperationState) {
 	logCtx := log.WithFields(applog.GetAppLogFields(app))
 	if state.Phase == "" {
@@ -1740,6 +1887,20 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		return
 	}
 
+	inMaintenance := ctrl.setClusterMaintenanceCondition(app, destCluster, now.Time)
+	if destCluster.IsWatchPaused(now.Time) {
+		logCtx.Info("Skipping comparison: watching the destination cluster is paused for maintenance")
+		patchDuration = ctrl.persistAppStatus(origApp, &app.Status)
+		return
+	}
+	if connectionHealth := ctrl.stateCache.GetClusterConnectionHealth(destCluster.Server); connectionHealth.IsQuarantined(now.Time) {
+		// the application is reconciled again once the quarantine ends, which probes the connection to the cluster
+		retryAfter := connectionHealth.QuarantinedUntil.Sub(now.Time)
+		logCtx.Debugf("Skipping comparison: the destination cluster is quarantined for %v after repeated connection failures", retryAfter)
+		ctrl.requestAppRefresh(app.QualifiedName(), &comparisonLevel, &retryAfter)
+		return
+	}
+
 	var localManifests []string
 	if opState := app.Status.OperationState; opState != nil && opState.Operation.Sync != nil {
 		localManifests = opState.Operation.Sync.Manifests
@@ -1796,9 +1957,25 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary(app)
 	}
 
+	var dependencyConditions []appv1.ApplicationCondition
+	dependencyCond := ctrl.getAppDependencyCondition(app)
+	if dependencyCond != nil {
+		dependencyConditions = append(dependencyConditions, *dependencyCond)
+	}
+	app.Status.SetConditions(dependencyConditions, map[appv1.ApplicationConditionType]bool{
+		appv1.ApplicationConditionDependencyError:   true,
+		appv1.ApplicationConditionDependencyWarning: true,
+	})
+
+	markRevisionHistoryHealthy(app, compareResult.healthStatus)
+
 	canSync, _ := project.Spec.SyncWindows.Matches(app).CanSync(false)
-	if canSync {
-		syncErrCond, opDuration := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionsMayHaveChanges)
+	switch {
+	case inMaintenance:
+		logCtx.Info("Automated sync prevented by cluster maintenance mode")
+	case canSync:
+		ctrl.autoRollback(app, compareResult.healthStatus)
+		syncErrCond, opDuration := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionsMayHaveChanges, dependencyCond)
 		setOpDuration = opDuration
 		if syncErrCond != nil {
 			app.Status.SetConditions(
@@ -1811,9 +1988,16 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
 			)
 		}
-	} else {
+	default:
 		logCtx.Info("Sync prevented by sync window")
 	}
+	var rollbackConditions []appv1.ApplicationCondition
+	if rollbackCond := getAutomatedRollbackCondition(app); rollbackCond != nil {
+		rollbackConditions = append(rollbackConditions, *rollbackCond)
+	}
+	app.Status.SetConditions(rollbackConditions, map[appv1.ApplicationConditionType]bool{
+		appv1.ApplicationConditionAutomatedRollbackWarning: true,
+	})
 	ts.AddCheckpoint("auto_sync_ms")
 
 	if app.Status.ReconciledAt == nil || comparisonLevel >= CompareWithLatest {
@@ -1832,8 +2016,15 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	patchDuration = ctrl.persistAppStatus(origApp, &app.Status)
 	// This is a partly a duplicate of patch_ms, but more descriptive and allows to have measurement for the next step.
 	ts.AddCheckpoint("persist_app_status_ms")
-	if (compareResult.hasPostDeleteHooks != app.HasPostDeleteFinalizer() || compareResult.hasPostDeleteHooks != app.HasPostDeleteFinalizer("cleanup")) &&
-		app.GetDeletionTimestamp() == nil {
+	if app.GetDeletionTimestamp() == nil && (compareResult.hasPreDeleteHooks != app.HasPreDeleteFinalizer() || compareResult.hasPreDeleteHooks != app.HasPreDeleteFinalizer("cleanup") ||
+		compareResult.hasPostDeleteHooks != app.HasPostDeleteFinalizer() || compareResult.hasPostDeleteHooks != app.HasPostDeleteFinalizer("cleanup")) {
+		if compareResult.hasPreDeleteHooks {
+			app.SetPreDeleteFinalizer()
+			app.SetPreDeleteFinalizer("cleanup")
+		} else {
+			app.UnSetPreDeleteFinalizer()
+			app.UnSetPreDeleteFinalizer("cleanup")
+		}
 		if compareResult.hasPostDeleteHooks {
 			app.SetPostDeleteFinalizer()
 			app.SetPostDeleteFinalizer("cleanup")
@@ -2092,8 +2283,9 @@ func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, new
 	return patchDuration
 }
 
-// autoSync will initiate a sync operation for an application configured with automated sync
-func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *appv1.SyncStatus, resources []appv1.ResourceStatus, shouldCompareRevisions bool) (*appv1.ApplicationCondition, time.Duration) {
+// autoSync will initiate a sync operation for an application configured with automated sync. No sync is initiated
+// while the given dependency condition of the application is set.
+func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *appv1.SyncStatus, resources []appv1.ResourceStatus, shouldCompareRevisions bool, dependencyCond *appv1.ApplicationCondition) (*appv1.ApplicationCondition, time.Duration) {
 	logCtx := log.WithFields(applog.GetAppLogFields(app))
 	ts := stats.NewTimingStats()
 	defer func() {
@@ -2115,6 +2307,10 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		logCtx.Infof("Skipping auto-sync: deletion in progress")
 		return nil, 0
 	}
+	if app.Status.AutomatedRollback != nil {
+		logCtx.Infof("Skipping auto-sync: application was rolled back automatically and the rollback is not acknowledged")
+		return nil, 0
+	}
 
 	// Only perform auto-sync if we detect OutOfSync status. This is to prevent us from attempting
 	// a sync when application is already in a Synced or Unknown state
@@ -2123,6 +2319,11 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		return nil, 0
 	}
 
+	if dependencyCond != nil {
+		logCtx.Infof("Skipping auto-sync: %s", dependencyCond.Message)
+		return nil, 0
+	}
+
 	if !app.Spec.SyncPolicy.Automated.Prune {
 		requirePruneOnly := true
 		for _, r := range resources {
@@ -2164,6 +2365,11 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	// auto-sync with pruning disabled). We need to ensure that we do not keep Syncing an
 	// application in an infinite loop. To detect this, we only attempt the Sync if the revision
 	// and parameter overrides are different from our most recent sync operation.
+	if revertCond := getAtomicSyncRevertCondition(app, desiredRevisions); revertCond != nil {
+		logCtx.Warnf("Skipping auto-sync: failed previous atomic sync attempt to %s was reverted", desiredRevisions)
+		return revertCond, 0
+	}
+
 	alreadyAttempted, lastAttemptedRevisions, lastAttemptedPhase := alreadyAttemptedSync(app, desiredRevisions, shouldCompareRevisions)
 	ts.AddCheckpoint("already_attempted_sync_ms")
 	if alreadyAttempted {
@@ -2339,6 +2545,19 @@ func (ctrl *ApplicationController) isAppNamespaceAllowed(app *appv1.Application)
 	return app.Namespace == ctrl.namespace || glob.MatchStringInList(ctrl.applicationNamespaces, app.Namespace, glob.REGEXP)
 }
 
+// evictResourceDiffs removes the cached resource diffs of an application which this controller no longer handles.
+func (ctrl *ApplicationController) evictResourceDiffs(obj any) {
+	if ctrl.resourceDiffCache == nil {
+		return
+	}
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if app, ok := obj.(*appv1.Application); ok {
+		ctrl.resourceDiffCache.Delete(app.InstanceName(ctrl.namespace))
+	}
+}
+
 func (ctrl *ApplicationController) canProcessApp(obj any) bool {
 	app, ok := obj.(*appv1.Application)
 	if !ok {
@@ -2369,7 +2588,7 @@ func (ctrl *ApplicationController) canProcessApp(obj any) bool {
 	if err != nil {
 		return ctrl.clusterSharding.IsManagedCluster(nil)
 	}
-	return ctrl.clusterSharding.IsManagedCluster(destCluster)
+	return ctrl.clusterHandoffs.canProcess(destCluster.Server, ctrl.clusterSharding.IsManagedApp(app, destCluster))
 }
 
 func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.SharedIndexInformer, applisters.ApplicationLister) {
@@ -2445,6 +2664,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 				return nil, nil
 			},
+			dependsOnIndex: appDependsOnIndexFunc,
 		},
 	)
 	lister := applisters.NewApplicationLister(informer.GetIndexer())
@@ -2465,6 +2685,9 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 			},
 			UpdateFunc: func(old, new any) {
 				if !ctrl.canProcessApp(new) {
+					// The application may have moved to another shard, which is noticed at the latest on the next
+					// resync of the informer
+					ctrl.evictResourceDiffs(new)
 					return
 				}
 
@@ -2483,6 +2706,13 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 						log.WithFields(applog.GetAppLogFields(newApp)).Info("Enabled automated sync")
 						compareWith = CompareWithLatest.Pointer()
 					}
+					if automatedRollbackAcknowledged(oldApp, newApp) {
+						log.WithFields(applog.GetAppLogFields(newApp)).Info("Automated rollback acknowledged")
+						compareWith = CompareWithLatest.Pointer()
+					}
+					if appDependencyStatusChanged(oldApp, newApp) {
+						ctrl.requestDependentAppsRefresh(newApp)
+					}
 					if ctrl.statusRefreshJitter != 0 && oldApp.ResourceVersion == newApp.ResourceVersion {
 						// Handler is refreshing the apps, add a random jitter to spread the load and avoid spikes
 						jitter := time.Duration(float64(ctrl.statusRefreshJitter) * rand.Float64())
@@ -2500,6 +2730,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				ctrl.clusterSharding.UpdateApp(newApp)
 			},
 			DeleteFunc: func(obj any) {
+				ctrl.evictResourceDiffs(obj)
 				if !ctrl.canProcessApp(obj) {
 					return
 				}
@@ -2537,7 +2768,7 @@ func (ctrl *ApplicationController) projectErrorToCondition(err error, app *appv1
 }
 
 func (ctrl *ApplicationController) RegisterClusterSecretUpdater(ctx context.Context) {
-	updater := NewClusterInfoUpdater(ctrl.stateCache, ctrl.db, ctrl.appLister.Applications(""), ctrl.cache, ctrl.clusterSharding.IsManagedCluster, ctrl.getAppProj, ctrl.namespace)
+	updater := NewClusterInfoUpdater(ctrl.stateCache, ctrl.db, ctrl.appLister.Applications(""), ctrl.cache, ctrl.clusterSharding.IsManagedCluster, ctrl.getAppProj, ctrl.namespace, ctrl.clusterSharding, ctrl.stateCache.GetClusterConnectionHealth)
 	go updater.Run(ctx)
 }
 
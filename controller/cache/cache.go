@@ -75,6 +75,15 @@ const (
 	// EnvClusterCacheEventsProcessingInterval is the env variable to control the interval between processing events when BatchEventsProcessing is enabled
 	EnvClusterCacheEventsProcessingInterval = "ARGOCD_CLUSTER_CACHE_EVENTS_PROCESSING_INTERVAL"
 
+	// EnvClusterQuarantineFailureThreshold is the env variable to control the number of consecutive connection failures after which a cluster is quarantined
+	EnvClusterQuarantineFailureThreshold = "ARGOCD_CLUSTER_QUARANTINE_FAILURE_THRESHOLD"
+
+	// EnvClusterQuarantineBackoffDuration is the env variable that holds the initial duration of the quarantine of a cluster
+	EnvClusterQuarantineBackoffDuration = "ARGOCD_CLUSTER_QUARANTINE_BACKOFF_DURATION"
+
+	// EnvClusterQuarantineMaxBackoffDuration is the env variable that holds the maximum duration of the quarantine of a cluster
+	EnvClusterQuarantineMaxBackoffDuration = "ARGOCD_CLUSTER_QUARANTINE_MAX_BACKOFF_DURATION"
+
 	// AnnotationIgnoreResourceUpdates when set to true on an untracked resource,
 	// argo will apply `ignoreResourceUpdates` configuration on it.
 	AnnotationIgnoreResourceUpdates = "argocd.argoproj.io/ignore-resource-updates"
@@ -117,6 +126,20 @@ var (
 	clusterCacheEventsProcessingInterval = 100 * time.Millisecond
 )
 
+// Cluster connection circuit breaker options
+var (
+	// clusterQuarantineFailureThreshold is the number of consecutive connection failures after which a cluster is
+	// quarantined. If set to 0, clusters are never quarantined.
+	clusterQuarantineFailureThreshold = 5
+
+	// clusterQuarantineBackoff is the duration of the first quarantine of a cluster, which doubles every time the
+	// connection probe at the end of the quarantine fails
+	clusterQuarantineBackoff = 30 * time.Second
+
+	// clusterQuarantineMaxBackoff is the maximum duration of the quarantine of a cluster
+	clusterQuarantineMaxBackoff = 10 * time.Minute
+)
+
 func init() {
 	clusterCacheResyncDuration = env.ParseDurationFromEnv(EnvClusterCacheResyncDuration, clusterCacheResyncDuration, 0, math.MaxInt64)
 	clusterCacheWatchResyncDuration = env.ParseDurationFromEnv(EnvClusterCacheWatchResyncDuration, clusterCacheWatchResyncDuration, 0, math.MaxInt64)
@@ -128,6 +151,9 @@ func init() {
 	clusterCacheRetryUseBackoff = env.ParseBoolFromEnv(EnvClusterCacheRetryUseBackoff, false)
 	clusterCacheBatchEventsProcessing = env.ParseBoolFromEnv(EnvClusterCacheBatchEventsProcessing, true)
 	clusterCacheEventsProcessingInterval = env.ParseDurationFromEnv(EnvClusterCacheEventsProcessingInterval, clusterCacheEventsProcessingInterval, 0, math.MaxInt64)
+	clusterQuarantineFailureThreshold = env.ParseNumFromEnv(EnvClusterQuarantineFailureThreshold, clusterQuarantineFailureThreshold, 0, math.MaxInt32)
+	clusterQuarantineBackoff = env.ParseDurationFromEnv(EnvClusterQuarantineBackoffDuration, clusterQuarantineBackoff, time.Second, math.MaxInt64)
+	clusterQuarantineMaxBackoff = env.ParseDurationFromEnv(EnvClusterQuarantineMaxBackoffDuration, clusterQuarantineMaxBackoff, time.Second, math.MaxInt64)
 }
 
 type LiveStateCache interface {
@@ -153,10 +179,18 @@ type LiveStateCache interface {
 	Init() error
 	// UpdateShard will update the shard of ClusterSharding when the shard has changed.
 	UpdateShard(shard int) bool
+	// Stops watching the given cluster and removes its cache, e.g. after the cluster was handed off to another shard
+	RemoveCluster(server string)
+	// Returns the connection failures of the given cluster, and whether it is quarantined because of them
+	GetClusterConnectionHealth(server string) ClusterConnectionHealth
 }
 
 type ObjectUpdatedHandler = func(managedByApp map[string]bool, ref corev1.ObjectReference)
 
+// ClusterClaimedFunc returns whether the shard holds its claim on the given cluster, which it keeps after the cluster
+// was assigned to another shard until the hand-off of the cluster is completed.
+type ClusterClaimedFunc = func(server string) bool
+
 type PodInfo struct {
 	NodeName         string
 	ResourceRequests corev1.ResourceList
@@ -193,6 +227,7 @@ func NewLiveStateCache(
 	onObjectUpdated ObjectUpdatedHandler,
 	clusterSharding sharding.ClusterShardingCache,
 	resourceTracking argo.ResourceTracking,
+	isClusterClaimed ClusterClaimedFunc,
 ) LiveStateCache {
 	return &liveStateCache{
 		appInformer:      appInformer,
@@ -203,6 +238,7 @@ func NewLiveStateCache(
 		metricsServer:    metricsServer,
 		clusterSharding:  clusterSharding,
 		resourceTracking: resourceTracking,
+		isClusterClaimed: isClusterClaimed,
 	}
 }
 
@@ -227,10 +263,12 @@ type liveStateCache struct {
 	clusterSharding      sharding.ClusterShardingCache
 	resourceTracking     argo.ResourceTracking
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts
+	isClusterClaimed     ClusterClaimedFunc
 
 	clusters      map[string]clustercache.ClusterCache
 	cacheSettings cacheSettings
 	lock          sync.RWMutex
+	connections   clusterConnectionTracker
 }
 
 func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
@@ -500,6 +538,10 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 		return nil, fmt.Errorf("controller is configured to ignore cluster %s", cluster.Server)
 	}
 
+	if cluster.IsWatchPaused(time.Now()) {
+		return nil, fmt.Errorf("watching cluster %s is paused for maintenance", cluster.Server)
+	}
+
 	resourceCustomLabels, err := c.settingsMgr.GetResourceCustomLabels()
 	if err != nil {
 		return nil, fmt.Errorf("error getting custom label: %w", err)
@@ -635,11 +677,15 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 }
 
 func (c *liveStateCache) getSyncedCluster(server *appv1.Cluster) (clustercache.ClusterCache, error) {
+	if err := c.checkClusterQuarantine(server.Server); err != nil {
+		return nil, err
+	}
 	clusterCache, err := c.getCluster(server)
 	if err != nil {
 		return nil, fmt.Errorf("error getting cluster: %w", err)
 	}
 	err = clusterCache.EnsureSynced()
+	c.recordClusterConnection(server.Server, clusterCache, err)
 	if err != nil {
 		return nil, fmt.Errorf("error synchronizing cache state : %w", err)
 	}
@@ -734,7 +780,7 @@ func (c *liveStateCache) isClusterHasApps(apps []any, cluster *appv1.Cluster) bo
 			log.Warnf("Failed to get destination cluster: %v", err)
 			continue
 		}
-		if destCluster.Server == cluster.Server {
+		if destCluster.Server == cluster.Server && c.clusterSharding.IsManagedApp(app, cluster) {
 			return true
 		}
 	}
@@ -796,8 +842,12 @@ func (c *liveStateCache) Run(ctx context.Context) error {
 	return nil
 }
 
+// canHandleCluster returns whether the cluster is watched by this shard. Clusters with application sharding enabled
+// are watched in full by every shard, since any shard may process some of their applications, and clusters which are
+// handed off to another shard are watched until the shard released its claim on them.
 func (c *liveStateCache) canHandleCluster(cluster *appv1.Cluster) bool {
-	return c.clusterSharding.IsManagedCluster(cluster)
+	return c.clusterSharding.IsManagedCluster(cluster) || sharding.IsApplicationShardingEnabled(cluster) ||
+		(c.isClusterClaimed != nil && c.isClusterClaimed(cluster.Server))
 }
 
 func (c *liveStateCache) handleAddEvent(cluster *appv1.Cluster) {
@@ -806,6 +856,10 @@ func (c *liveStateCache) handleAddEvent(cluster *appv1.Cluster) {
 		log.Infof("Ignoring cluster %s", cluster.Server)
 		return
 	}
+	if cluster.IsWatchPaused(time.Now()) {
+		log.Infof("Not watching cluster %s: cluster is in maintenance", cluster.Server)
+		return
+	}
 	c.lock.Lock()
 	_, ok := c.clusters[cluster.Server]
 	c.lock.Unlock()
@@ -830,7 +884,7 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 	cluster, ok := c.clusters[newCluster.Server]
 	c.lock.Unlock()
 	if ok {
-		if !c.canHandleCluster(newCluster) {
+		if !c.canHandleCluster(newCluster) || newCluster.IsWatchPaused(time.Now()) {
 			cluster.Invalidate()
 			c.lock.Lock()
 			delete(c.clusters, newCluster.Server)
@@ -881,6 +935,20 @@ func (c *liveStateCache) handleDeleteEvent(clusterServer string) {
 		delete(c.clusters, clusterServer)
 		c.lock.Unlock()
 	}
+	c.forgetClusterConnection(clusterServer)
+}
+
+func (c *liveStateCache) RemoveCluster(server string) {
+	c.lock.RLock()
+	cluster, ok := c.clusters[server]
+	c.lock.RUnlock()
+	if ok {
+		cluster.Invalidate()
+		c.lock.Lock()
+		delete(c.clusters, server)
+		c.lock.Unlock()
+	}
+	c.forgetClusterConnection(server)
 }
 
 func (c *liveStateCache) GetClustersInfo() []clustercache.ClusterInfo {
@@ -126,6 +126,66 @@ func TestHandleModEvent_ClusterExcluded(t *testing.T) {
 	assert.Len(t, clustersCache.clusters, 1)
 }
 
+func TestHandleModEvent_WatchPaused(t *testing.T) {
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("Invalidate").Return(nil).Once()
+	db := &dbmocks.ArgoDB{}
+	db.On("GetApplicationControllerReplicas").Return(1)
+	clustersCache := liveStateCache{
+		clusters: map[string]cache.ClusterCache{
+			"https://mycluster": clusterCache,
+		},
+		clusterSharding: sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm),
+	}
+
+	pausedCluster := &appv1.Cluster{
+		Server:      "https://mycluster",
+		Maintenance: &appv1.ClusterMaintenance{PauseWatch: true},
+	}
+	clustersCache.handleModEvent(&appv1.Cluster{Server: "https://mycluster"}, pausedCluster)
+
+	assert.Empty(t, clustersCache.clusters)
+	_, err := clustersCache.getCluster(pausedCluster)
+	require.ErrorContains(t, err, "paused for maintenance")
+}
+
+func TestHandleModEvent_ClusterReleasing(t *testing.T) {
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("Invalidate", mock.Anything, mock.Anything).Return(nil).Once()
+	clusterCache.On("EnsureSynced").Return(nil).Maybe()
+	db := &dbmocks.ArgoDB{}
+	db.On("GetApplicationControllerReplicas").Return(2)
+	releasing := true
+	clustersCache := liveStateCache{
+		clusters: map[string]cache.ClusterCache{
+			"https://mycluster": clusterCache,
+		},
+		// the cluster was assigned to the other shard
+		clusterSharding: sharding.NewClusterSharding(db, 0, 2, common.DefaultShardingAlgorithm),
+		isClusterClaimed: func(server string) bool {
+			return releasing && server == "https://mycluster"
+		},
+	}
+	oldCluster := &appv1.Cluster{ID: "2", Server: "https://mycluster", Config: appv1.ClusterConfig{Username: "foo"}}
+	clustersCache.clusterSharding.Add(oldCluster)
+	require.False(t, clustersCache.clusterSharding.IsManagedCluster(oldCluster))
+
+	// the cluster secret is updated while the cluster is released to the other shard
+	newCluster := &appv1.Cluster{ID: "2", Server: "https://mycluster", Config: appv1.ClusterConfig{Username: "bar"}}
+	clustersCache.handleModEvent(oldCluster, newCluster)
+
+	assert.Len(t, clustersCache.clusters, 1)
+	clusterCache.AssertExpectations(t)
+	cluster, err := clustersCache.getCluster(newCluster)
+	require.NoError(t, err)
+	assert.Equal(t, clusterCache, cluster)
+
+	releasing = false
+	clusterCache.On("Invalidate").Return(nil).Once()
+	clustersCache.handleModEvent(newCluster, newCluster)
+	assert.Empty(t, clustersCache.clusters)
+}
+
 func TestHandleModEvent_NoChanges(_ *testing.T) {
 	clusterCache := &mocks.ClusterCache{}
 	clusterCache.On("Invalidate", mock.Anything).Panic("should not invalidate")
@@ -163,6 +223,20 @@ func TestHandleAddEvent_ClusterExcluded(t *testing.T) {
 	assert.Empty(t, clustersCache.clusters)
 }
 
+func TestCanHandleCluster_ApplicationSharding(t *testing.T) {
+	db := &dbmocks.ArgoDB{}
+	clustersCache := liveStateCache{
+		clusters:        map[string]cache.ClusterCache{},
+		clusterSharding: sharding.NewClusterSharding(db, 0, 2, common.DefaultShardingAlgorithm),
+	}
+	cluster := &appv1.Cluster{ID: "2", Server: "https://mycluster"}
+	clustersCache.clusterSharding.Add(cluster)
+	assert.False(t, clustersCache.canHandleCluster(cluster))
+
+	cluster.Annotations = map[string]string{common.AnnotationKeyApplicationSharding: "true"}
+	assert.True(t, clustersCache.canHandleCluster(cluster))
+}
+
 func TestHandleDeleteEvent_CacheDeadlock(t *testing.T) {
 	testCluster := &appv1.Cluster{
 		Server: "https://mycluster",
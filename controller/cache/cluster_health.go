@@ -0,0 +1,142 @@
+package cache
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	log "github.com/sirupsen/logrus"
+)
+
+// ClusterConnectionHealth contains the connection failures of a cluster. A cluster is quarantined after repeated
+// connection failures: the applications of the cluster are not reconciled until QuarantinedUntil, when the connection
+// is probed again.
+type ClusterConnectionHealth struct {
+	// ConsecutiveFailures is the number of cache sync attempts which failed since the last successful one
+	ConsecutiveFailures int
+	// QuarantinedUntil is the time until which the cluster is quarantined, or nil if the cluster is not quarantined
+	QuarantinedUntil *time.Time
+}
+
+// IsQuarantined returns whether the cluster is quarantined and its connection must not be probed yet.
+func (h ClusterConnectionHealth) IsQuarantined(now time.Time) bool {
+	return h.QuarantinedUntil != nil && now.Before(*h.QuarantinedUntil)
+}
+
+type clusterConnectionState struct {
+	lastAttempt      time.Time
+	failures         int
+	backoff          time.Duration
+	quarantinedUntil *time.Time
+}
+
+// clusterConnectionTracker is a circuit breaker for the connections to the clusters. It counts the failed cache sync
+// attempts of each cluster and quarantines a cluster once the count reaches clusterQuarantineFailureThreshold. The
+// quarantine is doubled, up to clusterQuarantineMaxBackoff, every time the connection probe at its end fails, and is
+// lifted by the first successful sync. The zero value is ready to use.
+type clusterConnectionTracker struct {
+	lock     sync.Mutex
+	clusters map[string]*clusterConnectionState
+}
+
+func (t *clusterConnectionTracker) get(server string) ClusterConnectionHealth {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	state, ok := t.clusters[server]
+	if !ok {
+		return ClusterConnectionHealth{}
+	}
+	return ClusterConnectionHealth{ConsecutiveFailures: state.failures, QuarantinedUntil: state.quarantinedUntil}
+}
+
+// record records the result of the cache sync attempt made at the given time and returns the connection health before
+// and after the attempt. Results of attempts which were already recorded are ignored.
+func (t *clusterConnectionTracker) record(server string, attemptedAt time.Time, err error) (prev ClusterConnectionHealth, next ClusterConnectionHealth, recorded bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.clusters == nil {
+		t.clusters = map[string]*clusterConnectionState{}
+	}
+	state, ok := t.clusters[server]
+	if !ok {
+		state = &clusterConnectionState{}
+	}
+	prev = ClusterConnectionHealth{ConsecutiveFailures: state.failures, QuarantinedUntil: state.quarantinedUntil}
+	if err == nil {
+		delete(t.clusters, server)
+		return prev, ClusterConnectionHealth{}, ok
+	}
+	if attemptedAt.Equal(state.lastAttempt) {
+		return prev, prev, false
+	}
+	t.clusters[server] = state
+	state.lastAttempt = attemptedAt
+	state.failures++
+	if clusterQuarantineFailureThreshold > 0 && state.failures >= clusterQuarantineFailureThreshold {
+		switch {
+		case state.backoff == 0:
+			state.backoff = clusterQuarantineBackoff
+		case state.backoff < clusterQuarantineMaxBackoff:
+			state.backoff = min(2*state.backoff, clusterQuarantineMaxBackoff)
+		}
+		quarantinedUntil := attemptedAt.Add(state.backoff)
+		state.quarantinedUntil = &quarantinedUntil
+	}
+	return prev, ClusterConnectionHealth{ConsecutiveFailures: state.failures, QuarantinedUntil: state.quarantinedUntil}, true
+}
+
+func (t *clusterConnectionTracker) remove(server string) (quarantined bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	state, ok := t.clusters[server]
+	delete(t.clusters, server)
+	return ok && state.quarantinedUntil != nil
+}
+
+// forgetClusterConnection drops the connection health of a cluster which is not watched anymore.
+func (c *liveStateCache) forgetClusterConnection(server string) {
+	if c.connections.remove(server) {
+		c.metricsServer.SetClusterQuarantined(server, false)
+	}
+}
+
+// checkClusterQuarantine returns an error if the given cluster is quarantined, so that no connection attempt is made
+// until the quarantine ends.
+func (c *liveStateCache) checkClusterQuarantine(server string) error {
+	health := c.connections.get(server)
+	if !health.IsQuarantined(time.Now()) {
+		return nil
+	}
+	return fmt.Errorf("cluster %s is quarantined until %s after %d consecutive connection failures", server, health.QuarantinedUntil.Format(time.RFC3339), health.ConsecutiveFailures)
+}
+
+// recordClusterConnection records the result of the last cache sync attempt of the given cluster.
+func (c *liveStateCache) recordClusterConnection(server string, clusterCache clustercache.ClusterCache, err error) {
+	attemptedAt := time.Now()
+	if err != nil {
+		if syncTime := clusterCache.GetClusterInfo().LastCacheSyncTime; syncTime != nil {
+			attemptedAt = *syncTime
+		}
+	}
+	prev, next, recorded := c.connections.record(server, attemptedAt, err)
+	if !recorded {
+		return
+	}
+	if err != nil {
+		c.metricsServer.IncClusterConnectionFailures(server)
+	}
+	switch {
+	case next.QuarantinedUntil != nil && (prev.QuarantinedUntil == nil || !next.QuarantinedUntil.Equal(*prev.QuarantinedUntil)):
+		log.Warnf("Quarantining cluster %s until %s after %d consecutive connection failures: %v", server, next.QuarantinedUntil.Format(time.RFC3339), next.ConsecutiveFailures, err)
+		c.metricsServer.SetClusterQuarantined(server, true)
+	case prev.QuarantinedUntil != nil && next.QuarantinedUntil == nil:
+		log.Infof("Connection to cluster %s recovered, lifting its quarantine", server)
+		c.metricsServer.SetClusterQuarantined(server, false)
+	}
+}
+
+// GetClusterConnectionHealth returns the connection health of the given cluster.
+func (c *liveStateCache) GetClusterConnectionHealth(server string) ClusterConnectionHealth {
+	return c.connections.get(server)
+}
@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestClusterConnectionTracker_Quarantine(t *testing.T) {
+	server := "https://mycluster"
+	connErr := errors.New("connection refused")
+	tracker := clusterConnectionTracker{}
+	now := time.Now()
+
+	for i := 1; i < clusterQuarantineFailureThreshold; i++ {
+		_, next, recorded := tracker.record(server, now.Add(time.Duration(i)*time.Second), connErr)
+		require.True(t, recorded)
+		assert.Equal(t, i, next.ConsecutiveFailures)
+		assert.Nil(t, next.QuarantinedUntil)
+	}
+
+	// the same sync attempt is only counted once
+	_, next, recorded := tracker.record(server, now.Add(time.Duration(clusterQuarantineFailureThreshold-1)*time.Second), connErr)
+	assert.False(t, recorded)
+	assert.Equal(t, clusterQuarantineFailureThreshold-1, next.ConsecutiveFailures)
+
+	attemptedAt := now.Add(time.Minute)
+	_, next, recorded = tracker.record(server, attemptedAt, connErr)
+	require.True(t, recorded)
+	require.NotNil(t, next.QuarantinedUntil)
+	assert.Equal(t, attemptedAt.Add(clusterQuarantineBackoff), *next.QuarantinedUntil)
+	assert.True(t, next.IsQuarantined(attemptedAt))
+	assert.False(t, next.IsQuarantined(*next.QuarantinedUntil))
+
+	// the quarantine is doubled when the probe fails
+	attemptedAt = next.QuarantinedUntil.Add(time.Second)
+	_, next, _ = tracker.record(server, attemptedAt, connErr)
+	assert.Equal(t, attemptedAt.Add(2*clusterQuarantineBackoff), *next.QuarantinedUntil)
+
+	prev, next, recorded := tracker.record(server, attemptedAt.Add(time.Hour), nil)
+	assert.True(t, recorded)
+	assert.NotNil(t, prev.QuarantinedUntil)
+	assert.Equal(t, ClusterConnectionHealth{}, next)
+	assert.Equal(t, ClusterConnectionHealth{}, tracker.get(server))
+}
+
+func TestClusterConnectionTracker_MaxBackoff(t *testing.T) {
+	server := "https://mycluster"
+	connErr := errors.New("connection refused")
+	tracker := clusterConnectionTracker{}
+	attemptedAt := time.Now()
+
+	var next ClusterConnectionHealth
+	for i := 0; i < clusterQuarantineFailureThreshold+10; i++ {
+		attemptedAt = attemptedAt.Add(time.Hour)
+		_, next, _ = tracker.record(server, attemptedAt, connErr)
+	}
+	assert.Equal(t, attemptedAt.Add(clusterQuarantineMaxBackoff), *next.QuarantinedUntil)
+}
+
+func TestCheckClusterQuarantine(t *testing.T) {
+	server := "https://mycluster"
+	c := &liveStateCache{}
+	require.NoError(t, c.checkClusterQuarantine(server))
+
+	c.connections.clusters = map[string]*clusterConnectionState{
+		server: {failures: clusterQuarantineFailureThreshold, quarantinedUntil: ptr.To(time.Now().Add(time.Minute))},
+	}
+	err := c.checkClusterQuarantine(server)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is quarantined until")
+
+	c.connections.clusters[server].quarantinedUntil = ptr.To(time.Now().Add(-time.Second))
+	require.NoError(t, c.checkClusterQuarantine(server))
+
+	assert.True(t, c.connections.remove(server))
+	assert.Equal(t, ClusterConnectionHealth{}, c.GetClusterConnectionHealth(server))
+}
@@ -105,6 +105,57 @@ func (_c *LiveStateCache_GetClusterCache_Call) RunAndReturn(run func(server *v1a
 	return _c
 }
 
+// GetClusterConnectionHealth provides a mock function for the type LiveStateCache
+func (_mock *LiveStateCache) GetClusterConnectionHealth(server string) cache0.ClusterConnectionHealth {
+	ret := _mock.Called(server)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClusterConnectionHealth")
+	}
+
+	var r0 cache0.ClusterConnectionHealth
+	if returnFunc, ok := ret.Get(0).(func(string) cache0.ClusterConnectionHealth); ok {
+		r0 = returnFunc(server)
+	} else {
+		r0 = ret.Get(0).(cache0.ClusterConnectionHealth)
+	}
+	return r0
+}
+
+// LiveStateCache_GetClusterConnectionHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClusterConnectionHealth'
+type LiveStateCache_GetClusterConnectionHealth_Call struct {
+	*mock.Call
+}
+
+// GetClusterConnectionHealth is a helper method to define mock.On call
+//   - server string
+func (_e *LiveStateCache_Expecter) GetClusterConnectionHealth(server interface{}) *LiveStateCache_GetClusterConnectionHealth_Call {
+	return &LiveStateCache_GetClusterConnectionHealth_Call{Call: _e.mock.On("GetClusterConnectionHealth", server)}
+}
+
+func (_c *LiveStateCache_GetClusterConnectionHealth_Call) Run(run func(server string)) *LiveStateCache_GetClusterConnectionHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *LiveStateCache_GetClusterConnectionHealth_Call) Return(clusterConnectionHealth cache0.ClusterConnectionHealth) *LiveStateCache_GetClusterConnectionHealth_Call {
+	_c.Call.Return(clusterConnectionHealth)
+	return _c
+}
+
+func (_c *LiveStateCache_GetClusterConnectionHealth_Call) RunAndReturn(run func(server string) cache0.ClusterConnectionHealth) *LiveStateCache_GetClusterConnectionHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetClustersInfo provides a mock function for the type LiveStateCache
 func (_mock *LiveStateCache) GetClustersInfo() []cache.ClusterInfo {
 	ret := _mock.Called()
@@ -591,6 +642,46 @@ func (_c *LiveStateCache_IterateResources_Call) RunAndReturn(run func(server *v1
 	return _c
 }
 
+// RemoveCluster provides a mock function for the type LiveStateCache
+func (_mock *LiveStateCache) RemoveCluster(server string) {
+	_mock.Called(server)
+	return
+}
+
+// LiveStateCache_RemoveCluster_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveCluster'
+type LiveStateCache_RemoveCluster_Call struct {
+	*mock.Call
+}
+
+// RemoveCluster is a helper method to define mock.On call
+//   - server string
+func (_e *LiveStateCache_Expecter) RemoveCluster(server interface{}) *LiveStateCache_RemoveCluster_Call {
+	return &LiveStateCache_RemoveCluster_Call{Call: _e.mock.On("RemoveCluster", server)}
+}
+
+func (_c *LiveStateCache_RemoveCluster_Call) Run(run func(server string)) *LiveStateCache_RemoveCluster_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *LiveStateCache_RemoveCluster_Call) Return() *LiveStateCache_RemoveCluster_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *LiveStateCache_RemoveCluster_Call) RunAndReturn(run func(server string)) *LiveStateCache_RemoveCluster_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Run provides a mock function for the type LiveStateCache
 func (_mock *LiveStateCache) Run(ctx context.Context) error {
 	ret := _mock.Called(ctx)
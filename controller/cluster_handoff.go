@@ -0,0 +1,390 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v3/controller/sharding"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
+	"github.com/argoproj/argo-cd/v3/util/env"
+)
+
+const (
+	EnvClusterHandoffTimeout = "ARGOCD_CONTROLLER_CLUSTER_HANDOFF_TIMEOUT"
+)
+
+var (
+	// clusterHandoffTimeout is the time after which a shard takes over a cluster even if the shard which previously
+	// processed the cluster did not release it, and after which a shard releases a cluster even if the shard the
+	// cluster is handed off to did not sync its cache of the cluster.
+	clusterHandoffTimeout = env.ParseDurationFromEnv(EnvClusterHandoffTimeout, 5*time.Minute, 0, 24*time.Hour)
+	// clusterHandoffInterval is the interval at which the claims on the clusters are renewed and hand-offs progress.
+	clusterHandoffInterval = time.Duration(sharding.HeartbeatDuration) * time.Second
+	// clusterShardClaimExpiration is the time after which the claim of a shard on a cluster expires if it is not
+	// renewed, e.g. because the shard is not running anymore.
+	clusterShardClaimExpiration = time.Duration(sharding.HeartbeatTimeout) * time.Second
+)
+
+type clusterHandoffPhase string
+
+const (
+	// clusterHandoffPhaseOwned means that the shard processes the applications of the cluster and renews its claim
+	clusterHandoffPhaseOwned clusterHandoffPhase = "Owned"
+	// clusterHandoffPhaseWarming means that the cluster was assigned to the shard, which syncs its cache of the cluster
+	// while another shard still processes the applications of the cluster
+	clusterHandoffPhaseWarming clusterHandoffPhase = "Warming"
+	// clusterHandoffPhaseReleasing means that the cluster was assigned to another shard, and the shard keeps processing
+	// the applications of the cluster until the other shard is ready to take over the cluster
+	clusterHandoffPhaseReleasing clusterHandoffPhase = "Releasing"
+	// clusterHandoffPhaseReleased means that the shard does not start any operation on the applications of the cluster
+	// anymore, and releases its claim as soon as the running operations are completed
+	clusterHandoffPhaseReleased clusterHandoffPhase = "Released"
+)
+
+type clusterHandoffState struct {
+	phase clusterHandoffPhase
+	since time.Time
+	// shard is the shard number the cluster was claimed with
+	shard   int
+	warming bool
+	warmed  bool
+}
+
+// clusterHandoffs tracks the hand-offs of clusters between the shard of the controller and the other shards. A cluster
+// is only processed by the shard it is assigned to once the shard claimed it, which happens after the shard which
+// previously processed the cluster released it. The operations which are running on the applications of the cluster
+// are not interrupted: the previous shard releases the cluster once they are completed, and the new shard resumes the
+// operations from the state stored in the applications.
+type clusterHandoffs struct {
+	lock               sync.Mutex
+	clusters           map[string]*clusterHandoffState
+	runningOperations  map[string]int
+	onPhaseChanged     func(server string, phase clusterHandoffPhase)
+	onHandoffCompleted func(server string, result string)
+}
+
+func newClusterHandoffs() *clusterHandoffs {
+	return &clusterHandoffs{
+		clusters:          map[string]*clusterHandoffState{},
+		runningOperations: map[string]int{},
+	}
+}
+
+// canProcess returns whether the applications of the given cluster are processed by the shard, given whether the
+// cluster is assigned to the shard.
+func (h *clusterHandoffs) canProcess(server string, assigned bool) bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	state, ok := h.clusters[server]
+	if !ok {
+		return assigned
+	}
+	return state.phase == clusterHandoffPhaseOwned || state.phase == clusterHandoffPhaseReleasing
+}
+
+// isClaimed returns whether the shard holds its claim on the given cluster. The shard keeps its claim, and keeps watching
+// the cluster, while it hands off the cluster to another shard until it released the cluster.
+func (h *clusterHandoffs) isClaimed(server string) bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	state, ok := h.clusters[server]
+	if !ok {
+		return false
+	}
+	switch state.phase {
+	case clusterHandoffPhaseOwned, clusterHandoffPhaseReleasing, clusterHandoffPhaseReleased:
+		return true
+	}
+	return false
+}
+
+// startOperation registers an operation running on an application of the given cluster, unless the shard does not
+// process the cluster. The returned function must be called once the operation is processed.
+func (h *clusterHandoffs) startOperation(server string) (func(), bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if state, ok := h.clusters[server]; ok && state.phase != clusterHandoffPhaseOwned && state.phase != clusterHandoffPhaseReleasing {
+		return nil, false
+	}
+	h.runningOperations[server]++
+	return func() {
+		h.lock.Lock()
+		defer h.lock.Unlock()
+		h.runningOperations[server]--
+		if h.runningOperations[server] <= 0 {
+			delete(h.runningOperations, server)
+		}
+	}, true
+}
+
+func (h *clusterHandoffs) getState(server string) (clusterHandoffState, bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	state, ok := h.clusters[server]
+	if !ok {
+		return clusterHandoffState{}, false
+	}
+	return *state, true
+}
+
+func (h *clusterHandoffs) setPhase(server string, phase clusterHandoffPhase, now time.Time) {
+	h.lock.Lock()
+	state, ok := h.clusters[server]
+	if !ok {
+		state = &clusterHandoffState{}
+		h.clusters[server] = state
+	}
+	changed := state.phase != phase
+	if changed {
+		state.phase = phase
+		state.since = now
+	}
+	h.lock.Unlock()
+	if changed && h.onPhaseChanged != nil {
+		h.onPhaseChanged(server, phase)
+	}
+}
+
+// claimed records that the shard claimed the cluster with the given shard number.
+func (h *clusterHandoffs) claimed(server string, shard int, now time.Time) {
+	h.setPhase(server, clusterHandoffPhaseOwned, now)
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.clusters[server].shard = shard
+}
+
+func (h *clusterHandoffs) remove(server string) {
+	h.lock.Lock()
+	_, ok := h.clusters[server]
+	delete(h.clusters, server)
+	h.lock.Unlock()
+	if ok && h.onPhaseChanged != nil {
+		h.onPhaseChanged(server, "")
+	}
+}
+
+// startWarming marks the cache of the cluster as being synced, and returns false if it is already being synced.
+func (h *clusterHandoffs) startWarming(server string) bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	state, ok := h.clusters[server]
+	if !ok || state.warming || state.warmed {
+		return false
+	}
+	state.warming = true
+	return true
+}
+
+func (h *clusterHandoffs) finishWarming(server string, warmed bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if state, ok := h.clusters[server]; ok {
+		state.warming = false
+		state.warmed = warmed
+	}
+}
+
+func (h *clusterHandoffs) hasRunningOperations(server string) bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return h.runningOperations[server] > 0
+}
+
+func (h *clusterHandoffs) handoffCompleted(server string, result string) {
+	if h.onHandoffCompleted != nil {
+		h.onHandoffCompleted(server, result)
+	}
+}
+
+// runClusterHandoffs periodically renews the claims of the shard on its clusters and progresses the hand-offs of
+// clusters which were assigned to or away from the shard.
+func (ctrl *ApplicationController) runClusterHandoffs(ctx context.Context) {
+	ticker := time.NewTicker(clusterHandoffInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ctrl.updateClusterHandoffs(ctx, time.Now())
+		}
+	}
+}
+
+// updateClusterHandoffs progresses the hand-offs of all clusters which are processed by the shard or assigned to it.
+func (ctrl *ApplicationController) updateClusterHandoffs(ctx context.Context, now time.Time) {
+	clusters, err := ctrl.db.ListClusters(ctx)
+	if err != nil {
+		log.Warnf("Failed to update cluster hand-offs: %v", err)
+		return
+	}
+	distribution := ctrl.clusterSharding.GetDistribution()
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		// the applications of a cluster with application sharding are processed by all shards
+		if sharding.IsApplicationShardingEnabled(cluster) {
+			continue
+		}
+		shard, ok := distribution[cluster.Server]
+		if !ok {
+			continue
+		}
+		var err error
+		if ctrl.clusterSharding.IsManagedCluster(cluster) {
+			err = ctrl.claimCluster(cluster, shard, now)
+		} else {
+			err = ctrl.releaseCluster(cluster, shard, now)
+		}
+		if err != nil {
+			log.Warnf("Failed to update hand-off of cluster %s: %v", cluster.Server, err)
+		}
+	}
+}
+
+// claimCluster progresses the hand-off of a cluster which is assigned to the shard. The shard claims the cluster
+// right away unless another shard claimed it. Otherwise, the shard syncs its cache of the cluster, tells the other
+// shard it is ready to take over the cluster, and claims the cluster once the other shard released it.
+func (ctrl *ApplicationController) claimCluster(cluster *appv1.Cluster, shard int, now time.Time) error {
+	state, ok := ctrl.clusterHandoffs.getState(cluster.Server)
+	if ok && state.phase == clusterHandoffPhaseOwned {
+		return ctrl.cache.SetClusterShardClaim(cluster.Server, &appstatecache.ClusterShardClaim{Shard: shard, RenewedAt: now}, clusterShardClaimExpiration)
+	}
+
+	var claim appstatecache.ClusterShardClaim
+	claimed := true
+	if err := ctrl.cache.GetClusterShardClaim(cluster.Server, &claim); err != nil {
+		if !errors.Is(err, appstatecache.ErrCacheMiss) {
+			return err
+		}
+		claimed = false
+	}
+	timedOut := ok && state.phase == clusterHandoffPhaseWarming && now.Sub(state.since) > clusterHandoffTimeout
+	if !claimed || claim.Shard == shard || timedOut {
+		if err := ctrl.cache.SetClusterShardClaim(cluster.Server, &appstatecache.ClusterShardClaim{Shard: shard, RenewedAt: now}, clusterShardClaimExpiration); err != nil {
+			return err
+		}
+		if err := ctrl.cache.SetClusterShardHandoff(cluster.Server, nil, clusterShardClaimExpiration); err != nil {
+			log.Warnf("Failed to remove hand-off of cluster %s: %v", cluster.Server, err)
+		}
+		ctrl.clusterHandoffs.claimed(cluster.Server, shard, now)
+		if ok {
+			result := "claimed"
+			if timedOut {
+				result = "claimed_after_timeout"
+			}
+			log.Infof("Cluster %s was handed off to shard %d (%s)", cluster.Server, shard, result)
+			ctrl.clusterHandoffs.handoffCompleted(cluster.Server, result)
+			ctrl.requeueClusterApps(cluster.Server)
+		}
+		return nil
+	}
+
+	if !ok {
+		log.Infof("Cluster %s is claimed by shard %d, syncing the cluster cache before taking it over", cluster.Server, claim.Shard)
+		ctrl.clusterHandoffs.setPhase(cluster.Server, clusterHandoffPhaseWarming, now)
+	}
+	if state.warmed {
+		return ctrl.cache.SetClusterShardHandoff(cluster.Server, &appstatecache.ClusterShardHandoff{Shard: shard, ReadyAt: now}, clusterShardClaimExpiration)
+	}
+	if ctrl.clusterHandoffs.startWarming(cluster.Server) {
+		go func() {
+			_, err := ctrl.stateCache.GetClusterCache(cluster)
+			if err != nil {
+				log.Warnf("Failed to sync the cache of cluster %s before taking it over: %v", cluster.Server, err)
+			}
+			ctrl.clusterHandoffs.finishWarming(cluster.Server, err == nil)
+		}()
+	}
+	return nil
+}
+
+// releaseCluster progresses the hand-off of a cluster which is not assigned to the shard anymore. The shard keeps
+// processing the cluster until the shard the cluster is assigned to is ready to take it over, then waits for the
+// running operations to complete and releases the cluster.
+func (ctrl *ApplicationController) releaseCluster(cluster *appv1.Cluster, shard int, now time.Time) error {
+	state, ok := ctrl.clusterHandoffs.getState(cluster.Server)
+	if !ok {
+		return nil
+	}
+	switch state.phase {
+	case clusterHandoffPhaseWarming:
+		// the cluster was assigned back to the shard which processes it
+		ctrl.clusterHandoffs.remove(cluster.Server)
+		return nil
+	case clusterHandoffPhaseOwned:
+		log.Infof("Cluster %s was assigned to shard %d, waiting for it to be ready before releasing the cluster", cluster.Server, shard)
+		ctrl.clusterHandoffs.setPhase(cluster.Server, clusterHandoffPhaseReleasing, now)
+		state.phase, state.since = clusterHandoffPhaseReleasing, now
+	}
+
+	if state.phase == clusterHandoffPhaseReleasing {
+		var handoff appstatecache.ClusterShardHandoff
+		err := ctrl.cache.GetClusterShardHandoff(cluster.Server, &handoff)
+		if err != nil && !errors.Is(err, appstatecache.ErrCacheMiss) {
+			return err
+		}
+		ready := err == nil && handoff.Shard == shard
+		if !ready && now.Sub(state.since) <= clusterHandoffTimeout {
+			return ctrl.cache.SetClusterShardClaim(cluster.Server, &appstatecache.ClusterShardClaim{Shard: state.shard, RenewedAt: now}, clusterShardClaimExpiration)
+		}
+		ctrl.clusterHandoffs.setPhase(cluster.Server, clusterHandoffPhaseReleased, now)
+		state.since = now
+		if !ready {
+			log.Warnf("Shard %d is not ready to take over cluster %s after %v, releasing the cluster anyway", shard, cluster.Server, clusterHandoffTimeout)
+		}
+	}
+
+	if ctrl.clusterHandoffs.hasRunningOperations(cluster.Server) {
+		return ctrl.cache.SetClusterShardClaim(cluster.Server, &appstatecache.ClusterShardClaim{Shard: state.shard, RenewedAt: now}, clusterShardClaimExpiration)
+	}
+	if err := ctrl.cache.SetClusterShardClaim(cluster.Server, nil, clusterShardClaimExpiration); err != nil {
+		return err
+	}
+	// the claim is removed first, so that the cache of the cluster is not rebuilt once it is removed
+	ctrl.clusterHandoffs.remove(cluster.Server)
+	ctrl.stateCache.RemoveCluster(cluster.Server)
+	ctrl.clusterHandoffs.handoffCompleted(cluster.Server, "released")
+	log.Infof("Cluster %s was released to shard %d", cluster.Server, shard)
+	return nil
+}
+
+// startAppOperation registers an operation running on the given application, so that the cluster of the application
+// is not handed off to another shard while the operation is processed. It returns false if the operation must not be
+// processed, because the cluster is handed off.
+func (ctrl *ApplicationController) startAppOperation(app *appv1.Application) (func(), bool) {
+	destCluster, err := argo.GetDestinationCluster(context.Background(), app.Spec.Destination, ctrl.db)
+	if err != nil {
+		// the error is reported by the operation
+		return func() {}, true
+	}
+	return ctrl.clusterHandoffs.startOperation(destCluster.Server)
+}
+
+// requeueClusterApps requests the refresh of all applications of the given cluster, after the shard took it over.
+func (ctrl *ApplicationController) requeueClusterApps(server string) {
+	apps, err := ctrl.appLister.List(labels.Everything())
+	if err != nil {
+		log.Warnf("Failed to list applications of cluster %s: %v", server, err)
+		return
+	}
+	for _, app := range apps {
+		destCluster, err := argo.GetDestinationCluster(context.Background(), app.Spec.Destination, ctrl.db)
+		if err != nil || destCluster.Server != server || !ctrl.canProcessApp(app) {
+			continue
+		}
+		key, err := cache.MetaNamespaceKeyFunc(app)
+		if err != nil {
+			continue
+		}
+		ctrl.appRefreshQueue.AddRateLimited(key)
+		ctrl.appOperationQueue.AddRateLimited(key)
+	}
+}
@@ -0,0 +1,158 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	mockstatecache "github.com/argoproj/argo-cd/v3/controller/cache/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
+)
+
+const handoffTestServer = "https://handoff.example.com"
+
+func TestClusterHandoffs_CanProcess(t *testing.T) {
+	handoffs := newClusterHandoffs()
+	now := time.Now()
+
+	assert.True(t, handoffs.canProcess(handoffTestServer, true))
+	assert.False(t, handoffs.canProcess(handoffTestServer, false))
+
+	handoffs.setPhase(handoffTestServer, clusterHandoffPhaseWarming, now)
+	assert.False(t, handoffs.canProcess(handoffTestServer, true))
+	_, ok := handoffs.startOperation(handoffTestServer)
+	assert.False(t, ok)
+
+	handoffs.setPhase(handoffTestServer, clusterHandoffPhaseReleasing, now)
+	assert.True(t, handoffs.canProcess(handoffTestServer, false))
+	done, ok := handoffs.startOperation(handoffTestServer)
+	require.True(t, ok)
+	assert.True(t, handoffs.hasRunningOperations(handoffTestServer))
+	done()
+	assert.False(t, handoffs.hasRunningOperations(handoffTestServer))
+
+	handoffs.setPhase(handoffTestServer, clusterHandoffPhaseReleased, now)
+	assert.False(t, handoffs.canProcess(handoffTestServer, false))
+	_, ok = handoffs.startOperation(handoffTestServer)
+	assert.False(t, ok)
+}
+
+func TestClusterHandoffs_IsClaimed(t *testing.T) {
+	handoffs := newClusterHandoffs()
+	now := time.Now()
+
+	assert.False(t, handoffs.isClaimed(handoffTestServer))
+	for phase, claimed := range map[clusterHandoffPhase]bool{
+		clusterHandoffPhaseWarming:   false,
+		clusterHandoffPhaseOwned:     true,
+		clusterHandoffPhaseReleasing: true,
+		clusterHandoffPhaseReleased:  true,
+	} {
+		handoffs.setPhase(handoffTestServer, phase, now)
+		assert.Equal(t, claimed, handoffs.isClaimed(handoffTestServer), phase)
+	}
+	handoffs.remove(handoffTestServer)
+	assert.False(t, handoffs.isClaimed(handoffTestServer))
+}
+
+func TestClaimCluster(t *testing.T) {
+	cluster := &v1alpha1.Cluster{Server: handoffTestServer}
+	now := time.Now()
+
+	t.Run("NotClaimed", func(t *testing.T) {
+		ctrl := newFakeController(&fakeData{}, nil)
+
+		require.NoError(t, ctrl.claimCluster(cluster, 0, now))
+
+		state, ok := ctrl.clusterHandoffs.getState(handoffTestServer)
+		require.True(t, ok)
+		assert.Equal(t, clusterHandoffPhaseOwned, state.phase)
+		var claim appstatecache.ClusterShardClaim
+		require.NoError(t, ctrl.cache.GetClusterShardClaim(handoffTestServer, &claim))
+		assert.Equal(t, 0, claim.Shard)
+	})
+
+	t.Run("ClaimedByOtherShard", func(t *testing.T) {
+		ctrl := newFakeController(&fakeData{}, nil)
+		require.NoError(t, ctrl.cache.SetClusterShardClaim(handoffTestServer, &appstatecache.ClusterShardClaim{Shard: 1, RenewedAt: now}, time.Minute))
+
+		require.NoError(t, ctrl.claimCluster(cluster, 0, now))
+		state, ok := ctrl.clusterHandoffs.getState(handoffTestServer)
+		require.True(t, ok)
+		assert.Equal(t, clusterHandoffPhaseWarming, state.phase)
+		assert.False(t, ctrl.clusterHandoffs.canProcess(handoffTestServer, true))
+
+		assert.Eventually(t, func() bool {
+			state, _ := ctrl.clusterHandoffs.getState(handoffTestServer)
+			return state.warmed
+		}, 5*time.Second, 10*time.Millisecond)
+
+		// the shard tells the other shard it is ready once its cache of the cluster is synced
+		require.NoError(t, ctrl.claimCluster(cluster, 0, now))
+		var handoff appstatecache.ClusterShardHandoff
+		require.NoError(t, ctrl.cache.GetClusterShardHandoff(handoffTestServer, &handoff))
+		assert.Equal(t, 0, handoff.Shard)
+
+		// the other shard released the cluster
+		require.NoError(t, ctrl.cache.SetClusterShardClaim(handoffTestServer, nil, time.Minute))
+		require.NoError(t, ctrl.claimCluster(cluster, 0, now))
+		state, _ = ctrl.clusterHandoffs.getState(handoffTestServer)
+		assert.Equal(t, clusterHandoffPhaseOwned, state.phase)
+		require.ErrorIs(t, ctrl.cache.GetClusterShardHandoff(handoffTestServer, &handoff), appstatecache.ErrCacheMiss)
+	})
+
+	t.Run("TimedOut", func(t *testing.T) {
+		ctrl := newFakeController(&fakeData{}, nil)
+		require.NoError(t, ctrl.cache.SetClusterShardClaim(handoffTestServer, &appstatecache.ClusterShardClaim{Shard: 1, RenewedAt: now}, time.Minute))
+
+		require.NoError(t, ctrl.claimCluster(cluster, 0, now))
+		require.NoError(t, ctrl.claimCluster(cluster, 0, now.Add(clusterHandoffTimeout+time.Second)))
+
+		state, _ := ctrl.clusterHandoffs.getState(handoffTestServer)
+		assert.Equal(t, clusterHandoffPhaseOwned, state.phase)
+		var claim appstatecache.ClusterShardClaim
+		require.NoError(t, ctrl.cache.GetClusterShardClaim(handoffTestServer, &claim))
+		assert.Equal(t, 0, claim.Shard)
+	})
+}
+
+func TestReleaseCluster(t *testing.T) {
+	cluster := &v1alpha1.Cluster{Server: handoffTestServer}
+	now := time.Now()
+
+	ctrl := newFakeController(&fakeData{}, nil)
+	stateCache := ctrl.stateCache.(*mockstatecache.LiveStateCache)
+	stateCache.On("RemoveCluster", mock.Anything).Return()
+	require.NoError(t, ctrl.claimCluster(cluster, 0, now))
+
+	// the cluster is processed until the other shard is ready to take it over
+	require.NoError(t, ctrl.releaseCluster(cluster, 1, now))
+	state, _ := ctrl.clusterHandoffs.getState(handoffTestServer)
+	assert.Equal(t, clusterHandoffPhaseReleasing, state.phase)
+	assert.True(t, ctrl.clusterHandoffs.canProcess(handoffTestServer, false))
+
+	done, ok := ctrl.clusterHandoffs.startOperation(handoffTestServer)
+	require.True(t, ok)
+
+	// the cluster is not released while an operation is running
+	require.NoError(t, ctrl.cache.SetClusterShardHandoff(handoffTestServer, &appstatecache.ClusterShardHandoff{Shard: 1, ReadyAt: now}, time.Minute))
+	require.NoError(t, ctrl.releaseCluster(cluster, 1, now))
+	state, _ = ctrl.clusterHandoffs.getState(handoffTestServer)
+	assert.Equal(t, clusterHandoffPhaseReleased, state.phase)
+	assert.False(t, ctrl.clusterHandoffs.canProcess(handoffTestServer, false))
+	var claim appstatecache.ClusterShardClaim
+	require.NoError(t, ctrl.cache.GetClusterShardClaim(handoffTestServer, &claim))
+	assert.Equal(t, 0, claim.Shard)
+	stateCache.AssertNotCalled(t, "RemoveCluster", handoffTestServer)
+
+	done()
+	require.NoError(t, ctrl.releaseCluster(cluster, 1, now))
+	_, ok = ctrl.clusterHandoffs.getState(handoffTestServer)
+	assert.False(t, ok)
+	require.ErrorIs(t, ctrl.cache.GetClusterShardClaim(handoffTestServer, &claim), appstatecache.ErrCacheMiss)
+	stateCache.AssertCalled(t, "RemoveCluster", handoffTestServer)
+}
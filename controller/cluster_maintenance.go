@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// getClusterMaintenanceCondition returns a ClusterMaintenanceWarning condition if the destination cluster of the
+// application is in maintenance mode at the given time, and nil otherwise.
+func getClusterMaintenanceCondition(cluster *appv1.Cluster, now time.Time) *appv1.ApplicationCondition {
+	if !cluster.IsInMaintenance(now) {
+		return nil
+	}
+	message := fmt.Sprintf("Automated sync is paused since cluster %s is in maintenance mode", cluster.Server)
+	if cluster.Maintenance.Message != "" {
+		message += ": " + cluster.Maintenance.Message
+	}
+	if cluster.Maintenance.ExpiresAt != nil {
+		message += fmt.Sprintf(" (until %s)", cluster.Maintenance.ExpiresAt.Format(time.RFC3339))
+	}
+	if cluster.Maintenance.PauseWatch {
+		message += ". The live state of the cluster is not watched, so the sync and health status of the application are not updated"
+	}
+	return &appv1.ApplicationCondition{
+		Type:    appv1.ApplicationConditionClusterMaintenanceWarning,
+		Message: message,
+	}
+}
+
+// setClusterMaintenanceCondition sets or removes the ClusterMaintenanceWarning condition of the application, and
+// requests a refresh of the application once the maintenance mode of the cluster expires. It returns true if the
+// cluster is in maintenance mode.
+func (ctrl *ApplicationController) setClusterMaintenanceCondition(app *appv1.Application, cluster *appv1.Cluster, now time.Time) bool {
+	var conditions []appv1.ApplicationCondition
+	cond := getClusterMaintenanceCondition(cluster, now)
+	if cond != nil {
+		conditions = append(conditions, *cond)
+		if cluster.Maintenance.ExpiresAt != nil {
+			expiresIn := cluster.Maintenance.ExpiresAt.Sub(now)
+			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &expiresIn)
+		}
+	}
+	app.Status.SetConditions(conditions, map[appv1.ApplicationConditionType]bool{
+		appv1.ApplicationConditionClusterMaintenanceWarning: true,
+	})
+	return cond != nil
+}
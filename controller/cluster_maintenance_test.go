@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestGetClusterMaintenanceCondition(t *testing.T) {
+	now := time.Date(2025, 1, 31, 1, 0, 0, 0, time.UTC)
+	expiresAt := metav1.NewTime(now.Add(time.Hour))
+
+	t.Run("not in maintenance", func(t *testing.T) {
+		assert.Nil(t, getClusterMaintenanceCondition(&v1alpha1.Cluster{Server: "https://localhost:6443"}, now))
+	})
+
+	t.Run("in maintenance", func(t *testing.T) {
+		cluster := &v1alpha1.Cluster{
+			Server:      "https://localhost:6443",
+			Maintenance: &v1alpha1.ClusterMaintenance{Message: "upgrading to 1.32", ExpiresAt: &expiresAt},
+		}
+		cond := getClusterMaintenanceCondition(cluster, now)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionClusterMaintenanceWarning, cond.Type)
+		assert.Equal(t, "Automated sync is paused since cluster https://localhost:6443 is in maintenance mode: upgrading to 1.32 (until 2025-01-31T02:00:00Z)", cond.Message)
+	})
+
+	t.Run("maintenance expired", func(t *testing.T) {
+		cluster := &v1alpha1.Cluster{
+			Server:      "https://localhost:6443",
+			Maintenance: &v1alpha1.ClusterMaintenance{ExpiresAt: &expiresAt},
+		}
+		assert.Nil(t, getClusterMaintenanceCondition(cluster, now.Add(2*time.Hour)))
+	})
+}
+
+func TestSetClusterMaintenanceCondition(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+	now := time.Now()
+	expiresAt := metav1.NewTime(now.Add(time.Hour))
+	cluster := &v1alpha1.Cluster{
+		Server:      "https://localhost:6443",
+		Maintenance: &v1alpha1.ClusterMaintenance{ExpiresAt: &expiresAt},
+	}
+
+	assert.True(t, ctrl.setClusterMaintenanceCondition(app, cluster, now))
+	require.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ApplicationConditionClusterMaintenanceWarning, app.Status.Conditions[0].Type)
+
+	// the condition is removed once the maintenance mode expires
+	assert.False(t, ctrl.setClusterMaintenanceCondition(app, cluster, now.Add(2*time.Hour)))
+	assert.Empty(t, app.Status.Conditions)
+}
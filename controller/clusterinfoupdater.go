@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -15,7 +16,9 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/util/env"
 
+	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
 	"github.com/argoproj/argo-cd/v3/controller/metrics"
+	"github.com/argoproj/argo-cd/v3/controller/sharding"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/argo"
@@ -26,6 +29,9 @@ import (
 const (
 	defaultSecretUpdateInterval = 10 * time.Second
 
+	// connectionStateHistoryLimit is the number of connection status changes kept in the cluster info
+	connectionStateHistoryLimit = 10
+
 	EnvClusterInfoTimeout = "ARGO_CD_UPDATE_CLUSTER_INFO_TIMEOUT"
 )
 
@@ -40,6 +46,9 @@ type clusterInfoUpdater struct {
 	projGetter    func(app *appv1.Application) (*appv1.AppProject, error)
 	namespace     string
 	lastUpdated   time.Time
+	sharding      sharding.ClusterShardingCache
+	// connectionHealth returns the connection failures of a cluster tracked by the live state cache
+	connectionHealth func(server string) statecache.ClusterConnectionHealth
 }
 
 func NewClusterInfoUpdater(
@@ -50,8 +59,10 @@ func NewClusterInfoUpdater(
 	clusterFilter func(cluster *appv1.Cluster) bool,
 	projGetter func(app *appv1.Application) (*appv1.AppProject, error),
 	namespace string,
+	sharding sharding.ClusterShardingCache,
+	connectionHealth func(server string) statecache.ClusterConnectionHealth,
 ) *clusterInfoUpdater {
-	return &clusterInfoUpdater{infoSource, db, appLister, cache, clusterFilter, projGetter, namespace, time.Time{}}
+	return &clusterInfoUpdater{infoSource, db, appLister, cache, clusterFilter, projGetter, namespace, time.Time{}, sharding, connectionHealth}
 }
 
 func (c *clusterInfoUpdater) Run(ctx context.Context) {
@@ -111,6 +122,30 @@ func (c *clusterInfoUpdater) updateClusters() {
 		return nil
 	})
 	log.Debugf("Successfully saved info of %d clusters", len(clustersFiltered))
+
+	if c.sharding != nil && c.sharding.UsesClusterLoads() {
+		if err := c.updateShardingClusterLoads(clusters.Items); err != nil {
+			log.Warnf("Failed to update cluster loads of sharding: %v", err)
+		}
+	}
+}
+
+// updateShardingClusterLoads updates the sharding with the loads of all clusters, which are saved by the shards
+// managing them.
+func (c *clusterInfoUpdater) updateShardingClusterLoads(clusters []appv1.Cluster) error {
+	loads := make(map[string]int64, len(clusters))
+	for _, cluster := range clusters {
+		var load int64
+		err := c.cache.GetClusterLoad(cluster.Server, &load)
+		switch {
+		case err == nil:
+			loads[cluster.Server] = load
+		case !errors.Is(err, appstatecache.ErrCacheMiss):
+			return fmt.Errorf("error getting load of cluster %s: %w", cluster.Server, err)
+		}
+	}
+	c.sharding.UpdateClusterLoads(loads)
+	return nil
 }
 
 func (c *clusterInfoUpdater) updateClusterInfo(ctx context.Context, cluster appv1.Cluster, info *cache.ClusterInfo) error {
@@ -120,7 +155,30 @@ func (c *clusterInfoUpdater) updateClusterInfo(ctx context.Context, cluster appv
 	}
 
 	updated := c.getUpdatedClusterInfo(ctx, apps, cluster, info, metav1.Now())
-	return c.cache.SetClusterInfo(cluster.Server, &updated)
+	if c.connectionHealth != nil {
+		setConnectionHealth(&updated.ConnectionState, c.connectionHealth(cluster.Server))
+	}
+	var previous appv1.ClusterInfo
+	if err := c.cache.GetClusterInfo(cluster.Server, &previous); err != nil && !errors.Is(err, appstatecache.ErrCacheMiss) {
+		return fmt.Errorf("error getting info of cluster %s: %w", cluster.Server, err)
+	}
+	updated.ConnectionState.History = getConnectionStateHistory(previous.ConnectionState, updated.ConnectionState)
+	if err := c.cache.SetClusterInfo(cluster.Server, &updated); err != nil {
+		return err
+	}
+	if updated.CacheInfo.LastCacheSyncTime == nil {
+		return nil
+	}
+	return c.updateClusterLoad(cluster.Server, updated.CacheInfo)
+}
+
+// updateClusterLoad saves the load of a cluster used by the load-aware sharding algorithm.
+func (c *clusterInfoUpdater) updateClusterLoad(server string, info appv1.ClusterCacheInfo) error {
+	var usedLoad int64
+	if err := c.cache.GetClusterLoad(server, &usedLoad); err != nil && !errors.Is(err, appstatecache.ErrCacheMiss) {
+		return fmt.Errorf("error getting load of cluster %s: %w", server, err)
+	}
+	return c.cache.SetClusterLoad(server, sharding.GetNextClusterLoad(usedLoad, sharding.GetClusterLoad(info)))
 }
 
 func (c *clusterInfoUpdater) getUpdatedClusterInfo(ctx context.Context, apps []*appv1.Application, cluster appv1.Cluster, info *cache.ClusterInfo, now metav1.Time) appv1.ClusterInfo {
@@ -170,6 +228,32 @@ func (c *clusterInfoUpdater) getUpdatedClusterInfo(ctx context.Context, apps []*
 	return clusterInfo
 }
 
+// setConnectionHealth adds the connection failures of a cluster and its quarantine to its connection state.
+func setConnectionHealth(state *appv1.ConnectionState, health statecache.ClusterConnectionHealth) {
+	state.ConsecutiveFailures = int64(health.ConsecutiveFailures)
+	if health.IsQuarantined(time.Now()) {
+		quarantinedUntil := metav1.NewTime(*health.QuarantinedUntil)
+		state.QuarantinedUntil = &quarantinedUntil
+	}
+}
+
+// getConnectionStateHistory returns the history of the connection state of a cluster, which records the status each
+// time it changes.
+func getConnectionStateHistory(previous appv1.ConnectionState, current appv1.ConnectionState) []appv1.ConnectionStateHistoryEntry {
+	history := previous.History
+	if len(history) == 0 || history[len(history)-1].Status != current.Status {
+		history = append(history, appv1.ConnectionStateHistoryEntry{
+			Status:     current.Status,
+			Message:    current.Message,
+			ModifiedAt: current.ModifiedAt,
+		})
+	}
+	if len(history) > connectionStateHistoryLimit {
+		history = history[len(history)-connectionStateHistoryLimit:]
+	}
+	return history
+}
+
 func updateClusterLabels(ctx context.Context, clusterInfo *cache.ClusterInfo, cluster appv1.Cluster, updateCluster func(context.Context, *appv1.Cluster) (*appv1.Cluster, error)) error {
 	if clusterInfo != nil && cluster.Labels[common.LabelKeyAutoLabelClusterInfo] == "true" && cluster.Labels[common.LabelKeyClusterKubernetesVersion] != clusterInfo.K8SVersion {
 		cluster.Labels[common.LabelKeyClusterKubernetesVersion] = clusterInfo.K8SVersion
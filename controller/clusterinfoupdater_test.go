@@ -11,6 +11,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
+	"github.com/argoproj/argo-cd/v3/controller/sharding"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appsfake "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
@@ -88,7 +90,7 @@ func TestClusterSecretUpdater(t *testing.T) {
 		}
 
 		lister := applisters.NewApplicationLister(appInformer.GetIndexer()).Applications(fakeNamespace)
-		updater := NewClusterInfoUpdater(nil, argoDB, lister, appCache, nil, nil, fakeNamespace)
+		updater := NewClusterInfoUpdater(nil, argoDB, lister, appCache, nil, nil, fakeNamespace, nil, nil)
 
 		err = updater.updateClusterInfo(t.Context(), *cluster, info)
 		require.NoError(t, err, "Invoking updateClusterInfo failed.")
@@ -101,6 +103,90 @@ func TestClusterSecretUpdater(t *testing.T) {
 	}
 }
 
+func TestUpdateClusterLoad(t *testing.T) {
+	appCache := appstate.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Minute)), time.Minute)
+	clusterSharding := sharding.NewClusterSharding(nil, 0, 2, common.LoadAwareShardingAlgorithm)
+	updater := NewClusterInfoUpdater(nil, nil, nil, appCache, nil, nil, "", clusterSharding, nil)
+	server := "https://kubernetes.default.svc"
+	var load int64
+
+	require.NoError(t, updater.updateClusterLoad(server, v1alpha1.ClusterCacheInfo{ResourcesCount: 1000}))
+	require.NoError(t, appCache.GetClusterLoad(server, &load))
+	assert.Equal(t, int64(1000), load)
+
+	// small changes of the load are ignored
+	require.NoError(t, updater.updateClusterLoad(server, v1alpha1.ClusterCacheInfo{ResourcesCount: 1100}))
+	require.NoError(t, appCache.GetClusterLoad(server, &load))
+	assert.Equal(t, int64(1000), load)
+
+	require.NoError(t, updater.updateClusterLoad(server, v1alpha1.ClusterCacheInfo{ResourcesCount: 1500}))
+	require.NoError(t, appCache.GetClusterLoad(server, &load))
+	assert.Equal(t, int64(1500), load)
+
+	require.NoError(t, updater.updateShardingClusterLoads([]v1alpha1.Cluster{{Server: server}, {Server: "https://1.1.1.1"}}))
+	assert.Equal(t, map[string]int64{server: 1500}, clusterSharding.(*sharding.ClusterSharding).Loads)
+}
+
+func TestGetConnectionStateHistory(t *testing.T) {
+	now := metav1.Now()
+	failed := v1alpha1.ConnectionState{Status: v1alpha1.ConnectionStatusFailed, Message: "connection refused", ModifiedAt: &now}
+	successful := v1alpha1.ConnectionState{Status: v1alpha1.ConnectionStatusSuccessful, ModifiedAt: &now}
+
+	history := getConnectionStateHistory(v1alpha1.ConnectionState{}, successful)
+	assert.Equal(t, []v1alpha1.ConnectionStateHistoryEntry{{Status: v1alpha1.ConnectionStatusSuccessful, ModifiedAt: &now}}, history)
+
+	// the history only records changes of the status
+	successful.History = history
+	assert.Len(t, getConnectionStateHistory(successful, successful), 1)
+
+	history = getConnectionStateHistory(successful, failed)
+	require.Len(t, history, 2)
+	assert.Equal(t, v1alpha1.ConnectionStateHistoryEntry{Status: v1alpha1.ConnectionStatusFailed, Message: "connection refused", ModifiedAt: &now}, history[1])
+
+	for i := 0; i < connectionStateHistoryLimit; i++ {
+		failed.History, successful.History = history, history
+		if i%2 == 0 {
+			history = getConnectionStateHistory(failed, successful)
+		} else {
+			history = getConnectionStateHistory(successful, failed)
+		}
+	}
+	assert.Len(t, history, connectionStateHistoryLimit)
+	assert.Equal(t, v1alpha1.ConnectionStatusFailed, history[len(history)-1].Status)
+}
+
+func TestUpdateClusterInfo_ConnectionHealth(t *testing.T) {
+	appCache := appstate.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Minute)), time.Minute)
+	quarantinedUntil := time.Now().Add(time.Minute)
+	health := statecache.ClusterConnectionHealth{ConsecutiveFailures: 5, QuarantinedUntil: &quarantinedUntil}
+	appInformer := appinformers.NewApplicationInformer(appsfake.NewSimpleClientset(), "", time.Minute, cache.Indexers{})
+	lister := applisters.NewApplicationLister(appInformer.GetIndexer()).Applications("")
+	updater := NewClusterInfoUpdater(nil, nil, lister, appCache, nil, nil, "", nil, func(_ string) statecache.ClusterConnectionHealth {
+		return health
+	})
+	cluster := v1alpha1.Cluster{Server: "https://1.1.1.1"}
+	syncTime := time.Now()
+
+	require.NoError(t, updater.updateClusterInfo(t.Context(), cluster, &clustercache.ClusterInfo{LastCacheSyncTime: &syncTime, SyncError: errors.New("connection refused")}))
+	var info v1alpha1.ClusterInfo
+	require.NoError(t, appCache.GetClusterInfo(cluster.Server, &info))
+	assert.Equal(t, v1alpha1.ConnectionStatusFailed, info.ConnectionState.Status)
+	assert.Equal(t, int64(5), info.ConnectionState.ConsecutiveFailures)
+	require.NotNil(t, info.ConnectionState.QuarantinedUntil)
+	assert.Equal(t, quarantinedUntil.Unix(), info.ConnectionState.QuarantinedUntil.Unix())
+	assert.Len(t, info.ConnectionState.History, 1)
+
+	health = statecache.ClusterConnectionHealth{}
+	require.NoError(t, updater.updateClusterInfo(t.Context(), cluster, &clustercache.ClusterInfo{LastCacheSyncTime: &syncTime}))
+	info = v1alpha1.ClusterInfo{}
+	require.NoError(t, appCache.GetClusterInfo(cluster.Server, &info))
+	assert.Equal(t, v1alpha1.ConnectionStatusSuccessful, info.ConnectionState.Status)
+	assert.Nil(t, info.ConnectionState.QuarantinedUntil)
+	require.Len(t, info.ConnectionState.History, 2)
+	assert.Equal(t, v1alpha1.ConnectionStatusFailed, info.ConnectionState.History[0].Status)
+	assert.Equal(t, v1alpha1.ConnectionStatusSuccessful, info.ConnectionState.History[1].Status)
+}
+
 func TestUpdateClusterLabels(t *testing.T) {
 	shouldNotBeInvoked := func(_ context.Context, _ *v1alpha1.Cluster) (*v1alpha1.Cluster, error) {
 		shouldNotHappen := errors.New("if an error happens here, something's wrong")
@@ -2,6 +2,10 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
@@ -18,6 +22,11 @@ import (
 )
 
 var (
+	preDeleteHook  = "PreDelete"
+	preDeleteHooks = map[string]string{
+		"argocd.argoproj.io/hook": preDeleteHook,
+		"helm.sh/hook":            "pre-delete",
+	}
 	postDeleteHook  = "PostDelete"
 	postDeleteHooks = map[string]string{
 		"argocd.argoproj.io/hook": postDeleteHook,
@@ -25,15 +34,43 @@ var (
 	}
 )
 
+// deleteHookStage describes the hooks which run before or after the resources of an application are deleted
+type deleteHookStage struct {
+	// hookType is the type of the hooks, which is used in status messages
+	hookType string
+	// name is the name of the stage, which is used in log messages
+	name   string
+	isHook func(obj *unstructured.Unstructured) bool
+	// requireSuccess indicates that the stage only completes once all of its hooks succeeded
+	requireSuccess bool
+}
+
+var (
+	preDeleteHookStage  = deleteHookStage{hookType: preDeleteHook, name: "pre-delete", isHook: isPreDeleteHook, requireSuccess: true}
+	postDeleteHookStage = deleteHookStage{hookType: postDeleteHook, name: "post-delete", isHook: isPostDeleteHook}
+)
+
 func isHook(obj *unstructured.Unstructured) bool {
-	return hook.IsHook(obj) || isPostDeleteHook(obj)
+	return hook.IsHook(obj) || isPreDeleteHook(obj) || isPostDeleteHook(obj)
+}
+
+func isPostSyncHook(obj *unstructured.Unstructured) bool {
+	return obj != nil && slices.Contains(hook.Types(obj), common.HookTypePostSync)
+}
+
+func isPreDeleteHook(obj *unstructured.Unstructured) bool {
+	return hasHookAnnotation(obj, preDeleteHooks)
 }
 
 func isPostDeleteHook(obj *unstructured.Unstructured) bool {
+	return hasHookAnnotation(obj, postDeleteHooks)
+}
+
+func hasHookAnnotation(obj *unstructured.Unstructured, hooks map[string]string) bool {
 	if obj == nil || obj.GetAnnotations() == nil {
 		return false
 	}
-	for k, v := range postDeleteHooks {
+	for k, v := range hooks {
 		if val, ok := obj.GetAnnotations()[k]; ok && val == v {
 			return true
 		}
@@ -41,10 +78,13 @@ func isPostDeleteHook(obj *unstructured.Unstructured) bool {
 	return false
 }
 
-func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Application, proj *v1alpha1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
+// executeDeleteHooks creates the hooks of the given stage which do not exist yet, and waits for them to complete. It
+// returns true once all hooks completed, and otherwise a message naming the hooks which are still running. An error is
+// returned if a hook of a stage which requires success failed.
+func (ctrl *ApplicationController) executeDeleteHooks(app *v1alpha1.Application, proj *v1alpha1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry, stage deleteHookStage) (bool, string, error) {
 	appLabelKey, err := ctrl.settingsMgr.GetAppInstanceLabelKey()
 	if err != nil {
-		return false, err
+		return false, "", err
 	}
 	var revisions []string
 	for _, src := range app.Spec.GetSources() {
@@ -53,11 +93,11 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 
 	targets, _, _, err := ctrl.appStateManager.GetRepoObjs(context.Background(), app, app.Spec.GetSources(), appLabelKey, revisions, false, false, false, proj, true)
 	if err != nil {
-		return false, err
+		return false, "", err
 	}
 	runningHooks := map[kube.ResourceKey]*unstructured.Unstructured{}
 	for key, obj := range liveObjs {
-		if isPostDeleteHook(obj) {
+		if stage.isHook(obj) {
 			runningHooks[key] = obj
 		}
 	}
@@ -67,7 +107,7 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 		if obj.GetNamespace() == "" {
 			obj.SetNamespace(app.Spec.Destination.Namespace)
 		}
-		if !isPostDeleteHook(obj) {
+		if !stage.isHook(obj) {
 			continue
 		}
 		if runningHook := runningHooks[kube.GetResourceKey(obj)]; runningHook == nil {
@@ -75,28 +115,30 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 		}
 	}
 	createdCnt := 0
+	var created []string
 	for _, obj := range expectedHook {
 		_, err = ctrl.kubectl.CreateResource(context.Background(), config, obj.GroupVersionKind(), obj.GetName(), obj.GetNamespace(), obj, metav1.CreateOptions{})
 		if err != nil {
-			return false, err
+			return false, "", err
 		}
 		createdCnt++
+		created = append(created, hookName(obj))
 	}
 	if createdCnt > 0 {
-		logCtx.Infof("Created %d post-delete hooks", createdCnt)
-		return false, nil
+		logCtx.Infof("Created %d %s hooks", createdCnt, stage.name)
+		return false, waitingForHooksMessage(stage, created), nil
 	}
 	resourceOverrides, err := ctrl.settingsMgr.GetResourceOverrides()
 	if err != nil {
-		return false, err
+		return false, "", err
 	}
 	healthOverrides := lua.ResourceHealthOverrides(resourceOverrides)
 
-	progressingHooksCnt := 0
+	var progressing []string
 	for _, obj := range runningHooks {
 		hookHealth, err := health.GetResourceHealth(obj, healthOverrides)
 		if err != nil {
-			return false, err
+			return false, "", err
 		}
 		if hookHealth == nil {
 			logCtx.WithFields(log.Fields{
@@ -110,19 +152,24 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 				Status: health.HealthStatusHealthy,
 			}
 		}
-		if hookHealth.Status == health.HealthStatusProgressing {
-			progressingHooksCnt++
+		switch {
+		case hookHealth.Status == health.HealthStatusProgressing:
+			progressing = append(progressing, hookName(obj))
+		case stage.requireSuccess && hookHealth.Status == health.HealthStatusDegraded:
+			return false, "", fmt.Errorf("%s hook %s failed: %s. Delete the hook to run it again", stage.hookType, hookName(obj), hookHealth.Message)
 		}
 	}
-	if progressingHooksCnt > 0 {
-		logCtx.Infof("Waiting for %d post-delete hooks to complete", progressingHooksCnt)
-		return false, nil
+	if len(progressing) > 0 {
+		logCtx.Infof("Waiting for %d %s hooks to complete", len(progressing), stage.name)
+		return false, waitingForHooksMessage(stage, progressing), nil
 	}
 
-	return true, nil
+	return true, "", nil
 }
 
-func (ctrl *ApplicationController) cleanupPostDeleteHooks(liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
+// cleanupDeleteHooks deletes the hooks of the given stage according to their delete policies, and returns true once
+// they are deleted.
+func (ctrl *ApplicationController) cleanupDeleteHooks(liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry, stage deleteHookStage) (bool, error) {
 	resourceOverrides, err := ctrl.settingsMgr.GetResourceOverrides()
 	if err != nil {
 		return false, err
@@ -133,7 +180,7 @@ func (ctrl *ApplicationController) cleanupPostDeleteHooks(liveObjs map[kube.Reso
 	aggregatedHealth := health.HealthStatusHealthy
 	var hooks []*unstructured.Unstructured
 	for _, obj := range liveObjs {
-		if !isPostDeleteHook(obj) {
+		if !stage.isHook(obj) {
 			continue
 		}
 		hookHealth, err := health.GetResourceHealth(obj, healthOverrides)
@@ -160,7 +207,7 @@ func (ctrl *ApplicationController) cleanupPostDeleteHooks(liveObjs map[kube.Reso
 			if obj.GetDeletionTimestamp() != nil {
 				continue
 			}
-			logCtx.Infof("Deleting post-delete hook %s/%s", obj.GetNamespace(), obj.GetName())
+			logCtx.Infof("Deleting %s hook %s/%s", stage.name, obj.GetNamespace(), obj.GetName())
 			err = ctrl.kubectl.DeleteResource(context.Background(), config, obj.GroupVersionKind(), obj.GetName(), obj.GetNamespace(), metav1.DeleteOptions{})
 			if err != nil {
 				return false, err
@@ -168,8 +215,20 @@ func (ctrl *ApplicationController) cleanupPostDeleteHooks(liveObjs map[kube.Reso
 		}
 	}
 	if pendingDeletionCount > 0 {
-		logCtx.Infof("Waiting for %d post-delete hooks to be deleted", pendingDeletionCount)
+		logCtx.Infof("Waiting for %d %s hooks to be deleted", pendingDeletionCount, stage.name)
 		return false, nil
 	}
 	return true, nil
 }
+
+func waitingForHooksMessage(stage deleteHookStage, hooks []string) string {
+	sort.Strings(hooks)
+	return fmt.Sprintf("Waiting for %s hooks to complete: %s", stage.hookType, strings.Join(hooks, ", "))
+}
+
+func hookName(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetKind() + " " + obj.GetName()
+	}
+	return obj.GetKind() + " " + obj.GetNamespace() + "/" + obj.GetName()
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -46,9 +48,9 @@ type Dependencies interface {
 	// GetProcessableApps returns a list of applications that are processable by the controller.
 	GetProcessableApps() (*appv1.ApplicationList, error)
 
-	// GetRepoObjs returns the repository objects for the given application, source, and revision. It calls the repo-
-	// server and gets the manifests (objects).
-	GetRepoObjs(ctx context.Context, app *appv1.Application, source appv1.ApplicationSource, revision string, project *appv1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error)
+	// GetRepoObjs returns the repository objects for the given application, dry sources, and revisions. It calls the
+	// repo-server and gets the manifests (objects). One manifest response is returned per source.
+	GetRepoObjs(ctx context.Context, app *appv1.Application, sources []appv1.ApplicationSource, revisions []string, project *appv1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error)
 
 	// GetWriteCredentials returns the repository credentials for the given repository URL and project. These are to be
 	// sent to the commit server to write the hydrated manifests.
@@ -116,22 +118,119 @@ func (h *Hydrator) ProcessAppHydrateQueueItem(origApp *appv1.Application) {
 		return
 	}
 
+	var approvedBy, approvedDrySHA string
+	var approvedAdditionalDrySHAs []string
+	var approvedAt *metav1.Time
+	if isHydrationApproved(origApp) {
+		approvedBy = origApp.Status.SourceHydrator.CurrentOperation.ApprovedBy
+		approvedAt = origApp.Status.SourceHydrator.CurrentOperation.ApprovedAt
+		// The approval applies to the revisions which were awaiting approval, not to whatever the dry source branches
+		// point to now.
+		approvedDrySHA = origApp.Status.SourceHydrator.CurrentOperation.DrySHA
+		approvedAdditionalDrySHAs = origApp.Status.SourceHydrator.CurrentOperation.AdditionalDrySHAs
+	} else {
+		proj, err := h.dependencies.GetProcessableAppProj(app)
+		if err != nil {
+			logCtx.WithError(err).Error("Failed to get project for app hydrate queue item")
+			return
+		}
+		if requiresApproval(app, proj) {
+			h.awaitApproval(logCtx, origApp, app, proj, reason)
+			return
+		}
+	}
+
 	logCtx.WithField("reason", reason).Info("Hydrating app")
 
 	app.Status.SourceHydrator.CurrentOperation = &appv1.HydrateOperation{
-		StartedAt:      metav1.Now(),
-		FinishedAt:     nil,
-		Phase:          appv1.HydrateOperationPhaseHydrating,
-		SourceHydrator: *app.Spec.SourceHydrator,
+		StartedAt:         metav1.Now(),
+		FinishedAt:        nil,
+		Phase:             appv1.HydrateOperationPhaseHydrating,
+		DrySHA:            approvedDrySHA,
+		SourceHydrator:    *app.Spec.SourceHydrator,
+		ApprovedBy:        approvedBy,
+		ApprovedAt:        approvedAt,
+		AdditionalDrySHAs: approvedAdditionalDrySHAs,
 	}
 	h.dependencies.PersistAppHydratorStatus(origApp, &app.Status.SourceHydrator)
 	origApp.Status.SourceHydrator = app.Status.SourceHydrator
-	h.dependencies.AddHydrationQueueItem(getHydrationQueueKey(app))
+	h.dependencies.AddHydrationQueueItem(GetHydrationQueueKey(app))
 
 	logCtx.Debug("Successfully processed app hydrate queue item")
 }
 
-func getHydrationQueueKey(app *appv1.Application) types.HydrationQueueKey {
+// awaitApproval records that the hydration of the application is awaiting approval, along with the revisions of the dry
+// sources which would be hydrated. If neither the revisions nor spec.sourceHydrator changed since the last successful
+// hydration, there is nothing to approve, and the last hydrate operation is renewed instead.
+func (h *Hydrator) awaitApproval(logCtx *log.Entry, origApp, app *appv1.Application, proj *appv1.AppProject, reason string) {
+	revisions, err := h.resolveDryRevisions(context.Background(), app, proj)
+	if err != nil {
+		logCtx.WithError(err).Error("Failed to resolve dry source revisions of app awaiting hydration approval")
+		return
+	}
+	drySHA, additionalDrySHAs := revisions[0], revisions[1:]
+
+	currentOp := app.Status.SourceHydrator.CurrentOperation
+	lastOp := app.Status.SourceHydrator.LastSuccessfulOperation
+	if currentOp != nil && currentOp.Phase == appv1.HydrateOperationPhaseHydrated &&
+		lastOp != nil && lastOp.DrySHA == drySHA && slices.Equal(lastOp.AdditionalDrySHAs, additionalDrySHAs) &&
+		app.Spec.SourceHydrator.DeepEquals(lastOp.SourceHydrator) {
+		logCtx.WithField("drySHA", drySHA).Debug("Dry source of app is unchanged, no hydration approval needed")
+		currentOp.StartedAt = metav1.Now()
+		h.dependencies.PersistAppHydratorStatus(origApp, &app.Status.SourceHydrator)
+		return
+	}
+
+	logCtx.WithFields(log.Fields{"reason": reason, "drySHA": drySHA}).Info("Hydration of app is awaiting approval")
+	app.Status.SourceHydrator.CurrentOperation = &appv1.HydrateOperation{
+		StartedAt:         metav1.Now(),
+		Phase:             appv1.HydrateOperationPhaseAwaitingApproval,
+		Message:           "Hydration is awaiting approval: " + reason,
+		DrySHA:            drySHA,
+		SourceHydrator:    *app.Spec.SourceHydrator,
+		AdditionalDrySHAs: additionalDrySHAs,
+	}
+	h.dependencies.PersistAppHydratorStatus(origApp, &app.Status.SourceHydrator)
+}
+
+// resolveDryRevisions resolves the target revisions of the application's dry sources to commit SHAs, or to chart
+// versions for Helm charts. The revision of the DrySource comes first, followed by those of the additional dry sources.
+func (h *Hydrator) resolveDryRevisions(ctx context.Context, app *appv1.Application, proj *appv1.AppProject) ([]string, error) {
+	closer, repoService, err := h.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create repo service: %w", err)
+	}
+	defer utilio.Close(closer)
+
+	// The repo server resolves the revision of the app's source at the given index, so the dry sources are passed as
+	// the sources of a copy of the app.
+	dryApp := app.DeepCopy()
+	dryApp.Spec.SourceHydrator = nil
+	dryApp.Spec.Source = nil
+	dryApp.Spec.Sources = app.Spec.SourceHydrator.GetDrySources()
+	revisions := make([]string, len(dryApp.Spec.Sources))
+	for i, source := range dryApp.Spec.Sources {
+		repo, err := h.repoGetter.GetRepository(ctx, source.RepoURL, proj.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get repository %q: %w", source.RepoURL, err)
+		}
+		resp, err := repoService.ResolveRevision(ctx, &apiclient.ResolveRevisionRequest{
+			Repo:              repo,
+			App:               dryApp,
+			AmbiguousRevision: source.TargetRevision,
+			SourceIndex:       int64(i),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve revision %q of %q: %w", source.TargetRevision, source.RepoURL, err)
+		}
+		revisions[i] = resp.Revision
+	}
+	return revisions, nil
+}
+
+// GetHydrationQueueKey returns the key of the hydration operation the application is part of. Applications with the
+// same key are hydrated together, into a single commit.
+func GetHydrationQueueKey(app *appv1.Application) types.HydrationQueueKey {
 	destinationBranch := app.Spec.SourceHydrator.SyncSource.TargetBranch
 	if app.Spec.SourceHydrator.HydrateTo != nil {
 		destinationBranch = app.Spec.SourceHydrator.HydrateTo.TargetBranch
@@ -140,6 +239,7 @@ func getHydrationQueueKey(app *appv1.Application) types.HydrationQueueKey {
 		SourceRepoURL:        git.NormalizeGitURLAllowInvalid(app.Spec.SourceHydrator.DrySource.RepoURL),
 		SourceTargetRevision: app.Spec.SourceHydrator.DrySource.TargetRevision,
 		DestinationBranch:    destinationBranch,
+		DestinationRepoURL:   app.Spec.SourceHydrator.SyncSource.RepoURL,
 	}
 	return key
 }
@@ -154,8 +254,11 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 		"sourceTargetRevision": hydrationKey.SourceTargetRevision,
 		"destinationBranch":    hydrationKey.DestinationBranch,
 	})
+	if hydrationKey.DestinationRepoURL != "" {
+		logCtx = logCtx.WithField("destinationRepoURL", hydrationKey.DestinationRepoURL)
+	}
 
-	relevantApps, drySHA, hydratedSHA, err := h.hydrateAppsLatestCommit(logCtx, hydrationKey)
+	relevantApps, drySHA, additionalDrySHAs, commitResp, err := h.hydrateAppsLatestCommit(logCtx, hydrationKey)
 	if len(relevantApps) == 0 {
 		// return early if there are no relevant apps found to hydrate
 		// otherwise you'll be stuck in hydrating
@@ -183,25 +286,58 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 		return
 	}
 	logCtx.WithField("appCount", len(relevantApps)).Debug("Successfully hydrated apps")
+	hydratedSHA := commitResp.HydratedSha
+	var pullRequest *appv1.HydratedPullRequest
+	if commitResp.PullRequest != nil {
+		pullRequest = &appv1.HydratedPullRequest{
+			Number: commitResp.PullRequest.Number,
+			URL:    commitResp.PullRequest.Url,
+		}
+	}
+	var messages []string
+	if commitResp.ManifestsUnchanged {
+		messages = append(messages, "Hydrated manifests are unchanged, no commit was pushed")
+		logCtx.Info(messages[0])
+	}
+	if commitResp.PullRequestError != "" {
+		// The manifests were pushed, so the hydration succeeded. The pull request is opened again by the next hydration.
+		messages = append(messages, "Failed to open pull request: "+commitResp.PullRequestError)
+		logCtx.WithField("pullRequestError", commitResp.PullRequestError).Warn("Failed to open pull request for hydrated manifests")
+	}
+	message := strings.Join(messages, ". ")
 	finishedAt := metav1.Now()
 	for _, app := range relevantApps {
 		origApp := app.DeepCopy()
 		operation := &appv1.HydrateOperation{
-			StartedAt:      app.Status.SourceHydrator.CurrentOperation.StartedAt,
-			FinishedAt:     &finishedAt,
-			Phase:          appv1.HydrateOperationPhaseHydrated,
-			Message:        "",
-			DrySHA:         drySHA,
-			HydratedSHA:    hydratedSHA,
-			SourceHydrator: app.Status.SourceHydrator.CurrentOperation.SourceHydrator,
+			StartedAt:         app.Status.SourceHydrator.CurrentOperation.StartedAt,
+			FinishedAt:        &finishedAt,
+			Phase:             appv1.HydrateOperationPhaseHydrated,
+			Message:           message,
+			DrySHA:            drySHA,
+			HydratedSHA:       hydratedSHA,
+			SourceHydrator:    app.Status.SourceHydrator.CurrentOperation.SourceHydrator,
+			ApprovedBy:        app.Status.SourceHydrator.CurrentOperation.ApprovedBy,
+			ApprovedAt:        app.Status.SourceHydrator.CurrentOperation.ApprovedAt,
+			AdditionalDrySHAs: additionalDrySHAs[app.Spec.SourceHydrator.SyncSource.Path],
+		}
+		if revision, ok := commitResp.PathRevisions[app.Spec.SourceHydrator.SyncSource.Path]; ok {
+			// Manifests pushed to an OCI repository are identified by the digest of each path's artifact.
+			operation.HydratedSHA = revision
 		}
 		app.Status.SourceHydrator.CurrentOperation = operation
 		app.Status.SourceHydrator.LastSuccessfulOperation = &appv1.SuccessfulHydrateOperation{
-			DrySHA:         drySHA,
-			HydratedSHA:    hydratedSHA,
-			SourceHydrator: app.Status.SourceHydrator.CurrentOperation.SourceHydrator,
+			DrySHA:            drySHA,
+			HydratedSHA:       operation.HydratedSHA,
+			SourceHydrator:    operation.SourceHydrator,
+			AdditionalDrySHAs: operation.AdditionalDrySHAs,
+		}
+		if commitResp.PullRequestError == "" {
+			app.Status.SourceHydrator.PullRequest = pullRequest.DeepCopy()
 		}
 		h.dependencies.PersistAppHydratorStatus(origApp, &app.Status.SourceHydrator)
+		if commitResp.ManifestsUnchanged {
+			continue
+		}
 		// Request a refresh since we pushed a new commit.
 		err := h.dependencies.RequestAppRefresh(app.Name, app.Namespace)
 		if err != nil {
@@ -211,18 +347,18 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 	return
 }
 
-func (h *Hydrator) hydrateAppsLatestCommit(logCtx *log.Entry, hydrationKey types.HydrationQueueKey) ([]*appv1.Application, string, string, error) {
+func (h *Hydrator) hydrateAppsLatestCommit(logCtx *log.Entry, hydrationKey types.HydrationQueueKey) ([]*appv1.Application, string, map[string][]string, *commitclient.CommitHydratedManifestsResponse, error) {
 	relevantApps, projects, err := h.getRelevantAppsAndProjectsForHydration(logCtx, hydrationKey)
 	if err != nil {
-		return nil, "", "", fmt.Errorf("failed to get relevant apps for hydration: %w", err)
+		return nil, "", nil, nil, fmt.Errorf("failed to get relevant apps for hydration: %w", err)
 	}
 
-	dryRevision, hydratedRevision, err := h.hydrate(logCtx, relevantApps, projects)
+	dryRevision, additionalDryRevisions, commitResp, err := h.hydrate(logCtx, relevantApps, projects)
 	if err != nil {
-		return relevantApps, dryRevision, "", fmt.Errorf("failed to hydrate apps: %w", err)
+		return relevantApps, dryRevision, nil, nil, fmt.Errorf("failed to hydrate apps: %w", err)
 	}
 
-	return relevantApps, dryRevision, hydratedRevision, nil
+	return relevantApps, dryRevision, additionalDryRevisions, commitResp, nil
 }
 
 func (h *Hydrator) getRelevantAppsAndProjectsForHydration(logCtx *log.Entry, hydrationKey types.HydrationQueueKey) ([]*appv1.Application, map[string]*appv1.AppProject, error) {
@@ -248,7 +384,7 @@ func (h *Hydrator) getRelevantAppsAndProjectsForHydration(logCtx *log.Entry, hyd
 		if app.Spec.SourceHydrator.HydrateTo != nil {
 			destinationBranch = app.Spec.SourceHydrator.HydrateTo.TargetBranch
 		}
-		if destinationBranch != hydrationKey.DestinationBranch {
+		if destinationBranch != hydrationKey.DestinationBranch || app.Spec.SourceHydrator.SyncSource.RepoURL != hydrationKey.DestinationRepoURL {
 			continue
 		}
 
@@ -271,6 +407,17 @@ func (h *Hydrator) getRelevantAppsAndProjectsForHydration(logCtx *log.Entry, hyd
 			logCtx.Warnf("App %q is not permitted to use source %q", app.QualifiedName(), app.Spec.Source.String())
 			continue
 		}
+		if i := slices.IndexFunc(app.Spec.SourceHydrator.AdditionalDrySources, func(source appv1.ApplicationSource) bool {
+			return !proj.IsSourcePermitted(source)
+		}); i >= 0 {
+			logCtx.Warnf("App %q is not permitted to use dry source %q", app.QualifiedName(), app.Spec.SourceHydrator.AdditionalDrySources[i].RepoURL)
+			continue
+		}
+		if requiresApproval(&app, proj) && !isApprovedHydrationInProgress(&app) {
+			// The app's path is left untouched until its hydration is approved.
+			logCtx.Debugf("Skipping app %q since its hydration has not been approved", app.QualifiedName())
+			continue
+		}
 		projects[app.Spec.Project] = proj
 
 		// TODO: test the dupe detection
@@ -285,15 +432,19 @@ func (h *Hydrator) getRelevantAppsAndProjectsForHydration(logCtx *log.Entry, hyd
 	return relevantApps, projects, nil
 }
 
-func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, projects map[string]*appv1.AppProject) (string, string, error) {
+func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, projects map[string]*appv1.AppProject) (string, map[string][]string, *commitclient.CommitHydratedManifestsResponse, error) {
 	if len(apps) == 0 {
-		return "", "", nil
+		return "", nil, &commitclient.CommitHydratedManifestsResponse{}, nil
 	}
 
 	// These values are the same for all apps being hydrated together, so just get them from the first app.
 	repoURL := apps[0].Spec.SourceHydrator.DrySource.RepoURL
 	syncBranch := apps[0].Spec.SourceHydrator.SyncSource.TargetBranch
 	targetBranch := apps[0].Spec.GetHydrateToSource().TargetRevision
+	var pullRequest *appv1.HydratePullRequest
+	if apps[0].Spec.SourceHydrator.HydrateTo != nil {
+		pullRequest = apps[0].Spec.SourceHydrator.HydrateTo.PullRequest
+	}
 
 	// Disallow hydrating to the repository root.
 	// Hydrating to root would overwrite or delete files at the top level of the repo,
@@ -303,17 +454,26 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 	for _, app := range apps {
 		destPath := app.Spec.SourceHydrator.SyncSource.Path
 		if IsRootPath(destPath) {
-			return "", "", fmt.Errorf(
+			return "", nil, nil, fmt.Errorf(
 				"app %q is configured to hydrate to the repository root (branch %q, path %q) which is not allowed",
 				app.QualifiedName(), targetBranch, destPath,
 			)
 		}
 	}
 
-	// Get a static SHA revision from the first app so that all apps are hydrated from the same revision.
-	targetRevision, pathDetails, err := h.getManifests(context.Background(), apps[0], "", projects[apps[0].Spec.Project])
+	approvedRevision, err := getApprovedDryRevision(apps)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get manifests for app %q: %w", apps[0].QualifiedName(), err)
+		return "", nil, nil, err
+	}
+
+	// Get a static SHA revision from the first app so that all apps are hydrated from the same revision. If the
+	// hydration of an app was approved, that is the revision which was approved.
+	targetRevision, pathDetails, err := h.getManifests(context.Background(), apps[0], approvedRevision, projects[apps[0].Spec.Project])
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("failed to get manifests for app %q: %w", apps[0].QualifiedName(), err)
+	}
+	if approvedRevision != "" && targetRevision != approvedRevision {
+		return targetRevision, nil, nil, fmt.Errorf("approved revision %q resolved to %q, hydration must be approved again", approvedRevision, targetRevision)
 	}
 	paths := []*commitclient.PathDetails{pathDetails}
 
@@ -323,7 +483,7 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 	for _, app := range apps[1:] {
 		app := app
 		eg.Go(func() error {
-			_, pathDetails, err = h.getManifests(ctx, app, targetRevision, projects[app.Spec.Project])
+			_, pathDetails, err := h.getManifests(ctx, app, targetRevision, projects[app.Spec.Project])
 			if err != nil {
 				return fmt.Errorf("failed to get manifests for app %q: %w", app.QualifiedName(), err)
 			}
@@ -335,7 +495,14 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 	}
 	err = eg.Wait()
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get manifests for apps: %w", err)
+		return "", nil, nil, fmt.Errorf("failed to get manifests for apps: %w", err)
+	}
+	// The revisions of the additional dry sources differ between the apps, so they are returned for each path.
+	additionalDryRevisions := map[string][]string{}
+	for _, pathDetails := range paths {
+		for _, drySource := range pathDetails.DrySources {
+			additionalDryRevisions[pathDetails.Path] = append(additionalDryRevisions[pathDetails.Path], drySource.Revision)
+		}
 	}
 
 	// If all the apps are under the same project, use that project. Otherwise, use an empty string to indicate that we
@@ -350,28 +517,36 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 	// Get the commit metadata for the target revision.
 	revisionMetadata, err := h.getRevisionMetadata(context.Background(), repoURL, project, targetRevision)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get revision metadata for %q: %w", targetRevision, err)
+		return "", nil, nil, fmt.Errorf("failed to get revision metadata for %q: %w", targetRevision, err)
 	}
 
-	repo, err := h.dependencies.GetWriteCredentials(context.Background(), repoURL, project)
+	// Hydrated manifests are committed to the dry source repository, unless they are pushed to an OCI repository.
+	writeRepoURL := repoURL
+	if apps[0].Spec.SourceHydrator.SyncSource.IsOCI() {
+		writeRepoURL = apps[0].Spec.SourceHydrator.SyncSource.RepoURL
+	}
+	repo, err := h.dependencies.GetWriteCredentials(context.Background(), writeRepoURL, project)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get hydrator credentials: %w", err)
+		return "", nil, nil, fmt.Errorf("failed to get hydrator credentials: %w", err)
 	}
 	if repo == nil {
 		// Try without credentials.
 		repo = &appv1.Repository{
-			Repo: repoURL,
+			Repo: writeRepoURL,
+		}
+		if apps[0].Spec.SourceHydrator.SyncSource.IsOCI() {
+			repo.Type = "oci"
 		}
 		logCtx.Warn("no credentials found for repo, continuing without credentials")
 	}
 	// get the commit message template
 	commitMessageTemplate, err := h.dependencies.GetHydratorCommitMessageTemplate()
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get hydrated commit message template: %w", err)
+		return "", nil, nil, fmt.Errorf("failed to get hydrated commit message template: %w", err)
 	}
 	commitMessage, errMsg := getTemplatedCommitMessage(repoURL, targetRevision, commitMessageTemplate, revisionMetadata)
 	if errMsg != nil {
-		return "", "", fmt.Errorf("failed to get hydrator commit templated message: %w", errMsg)
+		return "", nil, nil, fmt.Errorf("failed to get hydrator commit templated message: %w", errMsg)
 	}
 
 	manifestsRequest := commitclient.CommitHydratedManifestsRequest{
@@ -382,39 +557,79 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 		CommitMessage:     commitMessage,
 		Paths:             paths,
 		DryCommitMetadata: revisionMetadata,
+		PullRequest:       pullRequest,
+		DryRepoURL:        repoURL,
 	}
 
 	closer, commitService, err := h.commitClientset.NewCommitServerClient()
 	if err != nil {
-		return targetRevision, "", fmt.Errorf("failed to create commit service: %w", err)
+		return targetRevision, nil, nil, fmt.Errorf("failed to create commit service: %w", err)
 	}
 	defer utilio.Close(closer)
 	resp, err := commitService.CommitHydratedManifests(context.Background(), &manifestsRequest)
 	if err != nil {
-		return targetRevision, "", fmt.Errorf("failed to commit hydrated manifests: %w", err)
+		return targetRevision, nil, nil, fmt.Errorf("failed to commit hydrated manifests: %w", err)
+	}
+	return targetRevision, additionalDryRevisions, resp, nil
+}
+
+// getApprovedDryRevision returns the dry source revision the hydration of the given applications was approved for, or
+// an empty string if none of them is an approved hydration. All apps are hydrated from a single revision, so the
+// approved applications must agree on it. The revisions of the additional dry sources are approved per application.
+func getApprovedDryRevision(apps []*appv1.Application) (string, error) {
+	revision := ""
+	for _, app := range apps {
+		if !isApprovedHydrationInProgress(app) {
+			continue
+		}
+		drySHA := app.Status.SourceHydrator.CurrentOperation.DrySHA
+		if drySHA == "" {
+			return "", fmt.Errorf("app %q was approved without a dry source revision, hydration must be approved again", app.QualifiedName())
+		}
+		if len(app.Status.SourceHydrator.CurrentOperation.AdditionalDrySHAs) != len(app.Spec.SourceHydrator.AdditionalDrySources) {
+			return "", fmt.Errorf("app %q was approved without the revisions of all its dry sources, hydration must be approved again", app.QualifiedName())
+		}
+		if revision != "" && revision != drySHA {
+			return "", fmt.Errorf("apps were approved for different dry source revisions %q and %q, hydration must be approved again", revision, drySHA)
+		}
+		revision = drySHA
 	}
-	return targetRevision, resp.HydratedSha, nil
+	return revision, nil
 }
 
 // getManifests gets the manifests for the given application and target revision. It returns the resolved revision
 // (a git SHA), and path details for the commit server.
 //
-// If the given target revision is empty, it uses the target revision from the app dry source spec.
+// If the given target revision is empty, it uses the target revision from the app dry source spec. The additional dry
+// sources of an approved hydration are hydrated at their approved revisions, and otherwise at their own target
+// revisions.
 func (h *Hydrator) getManifests(ctx context.Context, app *appv1.Application, targetRevision string, project *appv1.AppProject) (revision string, pathDetails *commitclient.PathDetails, err error) {
-	drySource := appv1.ApplicationSource{
-		RepoURL:        app.Spec.SourceHydrator.DrySource.RepoURL,
-		Path:           app.Spec.SourceHydrator.DrySource.Path,
-		TargetRevision: app.Spec.SourceHydrator.DrySource.TargetRevision,
-	}
+	// The dry sources carry the same tool-specific options (Helm, Kustomize, etc.) as a regular application source.
+	drySources := app.Spec.SourceHydrator.GetDrySources()
 	if targetRevision == "" {
 		targetRevision = app.Spec.SourceHydrator.DrySource.TargetRevision
 	}
+	var approvedRevisions []string
+	if isApprovedHydrationInProgress(app) {
+		approvedRevisions = app.Status.SourceHydrator.CurrentOperation.AdditionalDrySHAs
+	}
+	revisions := make([]string, len(drySources))
+	revisions[0] = targetRevision
+	for i, source := range drySources[1:] {
+		revisions[i+1] = source.TargetRevision
+		if i < len(approvedRevisions) {
+			revisions[i+1] = approvedRevisions[i]
+		}
+	}
 
 	// TODO: enable signature verification
-	objs, resp, err := h.dependencies.GetRepoObjs(ctx, app, drySource, targetRevision, project)
+	objs, resps, err := h.dependencies.GetRepoObjs(ctx, app, drySources, revisions, project)
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to get repo objects for app %q: %w", app.QualifiedName(), err)
 	}
+	if len(resps) != len(drySources) {
+		return "", nil, fmt.Errorf("expected %d manifest responses for app %q, got %d", len(drySources), app.QualifiedName(), len(resps))
+	}
 
 	// Set up a ManifestsRequest
 	manifestDetails := make([]*commitclient.HydratedManifestDetails, len(objs))
@@ -426,11 +641,38 @@ func (h *Hydrator) getManifests(ctx context.Context, app *appv1.Application, tar
 		manifestDetails[i] = &commitclient.HydratedManifestDetails{ManifestJSON: string(objJSON)}
 	}
 
-	return resp.Revision, &commitclient.PathDetails{
-		Path:      app.Spec.SourceHydrator.SyncSource.Path,
-		Manifests: manifestDetails,
-		Commands:  resp.Commands,
-	}, nil
+	var commands []string
+	for _, resp := range resps {
+		commands = append(commands, resp.Commands...)
+	}
+	var drySourceRevisions []*commitclient.DrySourceRevision
+	for i, source := range drySources[1:] {
+		if i < len(approvedRevisions) && resps[i+1].Revision != approvedRevisions[i] {
+			return "", nil, fmt.Errorf("approved revision %q of %q resolved to %q, hydration must be approved again", approvedRevisions[i], source.RepoURL, resps[i+1].Revision)
+		}
+		drySourceRevisions = append(drySourceRevisions, &commitclient.DrySourceRevision{
+			RepoURL:  source.RepoURL,
+			Path:     source.Path,
+			Chart:    source.Chart,
+			Ref:      source.Ref,
+			Revision: resps[i+1].Revision,
+		})
+	}
+
+	pathDetails = &commitclient.PathDetails{
+		Path:       app.Spec.SourceHydrator.SyncSource.Path,
+		Manifests:  manifestDetails,
+		Commands:   commands,
+		DrySources: drySourceRevisions,
+	}
+	if layout := app.Spec.SourceHydrator.SyncSource.Layout; layout != nil {
+		pathDetails.Layout = string(layout.Type)
+		pathDetails.Kustomization = layout.Kustomization
+	}
+	if app.Status.SourceHydrator.CurrentOperation != nil {
+		pathDetails.ApprovedBy = app.Status.SourceHydrator.CurrentOperation.ApprovedBy
+	}
+	return resps[0].Revision, pathDetails, nil
 }
 
 func (h *Hydrator) getRevisionMetadata(ctx context.Context, repoURL, project, revision string) (*appv1.RevisionMetadata, error) {
@@ -467,12 +709,17 @@ func appNeedsHydration(app *appv1.Application, statusHydrateTimeout time.Duratio
 	}
 
 	switch {
+	case isHydrationApproved(app):
+		return true, "hydration approved"
 	case app.IsHydrateRequested():
 		return true, "hydrate requested"
 	case app.Status.SourceHydrator.CurrentOperation == nil:
 		return true, "no previous hydrate operation"
 	case !app.Spec.SourceHydrator.DeepEquals(app.Status.SourceHydrator.CurrentOperation.SourceHydrator):
 		return true, "spec.sourceHydrator differs"
+	case app.Status.SourceHydrator.CurrentOperation.Phase == appv1.HydrateOperationPhaseAwaitingApproval:
+		// Pending hydrations wait for approval, however long that takes.
+		return false, ""
 	case app.Status.SourceHydrator.CurrentOperation.Phase == appv1.HydrateOperationPhaseFailed && metav1.Now().Sub(app.Status.SourceHydrator.CurrentOperation.FinishedAt.Time) > 2*time.Minute:
 		return true, "previous hydrate operation failed more than 2 minutes ago"
 	case hydratedAt == nil || hydratedAt.Add(statusHydrateTimeout).Before(time.Now().UTC()):
@@ -482,6 +729,26 @@ func appNeedsHydration(app *appv1.Application, statusHydrateTimeout time.Duratio
 	return false, ""
 }
 
+// requiresApproval returns whether the hydration of the application has to be approved, either because the application
+// or its project requires it.
+func requiresApproval(app *appv1.Application, proj *appv1.AppProject) bool {
+	return app.Spec.SourceHydrator.RequireApproval || proj.Spec.RequireHydrationApproval
+}
+
+// isHydrationApproved returns whether the application's hydration is awaiting approval and has been approved. An
+// approval only applies to the spec.sourceHydrator it was given for.
+func isHydrationApproved(app *appv1.Application) bool {
+	op := app.Status.SourceHydrator.CurrentOperation
+	return op != nil && op.Phase == appv1.HydrateOperationPhaseAwaitingApproval && op.ApprovedBy != "" &&
+		app.Spec.SourceHydrator.DeepEquals(op.SourceHydrator)
+}
+
+// isApprovedHydrationInProgress returns whether the application's hydration was approved and is in progress.
+func isApprovedHydrationInProgress(app *appv1.Application) bool {
+	op := app.Status.SourceHydrator.CurrentOperation
+	return op != nil && op.Phase == appv1.HydrateOperationPhaseHydrating && op.ApprovedBy != ""
+}
+
 // Gets the multi-line commit message based on the template defined in the configmap. It is a two step process:
 // 1.  Get the metadata template engine would use to render the template
 // 2. Pass the output of Step 1 and Step 2 to template Render
@@ -1,6 +1,7 @@
 package hydrator
 
 import (
+	"errors"
 	"path/filepath"
 	"testing"
 	"time"
@@ -11,9 +12,15 @@ import (
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	commitmocks "github.com/argoproj/argo-cd/v3/commitserver/apiclient/mocks"
 	"github.com/argoproj/argo-cd/v3/controller/hydrator/mocks"
 	"github.com/argoproj/argo-cd/v3/controller/hydrator/types"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	repomocks "github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
+	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
@@ -73,6 +80,22 @@ func Test_appNeedsHydration(t *testing.T) {
 			expectedNeedsHydration: true,
 			expectedMessage:        "spec.sourceHydrator differs",
 		},
+		{
+			name: "spec.sourceHydrator.drySource.helm differs",
+			app: &v1alpha1.Application{
+				Spec: v1alpha1.ApplicationSpec{SourceHydrator: &v1alpha1.SourceHydrator{DrySource: v1alpha1.DrySource{
+					Helm: &v1alpha1.ApplicationSourceHelm{ValueFiles: []string{"values-prod.yaml"}},
+				}}},
+				Status: v1alpha1.ApplicationStatus{SourceHydrator: v1alpha1.SourceHydratorStatus{CurrentOperation: &v1alpha1.HydrateOperation{
+					SourceHydrator: v1alpha1.SourceHydrator{DrySource: v1alpha1.DrySource{
+						Helm: &v1alpha1.ApplicationSourceHelm{ValueFiles: []string{"values.yaml"}},
+					}},
+				}}},
+			},
+			timeout:                1 * time.Hour,
+			expectedNeedsHydration: true,
+			expectedMessage:        "spec.sourceHydrator differs",
+		},
 		{
 			name: "hydration failed more than two minutes ago",
 			app: &v1alpha1.Application{
@@ -93,6 +116,44 @@ func Test_appNeedsHydration(t *testing.T) {
 			expectedNeedsHydration: true,
 			expectedMessage:        "hydration expired",
 		},
+		{
+			name: "hydration approved",
+			app: &v1alpha1.Application{
+				Spec: v1alpha1.ApplicationSpec{SourceHydrator: &v1alpha1.SourceHydrator{RequireApproval: true}},
+				Status: v1alpha1.ApplicationStatus{SourceHydrator: v1alpha1.SourceHydratorStatus{CurrentOperation: &v1alpha1.HydrateOperation{
+					StartedAt: now, Phase: v1alpha1.HydrateOperationPhaseAwaitingApproval, ApprovedBy: "admin",
+					SourceHydrator: v1alpha1.SourceHydrator{RequireApproval: true},
+				}}},
+			},
+			timeout:                1 * time.Hour,
+			expectedNeedsHydration: true,
+			expectedMessage:        "hydration approved",
+		},
+		{
+			name: "approved spec.sourceHydrator differs",
+			app: &v1alpha1.Application{
+				Spec: v1alpha1.ApplicationSpec{SourceHydrator: &v1alpha1.SourceHydrator{RequireApproval: true}},
+				Status: v1alpha1.ApplicationStatus{SourceHydrator: v1alpha1.SourceHydratorStatus{CurrentOperation: &v1alpha1.HydrateOperation{
+					StartedAt: now, Phase: v1alpha1.HydrateOperationPhaseAwaitingApproval, ApprovedBy: "admin",
+					SourceHydrator: v1alpha1.SourceHydrator{RequireApproval: true, DrySource: v1alpha1.DrySource{RepoURL: "something new"}},
+				}}},
+			},
+			timeout:                1 * time.Hour,
+			expectedNeedsHydration: true,
+			expectedMessage:        "spec.sourceHydrator differs",
+		},
+		{
+			name: "awaiting approval past timeout",
+			app: &v1alpha1.Application{
+				Spec: v1alpha1.ApplicationSpec{SourceHydrator: &v1alpha1.SourceHydrator{}},
+				Status: v1alpha1.ApplicationStatus{SourceHydrator: v1alpha1.SourceHydratorStatus{CurrentOperation: &v1alpha1.HydrateOperation{
+					StartedAt: oneHourAgo, Phase: v1alpha1.HydrateOperationPhaseAwaitingApproval,
+				}}},
+			},
+			timeout:                1 * time.Minute,
+			expectedNeedsHydration: false,
+			expectedMessage:        "",
+		},
 		{
 			name: "hydrate not needed",
 			app: &v1alpha1.Application{
@@ -176,6 +237,493 @@ func Test_getRelevantAppsForHydration_RepoURLNormalization(t *testing.T) {
 	assert.Len(t, relevantApps, 2, "Expected both apps to be considered relevant despite URL differences")
 }
 
+func Test_getRelevantAppsForHydration_DestinationRepoURL(t *testing.T) {
+	t.Parallel()
+
+	newApp := func(path, syncRepoURL string) v1alpha1.Application {
+		return v1alpha1.Application{
+			Spec: v1alpha1.ApplicationSpec{
+				Project: "project",
+				SourceHydrator: &v1alpha1.SourceHydrator{
+					DrySource: v1alpha1.DrySource{
+						RepoURL:        "https://example.com/repo",
+						TargetRevision: "main",
+						Path:           path,
+					},
+					SyncSource: v1alpha1.SyncSource{
+						RepoURL:      syncRepoURL,
+						TargetBranch: "prod",
+						Path:         path,
+					},
+				},
+			},
+		}
+	}
+	gitApp := newApp("git-app", "")
+	ociApp := newApp("oci-app", "oci://registry.example.com/hydrated")
+
+	d := mocks.NewDependencies(t)
+	d.On("GetProcessableApps").Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{gitApp, ociApp}}, nil)
+	d.On("GetProcessableAppProj", mock.Anything).Return(&v1alpha1.AppProject{
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos: []string{"*"},
+		},
+	}, nil)
+
+	hydrator := &Hydrator{dependencies: d}
+	logCtx := log.WithField("test", "DestinationRepoURL")
+
+	hydrationKey := GetHydrationQueueKey(&ociApp)
+	assert.Equal(t, "oci://registry.example.com/hydrated", hydrationKey.DestinationRepoURL)
+	relevantApps, _, err := hydrator.getRelevantAppsAndProjectsForHydration(logCtx, hydrationKey)
+	require.NoError(t, err)
+	require.Len(t, relevantApps, 1)
+	assert.Equal(t, "oci-app", relevantApps[0].Spec.SourceHydrator.SyncSource.Path)
+
+	relevantApps, _, err = hydrator.getRelevantAppsAndProjectsForHydration(logCtx, GetHydrationQueueKey(&gitApp))
+	require.NoError(t, err)
+	require.Len(t, relevantApps, 1)
+	assert.Equal(t, "git-app", relevantApps[0].Spec.SourceHydrator.SyncSource.Path)
+}
+
+func Test_getRelevantAppsForHydration_Approval(t *testing.T) {
+	t.Parallel()
+
+	newApp := func(path string, requireApproval bool, op *v1alpha1.HydrateOperation) v1alpha1.Application {
+		return v1alpha1.Application{
+			Spec: v1alpha1.ApplicationSpec{
+				Project: "project",
+				SourceHydrator: &v1alpha1.SourceHydrator{
+					DrySource:       v1alpha1.DrySource{RepoURL: "https://example.com/repo", TargetRevision: "main", Path: path},
+					SyncSource:      v1alpha1.SyncSource{TargetBranch: "prod", Path: path},
+					RequireApproval: requireApproval,
+				},
+			},
+			Status: v1alpha1.ApplicationStatus{SourceHydrator: v1alpha1.SourceHydratorStatus{CurrentOperation: op}},
+		}
+	}
+	apps := []v1alpha1.Application{
+		newApp("no-approval", false, &v1alpha1.HydrateOperation{Phase: v1alpha1.HydrateOperationPhaseHydrating}),
+		newApp("awaiting-approval", true, &v1alpha1.HydrateOperation{Phase: v1alpha1.HydrateOperationPhaseAwaitingApproval}),
+		newApp("stale", true, &v1alpha1.HydrateOperation{Phase: v1alpha1.HydrateOperationPhaseHydrated, ApprovedBy: "admin"}),
+		newApp("approved", true, &v1alpha1.HydrateOperation{Phase: v1alpha1.HydrateOperationPhaseHydrating, ApprovedBy: "admin"}),
+	}
+
+	d := mocks.NewDependencies(t)
+	d.On("GetProcessableApps").Return(&v1alpha1.ApplicationList{Items: apps}, nil)
+	d.On("GetProcessableAppProj", mock.Anything).Return(&v1alpha1.AppProject{
+		Spec: v1alpha1.AppProjectSpec{SourceRepos: []string{"*"}},
+	}, nil)
+
+	hydrator := &Hydrator{dependencies: d}
+	relevantApps, _, err := hydrator.getRelevantAppsAndProjectsForHydration(log.WithField("test", "Approval"), GetHydrationQueueKey(&apps[0]))
+	require.NoError(t, err)
+	var paths []string
+	for _, app := range relevantApps {
+		paths = append(paths, app.Spec.SourceHydrator.SyncSource.Path)
+	}
+	assert.Equal(t, []string{"no-approval", "approved"}, paths)
+}
+
+func TestHydrator_ProcessAppHydrateQueueItem_Approval(t *testing.T) {
+	t.Parallel()
+
+	newApp := func() *v1alpha1.Application {
+		return &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSpec{
+				Project: "project",
+				SourceHydrator: &v1alpha1.SourceHydrator{
+					DrySource:  v1alpha1.DrySource{RepoURL: "https://example.com/repo", TargetRevision: "main", Path: "app"},
+					SyncSource: v1alpha1.SyncSource{TargetBranch: "prod", Path: "app"},
+				},
+			},
+		}
+	}
+	newHydrator := func(t *testing.T, d *mocks.Dependencies, proj *v1alpha1.AppProject) *Hydrator {
+		t.Helper()
+		d.On("GetProcessableAppProj", mock.Anything).Return(proj, nil).Maybe()
+		repoGetter := dbmocks.NewArgoDB(t)
+		repoGetter.On("GetRepository", mock.Anything, "https://example.com/repo", "project").Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil).Maybe()
+		repoService := &repomocks.RepoServerServiceClient{}
+		repoService.On("ResolveRevision", mock.Anything, mock.Anything).Return(&apiclient.ResolveRevisionResponse{Revision: "abc123"}, nil).Maybe()
+		return &Hydrator{
+			dependencies:         d,
+			statusRefreshTimeout: time.Hour,
+			repoClientset:        &repomocks.Clientset{RepoServerServiceClient: repoService},
+			repoGetter:           repoGetter,
+		}
+	}
+	projectRequiringApproval := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "project"},
+		Spec:       v1alpha1.AppProjectSpec{RequireHydrationApproval: true},
+	}
+
+	t.Run("hydration awaits approval", func(t *testing.T) {
+		t.Parallel()
+
+		d := mocks.NewDependencies(t)
+		var status *v1alpha1.SourceHydratorStatus
+		d.On("PersistAppHydratorStatus", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			status = args.Get(1).(*v1alpha1.SourceHydratorStatus)
+		}).Once()
+
+		newHydrator(t, d, projectRequiringApproval).ProcessAppHydrateQueueItem(newApp())
+
+		require.NotNil(t, status)
+		assert.Equal(t, v1alpha1.HydrateOperationPhaseAwaitingApproval, status.CurrentOperation.Phase)
+		assert.Equal(t, "abc123", status.CurrentOperation.DrySHA)
+		assert.Equal(t, "Hydration is awaiting approval: no previous hydrate operation", status.CurrentOperation.Message)
+		d.AssertNotCalled(t, "AddHydrationQueueItem", mock.Anything)
+	})
+
+	t.Run("unchanged dry source needs no approval", func(t *testing.T) {
+		t.Parallel()
+
+		app := newApp()
+		app.Status.SourceHydrator = v1alpha1.SourceHydratorStatus{
+			CurrentOperation: &v1alpha1.HydrateOperation{
+				StartedAt:      metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+				Phase:          v1alpha1.HydrateOperationPhaseHydrated,
+				DrySHA:         "abc123",
+				SourceHydrator: *app.Spec.SourceHydrator,
+			},
+			LastSuccessfulOperation: &v1alpha1.SuccessfulHydrateOperation{DrySHA: "abc123", SourceHydrator: *app.Spec.SourceHydrator},
+		}
+		d := mocks.NewDependencies(t)
+		var status *v1alpha1.SourceHydratorStatus
+		d.On("PersistAppHydratorStatus", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			status = args.Get(1).(*v1alpha1.SourceHydratorStatus)
+		}).Once()
+
+		newHydrator(t, d, projectRequiringApproval).ProcessAppHydrateQueueItem(app)
+
+		require.NotNil(t, status)
+		assert.Equal(t, v1alpha1.HydrateOperationPhaseHydrated, status.CurrentOperation.Phase)
+		assert.WithinDuration(t, time.Now(), status.CurrentOperation.StartedAt.Time, time.Minute)
+	})
+
+	t.Run("hydration awaits approval of additional dry sources", func(t *testing.T) {
+		t.Parallel()
+
+		app := newApp()
+		app.Spec.SourceHydrator.AdditionalDrySources = v1alpha1.ApplicationSources{
+			{RepoURL: "https://example.com/values", TargetRevision: "main", Ref: "values"},
+		}
+		d := mocks.NewDependencies(t)
+		d.On("GetProcessableAppProj", mock.Anything).Return(projectRequiringApproval, nil)
+		var status *v1alpha1.SourceHydratorStatus
+		d.On("PersistAppHydratorStatus", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			status = args.Get(1).(*v1alpha1.SourceHydratorStatus)
+		}).Once()
+		repoGetter := dbmocks.NewArgoDB(t)
+		repoGetter.On("GetRepository", mock.Anything, "https://example.com/repo", "project").Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil).Once()
+		repoGetter.On("GetRepository", mock.Anything, "https://example.com/values", "project").Return(&v1alpha1.Repository{Repo: "https://example.com/values"}, nil).Once()
+		repoService := &repomocks.RepoServerServiceClient{}
+		repoService.On("ResolveRevision", mock.Anything, mock.MatchedBy(func(req *apiclient.ResolveRevisionRequest) bool {
+			return req.SourceIndex == 0 && req.Repo.Repo == "https://example.com/repo"
+		})).Return(&apiclient.ResolveRevisionResponse{Revision: "abc123"}, nil).Once()
+		repoService.On("ResolveRevision", mock.Anything, mock.MatchedBy(func(req *apiclient.ResolveRevisionRequest) bool {
+			return req.SourceIndex == 1 && req.Repo.Repo == "https://example.com/values"
+		})).Return(&apiclient.ResolveRevisionResponse{Revision: "values-sha"}, nil).Once()
+		hydrator := &Hydrator{
+			dependencies:         d,
+			statusRefreshTimeout: time.Hour,
+			repoClientset:        &repomocks.Clientset{RepoServerServiceClient: repoService},
+			repoGetter:           repoGetter,
+		}
+
+		hydrator.ProcessAppHydrateQueueItem(app)
+
+		require.NotNil(t, status)
+		assert.Equal(t, v1alpha1.HydrateOperationPhaseAwaitingApproval, status.CurrentOperation.Phase)
+		assert.Equal(t, "abc123", status.CurrentOperation.DrySHA)
+		assert.Equal(t, []string{"values-sha"}, status.CurrentOperation.AdditionalDrySHAs)
+	})
+
+	t.Run("changed additional dry source needs approval", func(t *testing.T) {
+		t.Parallel()
+
+		app := newApp()
+		app.Spec.SourceHydrator.AdditionalDrySources = v1alpha1.ApplicationSources{
+			{RepoURL: "https://example.com/repo", TargetRevision: "values", Ref: "values"},
+		}
+		app.Status.SourceHydrator = v1alpha1.SourceHydratorStatus{
+			CurrentOperation: &v1alpha1.HydrateOperation{
+				StartedAt:         metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+				Phase:             v1alpha1.HydrateOperationPhaseHydrated,
+				DrySHA:            "abc123",
+				SourceHydrator:    *app.Spec.SourceHydrator,
+				AdditionalDrySHAs: []string{"old-values-sha"},
+			},
+			LastSuccessfulOperation: &v1alpha1.SuccessfulHydrateOperation{
+				DrySHA:            "abc123",
+				SourceHydrator:    *app.Spec.SourceHydrator,
+				AdditionalDrySHAs: []string{"old-values-sha"},
+			},
+		}
+		d := mocks.NewDependencies(t)
+		var status *v1alpha1.SourceHydratorStatus
+		d.On("PersistAppHydratorStatus", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			status = args.Get(1).(*v1alpha1.SourceHydratorStatus)
+		}).Once()
+
+		newHydrator(t, d, projectRequiringApproval).ProcessAppHydrateQueueItem(app)
+
+		require.NotNil(t, status)
+		assert.Equal(t, v1alpha1.HydrateOperationPhaseAwaitingApproval, status.CurrentOperation.Phase)
+		assert.Equal(t, []string{"abc123"}, status.CurrentOperation.AdditionalDrySHAs)
+	})
+
+	t.Run("approved hydration proceeds", func(t *testing.T) {
+		t.Parallel()
+
+		app := newApp()
+		app.Spec.SourceHydrator.RequireApproval = true
+		app.Spec.SourceHydrator.AdditionalDrySources = v1alpha1.ApplicationSources{
+			{RepoURL: "https://example.com/repo", TargetRevision: "values", Ref: "values"},
+		}
+		approvedAt := metav1.Now()
+		app.Status.SourceHydrator.CurrentOperation = &v1alpha1.HydrateOperation{
+			StartedAt:         approvedAt,
+			Phase:             v1alpha1.HydrateOperationPhaseAwaitingApproval,
+			DrySHA:            "approved-sha",
+			SourceHydrator:    *app.Spec.SourceHydrator,
+			ApprovedBy:        "admin",
+			ApprovedAt:        &approvedAt,
+			AdditionalDrySHAs: []string{"approved-values-sha"},
+		}
+		d := mocks.NewDependencies(t)
+		var status *v1alpha1.SourceHydratorStatus
+		d.On("PersistAppHydratorStatus", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			status = args.Get(1).(*v1alpha1.SourceHydratorStatus)
+		}).Once()
+		d.On("AddHydrationQueueItem", GetHydrationQueueKey(app)).Once()
+
+		newHydrator(t, d, &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "project"}}).ProcessAppHydrateQueueItem(app)
+
+		require.NotNil(t, status)
+		assert.Equal(t, v1alpha1.HydrateOperationPhaseHydrating, status.CurrentOperation.Phase)
+		assert.Equal(t, "approved-sha", status.CurrentOperation.DrySHA)
+		assert.Equal(t, []string{"approved-values-sha"}, status.CurrentOperation.AdditionalDrySHAs)
+		assert.Equal(t, "admin", status.CurrentOperation.ApprovedBy)
+		assert.Equal(t, &approvedAt, status.CurrentOperation.ApprovedAt)
+	})
+
+	t.Run("hydration without approval policy", func(t *testing.T) {
+		t.Parallel()
+
+		d := mocks.NewDependencies(t)
+		d.On("PersistAppHydratorStatus", mock.Anything, mock.Anything).Once()
+		d.On("AddHydrationQueueItem", mock.Anything).Once()
+
+		newHydrator(t, d, &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "project"}}).ProcessAppHydrateQueueItem(newApp())
+	})
+}
+
+func TestHydrator_ProcessHydrationQueueItem_ApprovedDryRevision(t *testing.T) {
+	t.Parallel()
+
+	// newApprovedApp returns an app whose hydration of "approved-sha" was approved and is in progress.
+	newApprovedApp := func() *v1alpha1.Application {
+		app := &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSpec{
+				Project: "project",
+				SourceHydrator: &v1alpha1.SourceHydrator{
+					DrySource:       v1alpha1.DrySource{RepoURL: "https://example.com/repo", TargetRevision: "main", Path: "app"},
+					SyncSource:      v1alpha1.SyncSource{TargetBranch: "prod", Path: "app"},
+					RequireApproval: true,
+				},
+			},
+		}
+		approvedAt := metav1.Now()
+		app.Status.SourceHydrator.CurrentOperation = &v1alpha1.HydrateOperation{
+			StartedAt:      approvedAt,
+			Phase:          v1alpha1.HydrateOperationPhaseHydrating,
+			DrySHA:         "approved-sha",
+			SourceHydrator: *app.Spec.SourceHydrator,
+			ApprovedBy:     "admin",
+			ApprovedAt:     &approvedAt,
+		}
+		return app
+	}
+	newDependencies := func(t *testing.T, app *v1alpha1.Application) (*mocks.Dependencies, **v1alpha1.SourceHydratorStatus) {
+		t.Helper()
+		d := mocks.NewDependencies(t)
+		d.On("GetProcessableApps").Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{*app}}, nil)
+		d.On("GetProcessableAppProj", mock.Anything).Return(&v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "project"},
+			Spec:       v1alpha1.AppProjectSpec{SourceRepos: []string{"*"}},
+		}, nil)
+		var status *v1alpha1.SourceHydratorStatus
+		d.On("PersistAppHydratorStatus", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			status = args.Get(1).(*v1alpha1.SourceHydratorStatus)
+		}).Once()
+		return d, &status
+	}
+
+	t.Run("branch moved after approval", func(t *testing.T) {
+		t.Parallel()
+
+		app := newApprovedApp()
+		d, status := newDependencies(t, app)
+		// The dry source branch now points to "new-sha", but the approved revision is hydrated.
+		d.On("GetRepoObjs", mock.Anything, mock.Anything, mock.Anything, []string{"approved-sha"}, mock.Anything).
+			Return(nil, []*apiclient.ManifestResponse{{Revision: "approved-sha"}}, nil).Once()
+		d.On("GetWriteCredentials", mock.Anything, "https://example.com/repo", "project").Return(nil, nil).Once()
+		d.On("GetHydratorCommitMessageTemplate").Return(settings.CommitMessageTemplate, nil).Once()
+		d.On("RequestAppRefresh", "app", "argocd").Return(nil).Once()
+		repoGetter := dbmocks.NewArgoDB(t)
+		repoGetter.On("GetRepository", mock.Anything, "https://example.com/repo", "project").Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
+		repoService := &repomocks.RepoServerServiceClient{}
+		repoService.On("ResolveRevision", mock.Anything, mock.Anything).Return(&apiclient.ResolveRevisionResponse{Revision: "new-sha"}, nil).Maybe()
+		repoService.On("GetRevisionMetadata", mock.Anything, mock.MatchedBy(func(req *apiclient.RepoServerRevisionMetadataRequest) bool {
+			return req.Revision == "approved-sha"
+		})).Return(&v1alpha1.RevisionMetadata{Author: "author", Message: "message"}, nil).Once()
+		commitService := commitmocks.NewCommitServiceClient(t)
+		commitService.On("CommitHydratedManifests", mock.Anything, mock.MatchedBy(func(req *commitclient.CommitHydratedManifestsRequest) bool {
+			return req.DrySha == "approved-sha"
+		})).Return(&commitclient.CommitHydratedManifestsResponse{HydratedSha: "hydrated-sha"}, nil).Once()
+		commitClientset := commitmocks.NewClientset(t)
+		commitClientset.On("NewCommitServerClient").Return(utilio.NopCloser, commitService, nil).Once()
+
+		hydrator := &Hydrator{
+			dependencies:         d,
+			statusRefreshTimeout: time.Hour,
+			commitClientset:      commitClientset,
+			repoClientset:        &repomocks.Clientset{RepoServerServiceClient: repoService},
+			repoGetter:           repoGetter,
+		}
+		hydrator.ProcessHydrationQueueItem(GetHydrationQueueKey(app))
+
+		require.NotNil(t, *status)
+		assert.Equal(t, v1alpha1.HydrateOperationPhaseHydrated, (*status).CurrentOperation.Phase)
+		assert.Equal(t, "approved-sha", (*status).CurrentOperation.DrySHA)
+		assert.Equal(t, "hydrated-sha", (*status).CurrentOperation.HydratedSHA)
+		assert.Equal(t, "admin", (*status).CurrentOperation.ApprovedBy)
+	})
+
+	t.Run("additional dry source moved after approval", func(t *testing.T) {
+		t.Parallel()
+
+		app := newApprovedApp()
+		app.Spec.SourceHydrator.AdditionalDrySources = v1alpha1.ApplicationSources{
+			{RepoURL: "https://example.com/repo", TargetRevision: "values", Ref: "values"},
+		}
+		app.Status.SourceHydrator.CurrentOperation.SourceHydrator = *app.Spec.SourceHydrator
+		app.Status.SourceHydrator.CurrentOperation.AdditionalDrySHAs = []string{"approved-values-sha"}
+		d, status := newDependencies(t, app)
+		// The values branch now points to "new-values-sha", but the approved revision is hydrated.
+		d.On("GetRepoObjs", mock.Anything, mock.Anything, mock.Anything, []string{"approved-sha", "approved-values-sha"}, mock.Anything).
+			Return(nil, []*apiclient.ManifestResponse{{Revision: "approved-sha"}, {Revision: "approved-values-sha"}}, nil).Once()
+		d.On("GetWriteCredentials", mock.Anything, "https://example.com/repo", "project").Return(nil, nil).Once()
+		d.On("GetHydratorCommitMessageTemplate").Return(settings.CommitMessageTemplate, nil).Once()
+		d.On("RequestAppRefresh", "app", "argocd").Return(nil).Once()
+		repoGetter := dbmocks.NewArgoDB(t)
+		repoGetter.On("GetRepository", mock.Anything, "https://example.com/repo", "project").Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
+		repoService := &repomocks.RepoServerServiceClient{}
+		repoService.On("GetRevisionMetadata", mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{Author: "author", Message: "message"}, nil).Once()
+		commitService := commitmocks.NewCommitServiceClient(t)
+		commitService.On("CommitHydratedManifests", mock.Anything, mock.MatchedBy(func(req *commitclient.CommitHydratedManifestsRequest) bool {
+			return len(req.Paths) == 1 && len(req.Paths[0].DrySources) == 1 && req.Paths[0].DrySources[0].Revision == "approved-values-sha"
+		})).Return(&commitclient.CommitHydratedManifestsResponse{HydratedSha: "hydrated-sha"}, nil).Once()
+		commitClientset := commitmocks.NewClientset(t)
+		commitClientset.On("NewCommitServerClient").Return(utilio.NopCloser, commitService, nil).Once()
+
+		hydrator := &Hydrator{
+			dependencies:         d,
+			statusRefreshTimeout: time.Hour,
+			commitClientset:      commitClientset,
+			repoClientset:        &repomocks.Clientset{RepoServerServiceClient: repoService},
+			repoGetter:           repoGetter,
+		}
+		hydrator.ProcessHydrationQueueItem(GetHydrationQueueKey(app))
+
+		require.NotNil(t, *status)
+		assert.Equal(t, v1alpha1.HydrateOperationPhaseHydrated, (*status).CurrentOperation.Phase)
+		assert.Equal(t, []string{"approved-values-sha"}, (*status).CurrentOperation.AdditionalDrySHAs)
+		assert.Equal(t, []string{"approved-values-sha"}, (*status).LastSuccessfulOperation.AdditionalDrySHAs)
+	})
+
+	t.Run("approved additional dry source revision resolved differently", func(t *testing.T) {
+		t.Parallel()
+
+		app := newApprovedApp()
+		app.Spec.SourceHydrator.AdditionalDrySources = v1alpha1.ApplicationSources{
+			{RepoURL: "https://example.com/repo", TargetRevision: "values", Ref: "values"},
+		}
+		app.Status.SourceHydrator.CurrentOperation.SourceHydrator = *app.Spec.SourceHydrator
+		app.Status.SourceHydrator.CurrentOperation.AdditionalDrySHAs = []string{"approved-values-sha"}
+		d, status := newDependencies(t, app)
+		d.On("GetRepoObjs", mock.Anything, mock.Anything, mock.Anything, []string{"approved-sha", "approved-values-sha"}, mock.Anything).
+			Return(nil, []*apiclient.ManifestResponse{{Revision: "approved-sha"}, {Revision: "other-values-sha"}}, nil).Once()
+
+		hydrator := &Hydrator{dependencies: d, statusRefreshTimeout: time.Hour}
+		hydrator.ProcessHydrationQueueItem(GetHydrationQueueKey(app))
+
+		require.NotNil(t, *status)
+		assert.Equal(t, v1alpha1.HydrateOperationPhaseFailed, (*status).CurrentOperation.Phase)
+		assert.Contains(t, (*status).CurrentOperation.Message, "hydration must be approved again")
+	})
+
+	t.Run("approved without additional dry source revisions", func(t *testing.T) {
+		t.Parallel()
+
+		app := newApprovedApp()
+		app.Spec.SourceHydrator.AdditionalDrySources = v1alpha1.ApplicationSources{
+			{RepoURL: "https://example.com/repo", TargetRevision: "values", Ref: "values"},
+		}
+		app.Status.SourceHydrator.CurrentOperation.SourceHydrator = *app.Spec.SourceHydrator
+		d, status := newDependencies(t, app)
+
+		hydrator := &Hydrator{dependencies: d, statusRefreshTimeout: time.Hour}
+		hydrator.ProcessHydrationQueueItem(GetHydrationQueueKey(app))
+
+		require.NotNil(t, *status)
+		assert.Equal(t, v1alpha1.HydrateOperationPhaseFailed, (*status).CurrentOperation.Phase)
+		assert.Contains(t, (*status).CurrentOperation.Message, "hydration must be approved again")
+		d.AssertNotCalled(t, "GetRepoObjs", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("approved revision can't be hydrated", func(t *testing.T) {
+		t.Parallel()
+
+		app := newApprovedApp()
+		d, status := newDependencies(t, app)
+		d.On("GetRepoObjs", mock.Anything, mock.Anything, mock.Anything, []string{"approved-sha"}, mock.Anything).
+			Return(nil, nil, errors.New("revision approved-sha not found")).Once()
+
+		hydrator := &Hydrator{dependencies: d, statusRefreshTimeout: time.Hour}
+		hydrator.ProcessHydrationQueueItem(GetHydrationQueueKey(app))
+
+		require.NotNil(t, *status)
+		assert.Equal(t, v1alpha1.HydrateOperationPhaseFailed, (*status).CurrentOperation.Phase)
+		assert.Contains(t, (*status).CurrentOperation.Message, "revision approved-sha not found")
+		// A failed hydration isn't approved anymore, so it awaits approval of the latest revision again.
+		failedApp := app.DeepCopy()
+		failedApp.Status.SourceHydrator = **status
+		assert.False(t, isHydrationApproved(failedApp))
+		assert.False(t, isApprovedHydrationInProgress(failedApp))
+	})
+
+	t.Run("approved without dry source revision", func(t *testing.T) {
+		t.Parallel()
+
+		app := newApprovedApp()
+		app.Status.SourceHydrator.CurrentOperation.DrySHA = ""
+		d, status := newDependencies(t, app)
+
+		hydrator := &Hydrator{dependencies: d, statusRefreshTimeout: time.Hour}
+		hydrator.ProcessHydrationQueueItem(GetHydrationQueueKey(app))
+
+		require.NotNil(t, *status)
+		assert.Equal(t, v1alpha1.HydrateOperationPhaseFailed, (*status).CurrentOperation.Phase)
+		assert.Contains(t, (*status).CurrentOperation.Message, "hydration must be approved again")
+		d.AssertNotCalled(t, "GetRepoObjs", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
 func TestHydrator_getTemplatedCommitMessage(t *testing.T) {
 	references := make([]v1alpha1.RevisionReference, 0)
 	revReference := v1alpha1.RevisionReference{
@@ -300,6 +848,97 @@ func Test_getRelevantAppsForHydration_RootPathSkipped(t *testing.T) {
 	assert.Nil(t, proj)
 }
 
+func TestHydrator_getManifests_ToolOptions(t *testing.T) {
+	t.Parallel()
+
+	app := &v1alpha1.Application{
+		Spec: v1alpha1.ApplicationSpec{
+			SourceHydrator: &v1alpha1.SourceHydrator{
+				DrySource: v1alpha1.DrySource{
+					RepoURL:        "https://example.com/repo",
+					TargetRevision: "main",
+					Path:           "guestbook",
+					Helm: &v1alpha1.ApplicationSourceHelm{
+						ValueFiles: []string{"values-prod.yaml"},
+						Parameters: []v1alpha1.HelmParameter{{Name: "replicas", Value: "3"}},
+					},
+					Kustomize: &v1alpha1.ApplicationSourceKustomize{Images: v1alpha1.KustomizeImages{"nginx:1.27"}},
+					Directory: &v1alpha1.ApplicationSourceDirectory{Recurse: true, Exclude: "*.json"},
+					Plugin:    &v1alpha1.ApplicationSourcePlugin{Name: "my-plugin"},
+				},
+				SyncSource: v1alpha1.SyncSource{
+					TargetBranch: "env/prod",
+					Path:         "guestbook-prod",
+					Layout:       &v1alpha1.HydratedManifestLayout{Type: v1alpha1.HydratedManifestLayoutPerKind, Kustomization: true},
+				},
+			},
+		},
+	}
+	proj := &v1alpha1.AppProject{}
+
+	d := mocks.NewDependencies(t)
+	d.On("GetRepoObjs", mock.Anything, app, mock.MatchedBy(func(sources []v1alpha1.ApplicationSource) bool {
+		dry := app.Spec.SourceHydrator.DrySource
+		source := sources[0]
+		return len(sources) == 1 && source.RepoURL == dry.RepoURL && source.Path == dry.Path && source.TargetRevision == dry.TargetRevision &&
+			source.Helm == dry.Helm && source.Kustomize == dry.Kustomize && source.Directory == dry.Directory && source.Plugin == dry.Plugin
+	}), []string{"abc123"}, proj).Return(nil, []*apiclient.ManifestResponse{{Revision: "abc123"}}, nil).Once()
+
+	hydrator := &Hydrator{dependencies: d}
+	revision, pathDetails, err := hydrator.getManifests(t.Context(), app, "abc123", proj)
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", revision)
+	assert.Equal(t, "guestbook-prod", pathDetails.Path)
+	assert.Equal(t, string(v1alpha1.HydratedManifestLayoutPerKind), pathDetails.Layout)
+	assert.True(t, pathDetails.Kustomization)
+}
+
+func TestHydrator_getManifests_AdditionalDrySources(t *testing.T) {
+	t.Parallel()
+
+	app := &v1alpha1.Application{
+		Spec: v1alpha1.ApplicationSpec{
+			SourceHydrator: &v1alpha1.SourceHydrator{
+				DrySource: v1alpha1.DrySource{
+					RepoURL:        "https://example.com/charts",
+					TargetRevision: "main",
+					Path:           "guestbook",
+					Helm:           &v1alpha1.ApplicationSourceHelm{ValueFiles: []string{"$values/prod/values.yaml"}},
+				},
+				SyncSource: v1alpha1.SyncSource{
+					TargetBranch: "env/prod",
+					Path:         "guestbook-prod",
+				},
+				AdditionalDrySources: v1alpha1.ApplicationSources{
+					{RepoURL: "https://example.com/values", TargetRevision: "release", Ref: "values"},
+					{RepoURL: "https://example.com/extras", TargetRevision: "HEAD", Path: "extras"},
+				},
+			},
+		},
+	}
+	proj := &v1alpha1.AppProject{}
+
+	d := mocks.NewDependencies(t)
+	d.On("GetRepoObjs", mock.Anything, app, []v1alpha1.ApplicationSource(app.Spec.SourceHydrator.GetDrySources()), []string{"abc123", "release", "HEAD"}, proj).Return(nil, []*apiclient.ManifestResponse{
+		{Revision: "abc123", Commands: []string{"helm template . --values ../values/prod/values.yaml"}},
+		{Revision: "def456"},
+		{Revision: "789abc", Commands: []string{"kustomize build ."}},
+	}, nil).Once()
+
+	hydrator := &Hydrator{dependencies: d}
+	revision, pathDetails, err := hydrator.getManifests(t.Context(), app, "abc123", proj)
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", revision)
+	assert.Equal(t, []string{"helm template . --values ../values/prod/values.yaml", "kustomize build ."}, pathDetails.Commands)
+	require.Len(t, pathDetails.DrySources, 2)
+	assert.Equal(t, "https://example.com/values", pathDetails.DrySources[0].RepoURL)
+	assert.Equal(t, "values", pathDetails.DrySources[0].Ref)
+	assert.Equal(t, "def456", pathDetails.DrySources[0].Revision)
+	assert.Equal(t, "https://example.com/extras", pathDetails.DrySources[1].RepoURL)
+	assert.Equal(t, "extras", pathDetails.DrySources[1].Path)
+	assert.Equal(t, "789abc", pathDetails.DrySources[1].Revision)
+}
+
 func TestIsRootPath(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -31,10 +31,7 @@ func (ctrl *ApplicationController) GetProcessableApps() (*appv1.ApplicationList,
 	return ctrl.getAppList(metav1.ListOptions{})
 }
 
-func (ctrl *ApplicationController) GetRepoObjs(ctx context.Context, origApp *appv1.Application, drySource appv1.ApplicationSource, revision string, project *appv1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
-	drySources := []appv1.ApplicationSource{drySource}
-	dryRevisions := []string{revision}
-
+func (ctrl *ApplicationController) GetRepoObjs(ctx context.Context, origApp *appv1.Application, drySources []appv1.ApplicationSource, dryRevisions []string, project *appv1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error) {
 	appLabelKey, err := ctrl.settingsMgr.GetAppInstanceLabelKey()
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get app instance label key: %w", err)
@@ -48,6 +45,12 @@ func (ctrl *ApplicationController) GetRepoObjs(ctx context.Context, origApp *app
 	//
 	// The long-term solution will probably be to persist the synced _dry_ revision and use that for the comparison.
 	delete(app.Annotations, appv1.AnnotationKeyManifestGeneratePaths)
+	if len(drySources) > 1 {
+		// Present the dry sources as the sources of a multi-source app, so that the repo-server resolves references to
+		// `ref` sources, e.g. Helm value files from another repository.
+		app.Spec.SourceHydrator = nil
+		app.Spec.Sources = drySources
+	}
 
 	// FIXME: use cache and revision cache
 	objs, resp, _, err := ctrl.appStateManager.GetRepoObjs(ctx, app, drySources, appLabelKey, dryRevisions, true, true, false, project, false)
@@ -64,11 +67,11 @@ func (ctrl *ApplicationController) GetRepoObjs(ctx context.Context, origApp *app
 		}
 	}
 
-	if len(resp) != 1 {
-		return nil, nil, fmt.Errorf("expected one manifest response, got %d", len(resp))
+	if len(resp) != len(drySources) {
+		return nil, nil, fmt.Errorf("expected %d manifest responses, got %d", len(drySources), len(resp))
 	}
 
-	return objs, resp[0], nil
+	return objs, resp, nil
 }
 
 func (ctrl *ApplicationController) GetWriteCredentials(ctx context.Context, repoURL string, project string) (*appv1.Repository, error) {
@@ -47,7 +47,7 @@ func TestGetRepoObjs(t *testing.T) {
 	source := app.Spec.GetSource()
 	source.RepoURL = "oci://example.com/argo/argo-cd"
 
-	objs, resp, err := ctrl.GetRepoObjs(t.Context(), app, source, "abc123", &v1alpha1.AppProject{
+	objs, resp, err := ctrl.GetRepoObjs(t.Context(), app, []v1alpha1.ApplicationSource{source}, []string{"abc123"}, &v1alpha1.AppProject{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "default",
 			Namespace: test.FakeArgoCDNamespace,
@@ -63,8 +63,8 @@ func TestGetRepoObjs(t *testing.T) {
 		},
 	})
 	require.NoError(t, err)
-	assert.NotNil(t, resp)
-	assert.Equal(t, "abc123", resp.Revision)
+	require.Len(t, resp, 1)
+	assert.Equal(t, "abc123", resp[0].Revision)
 	assert.Len(t, objs, 1)
 
 	annotations := objs[0].GetAnnotations()
@@ -79,6 +79,70 @@ func TestGetRepoObjs(t *testing.T) {
 	assert.Equal(t, "ConfigMap", objs[0].GetKind())
 }
 
+func TestGetRepoObjs_MultipleDrySources(t *testing.T) {
+	cm := test.NewConfigMap()
+	cmBytes, _ := json.Marshal(cm)
+
+	app := newFakeApp()
+	app.Spec.Source = nil
+	app.Spec.SourceHydrator = &v1alpha1.SourceHydrator{
+		DrySource: v1alpha1.DrySource{
+			RepoURL:        "https://github.com/argoproj/argocd-example-apps",
+			TargetRevision: "main",
+			Path:           "helm-guestbook",
+			Helm:           &v1alpha1.ApplicationSourceHelm{ValueFiles: []string{"$values/prod/values.yaml"}},
+		},
+		SyncSource: v1alpha1.SyncSource{TargetBranch: "env/prod", Path: "helm-guestbook"},
+		AdditionalDrySources: v1alpha1.ApplicationSources{{
+			RepoURL:        "https://github.com/argoproj/argocd-example-values",
+			TargetRevision: "main",
+			Ref:            "values",
+		}},
+	}
+
+	data := fakeData{
+		manifestResponses: []*apiclient.ManifestResponse{
+			{
+				Manifests: []string{string(cmBytes)},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			{
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "def456",
+			},
+		},
+	}
+
+	ctrl := newFakeControllerWithResync(&data, time.Minute, nil, errors.New("this should not be called"))
+
+	objs, resp, err := ctrl.GetRepoObjs(t.Context(), app, app.Spec.SourceHydrator.GetDrySources(), []string{"abc123", "main"}, &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "default",
+			Namespace: test.FakeArgoCDNamespace,
+		},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos: []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{
+				{
+					Server:    "*",
+					Namespace: "*",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp, 2)
+	assert.Equal(t, "abc123", resp[0].Revision)
+	assert.Equal(t, "def456", resp[1].Revision)
+	assert.Len(t, objs, 1)
+	// The given app must not be modified.
+	assert.NotNil(t, app.Spec.SourceHydrator)
+	assert.Empty(t, app.Spec.Sources)
+}
+
 func TestGetHydratorCommitMessageTemplate_WhenTemplateisNotDefined_FallbackToDefault(t *testing.T) {
 	cm := test.NewConfigMap()
 	cmBytes, _ := json.Marshal(cm)
@@ -36,6 +36,12 @@ type MetricsServer struct {
 	syncDuration                      *prometheus.CounterVec
 	kubectlExecCounter                *prometheus.CounterVec
 	kubectlExecPendingGauge           *prometheus.GaugeVec
+	syncQueuedGauge                   *prometheus.GaugeVec
+	clusterHandoffGauge               *prometheus.GaugeVec
+	clusterHandoffCounter             *prometheus.CounterVec
+	clusterConnectionFailuresCounter  *prometheus.CounterVec
+	clusterQuarantinedGauge           *prometheus.GaugeVec
+	resourceDiffCacheCounter          *prometheus.CounterVec
 	orphanedResourcesGauge            *prometheus.GaugeVec
 	k8sRequestCounter                 *prometheus.CounterVec
 	clusterEventsCounter              *prometheus.CounterVec
@@ -103,6 +109,36 @@ var (
 		Help: "Number of pending kubectl executions",
 	}, []string{"hostname", "command"})
 
+	syncQueuedGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "argocd_app_sync_queued",
+		Help: "Number of sync operations waiting for a slot due to the cluster and project sync concurrency limits",
+	}, []string{"dest_server", "project"})
+
+	clusterHandoffGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "argocd_cluster_handoff_phase",
+		Help: "Phase of the hand-off of a cluster between application controller shards.",
+	}, []string{"server", "phase"})
+
+	clusterHandoffCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "argocd_cluster_handoff_total",
+		Help: "Number of completed hand-offs of clusters between application controller shards.",
+	}, []string{"server", "result"})
+
+	clusterConnectionFailuresCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "argocd_cluster_connection_failures_total",
+		Help: "Number of failed attempts to sync the cache of a cluster.",
+	}, []string{"server"})
+
+	clusterQuarantinedGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "argocd_cluster_quarantined",
+		Help: "Whether the reconciliation of the applications of a cluster is paused because of repeated connection failures.",
+	}, []string{"server"})
+
+	resourceDiffCacheCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "argocd_app_resource_diff_cache_total",
+		Help: "Number of resource diffs which were found in the diff cache (result=hit) or calculated (result=miss) during the comparisons of applications.",
+	}, []string{"result"})
+
 	reconcileHistogram = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name: "argocd_app_reconcile",
@@ -201,6 +237,12 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 	registry.MustRegister(k8sRequestCounter)
 	registry.MustRegister(kubectlExecCounter)
 	registry.MustRegister(kubectlExecPendingGauge)
+	registry.MustRegister(syncQueuedGauge)
+	registry.MustRegister(clusterHandoffGauge)
+	registry.MustRegister(clusterHandoffCounter)
+	registry.MustRegister(clusterConnectionFailuresCounter)
+	registry.MustRegister(clusterQuarantinedGauge)
+	registry.MustRegister(resourceDiffCacheCounter)
 	registry.MustRegister(orphanedResourcesGauge)
 	registry.MustRegister(reconcileHistogram)
 	registry.MustRegister(clusterEventsCounter)
@@ -223,6 +265,12 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 		k8sRequestCounter:                 k8sRequestCounter,
 		kubectlExecCounter:                kubectlExecCounter,
 		kubectlExecPendingGauge:           kubectlExecPendingGauge,
+		syncQueuedGauge:                   syncQueuedGauge,
+		clusterHandoffGauge:               clusterHandoffGauge,
+		clusterHandoffCounter:             clusterHandoffCounter,
+		clusterConnectionFailuresCounter:  clusterConnectionFailuresCounter,
+		clusterQuarantinedGauge:           clusterQuarantinedGauge,
+		resourceDiffCacheCounter:          resourceDiffCacheCounter,
 		orphanedResourcesGauge:            orphanedResourcesGauge,
 		reconcileHistogram:                reconcileHistogram,
 		clusterEventsCounter:              clusterEventsCounter,
@@ -274,6 +322,45 @@ func (m *MetricsServer) DecKubectlExecPending(command string) {
 	m.kubectlExecPendingGauge.WithLabelValues(m.hostname, command).Dec()
 }
 
+// SetSyncQueued sets the number of sync operations which wait for a slot on the given cluster and project
+func (m *MetricsServer) SetSyncQueued(destServer string, project string, count int) {
+	m.syncQueuedGauge.WithLabelValues(destServer, project).Set(float64(count))
+}
+
+// SetClusterHandoffPhase sets the phase of the hand-off of the given cluster, or removes it if the phase is empty
+func (m *MetricsServer) SetClusterHandoffPhase(server string, phase string) {
+	m.clusterHandoffGauge.DeletePartialMatch(prometheus.Labels{"server": server})
+	if phase != "" {
+		m.clusterHandoffGauge.WithLabelValues(server, phase).Set(1)
+	}
+}
+
+// IncClusterHandoff increments the number of completed hand-offs of the given cluster
+func (m *MetricsServer) IncClusterHandoff(server string, result string) {
+	m.clusterHandoffCounter.WithLabelValues(server, result).Inc()
+}
+
+// IncClusterConnectionFailures increments the number of failed cache sync attempts of the given cluster
+func (m *MetricsServer) IncClusterConnectionFailures(server string) {
+	m.clusterConnectionFailuresCounter.WithLabelValues(server).Inc()
+}
+
+// SetClusterQuarantined sets whether the given cluster is quarantined
+func (m *MetricsServer) SetClusterQuarantined(server string, quarantined bool) {
+	if quarantined {
+		m.clusterQuarantinedGauge.WithLabelValues(server).Set(1)
+	} else {
+		m.clusterQuarantinedGauge.DeleteLabelValues(server)
+	}
+}
+
+// IncResourceDiffCacheLookups increments the number of resource diffs which were found in the diff cache and which
+// were calculated
+func (m *MetricsServer) IncResourceDiffCacheLookups(hits int, misses int) {
+	m.resourceDiffCacheCounter.WithLabelValues("hit").Add(float64(hits))
+	m.resourceDiffCacheCounter.WithLabelValues("miss").Add(float64(misses))
+}
+
 func (m *MetricsServer) SetOrphanedResourcesMetric(app *argoappv1.Application, numOrphanedResources int) {
 	m.orphanedResourcesGauge.WithLabelValues(app.Namespace, app.Name, app.Spec.GetProject()).Set(float64(numOrphanedResources))
 }
@@ -0,0 +1,225 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/sync"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// resourceDependencyCycleError is returned if the dependencies declared by the resources of an application form a cycle
+type resourceDependencyCycleError struct {
+	cycle []kube.ResourceKey
+}
+
+func (e *resourceDependencyCycleError) Error() string {
+	names := make([]string, len(e.cycle))
+	for i, key := range e.cycle {
+		names[i] = key.String()
+	}
+	return "dependency cycle detected: " + strings.Join(names, " -> ")
+}
+
+// getResourceDependencies builds the dependency graph declared by the depends-on annotation of the given resources and
+// returns the dependencies of each resource which declares some. An error is returned if a reference can't be resolved
+// or if the dependencies form a cycle. Hooks can neither declare nor be dependencies.
+func getResourceDependencies(targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey][]kube.ResourceKey, error) {
+	objByKey := map[kube.ResourceKey]*unstructured.Unstructured{}
+	keysByKindName := map[string][]kube.ResourceKey{}
+	var keys []kube.ResourceKey
+	for _, obj := range targetObjs {
+		if obj == nil || isHook(obj) {
+			continue
+		}
+		key := kube.GetResourceKey(obj)
+		if _, ok := objByKey[key]; ok {
+			continue
+		}
+		objByKey[key] = obj
+		keysByKindName[key.Kind+"/"+key.Name] = append(keysByKindName[key.Kind+"/"+key.Name], key)
+		keys = append(keys, key)
+	}
+	// the order of the target objects is not stable, so sort the keys to always report the same dependency cycle
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Name != keys[j].Name {
+			return keys[i].Name < keys[j].Name
+		}
+		return keys[i].String() < keys[j].String()
+	})
+
+	dependencies := map[kube.ResourceKey][]kube.ResourceKey{}
+	for _, key := range keys {
+		refs := objByKey[key].GetAnnotations()[common.AnnotationDependsOn]
+		if refs == "" {
+			continue
+		}
+		for _, ref := range strings.Split(refs, ",") {
+			dependency, err := resolveResourceDependency(key, strings.TrimSpace(ref), keysByKindName)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve the dependencies of %s: %w", key.String(), err)
+			}
+			if dependency == key {
+				return nil, &resourceDependencyCycleError{cycle: []kube.ResourceKey{key, key}}
+			}
+			dependencies[key] = append(dependencies[key], dependency)
+		}
+	}
+
+	visited := map[kube.ResourceKey]bool{}
+	visiting := map[kube.ResourceKey]bool{}
+	var visit func(key kube.ResourceKey, path []kube.ResourceKey) error
+	visit = func(key kube.ResourceKey, path []kube.ResourceKey) error {
+		if visited[key] {
+			return nil
+		}
+		path = append(path, key)
+		if visiting[key] {
+			for i := range path {
+				if path[i] == key {
+					return &resourceDependencyCycleError{cycle: path[i:]}
+				}
+			}
+		}
+		visiting[key] = true
+		for _, dependency := range dependencies[key] {
+			if err := visit(dependency, path); err != nil {
+				return err
+			}
+		}
+		visiting[key] = false
+		visited[key] = true
+		return nil
+	}
+	for _, key := range keys {
+		if err := visit(key, nil); err != nil {
+			return nil, err
+		}
+	}
+	return dependencies, nil
+}
+
+// getResourcesWaitingForDependencies returns the resources which must not be applied yet, because one of their
+// dependencies is not ready or is itself waiting for its dependencies. Each resource is applied as soon as its own
+// dependencies are ready, so independent branches of the dependency graph progress concurrently. The dependency graph
+// must not contain cycles.
+func getResourcesWaitingForDependencies(dependencies map[kube.ResourceKey][]kube.ResourceKey, isReady func(key kube.ResourceKey) bool) map[kube.ResourceKey]bool {
+	waiting := map[kube.ResourceKey]bool{}
+	visited := map[kube.ResourceKey]bool{}
+	var visit func(key kube.ResourceKey) bool
+	visit = func(key kube.ResourceKey) bool {
+		if visited[key] {
+			return waiting[key]
+		}
+		visited[key] = true
+		for _, dependency := range dependencies[key] {
+			// visit every dependency, so that the waiting dependencies of the dependencies are found as well
+			if dependencyWaiting := visit(dependency); dependencyWaiting || !isReady(dependency) {
+				waiting[key] = true
+			}
+		}
+		return waiting[key]
+	}
+	for key := range dependencies {
+		visit(key)
+	}
+	return waiting
+}
+
+// resolveResourceDependency returns the key of the resource referred to by the given entry of the depends-on
+// annotation of a resource. A reference has the form "[group/]Kind/name" or "group/Kind/namespace/name". If the
+// namespace is omitted, the referenced resource must either be in the namespace of the dependent resource or be
+// cluster-scoped.
+func resolveResourceDependency(dependent kube.ResourceKey, ref string, keysByKindName map[string][]kube.ResourceKey) (kube.ResourceKey, error) {
+	var group, kind, namespace, name string
+	explicitGroup, explicitNamespace := false, false
+	parts := strings.Split(ref, "/")
+	switch len(parts) {
+	case 2:
+		kind, name = parts[0], parts[1]
+	case 3:
+		group, kind, name = parts[0], parts[1], parts[2]
+		explicitGroup = true
+	case 4:
+		group, kind, namespace, name = parts[0], parts[1], parts[2], parts[3]
+		explicitGroup, explicitNamespace = true, true
+	}
+	if kind == "" || name == "" {
+		return kube.ResourceKey{}, fmt.Errorf("invalid reference '%s', expected '[group/]Kind/name' or 'group/Kind/namespace/name'", ref)
+	}
+
+	var matches []kube.ResourceKey
+	for _, key := range keysByKindName[kind+"/"+name] {
+		if explicitGroup && key.Group != group {
+			continue
+		}
+		if explicitNamespace && key.Namespace != namespace {
+			continue
+		}
+		if !explicitNamespace && key.Namespace != "" && key.Namespace != dependent.Namespace {
+			continue
+		}
+		matches = append(matches, key)
+	}
+	switch len(matches) {
+	case 0:
+		return kube.ResourceKey{}, fmt.Errorf("referenced resource '%s' is not part of the application", ref)
+	case 1:
+		return matches[0], nil
+	default:
+		return kube.ResourceKey{}, fmt.Errorf("reference '%s' matches %d resources, specify the group", ref, len(matches))
+	}
+}
+
+// getSyncResourcesWaitingForDependencies returns the resources of a sync operation which can't be applied yet because
+// their dependencies are not ready. A dependency is ready once it is in sync, or was synced by the operation, and is
+// healthy. Dependencies which are not part of the operation, e.g. of a selective sync, are considered ready.
+func getSyncResourcesWaitingForDependencies(
+	reconciliationResult sync.ReconciliationResult,
+	resources []v1alpha1.ResourceStatus,
+	state *v1alpha1.OperationState,
+	isSelected func(key kube.ResourceKey) bool,
+	healthOverride health.HealthOverride,
+) (map[kube.ResourceKey]bool, error) {
+	dependencies, err := getResourceDependencies(reconciliationResult.Target)
+	if err != nil || len(dependencies) == 0 {
+		return nil, err
+	}
+	liveObjByKey := map[kube.ResourceKey]*unstructured.Unstructured{}
+	for i, target := range reconciliationResult.Target {
+		if target != nil && reconciliationResult.Live[i] != nil {
+			liveObjByKey[kube.GetResourceKey(target)] = reconciliationResult.Live[i]
+		}
+	}
+	synced := map[kube.ResourceKey]bool{}
+	for _, res := range resources {
+		if !res.Hook && res.Status == v1alpha1.SyncStatusCodeSynced {
+			synced[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = true
+		}
+	}
+	if state.SyncResult != nil {
+		for _, res := range state.SyncResult.Resources {
+			if res.HookType == "" && res.Status == synccommon.ResultCodeSynced {
+				synced[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = true
+			}
+		}
+	}
+	return getResourcesWaitingForDependencies(dependencies, func(key kube.ResourceKey) bool {
+		if !isSelected(key) {
+			return true
+		}
+		live, ok := liveObjByKey[key]
+		if !ok || !synced[key] {
+			return false
+		}
+		healthStatus, err := health.GetResourceHealth(live, healthOverride)
+		return err == nil && (healthStatus == nil || healthStatus.Status == health.HealthStatusHealthy)
+	}), nil
+}
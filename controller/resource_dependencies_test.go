@@ -0,0 +1,213 @@
+package controller
+
+import (
+	"slices"
+	"testing"
+
+	gitopssync "github.com/argoproj/gitops-engine/pkg/sync"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newDependentObj(apiVersion, kind, namespace, name string, annotations map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetAnnotations(annotations)
+	return obj
+}
+
+func TestGetResourceDependencies(t *testing.T) {
+	t.Run("no dependencies", func(t *testing.T) {
+		cm := newDependentObj("v1", "ConfigMap", "default", "config", nil)
+		dependencies, err := getResourceDependencies([]*unstructured.Unstructured{cm})
+		require.NoError(t, err)
+		assert.Empty(t, dependencies)
+	})
+
+	t.Run("dependency graph", func(t *testing.T) {
+		ns := newDependentObj("v1", "Namespace", "", "default", nil)
+		cm := newDependentObj("v1", "ConfigMap", "default", "config", nil)
+		secret := newDependentObj("v1", "Secret", "default", "creds", map[string]string{synccommon.AnnotationSyncWave: "2"})
+		db := newDependentObj("apps/v1", "StatefulSet", "default", "db", map[string]string{common.AnnotationDependsOn: "Secret/creds, Namespace/default"})
+		api := newDependentObj("apps/v1", "Deployment", "default", "api", map[string]string{common.AnnotationDependsOn: "ConfigMap/config,apps/StatefulSet/db"})
+		worker := newDependentObj("apps/v1", "Deployment", "default", "worker", map[string]string{common.AnnotationDependsOn: "/ConfigMap/default/config"})
+		hook := newDependentObj("batch/v1", "Job", "default", "migrate", map[string]string{synccommon.AnnotationKeyHook: "PreSync", common.AnnotationDependsOn: "Missing/resource"})
+		objs := []*unstructured.Unstructured{ns, cm, secret, db, api, worker, hook}
+
+		dependencies, err := getResourceDependencies(objs)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[kube.ResourceKey][]kube.ResourceKey{
+			kube.GetResourceKey(db):     {kube.GetResourceKey(secret), kube.GetResourceKey(ns)},
+			kube.GetResourceKey(api):    {kube.GetResourceKey(cm), kube.GetResourceKey(db)},
+			kube.GetResourceKey(worker): {kube.GetResourceKey(cm)},
+		}, dependencies)
+		// the desired manifests are left untouched
+		assert.Equal(t, "2", secret.GetAnnotations()[synccommon.AnnotationSyncWave])
+		assert.NotContains(t, api.GetAnnotations(), synccommon.AnnotationSyncWave)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		a := newDependentObj("apps/v1", "Deployment", "default", "a", map[string]string{common.AnnotationDependsOn: "Service/b"})
+		b := newDependentObj("v1", "Service", "default", "b", map[string]string{common.AnnotationDependsOn: "ConfigMap/c"})
+		c := newDependentObj("v1", "ConfigMap", "default", "c", map[string]string{common.AnnotationDependsOn: "Deployment/a"})
+		_, err := getResourceDependencies([]*unstructured.Unstructured{a, b, c})
+		require.EqualError(t, err, "dependency cycle detected: apps/Deployment/default/a -> /Service/default/b -> /ConfigMap/default/c -> apps/Deployment/default/a")
+	})
+
+	t.Run("self dependency", func(t *testing.T) {
+		a := newDependentObj("apps/v1", "Deployment", "default", "a", map[string]string{common.AnnotationDependsOn: "Deployment/a"})
+		_, err := getResourceDependencies([]*unstructured.Unstructured{a})
+		require.EqualError(t, err, "dependency cycle detected: apps/Deployment/default/a -> apps/Deployment/default/a")
+	})
+
+	t.Run("unknown resource", func(t *testing.T) {
+		a := newDependentObj("apps/v1", "Deployment", "default", "a", map[string]string{common.AnnotationDependsOn: "Service/b"})
+		_, err := getResourceDependencies([]*unstructured.Unstructured{a})
+		require.EqualError(t, err, "failed to resolve the dependencies of apps/Deployment/default/a: referenced resource 'Service/b' is not part of the application")
+	})
+
+	t.Run("invalid reference", func(t *testing.T) {
+		a := newDependentObj("apps/v1", "Deployment", "default", "a", map[string]string{common.AnnotationDependsOn: "b"})
+		_, err := getResourceDependencies([]*unstructured.Unstructured{a})
+		require.ErrorContains(t, err, "invalid reference 'b'")
+	})
+
+	t.Run("resource in another namespace", func(t *testing.T) {
+		cm := newDependentObj("v1", "ConfigMap", "other", "config", nil)
+		a := newDependentObj("apps/v1", "Deployment", "default", "a", map[string]string{common.AnnotationDependsOn: "ConfigMap/config"})
+		_, err := getResourceDependencies([]*unstructured.Unstructured{cm, a})
+		require.ErrorContains(t, err, "is not part of the application")
+
+		a.SetAnnotations(map[string]string{common.AnnotationDependsOn: "/ConfigMap/other/config"})
+		dependencies, err := getResourceDependencies([]*unstructured.Unstructured{cm, a})
+		require.NoError(t, err)
+		assert.Equal(t, []kube.ResourceKey{kube.GetResourceKey(cm)}, dependencies[kube.GetResourceKey(a)])
+	})
+}
+
+func TestGetResourcesWaitingForDependencies(t *testing.T) {
+	key := func(name string) kube.ResourceKey {
+		return kube.NewResourceKey("", "ConfigMap", "default", name)
+	}
+	// db <- api <- frontend, cache <- worker
+	dependencies := map[kube.ResourceKey][]kube.ResourceKey{
+		key("api"):      {key("db")},
+		key("frontend"): {key("api")},
+		key("worker"):   {key("cache")},
+	}
+	waiting := func(ready ...string) map[kube.ResourceKey]bool {
+		return getResourcesWaitingForDependencies(dependencies, func(k kube.ResourceKey) bool {
+			return slices.Contains(ready, k.Name)
+		})
+	}
+
+	assert.Equal(t, map[kube.ResourceKey]bool{key("api"): true, key("frontend"): true, key("worker"): true}, waiting())
+	// independent branches don't wait for each other
+	assert.Equal(t, map[kube.ResourceKey]bool{key("api"): true, key("frontend"): true}, waiting("cache"))
+	assert.Equal(t, map[kube.ResourceKey]bool{key("frontend"): true, key("worker"): true}, waiting("db"))
+	// a resource waits for the dependencies of its dependencies
+	assert.Equal(t, map[kube.ResourceKey]bool{key("api"): true, key("frontend"): true}, waiting("api", "cache"))
+	assert.Empty(t, waiting("db", "api", "cache"))
+}
+
+func TestGetSyncResourcesWaitingForDependencies(t *testing.T) {
+	config := newDependentObj("v1", "ConfigMap", "default", "config", nil)
+	db := newDependentObj("apps/v1", "StatefulSet", "default", "db", nil)
+	api := newDependentObj("apps/v1", "Deployment", "default", "api", map[string]string{common.AnnotationDependsOn: "ConfigMap/config,StatefulSet/db"})
+	worker := newDependentObj("apps/v1", "Deployment", "default", "worker", map[string]string{common.AnnotationDependsOn: "ConfigMap/config"})
+	targets := []*unstructured.Unstructured{config, db, api, worker}
+	newDB := func(readyReplicas int64) *unstructured.Unstructured {
+		live := db.DeepCopy()
+		require.NoError(t, unstructured.SetNestedField(live.Object, int64(1), "spec", "replicas"))
+		require.NoError(t, unstructured.SetNestedField(live.Object, int64(1), "status", "observedGeneration"))
+		require.NoError(t, unstructured.SetNestedField(live.Object, readyReplicas, "status", "readyReplicas"))
+		return live
+	}
+	healthyDB, progressingDB := newDB(1), newDB(0)
+
+	waiting := func(t *testing.T, lives []*unstructured.Unstructured, resources []v1alpha1.ResourceStatus, state *v1alpha1.OperationState, isSelected func(key kube.ResourceKey) bool) []string {
+		t.Helper()
+		if state == nil {
+			state = &v1alpha1.OperationState{SyncResult: &v1alpha1.SyncOperationResult{}}
+		}
+		if isSelected == nil {
+			isSelected = func(_ kube.ResourceKey) bool { return true }
+		}
+		result, err := getSyncResourcesWaitingForDependencies(gitopssync.ReconciliationResult{Target: targets, Live: lives}, resources, state, isSelected, nil)
+		require.NoError(t, err)
+		var names []string
+		for key := range result {
+			names = append(names, key.Name)
+		}
+		slices.Sort(names)
+		return names
+	}
+	status := func(obj *unstructured.Unstructured, syncStatus v1alpha1.SyncStatusCode) v1alpha1.ResourceStatus {
+		key := kube.GetResourceKey(obj)
+		return v1alpha1.ResourceStatus{Group: key.Group, Kind: key.Kind, Namespace: key.Namespace, Name: key.Name, Status: syncStatus}
+	}
+
+	t.Run("missing dependencies", func(t *testing.T) {
+		assert.Equal(t, []string{"api", "worker"}, waiting(t, make([]*unstructured.Unstructured, len(targets)), nil, nil, nil))
+	})
+
+	t.Run("independent branches progress on their own", func(t *testing.T) {
+		lives := []*unstructured.Unstructured{config.DeepCopy(), progressingDB, nil, nil}
+		resources := []v1alpha1.ResourceStatus{status(config, v1alpha1.SyncStatusCodeSynced), status(db, v1alpha1.SyncStatusCodeSynced)}
+		assert.Equal(t, []string{"api"}, waiting(t, lives, resources, nil, nil))
+
+		lives[1] = healthyDB
+		assert.Empty(t, waiting(t, lives, resources, nil, nil))
+	})
+
+	t.Run("dependencies synced by the operation", func(t *testing.T) {
+		lives := []*unstructured.Unstructured{config.DeepCopy(), healthyDB, nil, nil}
+		resources := []v1alpha1.ResourceStatus{status(config, v1alpha1.SyncStatusCodeOutOfSync), status(db, v1alpha1.SyncStatusCodeOutOfSync)}
+		assert.Equal(t, []string{"api", "worker"}, waiting(t, lives, resources, nil, nil))
+
+		state := &v1alpha1.OperationState{SyncResult: &v1alpha1.SyncOperationResult{Resources: v1alpha1.ResourceResults{
+			{Kind: "ConfigMap", Namespace: "default", Name: "config", Status: synccommon.ResultCodeSynced},
+		}}}
+		assert.Equal(t, []string{"api"}, waiting(t, lives, resources, state, nil))
+	})
+
+	t.Run("dependencies which are not part of the sync", func(t *testing.T) {
+		isSelected := func(key kube.ResourceKey) bool { return key.Kind != "StatefulSet" }
+		lives := []*unstructured.Unstructured{config.DeepCopy(), nil, nil, nil}
+		resources := []v1alpha1.ResourceStatus{status(config, v1alpha1.SyncStatusCodeSynced)}
+		assert.Empty(t, waiting(t, lives, resources, nil, isSelected))
+	})
+}
+
+func TestCompareAppState_ResourceDependencyCycle(t *testing.T) {
+	a := newDependentObj("v1", "ConfigMap", test.FakeDestNamespace, "a", map[string]string{common.AnnotationDependsOn: "ConfigMap/b"})
+	b := newDependentObj("v1", "ConfigMap", test.FakeDestNamespace, "b", map[string]string{common.AnnotationDependsOn: "ConfigMap/a"})
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, a), toJSON(t, b)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+	}, nil)
+
+	_, err := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []v1alpha1.ApplicationSource{app.Spec.GetSource()}, false, false, nil, false)
+	require.NoError(t, err)
+
+	require.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ApplicationConditionComparisonError, app.Status.Conditions[0].Type)
+	assert.Equal(t, "Failed to build resource dependency graph: dependency cycle detected: /ConfigMap/fake-dest-ns/a -> /ConfigMap/fake-dest-ns/b -> /ConfigMap/fake-dest-ns/a", app.Status.Conditions[0].Message)
+}
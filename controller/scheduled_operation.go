@@ -0,0 +1,97 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/typed/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// processScheduledAppOperation starts the scheduled operation of the application once it is due. The operation is
+// skipped if a sync window prevents a manual sync at that time.
+func (ctrl *ApplicationController) processScheduledAppOperation(app *appv1.Application) {
+	scheduledOp := app.ScheduledOperation
+	appKey := ctrl.toAppKey(app.QualifiedName())
+	if !scheduledOp.IsDue(time.Now()) {
+		ctrl.appOperationQueue.AddAfter(appKey, time.Until(scheduledOp.At.Time))
+		return
+	}
+
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	proj, err := ctrl.getAppProj(app)
+	if err != nil {
+		logCtx.Warnf("Unable to start scheduled operation: %v", err)
+		return
+	}
+	skipReason := ""
+	canSync, err := proj.Spec.SyncWindows.Matches(app).CanSync(true)
+	if err != nil {
+		skipReason = fmt.Sprintf("invalid sync window: %v", err)
+	} else if !canSync {
+		skipReason = "blocked by sync window"
+	}
+
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	updatedApp, err := startScheduledOperation(appIf, app.Name, scheduledOp, skipReason == "")
+	if err != nil {
+		logCtx.Errorf("Failed to start scheduled operation: %v", err)
+		return
+	}
+	if updatedApp == nil {
+		// The scheduled operation was changed, or another operation is still in progress
+		return
+	}
+	ctrl.writeBackToInformer(updatedApp)
+
+	scheduledBy := scheduledOp.Operation.InitiatedBy.Username
+	if scheduledBy == "" {
+		scheduledBy = "unknown user"
+	}
+	if skipReason != "" {
+		message := fmt.Sprintf("Skipped scheduled sync operation (scheduled at %s by %s): %s", scheduledOp.At.Format(time.RFC3339), scheduledBy, skipReason)
+		ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonOperationSkipped, Type: corev1.EventTypeWarning}, message)
+		logCtx.Info(message)
+		return
+	}
+	message := fmt.Sprintf("Started scheduled sync operation (scheduled at %s by %s)", scheduledOp.At.Format(time.RFC3339), scheduledBy)
+	ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: corev1.EventTypeNormal}, message)
+	logCtx.Info(message)
+	ctrl.appOperationQueue.Add(appKey)
+}
+
+// startScheduledOperation removes the given scheduled operation from the application and, if run is true, sets it as
+// the operation of the application. It returns nil if the scheduled operation was changed in the meantime or another
+// operation is in progress.
+func startScheduledOperation(appIf appclientset.ApplicationInterface, appName string, scheduledOp *appv1.ScheduledOperation, run bool) (*appv1.Application, error) {
+	for {
+		a, err := appIf.Get(context.Background(), appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting application %q: %w", appName, err)
+		}
+		a = a.DeepCopy()
+		if a.ScheduledOperation == nil || !a.ScheduledOperation.At.Equal(&scheduledOp.At) || (run && a.Operation != nil) {
+			return nil, nil
+		}
+		if run {
+			a.Operation = a.ScheduledOperation.Operation.DeepCopy()
+			a.Status.OperationState = nil
+		}
+		a.ScheduledOperation = nil
+		a, err = appIf.Update(context.Background(), a, metav1.UpdateOptions{})
+		if err == nil {
+			return a, nil
+		}
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("error updating application %q: %w", appName, err)
+		}
+	}
+}
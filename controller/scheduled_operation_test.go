@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newFakeScheduledApp(at time.Time) *v1alpha1.Application {
+	app := newFakeApp()
+	app.Operation = nil
+	app.ScheduledOperation = &v1alpha1.ScheduledOperation{
+		Operation: v1alpha1.Operation{
+			Sync:        &v1alpha1.SyncOperation{Revision: "abc123"},
+			InitiatedBy: v1alpha1.OperationInitiator{Username: "admin"},
+		},
+		At: metav1.NewTime(at),
+	}
+	return app
+}
+
+func TestProcessScheduledAppOperation(t *testing.T) {
+	getApp := func(t *testing.T, ctrl *ApplicationController) *v1alpha1.Application {
+		t.Helper()
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		return app
+	}
+
+	t.Run("not due yet", func(t *testing.T) {
+		app := newFakeScheduledApp(time.Now().Add(time.Hour))
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+
+		ctrl.processScheduledAppOperation(app)
+
+		updatedApp := getApp(t, ctrl)
+		assert.Nil(t, updatedApp.Operation)
+		assert.NotNil(t, updatedApp.ScheduledOperation)
+	})
+
+	t.Run("due", func(t *testing.T) {
+		app := newFakeScheduledApp(time.Now().Add(-time.Second))
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+
+		ctrl.processScheduledAppOperation(app)
+
+		updatedApp := getApp(t, ctrl)
+		assert.Nil(t, updatedApp.ScheduledOperation)
+		require.NotNil(t, updatedApp.Operation)
+		assert.Equal(t, "abc123", updatedApp.Operation.Sync.Revision)
+		assert.Equal(t, "admin", updatedApp.Operation.InitiatedBy.Username)
+		assert.Nil(t, updatedApp.Status.OperationState)
+	})
+
+	t.Run("another operation is in progress", func(t *testing.T) {
+		app := newFakeScheduledApp(time.Now().Add(-time.Second))
+		app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "def456"}}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+
+		ctrl.processScheduledAppOperation(app)
+
+		updatedApp := getApp(t, ctrl)
+		assert.NotNil(t, updatedApp.ScheduledOperation)
+		assert.Equal(t, "def456", updatedApp.Operation.Sync.Revision)
+	})
+
+	t.Run("blocked by sync window", func(t *testing.T) {
+		app := newFakeScheduledApp(time.Now().Add(-time.Second))
+		proj := defaultProj.DeepCopy()
+		proj.Spec.SyncWindows = v1alpha1.SyncWindows{{
+			Kind:         "deny",
+			Schedule:     "* * * * *",
+			Duration:     "1h",
+			Applications: []string{"*"},
+		}}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}}, nil)
+
+		ctrl.processScheduledAppOperation(app)
+
+		updatedApp := getApp(t, ctrl)
+		assert.Nil(t, updatedApp.ScheduledOperation)
+		assert.Nil(t, updatedApp.Operation)
+	})
+}
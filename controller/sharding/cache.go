@@ -1,10 +1,12 @@
 package sharding
 
 import (
+	"maps"
 	"sync"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/db"
 )
@@ -18,9 +20,13 @@ type ClusterShardingCache interface {
 	DeleteApp(a *v1alpha1.Application)
 	UpdateApp(a *v1alpha1.Application)
 	IsManagedCluster(c *v1alpha1.Cluster) bool
+	IsManagedApp(a *v1alpha1.Application, c *v1alpha1.Cluster) bool
 	GetDistribution() map[string]int
 	GetAppDistribution() map[string]int
 	UpdateShard(shard int) bool
+	GetReplicas() int
+	UsesClusterLoads() bool
+	UpdateClusterLoads(loads map[string]int64)
 }
 
 type ClusterSharding struct {
@@ -29,8 +35,10 @@ type ClusterSharding struct {
 	Shards          map[string]int
 	Clusters        map[string]*v1alpha1.Cluster
 	Apps            map[string]*v1alpha1.Application
+	Loads           map[string]int64
 	lock            sync.RWMutex
 	getClusterShard DistributionFunction
+	usesLoads       bool
 }
 
 func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm string) ClusterShardingCache {
@@ -41,11 +49,13 @@ func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm stri
 		Shards:   make(map[string]int),
 		Clusters: make(map[string]*v1alpha1.Cluster),
 		Apps:     make(map[string]*v1alpha1.Application),
+		Loads:    make(map[string]int64),
 	}
 	distributionFunction := NoShardingDistributionFunction()
 	if replicas > 1 {
 		log.Debugf("Processing clusters from shard %d: Using filter function:  %s", shard, shardingAlgorithm)
-		distributionFunction = GetDistributionFunction(clusterSharding.getClusterAccessor(), clusterSharding.getAppAccessor(), shardingAlgorithm, replicas)
+		distributionFunction = GetDistributionFunction(clusterSharding.getClusterAccessor(), clusterSharding.getAppAccessor(), clusterSharding.getClusterLoadAccessor(), shardingAlgorithm, replicas)
+		clusterSharding.usesLoads = shardingAlgorithm == common.LoadAwareShardingAlgorithm
 	} else {
 		log.Info("Processing all cluster shards")
 	}
@@ -70,6 +80,23 @@ func (sharding *ClusterSharding) IsManagedCluster(c *v1alpha1.Cluster) bool {
 	return clusterShard == sharding.Shard
 }
 
+// IsManagedApp returns whether or not the application with the given destination cluster should be processed by a
+// given shard. Applications are processed by the shard of their cluster, unless application sharding is enabled for
+// the cluster.
+func (sharding *ClusterSharding) IsManagedApp(a *v1alpha1.Application, c *v1alpha1.Cluster) bool {
+	if !IsApplicationShardingEnabled(c) || sharding.Replicas <= 1 {
+		return sharding.IsManagedCluster(c)
+	}
+	appShard := GetApplicationShard(a, sharding.Replicas)
+	log.Debugf("Checking if application %s/%s with shard %d should be processed by shard %d", a.Namespace, a.Name, appShard, sharding.Shard)
+	return appShard == sharding.Shard
+}
+
+// GetReplicas returns the number of application controller shards.
+func (sharding *ClusterSharding) GetReplicas() int {
+	return sharding.Replicas
+}
+
 func (sharding *ClusterSharding) Init(clusters *v1alpha1.ClusterList, apps *v1alpha1.ApplicationList) {
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
@@ -212,6 +239,33 @@ func (sharding *ClusterSharding) getAppAccessor() appAccessor {
 	}
 }
 
+// A read lock should be acquired before calling getClusterLoadAccessor.
+func (sharding *ClusterSharding) getClusterLoadAccessor() clusterLoadAccessor {
+	return func() map[string]int64 {
+		return sharding.Loads
+	}
+}
+
+// UsesClusterLoads returns whether the distribution depends on the cluster loads.
+func (sharding *ClusterSharding) UsesClusterLoads() bool {
+	return sharding.usesLoads
+}
+
+// UpdateClusterLoads updates the load of the clusters by server, and the distribution if it depends on them.
+func (sharding *ClusterSharding) UpdateClusterLoads(loads map[string]int64) {
+	sharding.lock.Lock()
+	defer sharding.lock.Unlock()
+
+	if maps.Equal(sharding.Loads, loads) {
+		log.Debugf("Skipping sharding distribution update. No cluster load changes")
+		return
+	}
+	sharding.Loads = loads
+	if sharding.usesLoads {
+		sharding.updateDistribution()
+	}
+}
+
 func (sharding *ClusterSharding) AddApp(a *v1alpha1.Application) {
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
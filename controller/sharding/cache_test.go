@@ -1,10 +1,12 @@
 package sharding
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
 )
@@ -122,6 +124,26 @@ func TestClusterSharding_AddRoundRobin_Redistributes(t *testing.T) {
 	assert.Equal(t, 0, clusterDistributionB) // will be reassigned to shard 0 because the .ID is bigger then the "C" cluster
 }
 
+func TestClusterSharding_UpdateClusterLoads(t *testing.T) {
+	db := &dbmocks.ArgoDB{}
+	sharding := NewClusterSharding(db, 0, 2, "load-aware").(*ClusterSharding)
+	assert.True(t, sharding.UsesClusterLoads())
+
+	clusterA := &v1alpha1.Cluster{ID: "1", Server: "https://127.0.0.1:6443"}
+	clusterB := &v1alpha1.Cluster{ID: "3", Server: "https://kubernetes.default.svc"}
+	clusterC := &v1alpha1.Cluster{ID: "2", Server: "https://1.1.1.1"}
+	sharding.Add(clusterA)
+	sharding.Add(clusterB)
+	sharding.Add(clusterC)
+	// without loads, clusters are distributed as by the legacy algorithm
+	assert.Equal(t, map[string]int{clusterA.Server: 0, clusterB.Server: 0, clusterC.Server: 1}, sharding.GetDistribution())
+
+	sharding.UpdateClusterLoads(map[string]int64{clusterA.Server: 100000, clusterB.Server: 1000, clusterC.Server: 2000})
+	assert.Equal(t, map[string]int{clusterA.Server: 0, clusterB.Server: 1, clusterC.Server: 1}, sharding.GetDistribution())
+
+	assert.False(t, setupTestSharding(0, 2).UsesClusterLoads())
+}
+
 func TestClusterSharding_Delete(t *testing.T) {
 	shard := 1
 	replicas := 2
@@ -322,6 +344,36 @@ func TestClusterSharding_IsManagedCluster(t *testing.T) {
 	}))
 }
 
+func TestClusterSharding_IsManagedApp(t *testing.T) {
+	replicas := 2
+	cluster := &v1alpha1.Cluster{ID: "1", Server: "https://kubernetes.default.svc"}
+	shardedCluster := &v1alpha1.Cluster{ID: "2", Server: "https://127.0.0.1:6443", Annotations: map[string]string{common.AnnotationKeyApplicationSharding: "true"}}
+	sharding0 := setupTestSharding(0, replicas)
+	sharding0.Init(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{*cluster, *shardedCluster}}, &v1alpha1.ApplicationList{})
+	sharding1 := setupTestSharding(1, replicas)
+	sharding1.Init(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{*cluster, *shardedCluster}}, &v1alpha1.ApplicationList{})
+
+	managedBy0, managedBy1 := 0, 0
+	for i := 0; i < 20; i++ {
+		app := createApp(fmt.Sprintf("app-%d", i), cluster.Server)
+		// applications of a cluster without application sharding are processed by the shard of the cluster
+		assert.True(t, sharding0.IsManagedApp(&app, cluster))
+		assert.False(t, sharding1.IsManagedApp(&app, cluster))
+
+		// applications of a cluster with application sharding are processed by exactly one shard
+		app.Spec.Destination.Server = shardedCluster.Server
+		assert.NotEqual(t, sharding0.IsManagedApp(&app, shardedCluster), sharding1.IsManagedApp(&app, shardedCluster))
+		assert.Equal(t, GetApplicationShard(&app, replicas) == 0, sharding0.IsManagedApp(&app, shardedCluster))
+		if sharding0.IsManagedApp(&app, shardedCluster) {
+			managedBy0++
+		} else {
+			managedBy1++
+		}
+	}
+	assert.Positive(t, managedBy0)
+	assert.Positive(t, managedBy1)
+}
+
 func TestClusterSharding_ClusterShardOfResourceShouldNotBeChanged(t *testing.T) {
 	shard := 1
 	replicas := 2
@@ -49,6 +49,18 @@ type (
 	ClusterFilterFunction func(c *v1alpha1.Cluster) bool
 	clusterAccessor       func() []*v1alpha1.Cluster
 	appAccessor           func() []*v1alpha1.Application
+	clusterLoadAccessor   func() map[string]int64
+)
+
+const (
+	// clusterLoadAPIWeight is the number of resources each API of a cluster counts for in the load of the cluster, as
+	// every API is watched by the controller.
+	clusterLoadAPIWeight = 10
+	// clusterLoadHysteresis is the relative change of the load of a cluster below which the load is not updated.
+	clusterLoadHysteresis = 0.2
+	// loadAwareImbalanceTolerance is the ratio of the load of a shard to the average shard load above which clusters
+	// are moved away from the shard.
+	loadAwareImbalanceTolerance = 1.2
 )
 
 // shardApplicationControllerMapping stores the mapping of Shard Number to Application Controller in ConfigMap.
@@ -82,7 +94,7 @@ func GetClusterFilter(_ db.ArgoDB, distributionFunction DistributionFunction, re
 
 // GetDistributionFunction returns which DistributionFunction should be used based on the passed algorithm and
 // the current datas.
-func GetDistributionFunction(clusters clusterAccessor, apps appAccessor, shardingAlgorithm string, replicasCount int) DistributionFunction {
+func GetDistributionFunction(clusters clusterAccessor, apps appAccessor, loads clusterLoadAccessor, shardingAlgorithm string, replicasCount int) DistributionFunction {
 	log.Debugf("Using filter function:  %s", shardingAlgorithm)
 	distributionFunction := LegacyDistributionFunction(replicasCount)
 	switch shardingAlgorithm {
@@ -92,6 +104,8 @@ func GetDistributionFunction(clusters clusterAccessor, apps appAccessor, shardin
 		distributionFunction = LegacyDistributionFunction(replicasCount)
 	case common.ConsistentHashingWithBoundedLoadsAlgorithm:
 		distributionFunction = ConsistentHashingWithBoundedLoadsDistributionFunction(clusters, apps, replicasCount)
+	case common.LoadAwareShardingAlgorithm:
+		distributionFunction = LoadAwareDistributionFunction(clusters, loads, replicasCount)
 	default:
 		log.Warnf("distribution type %s is not supported, defaulting to %s", shardingAlgorithm, common.DefaultShardingAlgorithm)
 	}
@@ -235,6 +249,111 @@ func createConsistentHashingWithBoundLoads(replicas int, getCluster clusterAcces
 	return shardIndexedByCluster
 }
 
+// LoadAwareDistributionFunction returns a DistributionFunction using a distribution algorithm weighted by the load of
+// the clusters: every cluster is first assigned to the shard computed by the legacy algorithm, and clusters are then
+// moved from the most loaded shard to the least loaded shard for as long as the most loaded shard is above the average
+// shard load by more than the tolerance and a move lowers the load of both shards below the load of the most loaded
+// shard. As the result only depends on the cluster loads, all shards compute the same distribution, and clusters
+// only change shards if the loads change by more than the tolerance.
+func LoadAwareDistributionFunction(clusters clusterAccessor, loads clusterLoadAccessor, replicas int) DistributionFunction {
+	return func(c *v1alpha1.Cluster) int {
+		if replicas > 0 {
+			if c == nil { // in-cluster does not necessarily have a secret assigned. So we are receiving a nil cluster here.
+				return 0
+			}
+			// if Shard is manually set and the assigned value is lower than the number of replicas,
+			// then its value is returned otherwise it is the default calculated value
+			if c.Shard != nil && int(*c.Shard) < replicas {
+				return int(*c.Shard)
+			}
+			shardIndexedByCluster := createLoadAwareDistribution(replicas, clusters, loads)
+			shard, ok := shardIndexedByCluster[c.ID]
+			if !ok {
+				log.Warnf("Cluster with id=%s not found in cluster map.", c.ID)
+				return -1
+			}
+			log.Debugf("Cluster with id=%s will be processed by shard %d", c.ID, shard)
+			return shard
+		}
+		log.Warnf("The number of replicas (%d) is lower than 1", replicas)
+		return -1
+	}
+}
+
+func createLoadAwareDistribution(replicas int, getCluster clusterAccessor, getLoads clusterLoadAccessor) map[string]int {
+	clusters := getSortedClustersList(getCluster)
+	var loads map[string]int64
+	if getLoads != nil {
+		loads = getLoads()
+	}
+	clusterLoad := func(c *v1alpha1.Cluster) int64 {
+		return max(loads[c.Server], 1)
+	}
+
+	legacyDistribution := LegacyDistributionFunction(replicas)
+	shardIndexedByCluster := make(map[string]int, len(clusters))
+	loadIndexedByShard := make([]int64, replicas)
+	var totalLoad int64
+	for _, c := range clusters {
+		shard := legacyDistribution(c)
+		shardIndexedByCluster[c.ID] = shard
+		loadIndexedByShard[shard] += clusterLoad(c)
+		totalLoad += clusterLoad(c)
+	}
+	maxLoad := float64(totalLoad) / float64(replicas) * loadAwareImbalanceTolerance
+
+	for range clusters {
+		heaviest, lightest := 0, 0
+		for shard, load := range loadIndexedByShard {
+			if load > loadIndexedByShard[heaviest] {
+				heaviest = shard
+			}
+			if load < loadIndexedByShard[lightest] {
+				lightest = shard
+			}
+		}
+		if float64(loadIndexedByShard[heaviest]) <= maxLoad {
+			break
+		}
+		// move the cluster which leaves the lowest load on the two shards
+		var moved *v1alpha1.Cluster
+		lowestPeakLoad := loadIndexedByShard[heaviest]
+		for _, c := range clusters {
+			if shardIndexedByCluster[c.ID] != heaviest || (c.Shard != nil && int(*c.Shard) < replicas) {
+				continue
+			}
+			peakLoad := max(loadIndexedByShard[heaviest]-clusterLoad(c), loadIndexedByShard[lightest]+clusterLoad(c))
+			if peakLoad < lowestPeakLoad {
+				moved = c
+				lowestPeakLoad = peakLoad
+			}
+		}
+		if moved == nil {
+			break
+		}
+		shardIndexedByCluster[moved.ID] = lightest
+		loadIndexedByShard[heaviest] -= clusterLoad(moved)
+		loadIndexedByShard[lightest] += clusterLoad(moved)
+	}
+	return shardIndexedByCluster
+}
+
+// GetClusterLoad returns the load of a cluster used by the load-aware distribution algorithm, based on the number of
+// resources and APIs cached for the cluster.
+func GetClusterLoad(info v1alpha1.ClusterCacheInfo) int64 {
+	return info.ResourcesCount + info.APIsCount*clusterLoadAPIWeight
+}
+
+// GetNextClusterLoad returns the load of a cluster to be used by the load-aware distribution algorithm given its
+// currently used load and its current actual load. The used load is only updated once the actual load differs from
+// it by more than the hysteresis, so that fluctuations of the actual load do not cause the cluster to change shards.
+func GetNextClusterLoad(usedLoad, actualLoad int64) int64 {
+	if usedLoad > 0 && math.Abs(float64(actualLoad-usedLoad)) <= float64(usedLoad)*clusterLoadHysteresis {
+		return usedLoad
+	}
+	return actualLoad
+}
+
 func getAppDistribution(getCluster clusterAccessor, getApps appAccessor) map[string]int64 {
 	apps := getApps()
 	clusters := getCluster()
@@ -249,6 +368,27 @@ func getAppDistribution(getCluster clusterAccessor, getApps appAccessor) map[str
 	return appDistribution
 }
 
+// IsApplicationShardingEnabled returns whether the Applications of the given cluster are distributed across the shards
+// by Application instead of by cluster.
+func IsApplicationShardingEnabled(c *v1alpha1.Cluster) bool {
+	if c == nil {
+		return false
+	}
+	enabled, _ := strconv.ParseBool(c.Annotations[common.AnnotationKeyApplicationSharding])
+	return enabled
+}
+
+// GetApplicationShard returns the shard which processes the given Application if application sharding is enabled for
+// its cluster, based on the hash of the Application namespace and name.
+func GetApplicationShard(a *v1alpha1.Application, replicas int) int {
+	if replicas <= 0 {
+		return -1
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(a.Namespace + "/" + a.Name))
+	return int(h.Sum32() % uint32(replicas))
+}
+
 // NoShardingDistributionFunction returns a DistributionFunction that will process all cluster by shard 0
 // the function is created for API compatibility purposes and is not supposed to be activated.
 func NoShardingDistributionFunction() DistributionFunction {
@@ -110,7 +110,7 @@ func TestGetClusterFilterUnknown(t *testing.T) {
 	t.Setenv(common.EnvControllerShardingAlgorithm, "unknown")
 	replicasCount := 2
 	db.On("GetApplicationControllerReplicas").Return(replicasCount)
-	distributionFunction := GetDistributionFunction(clusterAccessor, appAccessor, "unknown", replicasCount)
+	distributionFunction := GetDistributionFunction(clusterAccessor, appAccessor, nil, "unknown", replicasCount)
 	assert.Equal(t, 0, distributionFunction(nil))
 	assert.Equal(t, 0, distributionFunction(&cluster1))
 	assert.Equal(t, 1, distributionFunction(&cluster2))
@@ -125,7 +125,7 @@ func TestLegacyGetClusterFilterWithFixedShard(t *testing.T) {
 	appAccessor, _, _, _, _, _ := createTestApps()
 	replicasCount := 5
 	db.On("GetApplicationControllerReplicas").Return(replicasCount)
-	filter := GetDistributionFunction(clusterAccessor, appAccessor, common.DefaultShardingAlgorithm, replicasCount)
+	filter := GetDistributionFunction(clusterAccessor, appAccessor, nil, common.DefaultShardingAlgorithm, replicasCount)
 	assert.Equal(t, 0, filter(nil))
 	assert.Equal(t, 4, filter(&cluster1))
 	assert.Equal(t, 1, filter(&cluster2))
@@ -135,13 +135,13 @@ func TestLegacyGetClusterFilterWithFixedShard(t *testing.T) {
 	var fixedShard int64 = 4
 	cluster5 := &v1alpha1.Cluster{ID: "5", Shard: &fixedShard}
 	clusterAccessor = getClusterAccessor([]v1alpha1.Cluster{cluster1, cluster2, cluster2, cluster4, *cluster5})
-	filter = GetDistributionFunction(clusterAccessor, appAccessor, common.DefaultShardingAlgorithm, replicasCount)
+	filter = GetDistributionFunction(clusterAccessor, appAccessor, nil, common.DefaultShardingAlgorithm, replicasCount)
 	assert.Equal(t, int(fixedShard), filter(cluster5))
 
 	fixedShard = 1
 	cluster5.Shard = &fixedShard
 	clusterAccessor = getClusterAccessor([]v1alpha1.Cluster{cluster1, cluster2, cluster2, cluster4, *cluster5})
-	filter = GetDistributionFunction(clusterAccessor, appAccessor, common.DefaultShardingAlgorithm, replicasCount)
+	filter = GetDistributionFunction(clusterAccessor, appAccessor, nil, common.DefaultShardingAlgorithm, replicasCount)
 	assert.Equal(t, int(fixedShard), filter(&v1alpha1.Cluster{ID: "4", Shard: &fixedShard}))
 }
 
@@ -153,7 +153,7 @@ func TestRoundRobinGetClusterFilterWithFixedShard(t *testing.T) {
 	replicasCount := 4
 	db.On("GetApplicationControllerReplicas").Return(replicasCount)
 
-	filter := GetDistributionFunction(clusterAccessor, appAccessor, common.RoundRobinShardingAlgorithm, replicasCount)
+	filter := GetDistributionFunction(clusterAccessor, appAccessor, nil, common.RoundRobinShardingAlgorithm, replicasCount)
 	assert.Equal(t, 0, filter(nil))
 	assert.Equal(t, 0, filter(&cluster1))
 	assert.Equal(t, 1, filter(&cluster2))
@@ -166,14 +166,14 @@ func TestRoundRobinGetClusterFilterWithFixedShard(t *testing.T) {
 	cluster5 := v1alpha1.Cluster{Name: "cluster5", ID: "5", Shard: &fixedShard}
 	clusters := []v1alpha1.Cluster{cluster1, cluster2, cluster3, cluster4, cluster5}
 	clusterAccessor = getClusterAccessor(clusters)
-	filter = GetDistributionFunction(clusterAccessor, appAccessor, common.RoundRobinShardingAlgorithm, replicasCount)
+	filter = GetDistributionFunction(clusterAccessor, appAccessor, nil, common.RoundRobinShardingAlgorithm, replicasCount)
 	assert.Equal(t, int(fixedShard), filter(&cluster5))
 
 	fixedShard = 1
 	cluster5 = v1alpha1.Cluster{Name: "cluster5", ID: "5", Shard: &fixedShard}
 	clusters = []v1alpha1.Cluster{cluster1, cluster2, cluster3, cluster4, cluster5}
 	clusterAccessor = getClusterAccessor(clusters)
-	filter = GetDistributionFunction(clusterAccessor, appAccessor, common.RoundRobinShardingAlgorithm, replicasCount)
+	filter = GetDistributionFunction(clusterAccessor, appAccessor, nil, common.RoundRobinShardingAlgorithm, replicasCount)
 	assert.Equal(t, int(fixedShard), filter(&v1alpha1.Cluster{Name: "cluster4", ID: "4", Shard: &fixedShard}))
 }
 
@@ -378,6 +378,61 @@ func TestConsistentHashingWhenClusterWithFixedShard(t *testing.T) {
 	assert.Equal(t, fixedShard, int64(distributionFunction(cluster)))
 }
 
+func TestLoadAwareDistributionFunction(t *testing.T) {
+	clusters, _, cluster1, cluster2, cluster3, cluster4, cluster5 := createTestClusters()
+	replicasCount := 2
+
+	t.Run("clusters are moved away from overloaded shard", func(t *testing.T) {
+		loads := map[string]int64{cluster1.Server: 400000, cluster2.Server: 3000, cluster3.Server: 2000, cluster4.Server: 5000, cluster5.Server: 1000}
+		distributionFunction := LoadAwareDistributionFunction(clusters, func() map[string]int64 { return loads }, replicasCount)
+		assert.Equal(t, 0, distributionFunction(nil))
+		assert.Equal(t, 0, distributionFunction(&cluster1))
+		assert.Equal(t, 1, distributionFunction(&cluster2))
+		assert.Equal(t, 1, distributionFunction(&cluster3))
+		assert.Equal(t, 1, distributionFunction(&cluster4))
+		assert.Equal(t, 1, distributionFunction(&cluster5))
+	})
+
+	t.Run("clusters are not moved within tolerance", func(t *testing.T) {
+		loads := map[string]int64{cluster1.Server: 1000, cluster2.Server: 1000, cluster3.Server: 1000, cluster4.Server: 1000, cluster5.Server: 1000}
+		distributionFunction := LoadAwareDistributionFunction(clusters, func() map[string]int64 { return loads }, replicasCount)
+		legacyDistributionFunction := LegacyDistributionFunction(replicasCount)
+		for _, c := range clusters() {
+			assert.Equal(t, legacyDistributionFunction(c), distributionFunction(c))
+		}
+
+		loads[cluster5.Server] = 1400
+		assert.Equal(t, 1, distributionFunction(&cluster1))
+		assert.Equal(t, 0, distributionFunction(&cluster3))
+		assert.Equal(t, 0, distributionFunction(&cluster5))
+	})
+
+	t.Run("clusters with fixed shard are not moved", func(t *testing.T) {
+		var fixedShard int64
+		cluster3.Shard = &fixedShard
+		defer func() { cluster3.Shard = nil }()
+		pinnedClusters := getClusterAccessor([]v1alpha1.Cluster{cluster1, cluster2, cluster3, cluster4, cluster5})
+		loads := map[string]int64{cluster1.Server: 400000, cluster2.Server: 3000, cluster3.Server: 2000, cluster4.Server: 5000, cluster5.Server: 1000}
+		distributionFunction := LoadAwareDistributionFunction(pinnedClusters, func() map[string]int64 { return loads }, replicasCount)
+		assert.Equal(t, 0, distributionFunction(&cluster3))
+		assert.Equal(t, 1, distributionFunction(&cluster5))
+	})
+
+	t.Run("replicas set to 0", func(t *testing.T) {
+		distributionFunction := LoadAwareDistributionFunction(clusters, nil, 0)
+		assert.Equal(t, -1, distributionFunction(&cluster1))
+	})
+}
+
+func TestGetNextClusterLoad(t *testing.T) {
+	assert.Equal(t, int64(1000), GetNextClusterLoad(0, 1000))
+	assert.Equal(t, int64(1000), GetNextClusterLoad(1000, 1150))
+	assert.Equal(t, int64(1000), GetNextClusterLoad(1000, 800))
+	assert.Equal(t, int64(1250), GetNextClusterLoad(1000, 1250))
+	assert.Equal(t, int64(700), GetNextClusterLoad(1000, 700))
+	assert.Equal(t, int64(1050), GetClusterLoad(v1alpha1.ClusterCacheInfo{ResourcesCount: 1000, APIsCount: 5}))
+}
+
 func TestGetShardByIndexModuloReplicasCountDistributionFunction(t *testing.T) {
 	clusters, db, cluster1, cluster2, _, _, _ := createTestClusters()
 	replicasCount := 2
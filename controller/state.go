@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"slices"
 	"strings"
@@ -41,6 +42,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
 	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/gpg"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/settings"
@@ -49,6 +51,20 @@ import (
 
 var ErrCompareStateRepo = errors.New("failed to get repo objects")
 
+const (
+	EnvResourceDiffCacheEnabled      = "ARGOCD_CONTROLLER_RESOURCE_DIFF_CACHE_ENABLED"
+	EnvResourceDiffCacheMaxResources = "ARGOCD_CONTROLLER_RESOURCE_DIFF_CACHE_MAX_RESOURCES"
+)
+
+// resourceDiffCacheEnabled controls whether the diff results of the resources are kept in memory, so that only the
+// resources whose target manifest or live state changed are diffed again when an application is compared. The cache
+// keeps the normalized live and predicted live states of every managed resource, so it is disabled by default.
+var resourceDiffCacheEnabled = env.ParseBoolFromEnv(EnvResourceDiffCacheEnabled, false)
+
+// resourceDiffCacheMaxResources is the maximum number of resource diffs kept in memory, 0 means no limit. Once it is
+// reached, the diffs of the least recently compared applications are evicted.
+var resourceDiffCacheMaxResources = env.ParseNumFromEnv(EnvResourceDiffCacheMaxResources, 10000, 0, math.MaxInt32)
+
 type resourceInfoProviderStub struct{}
 
 func (r *resourceInfoProviderStub) IsNamespaced(_ schema.GroupKind) (bool, error) {
@@ -89,6 +105,7 @@ type comparisonResult struct {
 	// timings maps phases of comparison to the duration it took to complete (for statistical purposes)
 	timings            map[string]time.Duration
 	diffResultList     *diff.DiffResultList
+	hasPreDeleteHooks  bool
 	hasPostDeleteHooks bool
 	// revisionsMayHaveChanges indicates if there are any possibilities that the revisions contain changes
 	revisionsMayHaveChanges bool
@@ -121,6 +138,7 @@ type appStateManager struct {
 	repoErrorGracePeriod  time.Duration
 	serverSideDiff        bool
 	ignoreNormalizerOpts  normalizers.IgnoreNormalizerOpts
+	resourceDiffCache     *argodiff.ResourceDiffCache
 }
 
 // GetRepoObjs will generate the manifests for the given application delegating the
@@ -677,6 +695,10 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 			targetNsExists = true
 		}
 	}
+	if _, err := getResourceDependencies(targetObjs); err != nil {
+		msg := "Failed to build resource dependency graph: " + err.Error()
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+	}
 	ts.AddCheckpoint("dedup_ms")
 
 	liveObjByKey, err := m.liveStateCache.GetManagedLiveObjs(destCluster, app, targetObjs)
@@ -753,8 +775,12 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 			}
 		}
 	}
+	hasPreDeleteHooks := false
 	hasPostDeleteHooks := false
 	for _, obj := range targetObjs {
+		if isPreDeleteHook(obj) {
+			hasPreDeleteHooks = true
+		}
 		if isPostDeleteHook(obj) {
 			hasPostDeleteHooks = true
 		}
@@ -795,6 +821,14 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		diffConfigBuilder.WithNoCache()
 	}
 
+	if m.resourceDiffCache != nil {
+		if noCache {
+			// a hard refresh diffs all the resources again
+			m.resourceDiffCache.Delete(app.InstanceName(m.namespace))
+		}
+		diffConfigBuilder.WithResourceDiffCache(m.resourceDiffCache, app.InstanceName(m.namespace))
+	}
+
 	if resourceutil.HasAnnotationOption(app, common.AnnotationCompareOptions, "IncludeMutationWebhook=true") {
 		diffConfigBuilder.WithIgnoreMutationWebhook(false)
 	}
@@ -976,6 +1010,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		reconciliationResult:    reconciliation,
 		diffConfig:              diffConfig,
 		diffResultList:          diffResults,
+		hasPreDeleteHooks:       hasPreDeleteHooks,
 		hasPostDeleteHooks:      hasPostDeleteHooks,
 		revisionsMayHaveChanges: revisionsMayHaveChanges,
 	}
@@ -1062,6 +1097,7 @@ func (m *appStateManager) persistRevisionHistory(
 	hasMultipleSources bool,
 	startedAt metav1.Time,
 	initiatedBy v1alpha1.OperationInitiator,
+	revert *v1alpha1.SyncRevert,
 ) error {
 	var nextID int64
 	if len(app.Status.History) > 0 {
@@ -1076,6 +1112,7 @@ func (m *appStateManager) persistRevisionHistory(
 			Sources:         sources,
 			Revisions:       revisions,
 			InitiatedBy:     initiatedBy,
+			Revert:          revert,
 		})
 	} else {
 		app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{
@@ -1085,6 +1122,7 @@ func (m *appStateManager) persistRevisionHistory(
 			ID:              nextID,
 			Source:          source,
 			InitiatedBy:     initiatedBy,
+			Revert:          revert,
 		})
 	}
 
@@ -1120,6 +1158,7 @@ func NewAppStateManager(
 	repoErrorGracePeriod time.Duration,
 	serverSideDiff bool,
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts,
+	resourceDiffCache *argodiff.ResourceDiffCache,
 ) AppStateManager {
 	return &appStateManager{
 		liveStateCache:        liveStateCache,
@@ -1138,6 +1177,7 @@ func NewAppStateManager(
 		repoErrorGracePeriod:  repoErrorGracePeriod,
 		serverSideDiff:        serverSideDiff,
 		ignoreNormalizerOpts:  ignoreNormalizerOpts,
+		resourceDiffCache:     resourceDiffCache,
 	}
 }
 
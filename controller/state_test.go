@@ -32,6 +32,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v3/test"
+	argodiff "github.com/argoproj/argo-cd/v3/util/argo/diff"
 )
 
 // TestCompareAppStateEmpty tests comparison when both git and live have no objects
@@ -352,6 +353,56 @@ func TestCompareAppStateExtra(t *testing.T) {
 	assert.Empty(t, app.Status.Conditions)
 }
 
+func TestCompareAppState_ResourceDiffCache(t *testing.T) {
+	pod := NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	livePod := pod.DeepCopy()
+	livePod.SetResourceVersion("1")
+	app := newFakeApp()
+	key := kube.ResourceKey{Group: "", Kind: "Pod", Namespace: test.FakeDestNamespace, Name: pod.GetName()}
+	manifestResponse := &apiclient.ManifestResponse{
+		Manifests: []string{toJSON(t, pod)},
+		Namespace: test.FakeDestNamespace,
+		Server:    test.FakeClusterURL,
+		Revision:  "abc123",
+	}
+	data := fakeData{
+		manifestResponses: []*apiclient.ManifestResponse{manifestResponse, manifestResponse, manifestResponse},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			key: livePod,
+		},
+	}
+	ctrl := newFakeController(&data, nil)
+	var hits, misses int
+	ctrl.appStateManager.(*appStateManager).resourceDiffCache = argodiff.NewResourceDiffCache(0, func(h int, m int) {
+		hits, misses = h, m
+	})
+	sources := []v1alpha1.ApplicationSource{app.Spec.GetSource()}
+	revisions := []string{""}
+
+	compRes, err := ctrl.appStateManager.CompareAppState(app, &defaultProj, revisions, sources, false, false, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	assert.Equal(t, 0, hits)
+	assert.Equal(t, 1, misses)
+
+	// the reconciliation consumes the live objects
+	data.managedLiveObjs[key] = livePod
+	compRes, err = ctrl.appStateManager.CompareAppState(app, &defaultProj, revisions, sources, false, false, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	assert.Equal(t, 1, hits)
+	assert.Equal(t, 0, misses)
+
+	// a hard refresh diffs all the resources again
+	data.managedLiveObjs[key] = livePod
+	compRes, err = ctrl.appStateManager.CompareAppState(app, &defaultProj, revisions, sources, true, false, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	assert.Equal(t, 0, hits)
+	assert.Equal(t, 1, misses)
+}
+
 // TestCompareAppStateHook checks that hooks are detected during manifest generation, and not
 // considered as part of resources when assessing Synced status
 func TestCompareAppStateHook(t *testing.T) {
@@ -914,7 +965,7 @@ func Test_appStateManager_persistRevisionHistory(t *testing.T) {
 		app.Spec.RevisionHistoryLimit = &i
 	}
 	addHistory := func() {
-		err := manager.persistRevisionHistory(app, "my-revision", v1alpha1.ApplicationSource{}, []string{}, []v1alpha1.ApplicationSource{}, false, metav1.Time{}, v1alpha1.OperationInitiator{})
+		err := manager.persistRevisionHistory(app, "my-revision", v1alpha1.ApplicationSource{}, []string{}, []v1alpha1.ApplicationSource{}, false, metav1.Time{}, v1alpha1.OperationInitiator{}, nil)
 		require.NoError(t, err)
 	}
 	addHistory()
@@ -950,7 +1001,7 @@ func Test_appStateManager_persistRevisionHistory(t *testing.T) {
 	assert.Len(t, app.Status.History, 9)
 
 	metav1NowTime := metav1.NewTime(time.Now())
-	err := manager.persistRevisionHistory(app, "my-revision", v1alpha1.ApplicationSource{}, []string{}, []v1alpha1.ApplicationSource{}, false, metav1NowTime, v1alpha1.OperationInitiator{})
+	err := manager.persistRevisionHistory(app, "my-revision", v1alpha1.ApplicationSource{}, []string{}, []v1alpha1.ApplicationSource{}, false, metav1NowTime, v1alpha1.OperationInitiator{}, nil)
 	require.NoError(t, err)
 	assert.Equal(t, app.Status.History.LastRevisionHistory().DeployStartedAt, &metav1NowTime)
 
@@ -304,9 +304,27 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		}
 	}
 
+	isSelected := func(key kube.ResourceKey) bool {
+		return len(syncOp.Resources) == 0 ||
+			argo.ContainsSyncResource(key.Name, key.Namespace, schema.GroupVersionKind{Kind: key.Kind, Group: key.Group}, syncOp.Resources)
+	}
+	healthOverride := lua.ResourceHealthOverrides(resourceOverrides)
+
+	// Resources are only applied once the resources they depend on are synced and healthy. Resources which wait for
+	// their dependencies are left out of the sync until they are ready, so the operation keeps running meanwhile.
+	var waitingForDependencies map[kube.ResourceKey]bool
+	if !syncOp.DryRun && state.Phase != common.OperationTerminating {
+		waitingForDependencies, err = getSyncResourcesWaitingForDependencies(reconciliationResult, compareResult.resources, state, isSelected, healthOverride)
+		if err != nil {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("Failed to build resource dependency graph: %v", err)
+			return
+		}
+	}
+
 	opts := []sync.SyncOpt{
 		sync.WithLogr(logutils.NewLogrusLogger(logEntry)),
-		sync.WithHealthOverride(lua.ResourceHealthOverrides(resourceOverrides)),
+		sync.WithHealthOverride(healthOverride),
 		sync.WithPermissionValidator(func(un *unstructured.Unstructured, res *metav1.APIResource) error {
 			if !project.IsGroupKindPermitted(un.GroupVersionKind().GroupKind(), res.Namespaced) {
 				return fmt.Errorf("resource %s:%s is not permitted in project %s", un.GroupVersionKind().Group, un.GroupVersionKind().Kind, project.Name)
@@ -328,13 +346,17 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		sync.WithOperationSettings(syncOp.DryRun, syncOp.Prune, syncOp.SyncStrategy.Force(), syncOp.IsApplyStrategy() || len(syncOp.Resources) > 0),
 		sync.WithInitialState(state.Phase, state.Message, initialResourcesRes, state.StartedAt),
 		sync.WithResourcesFilter(func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool {
-			return (len(syncOp.Resources) == 0 ||
-				isPostDeleteHook(target) ||
-				argo.ContainsSyncResource(key.Name, key.Namespace, schema.GroupVersionKind{Kind: key.Kind, Group: key.Group}, syncOp.Resources)) &&
+			if waitingForDependencies[key] || (len(waitingForDependencies) > 0 && isPostSyncHook(target)) {
+				return false
+			}
+			return (isSelected(key) || isPreDeleteHook(target) || isPostDeleteHook(target)) &&
 				m.isSelfReferencedObj(live, target, app.GetName(), v1alpha1.TrackingMethod(trackingMethod), installationID)
 		}),
 		sync.WithManifestValidation(!syncOp.SyncOptions.HasOption(common.SyncOptionsDisableValidation)),
-		sync.WithSyncWaveHook(delayBetweenSyncWaves),
+		sync.WithSyncWaveHook(func(phase common.SyncPhase, wave int, finalWave bool) error {
+			state.SyncResult.WaveProgress = &v1alpha1.SyncWaveProgress{Phase: phase, Wave: int64(wave), Final: finalWave, AppliedAt: metav1.Now()}
+			return delayBetweenSyncWaves(phase, wave, finalWave)
+		}),
 		sync.WithPruneLast(syncOp.SyncOptions.HasOption(common.SyncOptionPruneLast)),
 		sync.WithResourceModificationChecker(syncOp.SyncOptions.HasOption("ApplyOutOfSyncOnly=true"), compareResult.diffResultList),
 		sync.WithPrunePropagationPolicy(&prunePropagationPolicy),
@@ -353,12 +375,30 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		opts = append(opts, sync.WithNamespaceModifier(syncNamespace(app.Spec.SyncPolicy)))
 	}
 
+	var wavesStrategy *v1alpha1.SyncWavesStrategy
+	if app.Spec.SyncPolicy != nil {
+		wavesStrategy = app.Spec.SyncPolicy.Waves
+	}
+	waveDelay, err := wavesStrategy.GetDelay()
+	if err != nil {
+		state.Phase = common.OperationError
+		state.Message = fmt.Sprintf("invalid delay between sync waves: %v", err)
+		return
+	}
+	if waitForSyncWaveDelay(state, compareResult.resources, waveDelay, time.Now()) {
+		return
+	}
+	kubectl := m.kubectl
+	if wavesStrategy != nil && wavesStrategy.MaxParallelism > 0 {
+		kubectl = &parallelismLimitedKubectl{Kubectl: m.kubectl, maxParallelism: wavesStrategy.MaxParallelism}
+	}
+
 	syncCtx, cleanup, err := sync.NewSyncContext(
 		compareResult.syncStatus.Revision,
 		reconciliationResult,
 		restConfig,
 		rawConfig,
-		m.kubectl,
+		kubectl,
 		app.Spec.Destination.Namespace,
 		openAPISchema,
 		opts...,
@@ -380,6 +420,10 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 	}
 	var resState []common.ResourceSyncResult
 	state.Phase, state.Message, resState = syncCtx.GetState()
+	if state.Phase == common.OperationSucceeded && len(waitingForDependencies) > 0 {
+		state.Phase = common.OperationRunning
+		state.Message = fmt.Sprintf("waiting for the dependencies of %d resources to be synced and healthy", len(waitingForDependencies))
+	}
 	state.SyncResult.Resources = nil
 
 	if app.Spec.SyncPolicy != nil {
@@ -421,8 +465,10 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
 
-	if !syncOp.DryRun && len(syncOp.Resources) == 0 && state.Phase.Successful() {
-		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, compareResult.syncStatus.ComparedTo.Source, compareResult.syncStatus.Revisions, compareResult.syncStatus.ComparedTo.Sources, isMultiSourceSync, state.StartedAt, state.Operation.InitiatedBy)
+	// A revert of a failed atomic sync only syncs the resources changed by the failed sync, which brings the whole
+	// application back to the reverted revision
+	if !syncOp.DryRun && (len(syncOp.Resources) == 0 || syncOp.Revert != nil) && state.Phase.Successful() {
+		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, compareResult.syncStatus.ComparedTo.Source, compareResult.syncStatus.Revisions, compareResult.syncStatus.ComparedTo.Sources, isMultiSourceSync, state.StartedAt, state.Operation.InitiatedBy, syncOp.Revert)
 		if err != nil {
 			state.Phase = common.OperationError
 			state.Message = fmt.Sprintf("failed to record sync to history: %v", err)
@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// syncOptionAtomic reverts the resources changed by a sync operation if it fails or times out
+const syncOptionAtomic = "Atomic=true"
+
+// newAtomicSyncRevertOperation returns the operation which re-applies the most recently deployed revision to the
+// resources changed by the given failed atomic sync operation. It returns nil if the operation is not atomic, changed
+// no resources, or there is no revision to revert to.
+func newAtomicSyncRevertOperation(app *appv1.Application, state *appv1.OperationState) *appv1.Operation {
+	syncOp := state.Operation.Sync
+	if syncOp == nil || syncOp.DryRun || syncOp.Revert != nil || !syncOp.SyncOptions.HasOption(syncOptionAtomic) || state.SyncResult == nil {
+		return nil
+	}
+	var resources []appv1.SyncOperationResource
+	for _, res := range state.SyncResult.Resources {
+		if res.HookType != "" || (res.Status != synccommon.ResultCodeSynced && res.Status != synccommon.ResultCodePruned) {
+			continue
+		}
+		resources = append(resources, appv1.SyncOperationResource{
+			Group:     res.Group,
+			Kind:      res.Kind,
+			Name:      res.Name,
+			Namespace: res.Namespace,
+		})
+	}
+	if len(resources) == 0 {
+		return nil
+	}
+	target := findAtomicSyncRevertTarget(app.Status.History, state)
+	if target == nil {
+		return nil
+	}
+
+	// Resources created by the failed sync which are not part of the reverted revision are pruned
+	op := argo.NewRollbackOperation(target, syncOp.SyncOptions.RemoveOption(syncOptionAtomic), true, false, appv1.OperationInitiator{Automated: true})
+	op.Sync.Resources = resources
+	op.Sync.Revert = &appv1.SyncRevert{
+		Revision:  state.SyncResult.Revision,
+		Revisions: state.SyncResult.Revisions,
+		Message:   state.Message,
+		StartedAt: state.StartedAt,
+		ID:        target.ID,
+	}
+	return op
+}
+
+// findAtomicSyncRevertTarget returns the most recent revision history entry which was deployed before the given sync
+// operation started, or nil if there is none or it is the revision the operation synced to.
+func findAtomicSyncRevertTarget(history appv1.RevisionHistories, state *appv1.OperationState) *appv1.RevisionHistory {
+	for i := len(history) - 1; i >= 0; i-- {
+		info := history[i]
+		if !info.DeployedAt.Before(&state.StartedAt) {
+			continue
+		}
+		if (info.Source.IsZero() && info.Sources.IsZero()) || deployedRevisionsEqual(info, state.SyncResult) {
+			return nil
+		}
+		return &history[i]
+	}
+	return nil
+}
+
+// startAtomicSyncRevert starts the given operation which reverts a failed atomic sync operation.
+func (ctrl *ApplicationController) startAtomicSyncRevert(app *appv1.Application, op *appv1.Operation) {
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	updatedApp, err := argo.SetAppOperation(appIf, app.Name, op)
+	if err != nil {
+		logCtx.Errorf("Failed to revert failed atomic sync to revision history %d: %v", op.Sync.Revert.ID, err)
+		return
+	}
+	ctrl.writeBackToInformer(updatedApp)
+	ctrl.appOperationQueue.Add(ctrl.toAppKey(app.QualifiedName()))
+
+	message := fmt.Sprintf("Reverting %d resources changed by the failed atomic sync to %s", len(op.Sync.Resources), atomicSyncRevertTargetString(op))
+	ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: corev1.EventTypeWarning}, message)
+	logCtx.Warn(message)
+}
+
+// getAtomicSyncRevertCondition returns a SyncError condition if the most recent operation reverted a failed atomic
+// sync to the given revisions, which prevents automated sync from attempting the same revisions again.
+func getAtomicSyncRevertCondition(app *appv1.Application, desiredRevisions []string) *appv1.ApplicationCondition {
+	opState := app.Status.OperationState
+	if opState == nil || opState.Operation.Sync == nil || opState.Operation.Sync.Revert == nil {
+		return nil
+	}
+	revert := opState.Operation.Sync.Revert
+	if app.Spec.HasMultipleSources() {
+		if !reflect.DeepEqual(revert.Revisions, desiredRevisions) {
+			return nil
+		}
+	} else if len(desiredRevisions) != 1 || revert.Revision != desiredRevisions[0] {
+		return nil
+	}
+	return &appv1.ApplicationCondition{
+		Type:    appv1.ApplicationConditionSyncError,
+		Message: fmt.Sprintf("Failed last atomic sync attempt to %s and reverted the changed resources: %s", desiredRevisions, revert.Message),
+	}
+}
+
+func atomicSyncRevertTargetString(op *appv1.Operation) string {
+	return fmt.Sprintf("revision %s (history ID %d)", deployedRevisionsString(op.Sync.Revision, op.Sync.Revisions), op.Sync.Revert.ID)
+}
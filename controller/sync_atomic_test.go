@@ -0,0 +1,141 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+// newFakeAtomicSyncApp returns an application whose atomic sync to revision "bbb" started two minutes ago and updated
+// a Deployment, after revision "aaa" was deployed.
+func newFakeAtomicSyncApp() *v1alpha1.Application {
+	app := newFakeApp()
+	app.Spec.Project = "default"
+	source := app.Spec.GetSource()
+	startedAt := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+	app.Status.History = v1alpha1.RevisionHistories{
+		{ID: 1, Revision: "aaa", Source: source, DeployedAt: metav1.NewTime(startedAt.Add(-time.Hour))},
+	}
+	app.Operation = &v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{
+			Revision:    "bbb",
+			SyncOptions: v1alpha1.SyncOptions{"Atomic=true", "CreateNamespace=true"},
+		},
+	}
+	app.Status.OperationState = &v1alpha1.OperationState{
+		Operation: *app.Operation,
+		Phase:     synccommon.OperationRunning,
+		StartedAt: startedAt,
+		SyncResult: &v1alpha1.SyncOperationResult{
+			Revision: "bbb",
+			Source:   source,
+			Resources: v1alpha1.ResourceResults{
+				{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Status: synccommon.ResultCodeSynced, SyncPhase: synccommon.SyncPhaseSync},
+				{Group: "batch", Kind: "Job", Namespace: "default", Name: "migrate", Status: synccommon.ResultCodeSynced, HookType: synccommon.HookTypePreSync, SyncPhase: synccommon.SyncPhasePreSync},
+				{Kind: "Service", Namespace: "default", Name: "guestbook", Status: synccommon.ResultCodeSyncFailed, SyncPhase: synccommon.SyncPhaseSync},
+			},
+		},
+	}
+	return app
+}
+
+func TestNewAtomicSyncRevertOperation(t *testing.T) {
+	t.Run("reverts changed resources", func(t *testing.T) {
+		app := newFakeAtomicSyncApp()
+		state := app.Status.OperationState
+		state.Message = "one or more objects failed to apply"
+
+		op := newAtomicSyncRevertOperation(app, state)
+
+		require.NotNil(t, op)
+		assert.Equal(t, "aaa", op.Sync.Revision)
+		assert.True(t, op.Sync.Prune)
+		assert.True(t, op.InitiatedBy.Automated)
+		assert.Equal(t, v1alpha1.SyncOptions{"CreateNamespace=true"}, op.Sync.SyncOptions)
+		assert.Equal(t, []v1alpha1.SyncOperationResource{{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook"}}, op.Sync.Resources)
+		require.NotNil(t, op.Sync.Revert)
+		assert.Equal(t, int64(1), op.Sync.Revert.ID)
+		assert.Equal(t, "bbb", op.Sync.Revert.Revision)
+		assert.Equal(t, "one or more objects failed to apply", op.Sync.Revert.Message)
+	})
+
+	t.Run("sync is not atomic", func(t *testing.T) {
+		app := newFakeAtomicSyncApp()
+		app.Status.OperationState.Operation.Sync.SyncOptions = nil
+		assert.Nil(t, newAtomicSyncRevertOperation(app, app.Status.OperationState))
+	})
+
+	t.Run("sync is a revert", func(t *testing.T) {
+		app := newFakeAtomicSyncApp()
+		app.Status.OperationState.Operation.Sync.Revert = &v1alpha1.SyncRevert{ID: 1}
+		assert.Nil(t, newAtomicSyncRevertOperation(app, app.Status.OperationState))
+	})
+
+	t.Run("no resources changed", func(t *testing.T) {
+		app := newFakeAtomicSyncApp()
+		app.Status.OperationState.SyncResult.Resources = app.Status.OperationState.SyncResult.Resources[1:]
+		assert.Nil(t, newAtomicSyncRevertOperation(app, app.Status.OperationState))
+	})
+
+	t.Run("no revision to revert to", func(t *testing.T) {
+		app := newFakeAtomicSyncApp()
+		app.Status.History = nil
+		assert.Nil(t, newAtomicSyncRevertOperation(app, app.Status.OperationState))
+	})
+
+	t.Run("previous revision is the synced revision", func(t *testing.T) {
+		app := newFakeAtomicSyncApp()
+		app.Status.History[0].Revision = "bbb"
+		assert.Nil(t, newAtomicSyncRevertOperation(app, app.Status.OperationState))
+	})
+}
+
+func TestProcessRequestedAppOperation_AtomicSyncTimeout(t *testing.T) {
+	app := newFakeAtomicSyncApp()
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponses: []*apiclient.ManifestResponse{{
+			Manifests: []string{},
+			Revision:  "bbb",
+		}},
+	}, nil)
+	ctrl.syncTimeout = time.Minute
+
+	ctrl.processRequestedAppOperation(app)
+
+	updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, updatedApp.Operation)
+	assert.Equal(t, "aaa", updatedApp.Operation.Sync.Revision)
+	require.NotNil(t, updatedApp.Operation.Sync.Revert)
+	assert.Equal(t, "bbb", updatedApp.Operation.Sync.Revert.Revision)
+	assert.Equal(t, "Operation terminated, triggered by controller sync timeout", updatedApp.Operation.Sync.Revert.Message)
+}
+
+func TestGetAtomicSyncRevertCondition(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = &v1alpha1.OperationState{
+		Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{
+				Revision: "aaa",
+				Revert:   &v1alpha1.SyncRevert{Revision: "bbb", Message: "one or more objects failed to apply", ID: 1},
+			},
+		},
+		Phase: synccommon.OperationSucceeded,
+	}
+
+	cond := getAtomicSyncRevertCondition(app, []string{"bbb"})
+	require.NotNil(t, cond)
+	assert.Equal(t, v1alpha1.ApplicationConditionSyncError, cond.Type)
+	assert.Equal(t, "Failed last atomic sync attempt to [bbb] and reverted the changed resources: one or more objects failed to apply", cond.Message)
+
+	assert.Nil(t, getAtomicSyncRevertCondition(app, []string{"ccc"}))
+}
@@ -0,0 +1,216 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+
+	"github.com/argoproj/argo-cd/v3/controller/sharding"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// syncSlot identifies the destination cluster and the project a sync operation counts against
+type syncSlot struct {
+	cluster string
+	project string
+	// clusterShards is the number of controller shards which sync applications of the cluster, each of them may run
+	// its share of the cluster limit
+	clusterShards int
+}
+
+type queuedSync struct {
+	syncSlot
+	queuedAt time.Time
+}
+
+// syncConcurrencyLimiter caps the number of sync operations which run concurrently against the same destination
+// cluster and within the same project. A limit lower than 1 means no limit.
+type syncConcurrencyLimiter struct {
+	clusterLimit int
+	projectLimit int
+	// queuedChanged is called with the number of queued sync operations of a cluster and project when it changes
+	queuedChanged func(slot syncSlot, count int)
+
+	lock sync.Mutex
+	// running contains the keys of the applications whose sync operation holds a slot
+	running map[string]syncSlot
+	// queued contains the keys of the applications whose sync operation waits for a slot
+	queued map[string]queuedSync
+}
+
+func newSyncConcurrencyLimiter(clusterLimit int, projectLimit int, queuedChanged func(slot syncSlot, count int)) *syncConcurrencyLimiter {
+	return &syncConcurrencyLimiter{
+		clusterLimit:  clusterLimit,
+		projectLimit:  projectLimit,
+		queuedChanged: queuedChanged,
+		running:       map[string]syncSlot{},
+		queued:        map[string]queuedSync{},
+	}
+}
+
+func (l *syncConcurrencyLimiter) enabled() bool {
+	return l != nil && (l.clusterLimit > 0 || l.projectLimit > 0)
+}
+
+// acquire reserves a slot for the sync operation of the given application. If force is true, the slot is reserved
+// even if the limits are exceeded, which is used for operations that already started. It returns an empty string if
+// the operation may run, and otherwise a message explaining why it is queued.
+func (l *syncConcurrencyLimiter) acquire(appKey string, slot syncSlot, force bool) string {
+	if !l.enabled() {
+		return ""
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if _, ok := l.running[appKey]; ok {
+		return ""
+	}
+	if !force {
+		clusterCount, projectCount := l.runningCount(slot)
+		var message string
+		switch {
+		case l.clusterLimit > 0 && clusterCount >= l.clusterLimitOf(slot):
+			message = fmt.Sprintf("Queued: waiting for one of %d running sync operations on cluster %s to complete", clusterCount, slot.cluster)
+		case l.projectLimit > 0 && projectCount >= l.projectLimit:
+			message = fmt.Sprintf("Queued: waiting for one of %d running sync operations in project %s to complete", projectCount, slot.project)
+		}
+		if message != "" {
+			if _, ok := l.queued[appKey]; !ok {
+				l.queued[appKey] = queuedSync{syncSlot: slot, queuedAt: time.Now()}
+				l.notifyQueuedChanged(slot)
+			}
+			return message
+		}
+	}
+	l.dequeue(appKey)
+	l.running[appKey] = slot
+	return ""
+}
+
+// release frees the slot held by the sync operation of the given application, or removes it from the queue. It
+// returns the keys of the queued applications which might be able to run now, in the order they were queued.
+func (l *syncConcurrencyLimiter) release(appKey string) []string {
+	if !l.enabled() {
+		return nil
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.dequeue(appKey)
+	slot, ok := l.running[appKey]
+	if !ok {
+		return nil
+	}
+	delete(l.running, appKey)
+
+	var candidates []string
+	for key, queued := range l.queued {
+		if queued.cluster == slot.cluster || queued.project == slot.project {
+			candidates = append(candidates, key)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return l.queued[candidates[i]].queuedAt.Before(l.queued[candidates[j]].queuedAt)
+	})
+	return candidates
+}
+
+func (l *syncConcurrencyLimiter) dequeue(appKey string) {
+	queued, ok := l.queued[appKey]
+	if !ok {
+		return
+	}
+	delete(l.queued, appKey)
+	l.notifyQueuedChanged(queued.syncSlot)
+}
+
+// isQueued returns true if the sync operation of the given application is waiting for a slot.
+func (l *syncConcurrencyLimiter) isQueued(appKey string) bool {
+	if !l.enabled() {
+		return false
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	_, ok := l.queued[appKey]
+	return ok
+}
+
+func (l *syncConcurrencyLimiter) notifyQueuedChanged(slot syncSlot) {
+	if l.queuedChanged == nil {
+		return
+	}
+	count := 0
+	for _, queued := range l.queued {
+		if queued.syncSlot == slot {
+			count++
+		}
+	}
+	l.queuedChanged(slot, count)
+}
+
+// clusterLimitOf returns the number of sync operations which this shard may run concurrently against the cluster of
+// the given slot. The limit of a cluster whose applications are distributed across several shards is divided evenly
+// among them, so that the limit holds for the whole cluster.
+func (l *syncConcurrencyLimiter) clusterLimitOf(slot syncSlot) int {
+	if slot.clusterShards <= 1 {
+		return l.clusterLimit
+	}
+	return max(1, l.clusterLimit/slot.clusterShards)
+}
+
+func (l *syncConcurrencyLimiter) runningCount(slot syncSlot) (int, int) {
+	clusterCount, projectCount := 0, 0
+	for _, running := range l.running {
+		if running.cluster == slot.cluster {
+			clusterCount++
+		}
+		if running.project == slot.project {
+			projectCount++
+		}
+	}
+	return clusterCount, projectCount
+}
+
+// acquireSyncSlot reserves a slot for the sync operation of the application. It returns an empty string if the
+// operation may run, and otherwise the message of the queued operation. The start time of an operation which was queued
+// is reset once it acquires a slot, so that the time it was queued does not count towards the sync timeout.
+func (ctrl *ApplicationController) acquireSyncSlot(app *appv1.Application, state *appv1.OperationState) string {
+	if !ctrl.syncLimiter.enabled() || state.Phase == synccommon.OperationTerminating {
+		return ""
+	}
+	appKey := ctrl.toAppKey(app.QualifiedName())
+	wasQueued := ctrl.syncLimiter.isQueued(appKey)
+	// Operations which already applied resources, e.g. before the controller restarted, are never queued
+	started := state.SyncResult != nil && len(state.SyncResult.Resources) > 0
+	message := ctrl.syncLimiter.acquire(appKey, ctrl.getSyncSlot(app), started)
+	if message == "" && wasQueued {
+		ctrl.restartOperation(appKey, state)
+	}
+	return message
+}
+
+// releaseSyncSlot frees the slot held by the sync operation of the application and requeues the operations which were
+// waiting for it.
+func (ctrl *ApplicationController) releaseSyncSlot(appKey string) {
+	for _, key := range ctrl.syncLimiter.release(appKey) {
+		ctrl.appOperationQueue.Add(key)
+	}
+}
+
+func (ctrl *ApplicationController) getSyncSlot(app *appv1.Application) syncSlot {
+	slot := syncSlot{cluster: app.Spec.Destination.Server, project: app.Spec.GetProject(), clusterShards: 1}
+	if destCluster, err := argo.GetDestinationCluster(context.Background(), app.Spec.Destination, ctrl.db); err == nil {
+		slot.cluster = destCluster.Server
+		if sharding.IsApplicationShardingEnabled(destCluster) {
+			slot.clusterShards = ctrl.clusterSharding.GetReplicas()
+		}
+	} else if slot.cluster == "" {
+		slot.cluster = app.Spec.Destination.Name
+	}
+	return slot
+}
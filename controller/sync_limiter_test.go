@@ -0,0 +1,186 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubetesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+func TestSyncConcurrencyLimiter(t *testing.T) {
+	clusterA := syncSlot{cluster: "https://a", project: "default"}
+	clusterB := syncSlot{cluster: "https://b", project: "default"}
+	clusterBOtherProject := syncSlot{cluster: "https://b", project: "other"}
+
+	t.Run("no limits", func(t *testing.T) {
+		limiter := newSyncConcurrencyLimiter(0, 0, nil)
+		assert.Empty(t, limiter.acquire("argocd/app1", clusterA, false))
+		assert.Empty(t, limiter.acquire("argocd/app2", clusterA, false))
+	})
+
+	t.Run("cluster limit", func(t *testing.T) {
+		queued := map[syncSlot]int{}
+		limiter := newSyncConcurrencyLimiter(1, 0, func(slot syncSlot, count int) {
+			queued[slot] = count
+		})
+		assert.Empty(t, limiter.acquire("argocd/app1", clusterA, false))
+		assert.Empty(t, limiter.acquire("argocd/app2", clusterB, false))
+		assert.Equal(t, "Queued: waiting for one of 1 running sync operations on cluster https://a to complete", limiter.acquire("argocd/app3", clusterA, false))
+		assert.Equal(t, 1, queued[clusterA])
+		// an application which holds a slot may acquire it again
+		assert.Empty(t, limiter.acquire("argocd/app1", clusterA, false))
+
+		assert.Equal(t, []string{"argocd/app3"}, limiter.release("argocd/app1"))
+		assert.Empty(t, limiter.acquire("argocd/app3", clusterA, false))
+		assert.Equal(t, 0, queued[clusterA])
+	})
+
+	t.Run("cluster limit shared by shards", func(t *testing.T) {
+		limiter := newSyncConcurrencyLimiter(4, 0, nil)
+		shardedClusterA := syncSlot{cluster: "https://a", project: "default", clusterShards: 2}
+		assert.Empty(t, limiter.acquire("argocd/app1", shardedClusterA, false))
+		assert.Empty(t, limiter.acquire("argocd/app2", shardedClusterA, false))
+		assert.NotEmpty(t, limiter.acquire("argocd/app3", shardedClusterA, false))
+
+		// every shard may run at least one sync operation
+		limiter = newSyncConcurrencyLimiter(1, 0, nil)
+		shardedClusterA.clusterShards = 3
+		assert.Empty(t, limiter.acquire("argocd/app1", shardedClusterA, false))
+		assert.NotEmpty(t, limiter.acquire("argocd/app2", shardedClusterA, false))
+	})
+
+	t.Run("project limit", func(t *testing.T) {
+		limiter := newSyncConcurrencyLimiter(0, 1, nil)
+		assert.Empty(t, limiter.acquire("argocd/app1", clusterA, false))
+		assert.Equal(t, "Queued: waiting for one of 1 running sync operations in project default to complete", limiter.acquire("argocd/app2", clusterB, false))
+		assert.Empty(t, limiter.acquire("argocd/app3", clusterBOtherProject, false))
+	})
+
+	t.Run("started operations are never queued", func(t *testing.T) {
+		limiter := newSyncConcurrencyLimiter(1, 0, nil)
+		assert.Empty(t, limiter.acquire("argocd/app1", clusterA, false))
+		assert.Empty(t, limiter.acquire("argocd/app2", clusterA, true))
+		assert.NotEmpty(t, limiter.acquire("argocd/app3", clusterA, false))
+	})
+
+	t.Run("queued operations are released in order", func(t *testing.T) {
+		limiter := newSyncConcurrencyLimiter(1, 0, nil)
+		assert.Empty(t, limiter.acquire("argocd/app1", clusterA, false))
+		assert.NotEmpty(t, limiter.acquire("argocd/app2", clusterA, false))
+		assert.NotEmpty(t, limiter.acquire("argocd/app3", clusterA, false))
+		assert.Empty(t, limiter.acquire("argocd/app4", clusterB, false))
+		assert.NotEmpty(t, limiter.acquire("argocd/app5", clusterB, false))
+
+		// removing a queued operation does not free a slot
+		assert.Empty(t, limiter.release("argocd/app5"))
+		assert.Equal(t, []string{"argocd/app2", "argocd/app3"}, limiter.release("argocd/app1"))
+	})
+}
+
+func TestProcessRequestedAppOperation_SyncConcurrencyLimit(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Project = "default"
+	app.Operation = &v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponses: []*apiclient.ManifestResponse{{
+			Manifests: []string{},
+		}},
+	}, nil)
+	ctrl.syncLimiter = newSyncConcurrencyLimiter(1, 0, nil)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]any{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, &v1alpha1.Application{}, nil
+	})
+
+	// another application syncs to the same cluster
+	require.Empty(t, ctrl.syncLimiter.acquire("argocd/other-app", ctrl.getSyncSlot(app), false))
+
+	ctrl.processRequestedAppOperation(app.DeepCopy())
+
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Equal(t, string(synccommon.OperationRunning), phase)
+	assert.Equal(t, "Queued: waiting for one of 1 running sync operations on cluster https://localhost:6443 to complete", message)
+
+	// the queued operation is requeued once the other operation completes
+	ctrl.releaseSyncSlot("argocd/other-app")
+	assert.Equal(t, 1, ctrl.appOperationQueue.Len())
+
+	ctrl.processRequestedAppOperation(app.DeepCopy())
+
+	phase, _, _ = unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	message, _, _ = unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Equal(t, string(synccommon.OperationSucceeded), phase)
+	assert.Equal(t, "successfully synced (no more tasks)", message)
+	assert.Empty(t, ctrl.syncLimiter.running)
+}
+
+func TestProcessRequestedAppOperation_SyncTimeoutExcludesQueuedTime(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Project = "default"
+	app.Operation = &v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}
+	// the operation was queued longer than the sync timeout
+	app.Status.OperationState = &v1alpha1.OperationState{
+		Operation: *app.Operation,
+		Phase:     synccommon.OperationRunning,
+		Message:   "Queued: waiting for one of 1 running sync operations on cluster https://localhost:6443 to complete",
+		StartedAt: metav1.NewTime(time.Now().Add(-time.Hour)),
+	}
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponses: []*apiclient.ManifestResponse{{
+			Manifests: []string{},
+		}},
+	}, nil)
+	ctrl.syncTimeout = time.Minute
+	ctrl.syncLimiter = newSyncConcurrencyLimiter(1, 0, nil)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]any{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, &v1alpha1.Application{}, nil
+	})
+
+	// another application syncs to the same cluster
+	require.Empty(t, ctrl.syncLimiter.acquire("argocd/other-app", ctrl.getSyncSlot(app), false))
+	require.NotEmpty(t, ctrl.syncLimiter.acquire(ctrl.toAppKey(app.QualifiedName()), ctrl.getSyncSlot(app), false))
+
+	ctrl.processRequestedAppOperation(app.DeepCopy())
+
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	assert.NotEqual(t, string(synccommon.OperationTerminating), phase)
+	assert.True(t, ctrl.syncLimiter.isQueued(ctrl.toAppKey(app.QualifiedName())))
+
+	// the timeout of the operation starts once it acquires a slot
+	ctrl.releaseSyncSlot("argocd/other-app")
+	ctrl.processRequestedAppOperation(app.DeepCopy())
+
+	phase, _, _ = unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	startedAt, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "startedAt")
+	assert.Equal(t, string(synccommon.OperationSucceeded), phase)
+	started, err := time.Parse(time.RFC3339, startedAt)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), started, time.Minute)
+}
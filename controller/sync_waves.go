@@ -0,0 +1,127 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/openapi"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// parallelismLimitedKubectl limits the number of resources which are applied concurrently by a sync operation. Within
+// a sync wave, gitops-engine applies all resources concurrently.
+type parallelismLimitedKubectl struct {
+	kube.Kubectl
+	maxParallelism int64
+}
+
+func (k *parallelismLimitedKubectl) ManageResources(config *rest.Config, openAPISchema openapi.Resources) (kube.ResourceOperations, func(), error) {
+	resourceOps, cleanup, err := k.Kubectl.ManageResources(config, openAPISchema)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &parallelismLimitedResourceOperations{
+		ResourceOperations: resourceOps,
+		slots:              make(chan struct{}, k.maxParallelism),
+	}, cleanup, nil
+}
+
+// parallelismLimitedResourceOperations blocks an operation which changes a resource until one of its slots is free.
+// Dry runs are not limited.
+type parallelismLimitedResourceOperations struct {
+	kube.ResourceOperations
+	slots chan struct{}
+}
+
+func (r *parallelismLimitedResourceOperations) acquire(dryRunStrategy cmdutil.DryRunStrategy) func() {
+	if dryRunStrategy != cmdutil.DryRunNone {
+		return func() {}
+	}
+	r.slots <- struct{}{}
+	return func() {
+		<-r.slots
+	}
+}
+
+func (r *parallelismLimitedResourceOperations) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force, validate, serverSideApply bool, manager string) (string, error) {
+	defer r.acquire(dryRunStrategy)()
+	return r.ResourceOperations.ApplyResource(ctx, obj, dryRunStrategy, force, validate, serverSideApply, manager)
+}
+
+func (r *parallelismLimitedResourceOperations) ReplaceResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force bool) (string, error) {
+	defer r.acquire(dryRunStrategy)()
+	return r.ResourceOperations.ReplaceResource(ctx, obj, dryRunStrategy, force)
+}
+
+func (r *parallelismLimitedResourceOperations) CreateResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, validate bool) (string, error) {
+	defer r.acquire(dryRunStrategy)()
+	return r.ResourceOperations.CreateResource(ctx, obj, dryRunStrategy, validate)
+}
+
+func (r *parallelismLimitedResourceOperations) UpdateResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy) (*unstructured.Unstructured, error) {
+	defer r.acquire(dryRunStrategy)()
+	return r.ResourceOperations.UpdateResource(ctx, obj, dryRunStrategy)
+}
+
+// waitForSyncWaveDelay returns true if the next sync wave must not be applied yet, because the most recently applied
+// wave of the Sync phase completed less than the given delay ago. A wave is completed once all of its resources which
+// are part of the sync are healthy, which is recorded in the wave progress of the operation.
+func waitForSyncWaveDelay(state *v1alpha1.OperationState, resources []v1alpha1.ResourceStatus, delay time.Duration, now time.Time) bool {
+	if delay <= 0 || state.Phase != synccommon.OperationRunning || state.SyncResult == nil {
+		return false
+	}
+	progress := state.SyncResult.WaveProgress
+	if progress == nil || progress.Final || progress.Phase != synccommon.SyncPhaseSync {
+		return false
+	}
+	if progress.CompletedAt == nil {
+		if !isSyncWaveHealthy(resources, state.Operation.Sync.Resources, progress.Wave) {
+			return false
+		}
+		completedAt := metav1.NewTime(now)
+		nextWaveAt := metav1.NewTime(now.Add(delay))
+		progress.CompletedAt = &completedAt
+		progress.NextWaveAt = &nextWaveAt
+	}
+	if progress.NextWaveAt == nil || !now.Before(progress.NextWaveAt.Time) {
+		return false
+	}
+	state.Message = fmt.Sprintf("Waiting until %s before applying the next sync wave", progress.NextWaveAt.UTC().Format(time.RFC3339))
+	return true
+}
+
+// isSyncWaveHealthy returns true if all resources of the given sync wave which are part of the sync are healthy or
+// have no health.
+func isSyncWaveHealthy(resources []v1alpha1.ResourceStatus, syncResources []v1alpha1.SyncOperationResource, wave int64) bool {
+	for _, res := range resources {
+		if res.Hook || res.SyncWave != wave {
+			continue
+		}
+		if len(syncResources) > 0 && !argo.ContainsSyncResource(res.Name, res.Namespace, res.GroupVersionKind(), syncResources) {
+			continue
+		}
+		if res.Health != nil && res.Health.Status != health.HealthStatusHealthy {
+			return false
+		}
+	}
+	return true
+}
+
+// syncWaveDelayRemaining returns the time until the next sync wave of a running operation is applied, or zero if the
+// operation doesn't wait for a delay between sync waves.
+func syncWaveDelayRemaining(state *v1alpha1.OperationState, now time.Time) time.Duration {
+	if state.Phase != synccommon.OperationRunning || state.SyncResult == nil || state.SyncResult.WaveProgress == nil || state.SyncResult.WaveProgress.NextWaveAt == nil {
+		return 0
+	}
+	return max(state.SyncResult.WaveProgress.NextWaveAt.Sub(now), 0)
+}
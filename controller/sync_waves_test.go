@@ -0,0 +1,169 @@
+package controller
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+// concurrencyTrackingResourceOperations records the maximum number of operations which ran concurrently
+type concurrencyTrackingResourceOperations struct {
+	kube.ResourceOperations
+	lock          sync.Mutex
+	running       int
+	maxConcurrent int
+}
+
+func (r *concurrencyTrackingResourceOperations) ApplyResource(_ context.Context, _ *unstructured.Unstructured, _ cmdutil.DryRunStrategy, _, _, _ bool, _ string) (string, error) {
+	r.lock.Lock()
+	r.running++
+	r.maxConcurrent = max(r.maxConcurrent, r.running)
+	r.lock.Unlock()
+	time.Sleep(10 * time.Millisecond)
+	r.lock.Lock()
+	r.running--
+	r.lock.Unlock()
+	return "", nil
+}
+
+func TestParallelismLimitedResourceOperations(t *testing.T) {
+	apply := func(resourceOps kube.ResourceOperations, dryRunStrategy cmdutil.DryRunStrategy) {
+		var wg sync.WaitGroup
+		for i := 0; i < 6; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := resourceOps.ApplyResource(t.Context(), &unstructured.Unstructured{}, dryRunStrategy, false, false, false, "")
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+	}
+
+	t.Run("applies are limited", func(t *testing.T) {
+		tracker := &concurrencyTrackingResourceOperations{}
+		apply(&parallelismLimitedResourceOperations{ResourceOperations: tracker, slots: make(chan struct{}, 2)}, cmdutil.DryRunNone)
+		assert.Equal(t, 2, tracker.maxConcurrent)
+	})
+
+	t.Run("dry runs are not limited", func(t *testing.T) {
+		tracker := &concurrencyTrackingResourceOperations{}
+		apply(&parallelismLimitedResourceOperations{ResourceOperations: tracker, slots: make(chan struct{}, 2)}, cmdutil.DryRunServer)
+		assert.Greater(t, tracker.maxConcurrent, 2)
+	})
+}
+
+func newSyncWaveOperationState(appliedAt time.Time) *v1alpha1.OperationState {
+	return &v1alpha1.OperationState{
+		Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}},
+		Phase:     synccommon.OperationRunning,
+		SyncResult: &v1alpha1.SyncOperationResult{
+			WaveProgress: &v1alpha1.SyncWaveProgress{Phase: synccommon.SyncPhaseSync, Wave: 1, AppliedAt: metav1.NewTime(appliedAt)},
+		},
+	}
+}
+
+func TestWaitForSyncWaveDelay(t *testing.T) {
+	now := time.Date(2025, 1, 31, 1, 0, 0, 0, time.UTC)
+	resources := []v1alpha1.ResourceStatus{
+		{Group: "apps", Kind: "StatefulSet", Namespace: "default", Name: "db-0", SyncWave: 1, Health: &v1alpha1.HealthStatus{Status: health.HealthStatusHealthy}},
+		{Kind: "ConfigMap", Namespace: "default", Name: "config", SyncWave: 1},
+		{Group: "apps", Kind: "StatefulSet", Namespace: "default", Name: "db-1", SyncWave: 2, Health: &v1alpha1.HealthStatus{Status: health.HealthStatusProgressing}},
+	}
+
+	t.Run("wave is completed", func(t *testing.T) {
+		state := newSyncWaveOperationState(now.Add(-time.Minute))
+		assert.True(t, waitForSyncWaveDelay(state, resources, 5*time.Minute, now))
+		progress := state.SyncResult.WaveProgress
+		require.NotNil(t, progress.CompletedAt)
+		assert.Equal(t, now, progress.CompletedAt.Time)
+		assert.Equal(t, now.Add(5*time.Minute), progress.NextWaveAt.Time)
+		assert.Equal(t, "Waiting until 2025-01-31T01:05:00Z before applying the next sync wave", state.Message)
+
+		// the delay is measured from the time the wave was found completed
+		assert.True(t, waitForSyncWaveDelay(state, resources, 5*time.Minute, now.Add(4*time.Minute)))
+		assert.Equal(t, 5*time.Minute-4*time.Minute, syncWaveDelayRemaining(state, now.Add(4*time.Minute)))
+		assert.False(t, waitForSyncWaveDelay(state, resources, 5*time.Minute, now.Add(5*time.Minute)))
+		assert.Zero(t, syncWaveDelayRemaining(state, now.Add(5*time.Minute)))
+	})
+
+	t.Run("wave is not healthy", func(t *testing.T) {
+		state := newSyncWaveOperationState(now.Add(-time.Minute))
+		state.SyncResult.WaveProgress.Wave = 2
+		assert.False(t, waitForSyncWaveDelay(state, resources, 5*time.Minute, now))
+		assert.Nil(t, state.SyncResult.WaveProgress.CompletedAt)
+	})
+
+	t.Run("unhealthy resource is not part of the sync", func(t *testing.T) {
+		state := newSyncWaveOperationState(now.Add(-time.Minute))
+		state.SyncResult.WaveProgress.Wave = 2
+		state.Operation.Sync.Resources = []v1alpha1.SyncOperationResource{{Kind: "ConfigMap", Name: "config"}}
+		assert.True(t, waitForSyncWaveDelay(state, resources, 5*time.Minute, now))
+	})
+
+	t.Run("final wave", func(t *testing.T) {
+		state := newSyncWaveOperationState(now.Add(-time.Minute))
+		state.SyncResult.WaveProgress.Final = true
+		assert.False(t, waitForSyncWaveDelay(state, resources, 5*time.Minute, now))
+	})
+
+	t.Run("no delay", func(t *testing.T) {
+		state := newSyncWaveOperationState(now.Add(-time.Minute))
+		assert.False(t, waitForSyncWaveDelay(state, resources, 0, now))
+		assert.Nil(t, state.SyncResult.WaveProgress.CompletedAt)
+	})
+}
+
+func newFakeSyncWaveController(app *v1alpha1.Application) *ApplicationController {
+	return newFakeController(&fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}, nil)
+}
+
+func TestSyncAppState_WaveDelay(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.Waves = &v1alpha1.SyncWavesStrategy{Delay: "10m"}
+	ctrl := newFakeSyncWaveController(app)
+	state := newSyncWaveOperationState(time.Now().Add(-time.Minute))
+
+	ctrl.appStateManager.SyncAppState(app, &defaultProj, state)
+
+	assert.Equal(t, synccommon.OperationRunning, state.Phase)
+	assert.Contains(t, state.Message, "before applying the next sync wave")
+	require.NotNil(t, state.SyncResult.WaveProgress.NextWaveAt)
+	assert.Greater(t, syncWaveDelayRemaining(state, time.Now()), 9*time.Minute)
+}
+
+func TestSyncAppState_InvalidWaveDelay(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.Waves = &v1alpha1.SyncWavesStrategy{Delay: "soon"}
+	ctrl := newFakeSyncWaveController(app)
+	state := newSyncWaveOperationState(time.Now().Add(-time.Minute))
+
+	ctrl.appStateManager.SyncAppState(app, &defaultProj, state)
+
+	assert.Equal(t, synccommon.OperationError, state.Phase)
+	assert.Equal(t, "invalid delay between sync waves: unable to parse soon as a duration", state.Message)
+}
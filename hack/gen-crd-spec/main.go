@@ -53,6 +53,24 @@ func getCustomResourceDefinitions() map[string]*apiextensionsv1.CustomResourceDe
 			removeValidation(un, "status")
 		}
 
+		// The operation of the scheduled operation and the source hydrator snapshots of the status repeat the schemas
+		// of the operation and of the source hydrator, which would make the CRD too large to be applied client-side.
+		if un.GetName() == "applications.argoproj.io" {
+			forEachVersionSchema(un, func(schema map[string]any) {
+				preserveUnknownFields(schema, "scheduledOperation", "operation")
+				preserveUnknownFields(schema, "status", "sourceHydrator", "currentOperation", "sourceHydrator")
+				preserveUnknownFields(schema, "status", "sourceHydrator", "lastSuccessfulOperation", "sourceHydrator")
+			})
+		}
+		// The templates of the ApplicationSet generators repeat the schema of the Application spec once per generator,
+		// which would make the CRD too large to be applied client-side, so only the template of the ApplicationSet
+		// itself is validated.
+		if un.GetName() == "applicationsets.argoproj.io" {
+			forEachVersionSchema(un, func(schema map[string]any) {
+				removeTemplateSpecValidation(propertySchema(schema, "spec", "generators"))
+			})
+		}
+
 		crd := toCRD(un, un.GetName() == "applicationsets.argoproj.io")
 		crd.Labels = map[string]string{
 			"app.kubernetes.io/name":    crd.Name,
@@ -80,6 +98,77 @@ func removeValidation(un *unstructured.Unstructured, path string) {
 	unstructured.RemoveNestedField(un.Object, schemaPath...)
 }
 
+// forEachVersionSchema calls f with the OpenAPI schema of each version of the CRD.
+func forEachVersionSchema(un *unstructured.Unstructured, f func(schema map[string]any)) {
+	spec, _ := un.Object["spec"].(map[string]any)
+	versions, _ := spec["versions"].([]any)
+	for _, version := range versions {
+		version, _ := version.(map[string]any)
+		schema, _ := version["schema"].(map[string]any)
+		if openAPIV3Schema, ok := schema["openAPIV3Schema"].(map[string]any); ok {
+			f(openAPIV3Schema)
+		}
+	}
+}
+
+// propertySchema returns the schema of the property at the given path of the schema, descending into the items of
+// arrays, or nil if there is no such property.
+func propertySchema(schema map[string]any, path ...string) map[string]any {
+	for _, part := range path {
+		if items, ok := schema["items"].(map[string]any); ok {
+			schema = items
+		}
+		properties, _ := schema["properties"].(map[string]any)
+		schema, _ = properties[part].(map[string]any)
+		if schema == nil {
+			return nil
+		}
+	}
+	return schema
+}
+
+// preserveUnknownFields replaces the schema of the property at the given path by an object schema which accepts any
+// fields, keeping only its description.
+func preserveUnknownFields(schema map[string]any, path ...string) {
+	parent := propertySchema(schema, path[:len(path)-1]...)
+	if parent == nil {
+		return
+	}
+	if items, ok := parent["items"].(map[string]any); ok {
+		parent = items
+	}
+	properties, _ := parent["properties"].(map[string]any)
+	property, ok := properties[path[len(path)-1]].(map[string]any)
+	if !ok {
+		return
+	}
+	schemaless := map[string]any{
+		"type":                                 "object",
+		"x-kubernetes-preserve-unknown-fields": true,
+	}
+	if description, ok := property["description"]; ok {
+		schemaless["description"] = description
+	}
+	properties[path[len(path)-1]] = schemaless
+}
+
+// removeTemplateSpecValidation removes the validation of the spec of every template found in the given schema.
+func removeTemplateSpecValidation(v any) {
+	switch v := v.(type) {
+	case []any:
+		for _, v := range v {
+			removeTemplateSpecValidation(v)
+		}
+	case map[string]any:
+		if template := propertySchema(v, "template"); propertySchema(template, "spec") != nil {
+			preserveUnknownFields(template, "spec")
+		}
+		for _, v := range v {
+			removeTemplateSpecValidation(v)
+		}
+	}
+}
+
 func toCRD(un *unstructured.Unstructured, removeDesc bool) *apiextensionsv1.CustomResourceDefinition {
 	if removeDesc {
 		removeDescription(un.Object)
@@ -263,10 +263,38 @@ func (m *ApplicationCondition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationCondition proto.InternalMessageInfo
 
+func (m *ApplicationDependency) Reset()      { *m = ApplicationDependency{} }
+func (*ApplicationDependency) ProtoMessage() {}
+func (*ApplicationDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{8}
+}
+func (m *ApplicationDependency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDependency.Merge(m, src)
+}
+func (m *ApplicationDependency) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDependency proto.InternalMessageInfo
+
 func (m *ApplicationDestination) Reset()      { *m = ApplicationDestination{} }
 func (*ApplicationDestination) ProtoMessage() {}
 func (*ApplicationDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{8}
+	return fileDescriptor_c078c3c476799f44, []int{9}
 }
 func (m *ApplicationDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,7 +322,7 @@ var xxx_messageInfo_ApplicationDestination proto.InternalMessageInfo
 func (m *ApplicationDestinationServiceAccount) Reset()      { *m = ApplicationDestinationServiceAccount{} }
 func (*ApplicationDestinationServiceAccount) ProtoMessage() {}
 func (*ApplicationDestinationServiceAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{9}
+	return fileDescriptor_c078c3c476799f44, []int{10}
 }
 func (m *ApplicationDestinationServiceAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -322,7 +350,7 @@ var xxx_messageInfo_ApplicationDestinationServiceAccount proto.InternalMessageIn
 func (m *ApplicationList) Reset()      { *m = ApplicationList{} }
 func (*ApplicationList) ProtoMessage() {}
 func (*ApplicationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{10}
+	return fileDescriptor_c078c3c476799f44, []int{11}
 }
 func (m *ApplicationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +378,7 @@ var xxx_messageInfo_ApplicationList proto.InternalMessageInfo
 func (m *ApplicationMatchExpression) Reset()      { *m = ApplicationMatchExpression{} }
 func (*ApplicationMatchExpression) ProtoMessage() {}
 func (*ApplicationMatchExpression) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{11}
+	return fileDescriptor_c078c3c476799f44, []int{12}
 }
 func (m *ApplicationMatchExpression) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +406,7 @@ var xxx_messageInfo_ApplicationMatchExpression proto.InternalMessageInfo
 func (m *ApplicationPreservedFields) Reset()      { *m = ApplicationPreservedFields{} }
 func (*ApplicationPreservedFields) ProtoMessage() {}
 func (*ApplicationPreservedFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{12}
+	return fileDescriptor_c078c3c476799f44, []int{13}
 }
 func (m *ApplicationPreservedFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +434,7 @@ var xxx_messageInfo_ApplicationPreservedFields proto.InternalMessageInfo
 func (m *ApplicationSet) Reset()      { *m = ApplicationSet{} }
 func (*ApplicationSet) ProtoMessage() {}
 func (*ApplicationSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{13}
+	return fileDescriptor_c078c3c476799f44, []int{14}
 }
 func (m *ApplicationSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -434,7 +462,7 @@ var xxx_messageInfo_ApplicationSet proto.InternalMessageInfo
 func (m *ApplicationSetApplicationStatus) Reset()      { *m = ApplicationSetApplicationStatus{} }
 func (*ApplicationSetApplicationStatus) ProtoMessage() {}
 func (*ApplicationSetApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{14}
+	return fileDescriptor_c078c3c476799f44, []int{15}
 }
 func (m *ApplicationSetApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +490,7 @@ var xxx_messageInfo_ApplicationSetApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSetCondition) Reset()      { *m = ApplicationSetCondition{} }
 func (*ApplicationSetCondition) ProtoMessage() {}
 func (*ApplicationSetCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{15}
+	return fileDescriptor_c078c3c476799f44, []int{16}
 }
 func (m *ApplicationSetCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,7 +518,7 @@ var xxx_messageInfo_ApplicationSetCondition proto.InternalMessageInfo
 func (m *ApplicationSetGenerator) Reset()      { *m = ApplicationSetGenerator{} }
 func (*ApplicationSetGenerator) ProtoMessage() {}
 func (*ApplicationSetGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{16}
+	return fileDescriptor_c078c3c476799f44, []int{17}
 }
 func (m *ApplicationSetGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +546,7 @@ var xxx_messageInfo_ApplicationSetGenerator proto.InternalMessageInfo
 func (m *ApplicationSetList) Reset()      { *m = ApplicationSetList{} }
 func (*ApplicationSetList) ProtoMessage() {}
 func (*ApplicationSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{17}
+	return fileDescriptor_c078c3c476799f44, []int{18}
 }
 func (m *ApplicationSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,7 +574,7 @@ var xxx_messageInfo_ApplicationSetList proto.InternalMessageInfo
 func (m *ApplicationSetNestedGenerator) Reset()      { *m = ApplicationSetNestedGenerator{} }
 func (*ApplicationSetNestedGenerator) ProtoMessage() {}
 func (*ApplicationSetNestedGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{18}
+	return fileDescriptor_c078c3c476799f44, []int{19}
 }
 func (m *ApplicationSetNestedGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,7 +604,7 @@ func (m *ApplicationSetResourceIgnoreDifferences) Reset() {
 }
 func (*ApplicationSetResourceIgnoreDifferences) ProtoMessage() {}
 func (*ApplicationSetResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{19}
+	return fileDescriptor_c078c3c476799f44, []int{20}
 }
 func (m *ApplicationSetResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -604,7 +632,7 @@ var xxx_messageInfo_ApplicationSetResourceIgnoreDifferences proto.InternalMessag
 func (m *ApplicationSetRolloutStep) Reset()      { *m = ApplicationSetRolloutStep{} }
 func (*ApplicationSetRolloutStep) ProtoMessage() {}
 func (*ApplicationSetRolloutStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{20}
+	return fileDescriptor_c078c3c476799f44, []int{21}
 }
 func (m *ApplicationSetRolloutStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,7 +660,7 @@ var xxx_messageInfo_ApplicationSetRolloutStep proto.InternalMessageInfo
 func (m *ApplicationSetRolloutStrategy) Reset()      { *m = ApplicationSetRolloutStrategy{} }
 func (*ApplicationSetRolloutStrategy) ProtoMessage() {}
 func (*ApplicationSetRolloutStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{21}
+	return fileDescriptor_c078c3c476799f44, []int{22}
 }
 func (m *ApplicationSetRolloutStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,7 +688,7 @@ var xxx_messageInfo_ApplicationSetRolloutStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSpec) Reset()      { *m = ApplicationSetSpec{} }
 func (*ApplicationSetSpec) ProtoMessage() {}
 func (*ApplicationSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{22}
+	return fileDescriptor_c078c3c476799f44, []int{23}
 }
 func (m *ApplicationSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +716,7 @@ var xxx_messageInfo_ApplicationSetSpec proto.InternalMessageInfo
 func (m *ApplicationSetStatus) Reset()      { *m = ApplicationSetStatus{} }
 func (*ApplicationSetStatus) ProtoMessage() {}
 func (*ApplicationSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{23}
+	return fileDescriptor_c078c3c476799f44, []int{24}
 }
 func (m *ApplicationSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,7 +744,7 @@ var xxx_messageInfo_ApplicationSetStatus proto.InternalMessageInfo
 func (m *ApplicationSetStrategy) Reset()      { *m = ApplicationSetStrategy{} }
 func (*ApplicationSetStrategy) ProtoMessage() {}
 func (*ApplicationSetStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{24}
+	return fileDescriptor_c078c3c476799f44, []int{25}
 }
 func (m *ApplicationSetStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +772,7 @@ var xxx_messageInfo_ApplicationSetStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSyncPolicy) Reset()      { *m = ApplicationSetSyncPolicy{} }
 func (*ApplicationSetSyncPolicy) ProtoMessage() {}
 func (*ApplicationSetSyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{25}
+	return fileDescriptor_c078c3c476799f44, []int{26}
 }
 func (m *ApplicationSetSyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -772,7 +800,7 @@ var xxx_messageInfo_ApplicationSetSyncPolicy proto.InternalMessageInfo
 func (m *ApplicationSetTemplate) Reset()      { *m = ApplicationSetTemplate{} }
 func (*ApplicationSetTemplate) ProtoMessage() {}
 func (*ApplicationSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{26}
+	return fileDescriptor_c078c3c476799f44, []int{27}
 }
 func (m *ApplicationSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,7 +828,7 @@ var xxx_messageInfo_ApplicationSetTemplate proto.InternalMessageInfo
 func (m *ApplicationSetTemplateMeta) Reset()      { *m = ApplicationSetTemplateMeta{} }
 func (*ApplicationSetTemplateMeta) ProtoMessage() {}
 func (*ApplicationSetTemplateMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{27}
+	return fileDescriptor_c078c3c476799f44, []int{28}
 }
 func (m *ApplicationSetTemplateMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +856,7 @@ var xxx_messageInfo_ApplicationSetTemplateMeta proto.InternalMessageInfo
 func (m *ApplicationSetTerminalGenerator) Reset()      { *m = ApplicationSetTerminalGenerator{} }
 func (*ApplicationSetTerminalGenerator) ProtoMessage() {}
 func (*ApplicationSetTerminalGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{28}
+	return fileDescriptor_c078c3c476799f44, []int{29}
 }
 func (m *ApplicationSetTerminalGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,7 +884,7 @@ var xxx_messageInfo_ApplicationSetTerminalGenerator proto.InternalMessageInfo
 func (m *ApplicationSetTree) Reset()      { *m = ApplicationSetTree{} }
 func (*ApplicationSetTree) ProtoMessage() {}
 func (*ApplicationSetTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{29}
+	return fileDescriptor_c078c3c476799f44, []int{30}
 }
 func (m *ApplicationSetTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +912,7 @@ var xxx_messageInfo_ApplicationSetTree proto.InternalMessageInfo
 func (m *ApplicationSource) Reset()      { *m = ApplicationSource{} }
 func (*ApplicationSource) ProtoMessage() {}
 func (*ApplicationSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{30}
+	return fileDescriptor_c078c3c476799f44, []int{31}
 }
 func (m *ApplicationSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -912,7 +940,7 @@ var xxx_messageInfo_ApplicationSource proto.InternalMessageInfo
 func (m *ApplicationSourceDirectory) Reset()      { *m = ApplicationSourceDirectory{} }
 func (*ApplicationSourceDirectory) ProtoMessage() {}
 func (*ApplicationSourceDirectory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{31}
+	return fileDescriptor_c078c3c476799f44, []int{32}
 }
 func (m *ApplicationSourceDirectory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +968,7 @@ var xxx_messageInfo_ApplicationSourceDirectory proto.InternalMessageInfo
 func (m *ApplicationSourceHelm) Reset()      { *m = ApplicationSourceHelm{} }
 func (*ApplicationSourceHelm) ProtoMessage() {}
 func (*ApplicationSourceHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{32}
+	return fileDescriptor_c078c3c476799f44, []int{33}
 }
 func (m *ApplicationSourceHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +996,7 @@ var xxx_messageInfo_ApplicationSourceHelm proto.InternalMessageInfo
 func (m *ApplicationSourceJsonnet) Reset()      { *m = ApplicationSourceJsonnet{} }
 func (*ApplicationSourceJsonnet) ProtoMessage() {}
 func (*ApplicationSourceJsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{33}
+	return fileDescriptor_c078c3c476799f44, []int{34}
 }
 func (m *ApplicationSourceJsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -996,7 +1024,7 @@ var xxx_messageInfo_ApplicationSourceJsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKustomize) Reset()      { *m = ApplicationSourceKustomize{} }
 func (*ApplicationSourceKustomize) ProtoMessage() {}
 func (*ApplicationSourceKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{34}
+	return fileDescriptor_c078c3c476799f44, []int{35}
 }
 func (m *ApplicationSourceKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,7 +1052,7 @@ var xxx_messageInfo_ApplicationSourceKustomize proto.InternalMessageInfo
 func (m *ApplicationSourcePlugin) Reset()      { *m = ApplicationSourcePlugin{} }
 func (*ApplicationSourcePlugin) ProtoMessage() {}
 func (*ApplicationSourcePlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{35}
+	return fileDescriptor_c078c3c476799f44, []int{36}
 }
 func (m *ApplicationSourcePlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1052,7 +1080,7 @@ var xxx_messageInfo_ApplicationSourcePlugin proto.InternalMessageInfo
 func (m *ApplicationSourcePluginParameter) Reset()      { *m = ApplicationSourcePluginParameter{} }
 func (*ApplicationSourcePluginParameter) ProtoMessage() {}
 func (*ApplicationSourcePluginParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{36}
+	return fileDescriptor_c078c3c476799f44, []int{37}
 }
 func (m *ApplicationSourcePluginParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1080,7 +1108,7 @@ var xxx_messageInfo_ApplicationSourcePluginParameter proto.InternalMessageInfo
 func (m *ApplicationSpec) Reset()      { *m = ApplicationSpec{} }
 func (*ApplicationSpec) ProtoMessage() {}
 func (*ApplicationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{37}
+	return fileDescriptor_c078c3c476799f44, []int{38}
 }
 func (m *ApplicationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1108,7 +1136,7 @@ var xxx_messageInfo_ApplicationSpec proto.InternalMessageInfo
 func (m *ApplicationStatus) Reset()      { *m = ApplicationStatus{} }
 func (*ApplicationStatus) ProtoMessage() {}
 func (*ApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{38}
+	return fileDescriptor_c078c3c476799f44, []int{39}
 }
 func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1136,7 +1164,7 @@ var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSummary) Reset()      { *m = ApplicationSummary{} }
 func (*ApplicationSummary) ProtoMessage() {}
 func (*ApplicationSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{39}
+	return fileDescriptor_c078c3c476799f44, []int{40}
 }
 func (m *ApplicationSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1164,7 +1192,7 @@ var xxx_messageInfo_ApplicationSummary proto.InternalMessageInfo
 func (m *ApplicationTree) Reset()      { *m = ApplicationTree{} }
 func (*ApplicationTree) ProtoMessage() {}
 func (*ApplicationTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{40}
+	return fileDescriptor_c078c3c476799f44, []int{41}
 }
 func (m *ApplicationTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1220,7 @@ var xxx_messageInfo_ApplicationTree proto.InternalMessageInfo
 func (m *ApplicationWatchEvent) Reset()      { *m = ApplicationWatchEvent{} }
 func (*ApplicationWatchEvent) ProtoMessage() {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{41}
+	return fileDescriptor_c078c3c476799f44, []int{42}
 }
 func (m *ApplicationWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1217,10 +1245,66 @@ func (m *ApplicationWatchEvent) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 
+func (m *AutomatedRollback) Reset()      { *m = AutomatedRollback{} }
+func (*AutomatedRollback) ProtoMessage() {}
+func (*AutomatedRollback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{43}
+}
+func (m *AutomatedRollback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutomatedRollback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AutomatedRollback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutomatedRollback.Merge(m, src)
+}
+func (m *AutomatedRollback) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutomatedRollback) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutomatedRollback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutomatedRollback proto.InternalMessageInfo
+
+func (m *AutomatedRollbackStatus) Reset()      { *m = AutomatedRollbackStatus{} }
+func (*AutomatedRollbackStatus) ProtoMessage() {}
+func (*AutomatedRollbackStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{44}
+}
+func (m *AutomatedRollbackStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutomatedRollbackStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AutomatedRollbackStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutomatedRollbackStatus.Merge(m, src)
+}
+func (m *AutomatedRollbackStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutomatedRollbackStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutomatedRollbackStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutomatedRollbackStatus proto.InternalMessageInfo
+
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{42}
+	return fileDescriptor_c078c3c476799f44, []int{45}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1248,7 +1332,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *BasicAuthBitbucketServer) Reset()      { *m = BasicAuthBitbucketServer{} }
 func (*BasicAuthBitbucketServer) ProtoMessage() {}
 func (*BasicAuthBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{43}
+	return fileDescriptor_c078c3c476799f44, []int{46}
 }
 func (m *BasicAuthBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,7 +1360,7 @@ var xxx_messageInfo_BasicAuthBitbucketServer proto.InternalMessageInfo
 func (m *BearerTokenBitbucket) Reset()      { *m = BearerTokenBitbucket{} }
 func (*BearerTokenBitbucket) ProtoMessage() {}
 func (*BearerTokenBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{44}
+	return fileDescriptor_c078c3c476799f44, []int{47}
 }
 func (m *BearerTokenBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1304,7 +1388,7 @@ var xxx_messageInfo_BearerTokenBitbucket proto.InternalMessageInfo
 func (m *BearerTokenBitbucketCloud) Reset()      { *m = BearerTokenBitbucketCloud{} }
 func (*BearerTokenBitbucketCloud) ProtoMessage() {}
 func (*BearerTokenBitbucketCloud) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{45}
+	return fileDescriptor_c078c3c476799f44, []int{48}
 }
 func (m *BearerTokenBitbucketCloud) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1332,7 +1416,7 @@ var xxx_messageInfo_BearerTokenBitbucketCloud proto.InternalMessageInfo
 func (m *ChartDetails) Reset()      { *m = ChartDetails{} }
 func (*ChartDetails) ProtoMessage() {}
 func (*ChartDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{46}
+	return fileDescriptor_c078c3c476799f44, []int{49}
 }
 func (m *ChartDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1444,7 @@ var xxx_messageInfo_ChartDetails proto.InternalMessageInfo
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{47}
+	return fileDescriptor_c078c3c476799f44, []int{50}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1388,7 +1472,7 @@ var xxx_messageInfo_Cluster proto.InternalMessageInfo
 func (m *ClusterCacheInfo) Reset()      { *m = ClusterCacheInfo{} }
 func (*ClusterCacheInfo) ProtoMessage() {}
 func (*ClusterCacheInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{48}
+	return fileDescriptor_c078c3c476799f44, []int{51}
 }
 func (m *ClusterCacheInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1416,7 +1500,7 @@ var xxx_messageInfo_ClusterCacheInfo proto.InternalMessageInfo
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{49}
+	return fileDescriptor_c078c3c476799f44, []int{52}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1444,7 +1528,7 @@ var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 func (m *ClusterGenerator) Reset()      { *m = ClusterGenerator{} }
 func (*ClusterGenerator) ProtoMessage() {}
 func (*ClusterGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{50}
+	return fileDescriptor_c078c3c476799f44, []int{53}
 }
 func (m *ClusterGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1472,7 +1556,7 @@ var xxx_messageInfo_ClusterGenerator proto.InternalMessageInfo
 func (m *ClusterInfo) Reset()      { *m = ClusterInfo{} }
 func (*ClusterInfo) ProtoMessage() {}
 func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{51}
+	return fileDescriptor_c078c3c476799f44, []int{54}
 }
 func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,7 +1584,7 @@ var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{52}
+	return fileDescriptor_c078c3c476799f44, []int{55}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1525,10 +1609,38 @@ func (m *ClusterList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 
+func (m *ClusterMaintenance) Reset()      { *m = ClusterMaintenance{} }
+func (*ClusterMaintenance) ProtoMessage() {}
+func (*ClusterMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{56}
+}
+func (m *ClusterMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterMaintenance.Merge(m, src)
+}
+func (m *ClusterMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterMaintenance proto.InternalMessageInfo
+
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{53}
+	return fileDescriptor_c078c3c476799f44, []int{57}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1556,7 +1668,7 @@ var xxx_messageInfo_Command proto.InternalMessageInfo
 func (m *CommitMetadata) Reset()      { *m = CommitMetadata{} }
 func (*CommitMetadata) ProtoMessage() {}
 func (*CommitMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{54}
+	return fileDescriptor_c078c3c476799f44, []int{58}
 }
 func (m *CommitMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1584,7 +1696,7 @@ var xxx_messageInfo_CommitMetadata proto.InternalMessageInfo
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{55}
+	return fileDescriptor_c078c3c476799f44, []int{59}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1612,7 +1724,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{56}
+	return fileDescriptor_c078c3c476799f44, []int{60}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1640,7 +1752,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{57}
+	return fileDescriptor_c078c3c476799f44, []int{61}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1668,7 +1780,7 @@ var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 func (m *ConfigMapKeyRef) Reset()      { *m = ConfigMapKeyRef{} }
 func (*ConfigMapKeyRef) ProtoMessage() {}
 func (*ConfigMapKeyRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{58}
+	return fileDescriptor_c078c3c476799f44, []int{62}
 }
 func (m *ConfigMapKeyRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1696,7 +1808,7 @@ var xxx_messageInfo_ConfigMapKeyRef proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{59}
+	return fileDescriptor_c078c3c476799f44, []int{63}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1721,10 +1833,38 @@ func (m *ConnectionState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 
+func (m *ConnectionStateHistoryEntry) Reset()      { *m = ConnectionStateHistoryEntry{} }
+func (*ConnectionStateHistoryEntry) ProtoMessage() {}
+func (*ConnectionStateHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{64}
+}
+func (m *ConnectionStateHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionStateHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ConnectionStateHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionStateHistoryEntry.Merge(m, src)
+}
+func (m *ConnectionStateHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionStateHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionStateHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionStateHistoryEntry proto.InternalMessageInfo
+
 func (m *DrySource) Reset()      { *m = DrySource{} }
 func (*DrySource) ProtoMessage() {}
 func (*DrySource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{60}
+	return fileDescriptor_c078c3c476799f44, []int{65}
 }
 func (m *DrySource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1752,7 +1892,7 @@ var xxx_messageInfo_DrySource proto.InternalMessageInfo
 func (m *DuckTypeGenerator) Reset()      { *m = DuckTypeGenerator{} }
 func (*DuckTypeGenerator) ProtoMessage() {}
 func (*DuckTypeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{61}
+	return fileDescriptor_c078c3c476799f44, []int{66}
 }
 func (m *DuckTypeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1780,7 +1920,7 @@ var xxx_messageInfo_DuckTypeGenerator proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{62}
+	return fileDescriptor_c078c3c476799f44, []int{67}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1808,7 +1948,7 @@ var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 func (m *ExecProviderConfig) Reset()      { *m = ExecProviderConfig{} }
 func (*ExecProviderConfig) ProtoMessage() {}
 func (*ExecProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{63}
+	return fileDescriptor_c078c3c476799f44, []int{68}
 }
 func (m *ExecProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1836,7 +1976,7 @@ var xxx_messageInfo_ExecProviderConfig proto.InternalMessageInfo
 func (m *GitDirectoryGeneratorItem) Reset()      { *m = GitDirectoryGeneratorItem{} }
 func (*GitDirectoryGeneratorItem) ProtoMessage() {}
 func (*GitDirectoryGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{64}
+	return fileDescriptor_c078c3c476799f44, []int{69}
 }
 func (m *GitDirectoryGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1864,7 +2004,7 @@ var xxx_messageInfo_GitDirectoryGeneratorItem proto.InternalMessageInfo
 func (m *GitFileGeneratorItem) Reset()      { *m = GitFileGeneratorItem{} }
 func (*GitFileGeneratorItem) ProtoMessage() {}
 func (*GitFileGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{65}
+	return fileDescriptor_c078c3c476799f44, []int{70}
 }
 func (m *GitFileGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1892,7 +2032,7 @@ var xxx_messageInfo_GitFileGeneratorItem proto.InternalMessageInfo
 func (m *GitGenerator) Reset()      { *m = GitGenerator{} }
 func (*GitGenerator) ProtoMessage() {}
 func (*GitGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{66}
+	return fileDescriptor_c078c3c476799f44, []int{71}
 }
 func (m *GitGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1920,7 +2060,7 @@ var xxx_messageInfo_GitGenerator proto.InternalMessageInfo
 func (m *GnuPGPublicKey) Reset()      { *m = GnuPGPublicKey{} }
 func (*GnuPGPublicKey) ProtoMessage() {}
 func (*GnuPGPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{67}
+	return fileDescriptor_c078c3c476799f44, []int{72}
 }
 func (m *GnuPGPublicKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1948,7 +2088,7 @@ var xxx_messageInfo_GnuPGPublicKey proto.InternalMessageInfo
 func (m *GnuPGPublicKeyList) Reset()      { *m = GnuPGPublicKeyList{} }
 func (*GnuPGPublicKeyList) ProtoMessage() {}
 func (*GnuPGPublicKeyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{68}
+	return fileDescriptor_c078c3c476799f44, []int{73}
 }
 func (m *GnuPGPublicKeyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1976,7 +2116,7 @@ var xxx_messageInfo_GnuPGPublicKeyList proto.InternalMessageInfo
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{69}
+	return fileDescriptor_c078c3c476799f44, []int{74}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2004,7 +2144,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HelmFileParameter) Reset()      { *m = HelmFileParameter{} }
 func (*HelmFileParameter) ProtoMessage() {}
 func (*HelmFileParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{70}
+	return fileDescriptor_c078c3c476799f44, []int{75}
 }
 func (m *HelmFileParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2032,7 +2172,7 @@ var xxx_messageInfo_HelmFileParameter proto.InternalMessageInfo
 func (m *HelmOptions) Reset()      { *m = HelmOptions{} }
 func (*HelmOptions) ProtoMessage() {}
 func (*HelmOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{71}
+	return fileDescriptor_c078c3c476799f44, []int{76}
 }
 func (m *HelmOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2060,7 +2200,7 @@ var xxx_messageInfo_HelmOptions proto.InternalMessageInfo
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{72}
+	return fileDescriptor_c078c3c476799f44, []int{77}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2088,7 +2228,7 @@ var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 func (m *HostInfo) Reset()      { *m = HostInfo{} }
 func (*HostInfo) ProtoMessage() {}
 func (*HostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{73}
+	return fileDescriptor_c078c3c476799f44, []int{78}
 }
 func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2116,7 +2256,7 @@ var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 func (m *HostResourceInfo) Reset()      { *m = HostResourceInfo{} }
 func (*HostResourceInfo) ProtoMessage() {}
 func (*HostResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{74}
+	return fileDescriptor_c078c3c476799f44, []int{79}
 }
 func (m *HostResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2144,7 +2284,7 @@ var xxx_messageInfo_HostResourceInfo proto.InternalMessageInfo
 func (m *HydrateOperation) Reset()      { *m = HydrateOperation{} }
 func (*HydrateOperation) ProtoMessage() {}
 func (*HydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{75}
+	return fileDescriptor_c078c3c476799f44, []int{80}
 }
 func (m *HydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2169,10 +2309,38 @@ func (m *HydrateOperation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HydrateOperation proto.InternalMessageInfo
 
+func (m *HydratePullRequest) Reset()      { *m = HydratePullRequest{} }
+func (*HydratePullRequest) ProtoMessage() {}
+func (*HydratePullRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{81}
+}
+func (m *HydratePullRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HydratePullRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HydratePullRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HydratePullRequest.Merge(m, src)
+}
+func (m *HydratePullRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HydratePullRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HydratePullRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HydratePullRequest proto.InternalMessageInfo
+
 func (m *HydrateTo) Reset()      { *m = HydrateTo{} }
 func (*HydrateTo) ProtoMessage() {}
 func (*HydrateTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{76}
+	return fileDescriptor_c078c3c476799f44, []int{82}
 }
 func (m *HydrateTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2197,10 +2365,66 @@ func (m *HydrateTo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HydrateTo proto.InternalMessageInfo
 
+func (m *HydratedManifestLayout) Reset()      { *m = HydratedManifestLayout{} }
+func (*HydratedManifestLayout) ProtoMessage() {}
+func (*HydratedManifestLayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{83}
+}
+func (m *HydratedManifestLayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HydratedManifestLayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HydratedManifestLayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HydratedManifestLayout.Merge(m, src)
+}
+func (m *HydratedManifestLayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *HydratedManifestLayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_HydratedManifestLayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HydratedManifestLayout proto.InternalMessageInfo
+
+func (m *HydratedPullRequest) Reset()      { *m = HydratedPullRequest{} }
+func (*HydratedPullRequest) ProtoMessage() {}
+func (*HydratedPullRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{84}
+}
+func (m *HydratedPullRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HydratedPullRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HydratedPullRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HydratedPullRequest.Merge(m, src)
+}
+func (m *HydratedPullRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HydratedPullRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HydratedPullRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HydratedPullRequest proto.InternalMessageInfo
+
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{77}
+	return fileDescriptor_c078c3c476799f44, []int{85}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2228,7 +2452,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{78}
+	return fileDescriptor_c078c3c476799f44, []int{86}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2256,7 +2480,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{79}
+	return fileDescriptor_c078c3c476799f44, []int{87}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2284,7 +2508,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JWTTokens) Reset()      { *m = JWTTokens{} }
 func (*JWTTokens) ProtoMessage() {}
 func (*JWTTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{80}
+	return fileDescriptor_c078c3c476799f44, []int{88}
 }
 func (m *JWTTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2312,7 +2536,7 @@ var xxx_messageInfo_JWTTokens proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{81}
+	return fileDescriptor_c078c3c476799f44, []int{89}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2340,7 +2564,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KnownTypeField) Reset()      { *m = KnownTypeField{} }
 func (*KnownTypeField) ProtoMessage() {}
 func (*KnownTypeField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{82}
+	return fileDescriptor_c078c3c476799f44, []int{90}
 }
 func (m *KnownTypeField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2368,7 +2592,7 @@ var xxx_messageInfo_KnownTypeField proto.InternalMessageInfo
 func (m *KustomizeGvk) Reset()      { *m = KustomizeGvk{} }
 func (*KustomizeGvk) ProtoMessage() {}
 func (*KustomizeGvk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{83}
+	return fileDescriptor_c078c3c476799f44, []int{91}
 }
 func (m *KustomizeGvk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2396,7 +2620,7 @@ var xxx_messageInfo_KustomizeGvk proto.InternalMessageInfo
 func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
 func (*KustomizeOptions) ProtoMessage() {}
 func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{84}
+	return fileDescriptor_c078c3c476799f44, []int{92}
 }
 func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2424,7 +2648,7 @@ var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 func (m *KustomizePatch) Reset()      { *m = KustomizePatch{} }
 func (*KustomizePatch) ProtoMessage() {}
 func (*KustomizePatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{85}
+	return fileDescriptor_c078c3c476799f44, []int{93}
 }
 func (m *KustomizePatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2452,7 +2676,7 @@ var xxx_messageInfo_KustomizePatch proto.InternalMessageInfo
 func (m *KustomizeReplica) Reset()      { *m = KustomizeReplica{} }
 func (*KustomizeReplica) ProtoMessage() {}
 func (*KustomizeReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{86}
+	return fileDescriptor_c078c3c476799f44, []int{94}
 }
 func (m *KustomizeReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2480,7 +2704,7 @@ var xxx_messageInfo_KustomizeReplica proto.InternalMessageInfo
 func (m *KustomizeResId) Reset()      { *m = KustomizeResId{} }
 func (*KustomizeResId) ProtoMessage() {}
 func (*KustomizeResId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{87}
+	return fileDescriptor_c078c3c476799f44, []int{95}
 }
 func (m *KustomizeResId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2508,7 +2732,7 @@ var xxx_messageInfo_KustomizeResId proto.InternalMessageInfo
 func (m *KustomizeSelector) Reset()      { *m = KustomizeSelector{} }
 func (*KustomizeSelector) ProtoMessage() {}
 func (*KustomizeSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{88}
+	return fileDescriptor_c078c3c476799f44, []int{96}
 }
 func (m *KustomizeSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2536,7 +2760,7 @@ var xxx_messageInfo_KustomizeSelector proto.InternalMessageInfo
 func (m *KustomizeVersion) Reset()      { *m = KustomizeVersion{} }
 func (*KustomizeVersion) ProtoMessage() {}
 func (*KustomizeVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{89}
+	return fileDescriptor_c078c3c476799f44, []int{97}
 }
 func (m *KustomizeVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2564,7 +2788,7 @@ var xxx_messageInfo_KustomizeVersion proto.InternalMessageInfo
 func (m *ListGenerator) Reset()      { *m = ListGenerator{} }
 func (*ListGenerator) ProtoMessage() {}
 func (*ListGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{90}
+	return fileDescriptor_c078c3c476799f44, []int{98}
 }
 func (m *ListGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2592,7 +2816,7 @@ var xxx_messageInfo_ListGenerator proto.InternalMessageInfo
 func (m *ManagedNamespaceMetadata) Reset()      { *m = ManagedNamespaceMetadata{} }
 func (*ManagedNamespaceMetadata) ProtoMessage() {}
 func (*ManagedNamespaceMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{91}
+	return fileDescriptor_c078c3c476799f44, []int{99}
 }
 func (m *ManagedNamespaceMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2620,7 +2844,7 @@ var xxx_messageInfo_ManagedNamespaceMetadata proto.InternalMessageInfo
 func (m *MatrixGenerator) Reset()      { *m = MatrixGenerator{} }
 func (*MatrixGenerator) ProtoMessage() {}
 func (*MatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{92}
+	return fileDescriptor_c078c3c476799f44, []int{100}
 }
 func (m *MatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2648,7 +2872,7 @@ var xxx_messageInfo_MatrixGenerator proto.InternalMessageInfo
 func (m *MergeGenerator) Reset()      { *m = MergeGenerator{} }
 func (*MergeGenerator) ProtoMessage() {}
 func (*MergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{93}
+	return fileDescriptor_c078c3c476799f44, []int{101}
 }
 func (m *MergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2676,7 +2900,7 @@ var xxx_messageInfo_MergeGenerator proto.InternalMessageInfo
 func (m *NestedMatrixGenerator) Reset()      { *m = NestedMatrixGenerator{} }
 func (*NestedMatrixGenerator) ProtoMessage() {}
 func (*NestedMatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{94}
+	return fileDescriptor_c078c3c476799f44, []int{102}
 }
 func (m *NestedMatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2704,7 +2928,7 @@ var xxx_messageInfo_NestedMatrixGenerator proto.InternalMessageInfo
 func (m *NestedMergeGenerator) Reset()      { *m = NestedMergeGenerator{} }
 func (*NestedMergeGenerator) ProtoMessage() {}
 func (*NestedMergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{95}
+	return fileDescriptor_c078c3c476799f44, []int{103}
 }
 func (m *NestedMergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2732,7 +2956,7 @@ var xxx_messageInfo_NestedMergeGenerator proto.InternalMessageInfo
 func (m *OCIMetadata) Reset()      { *m = OCIMetadata{} }
 func (*OCIMetadata) ProtoMessage() {}
 func (*OCIMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{96}
+	return fileDescriptor_c078c3c476799f44, []int{104}
 }
 func (m *OCIMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2760,7 +2984,7 @@ var xxx_messageInfo_OCIMetadata proto.InternalMessageInfo
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{97}
+	return fileDescriptor_c078c3c476799f44, []int{105}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2788,7 +3012,7 @@ var xxx_messageInfo_Operation proto.InternalMessageInfo
 func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
 func (*OperationInitiator) ProtoMessage() {}
 func (*OperationInitiator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{98}
+	return fileDescriptor_c078c3c476799f44, []int{106}
 }
 func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2816,7 +3040,7 @@ var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{99}
+	return fileDescriptor_c078c3c476799f44, []int{107}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2844,7 +3068,7 @@ var xxx_messageInfo_OperationState proto.InternalMessageInfo
 func (m *OptionalArray) Reset()      { *m = OptionalArray{} }
 func (*OptionalArray) ProtoMessage() {}
 func (*OptionalArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{100}
+	return fileDescriptor_c078c3c476799f44, []int{108}
 }
 func (m *OptionalArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2872,7 +3096,7 @@ var xxx_messageInfo_OptionalArray proto.InternalMessageInfo
 func (m *OptionalMap) Reset()      { *m = OptionalMap{} }
 func (*OptionalMap) ProtoMessage() {}
 func (*OptionalMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{101}
+	return fileDescriptor_c078c3c476799f44, []int{109}
 }
 func (m *OptionalMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2900,7 +3124,7 @@ var xxx_messageInfo_OptionalMap proto.InternalMessageInfo
 func (m *OrphanedResourceKey) Reset()      { *m = OrphanedResourceKey{} }
 func (*OrphanedResourceKey) ProtoMessage() {}
 func (*OrphanedResourceKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{102}
+	return fileDescriptor_c078c3c476799f44, []int{110}
 }
 func (m *OrphanedResourceKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2928,7 +3152,7 @@ var xxx_messageInfo_OrphanedResourceKey proto.InternalMessageInfo
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{103}
+	return fileDescriptor_c078c3c476799f44, []int{111}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2956,7 +3180,7 @@ var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 func (m *OverrideIgnoreDiff) Reset()      { *m = OverrideIgnoreDiff{} }
 func (*OverrideIgnoreDiff) ProtoMessage() {}
 func (*OverrideIgnoreDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{104}
+	return fileDescriptor_c078c3c476799f44, []int{112}
 }
 func (m *OverrideIgnoreDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2984,7 +3208,7 @@ var xxx_messageInfo_OverrideIgnoreDiff proto.InternalMessageInfo
 func (m *PluginConfigMapRef) Reset()      { *m = PluginConfigMapRef{} }
 func (*PluginConfigMapRef) ProtoMessage() {}
 func (*PluginConfigMapRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{105}
+	return fileDescriptor_c078c3c476799f44, []int{113}
 }
 func (m *PluginConfigMapRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3012,7 +3236,7 @@ var xxx_messageInfo_PluginConfigMapRef proto.InternalMessageInfo
 func (m *PluginGenerator) Reset()      { *m = PluginGenerator{} }
 func (*PluginGenerator) ProtoMessage() {}
 func (*PluginGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{106}
+	return fileDescriptor_c078c3c476799f44, []int{114}
 }
 func (m *PluginGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3040,7 +3264,7 @@ var xxx_messageInfo_PluginGenerator proto.InternalMessageInfo
 func (m *PluginInput) Reset()      { *m = PluginInput{} }
 func (*PluginInput) ProtoMessage() {}
 func (*PluginInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{107}
+	return fileDescriptor_c078c3c476799f44, []int{115}
 }
 func (m *PluginInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3068,7 +3292,7 @@ var xxx_messageInfo_PluginInput proto.InternalMessageInfo
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{108}
+	return fileDescriptor_c078c3c476799f44, []int{116}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3096,7 +3320,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *PullRequestGenerator) Reset()      { *m = PullRequestGenerator{} }
 func (*PullRequestGenerator) ProtoMessage() {}
 func (*PullRequestGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{109}
+	return fileDescriptor_c078c3c476799f44, []int{117}
 }
 func (m *PullRequestGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3124,7 +3348,7 @@ var xxx_messageInfo_PullRequestGenerator proto.InternalMessageInfo
 func (m *PullRequestGeneratorAzureDevOps) Reset()      { *m = PullRequestGeneratorAzureDevOps{} }
 func (*PullRequestGeneratorAzureDevOps) ProtoMessage() {}
 func (*PullRequestGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{110}
+	return fileDescriptor_c078c3c476799f44, []int{118}
 }
 func (m *PullRequestGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3152,7 +3376,7 @@ var xxx_messageInfo_PullRequestGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucket) Reset()      { *m = PullRequestGeneratorBitbucket{} }
 func (*PullRequestGeneratorBitbucket) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{111}
+	return fileDescriptor_c078c3c476799f44, []int{119}
 }
 func (m *PullRequestGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3180,7 +3404,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucket proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucketServer) Reset()      { *m = PullRequestGeneratorBitbucketServer{} }
 func (*PullRequestGeneratorBitbucketServer) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{112}
+	return fileDescriptor_c078c3c476799f44, []int{120}
 }
 func (m *PullRequestGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3208,7 +3432,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucketServer proto.InternalMessageInf
 func (m *PullRequestGeneratorFilter) Reset()      { *m = PullRequestGeneratorFilter{} }
 func (*PullRequestGeneratorFilter) ProtoMessage() {}
 func (*PullRequestGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{113}
+	return fileDescriptor_c078c3c476799f44, []int{121}
 }
 func (m *PullRequestGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3236,7 +3460,7 @@ var xxx_messageInfo_PullRequestGeneratorFilter proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitLab) Reset()      { *m = PullRequestGeneratorGitLab{} }
 func (*PullRequestGeneratorGitLab) ProtoMessage() {}
 func (*PullRequestGeneratorGitLab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{114}
+	return fileDescriptor_c078c3c476799f44, []int{122}
 }
 func (m *PullRequestGeneratorGitLab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3264,7 +3488,7 @@ var xxx_messageInfo_PullRequestGeneratorGitLab proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitea) Reset()      { *m = PullRequestGeneratorGitea{} }
 func (*PullRequestGeneratorGitea) ProtoMessage() {}
 func (*PullRequestGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{115}
+	return fileDescriptor_c078c3c476799f44, []int{123}
 }
 func (m *PullRequestGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3292,7 +3516,7 @@ var xxx_messageInfo_PullRequestGeneratorGitea proto.InternalMessageInfo
 func (m *PullRequestGeneratorGithub) Reset()      { *m = PullRequestGeneratorGithub{} }
 func (*PullRequestGeneratorGithub) ProtoMessage() {}
 func (*PullRequestGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{116}
+	return fileDescriptor_c078c3c476799f44, []int{124}
 }
 func (m *PullRequestGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3320,7 +3544,7 @@ var xxx_messageInfo_PullRequestGeneratorGithub proto.InternalMessageInfo
 func (m *RefTarget) Reset()      { *m = RefTarget{} }
 func (*RefTarget) ProtoMessage() {}
 func (*RefTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{117}
+	return fileDescriptor_c078c3c476799f44, []int{125}
 }
 func (m *RefTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3348,7 +3572,7 @@ var xxx_messageInfo_RefTarget proto.InternalMessageInfo
 func (m *RepoCreds) Reset()      { *m = RepoCreds{} }
 func (*RepoCreds) ProtoMessage() {}
 func (*RepoCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{118}
+	return fileDescriptor_c078c3c476799f44, []int{126}
 }
 func (m *RepoCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3376,7 +3600,7 @@ var xxx_messageInfo_RepoCreds proto.InternalMessageInfo
 func (m *RepoCredsList) Reset()      { *m = RepoCredsList{} }
 func (*RepoCredsList) ProtoMessage() {}
 func (*RepoCredsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{119}
+	return fileDescriptor_c078c3c476799f44, []int{127}
 }
 func (m *RepoCredsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3404,7 +3628,7 @@ var xxx_messageInfo_RepoCredsList proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{120}
+	return fileDescriptor_c078c3c476799f44, []int{128}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3432,7 +3656,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{121}
+	return fileDescriptor_c078c3c476799f44, []int{129}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3460,7 +3684,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{122}
+	return fileDescriptor_c078c3c476799f44, []int{130}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3488,7 +3712,7 @@ var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{123}
+	return fileDescriptor_c078c3c476799f44, []int{131}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3516,7 +3740,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{124}
+	return fileDescriptor_c078c3c476799f44, []int{132}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3544,7 +3768,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{125}
+	return fileDescriptor_c078c3c476799f44, []int{133}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3572,7 +3796,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{126}
+	return fileDescriptor_c078c3c476799f44, []int{134}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3600,7 +3824,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{127}
+	return fileDescriptor_c078c3c476799f44, []int{135}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3628,7 +3852,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{128}
+	return fileDescriptor_c078c3c476799f44, []int{136}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3656,7 +3880,7 @@ var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{129}
+	return fileDescriptor_c078c3c476799f44, []int{137}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3684,7 +3908,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{130}
+	return fileDescriptor_c078c3c476799f44, []int{138}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3712,7 +3936,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{131}
+	return fileDescriptor_c078c3c476799f44, []int{139}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3740,7 +3964,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{132}
+	return fileDescriptor_c078c3c476799f44, []int{140}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3768,7 +3992,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{133}
+	return fileDescriptor_c078c3c476799f44, []int{141}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3796,7 +4020,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{134}
+	return fileDescriptor_c078c3c476799f44, []int{142}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3824,7 +4048,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{135}
+	return fileDescriptor_c078c3c476799f44, []int{143}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3852,7 +4076,7 @@ var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{136}
+	return fileDescriptor_c078c3c476799f44, []int{144}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3880,7 +4104,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{137}
+	return fileDescriptor_c078c3c476799f44, []int{145}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3908,7 +4132,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{138}
+	return fileDescriptor_c078c3c476799f44, []int{146}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3936,7 +4160,7 @@ var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 func (m *RevisionReference) Reset()      { *m = RevisionReference{} }
 func (*RevisionReference) ProtoMessage() {}
 func (*RevisionReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{139}
+	return fileDescriptor_c078c3c476799f44, []int{147}
 }
 func (m *RevisionReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3964,7 +4188,7 @@ var xxx_messageInfo_RevisionReference proto.InternalMessageInfo
 func (m *SCMProviderGenerator) Reset()      { *m = SCMProviderGenerator{} }
 func (*SCMProviderGenerator) ProtoMessage() {}
 func (*SCMProviderGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{140}
+	return fileDescriptor_c078c3c476799f44, []int{148}
 }
 func (m *SCMProviderGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3992,7 +4216,7 @@ var xxx_messageInfo_SCMProviderGenerator proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAWSCodeCommit) Reset()      { *m = SCMProviderGeneratorAWSCodeCommit{} }
 func (*SCMProviderGeneratorAWSCodeCommit) ProtoMessage() {}
 func (*SCMProviderGeneratorAWSCodeCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{141}
+	return fileDescriptor_c078c3c476799f44, []int{149}
 }
 func (m *SCMProviderGeneratorAWSCodeCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4020,7 +4244,7 @@ var xxx_messageInfo_SCMProviderGeneratorAWSCodeCommit proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAzureDevOps) Reset()      { *m = SCMProviderGeneratorAzureDevOps{} }
 func (*SCMProviderGeneratorAzureDevOps) ProtoMessage() {}
 func (*SCMProviderGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{142}
+	return fileDescriptor_c078c3c476799f44, []int{150}
 }
 func (m *SCMProviderGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4048,7 +4272,7 @@ var xxx_messageInfo_SCMProviderGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucket) Reset()      { *m = SCMProviderGeneratorBitbucket{} }
 func (*SCMProviderGeneratorBitbucket) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{143}
+	return fileDescriptor_c078c3c476799f44, []int{151}
 }
 func (m *SCMProviderGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4076,7 +4300,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucket proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucketServer) Reset()      { *m = SCMProviderGeneratorBitbucketServer{} }
 func (*SCMProviderGeneratorBitbucketServer) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{144}
+	return fileDescriptor_c078c3c476799f44, []int{152}
 }
 func (m *SCMProviderGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4104,7 +4328,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucketServer proto.InternalMessageInf
 func (m *SCMProviderGeneratorFilter) Reset()      { *m = SCMProviderGeneratorFilter{} }
 func (*SCMProviderGeneratorFilter) ProtoMessage() {}
 func (*SCMProviderGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{145}
+	return fileDescriptor_c078c3c476799f44, []int{153}
 }
 func (m *SCMProviderGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4132,7 +4356,7 @@ var xxx_messageInfo_SCMProviderGeneratorFilter proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitea) Reset()      { *m = SCMProviderGeneratorGitea{} }
 func (*SCMProviderGeneratorGitea) ProtoMessage() {}
 func (*SCMProviderGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{146}
+	return fileDescriptor_c078c3c476799f44, []int{154}
 }
 func (m *SCMProviderGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4160,7 +4384,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitea proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGithub) Reset()      { *m = SCMProviderGeneratorGithub{} }
 func (*SCMProviderGeneratorGithub) ProtoMessage() {}
 func (*SCMProviderGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{147}
+	return fileDescriptor_c078c3c476799f44, []int{155}
 }
 func (m *SCMProviderGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4188,7 +4412,7 @@ var xxx_messageInfo_SCMProviderGeneratorGithub proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitlab) Reset()      { *m = SCMProviderGeneratorGitlab{} }
 func (*SCMProviderGeneratorGitlab) ProtoMessage() {}
 func (*SCMProviderGeneratorGitlab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{148}
+	return fileDescriptor_c078c3c476799f44, []int{156}
 }
 func (m *SCMProviderGeneratorGitlab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4213,10 +4437,38 @@ func (m *SCMProviderGeneratorGitlab) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SCMProviderGeneratorGitlab proto.InternalMessageInfo
 
+func (m *ScheduledOperation) Reset()      { *m = ScheduledOperation{} }
+func (*ScheduledOperation) ProtoMessage() {}
+func (*ScheduledOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{157}
+}
+func (m *ScheduledOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScheduledOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledOperation.Merge(m, src)
+}
+func (m *ScheduledOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledOperation proto.InternalMessageInfo
+
 func (m *SecretRef) Reset()      { *m = SecretRef{} }
 func (*SecretRef) ProtoMessage() {}
 func (*SecretRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{149}
+	return fileDescriptor_c078c3c476799f44, []int{158}
 }
 func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4244,7 +4496,7 @@ var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
 func (*SignatureKey) ProtoMessage() {}
 func (*SignatureKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{150}
+	return fileDescriptor_c078c3c476799f44, []int{159}
 }
 func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4272,7 +4524,7 @@ var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
 func (m *SourceHydrator) Reset()      { *m = SourceHydrator{} }
 func (*SourceHydrator) ProtoMessage() {}
 func (*SourceHydrator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{151}
+	return fileDescriptor_c078c3c476799f44, []int{160}
 }
 func (m *SourceHydrator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4300,7 +4552,7 @@ var xxx_messageInfo_SourceHydrator proto.InternalMessageInfo
 func (m *SourceHydratorStatus) Reset()      { *m = SourceHydratorStatus{} }
 func (*SourceHydratorStatus) ProtoMessage() {}
 func (*SourceHydratorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{152}
+	return fileDescriptor_c078c3c476799f44, []int{161}
 }
 func (m *SourceHydratorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4328,7 +4580,7 @@ var xxx_messageInfo_SourceHydratorStatus proto.InternalMessageInfo
 func (m *SuccessfulHydrateOperation) Reset()      { *m = SuccessfulHydrateOperation{} }
 func (*SuccessfulHydrateOperation) ProtoMessage() {}
 func (*SuccessfulHydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{153}
+	return fileDescriptor_c078c3c476799f44, []int{162}
 }
 func (m *SuccessfulHydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4356,7 +4608,7 @@ var xxx_messageInfo_SuccessfulHydrateOperation proto.InternalMessageInfo
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{154}
+	return fileDescriptor_c078c3c476799f44, []int{163}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4384,7 +4636,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{155}
+	return fileDescriptor_c078c3c476799f44, []int{164}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4412,7 +4664,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{156}
+	return fileDescriptor_c078c3c476799f44, []int{165}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4440,7 +4692,7 @@ var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{157}
+	return fileDescriptor_c078c3c476799f44, []int{166}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4468,7 +4720,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{158}
+	return fileDescriptor_c078c3c476799f44, []int{167}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4493,10 +4745,38 @@ func (m *SyncPolicyAutomated) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 
+func (m *SyncRevert) Reset()      { *m = SyncRevert{} }
+func (*SyncRevert) ProtoMessage() {}
+func (*SyncRevert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{168}
+}
+func (m *SyncRevert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncRevert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncRevert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncRevert.Merge(m, src)
+}
+func (m *SyncRevert) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncRevert) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncRevert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncRevert proto.InternalMessageInfo
+
 func (m *SyncSource) Reset()      { *m = SyncSource{} }
 func (*SyncSource) ProtoMessage() {}
 func (*SyncSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{159}
+	return fileDescriptor_c078c3c476799f44, []int{169}
 }
 func (m *SyncSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4524,7 +4804,7 @@ var xxx_messageInfo_SyncSource proto.InternalMessageInfo
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{160}
+	return fileDescriptor_c078c3c476799f44, []int{170}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4552,7 +4832,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{161}
+	return fileDescriptor_c078c3c476799f44, []int{171}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4580,7 +4860,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{162}
+	return fileDescriptor_c078c3c476799f44, []int{172}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4608,7 +4888,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{163}
+	return fileDescriptor_c078c3c476799f44, []int{173}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4633,10 +4913,66 @@ func (m *SyncStrategyHook) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 
+func (m *SyncWaveProgress) Reset()      { *m = SyncWaveProgress{} }
+func (*SyncWaveProgress) ProtoMessage() {}
+func (*SyncWaveProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{174}
+}
+func (m *SyncWaveProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWaveProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncWaveProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWaveProgress.Merge(m, src)
+}
+func (m *SyncWaveProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWaveProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWaveProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWaveProgress proto.InternalMessageInfo
+
+func (m *SyncWavesStrategy) Reset()      { *m = SyncWavesStrategy{} }
+func (*SyncWavesStrategy) ProtoMessage() {}
+func (*SyncWavesStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{175}
+}
+func (m *SyncWavesStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWavesStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncWavesStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWavesStrategy.Merge(m, src)
+}
+func (m *SyncWavesStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWavesStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWavesStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWavesStrategy proto.InternalMessageInfo
+
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{164}
+	return fileDescriptor_c078c3c476799f44, []int{176}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4661,10 +4997,38 @@ func (m *SyncWindow) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 
+func (m *SyncWindowCalendar) Reset()      { *m = SyncWindowCalendar{} }
+func (*SyncWindowCalendar) ProtoMessage() {}
+func (*SyncWindowCalendar) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{177}
+}
+func (m *SyncWindowCalendar) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindowCalendar) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncWindowCalendar) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindowCalendar.Merge(m, src)
+}
+func (m *SyncWindowCalendar) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindowCalendar) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindowCalendar.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindowCalendar proto.InternalMessageInfo
+
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{165}
+	return fileDescriptor_c078c3c476799f44, []int{178}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4692,7 +5056,7 @@ var xxx_messageInfo_TLSClientConfig proto.InternalMessageInfo
 func (m *TagFilter) Reset()      { *m = TagFilter{} }
 func (*TagFilter) ProtoMessage() {}
 func (*TagFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{166}
+	return fileDescriptor_c078c3c476799f44, []int{179}
 }
 func (m *TagFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4727,6 +5091,7 @@ func init() {
 	proto.RegisterMapType((map[string]JWTTokens)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AppProjectStatus.JwtTokensByRoleEntry")
 	proto.RegisterType((*Application)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Application")
 	proto.RegisterType((*ApplicationCondition)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationCondition")
+	proto.RegisterType((*ApplicationDependency)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDependency")
 	proto.RegisterType((*ApplicationDestination)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDestination")
 	proto.RegisterType((*ApplicationDestinationServiceAccount)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDestinationServiceAccount")
 	proto.RegisterType((*ApplicationList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationList")
@@ -4765,6 +5130,8 @@ func init() {
 	proto.RegisterType((*ApplicationSummary)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSummary")
 	proto.RegisterType((*ApplicationTree)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationTree")
 	proto.RegisterType((*ApplicationWatchEvent)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationWatchEvent")
+	proto.RegisterType((*AutomatedRollback)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AutomatedRollback")
+	proto.RegisterType((*AutomatedRollbackStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AutomatedRollbackStatus")
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Backoff")
 	proto.RegisterType((*BasicAuthBitbucketServer)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.BasicAuthBitbucketServer")
 	proto.RegisterType((*BearerTokenBitbucket)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.BearerTokenBitbucket")
@@ -4779,6 +5146,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ClusterGenerator.ValuesEntry")
 	proto.RegisterType((*ClusterInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ClusterInfo")
 	proto.RegisterType((*ClusterList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ClusterList")
+	proto.RegisterType((*ClusterMaintenance)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ClusterMaintenance")
 	proto.RegisterType((*Command)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Command")
 	proto.RegisterType((*CommitMetadata)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.CommitMetadata")
 	proto.RegisterType((*ComparedTo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ComparedTo")
@@ -4786,6 +5154,7 @@ func init() {
 	proto.RegisterType((*ConfigManagementPlugin)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ConfigManagementPlugin")
 	proto.RegisterType((*ConfigMapKeyRef)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ConfigMapKeyRef")
 	proto.RegisterType((*ConnectionState)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ConnectionState")
+	proto.RegisterType((*ConnectionStateHistoryEntry)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ConnectionStateHistoryEntry")
 	proto.RegisterType((*DrySource)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.DrySource")
 	proto.RegisterType((*DuckTypeGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.DuckTypeGenerator")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.DuckTypeGenerator.ValuesEntry")
@@ -4806,7 +5175,10 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HostInfo.LabelsEntry")
 	proto.RegisterType((*HostResourceInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HostResourceInfo")
 	proto.RegisterType((*HydrateOperation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydrateOperation")
+	proto.RegisterType((*HydratePullRequest)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydratePullRequest")
 	proto.RegisterType((*HydrateTo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydrateTo")
+	proto.RegisterType((*HydratedManifestLayout)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydratedManifestLayout")
+	proto.RegisterType((*HydratedPullRequest)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydratedPullRequest")
 	proto.RegisterType((*Info)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Info")
 	proto.RegisterType((*InfoItem)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.InfoItem")
 	proto.RegisterType((*JWTToken)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.JWTToken")
@@ -4889,6 +5261,7 @@ func init() {
 	proto.RegisterType((*SCMProviderGeneratorGitea)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SCMProviderGeneratorGitea")
 	proto.RegisterType((*SCMProviderGeneratorGithub)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SCMProviderGeneratorGithub")
 	proto.RegisterType((*SCMProviderGeneratorGitlab)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SCMProviderGeneratorGitlab")
+	proto.RegisterType((*ScheduledOperation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ScheduledOperation")
 	proto.RegisterType((*SecretRef)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SecretRef")
 	proto.RegisterType((*SignatureKey)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SignatureKey")
 	proto.RegisterType((*SourceHydrator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SourceHydrator")
@@ -4899,12 +5272,16 @@ func init() {
 	proto.RegisterType((*SyncOperationResult)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncOperationResult")
 	proto.RegisterType((*SyncPolicy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncPolicy")
 	proto.RegisterType((*SyncPolicyAutomated)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncPolicyAutomated")
+	proto.RegisterType((*SyncRevert)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncRevert")
 	proto.RegisterType((*SyncSource)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncSource")
 	proto.RegisterType((*SyncStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStatus")
 	proto.RegisterType((*SyncStrategy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategy")
 	proto.RegisterType((*SyncStrategyApply)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategyApply")
 	proto.RegisterType((*SyncStrategyHook)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategyHook")
+	proto.RegisterType((*SyncWaveProgress)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWaveProgress")
+	proto.RegisterType((*SyncWavesStrategy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWavesStrategy")
 	proto.RegisterType((*SyncWindow)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWindow")
+	proto.RegisterType((*SyncWindowCalendar)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWindowCalendar")
 	proto.RegisterType((*TLSClientConfig)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TLSClientConfig")
 	proto.RegisterType((*TagFilter)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TagFilter")
 }
@@ -4914,781 +5291,844 @@ func init() {
 }
 
 var fileDescriptor_c078c3c476799f44 = []byte{
-	// 12372 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x24, 0x49,
-	0x56, 0xd8, 0x55, 0xb7, 0x5a, 0xea, 0x7e, 0xfa, 0x1a, 0xe5, 0xcc, 0xec, 0x6a, 0x66, 0x3f, 0x34,
-	0xd4, 0xc2, 0xde, 0xe1, 0xdb, 0x95, 0xd8, 0xd9, 0xdd, 0x63, 0xcd, 0xc2, 0x81, 0x3e, 0xe6, 0x43,
-	0x33, 0xd2, 0x48, 0x9b, 0xad, 0x99, 0xe1, 0xf6, 0xd8, 0xdb, 0x2b, 0x55, 0xa7, 0xa4, 0x1a, 0x55,
-	0x57, 0xf5, 0x56, 0x55, 0x6b, 0xd4, 0xcb, 0x72, 0xdc, 0x71, 0x77, 0x70, 0x70, 0x5f, 0x6b, 0x70,
-	0x98, 0xc5, 0x36, 0xf8, 0x30, 0xf8, 0x23, 0xc2, 0x41, 0x80, 0xcd, 0x0f, 0x13, 0x01, 0x04, 0x61,
-	0x20, 0x08, 0xf0, 0x17, 0x98, 0xc0, 0x80, 0x0d, 0xc8, 0x77, 0x63, 0x3b, 0x20, 0x1c, 0x61, 0x22,
-	0xc0, 0xfe, 0xe1, 0x18, 0x3b, 0x08, 0x47, 0x7e, 0x67, 0x55, 0x57, 0x4b, 0xad, 0x51, 0x69, 0x66,
-	0xee, 0xd8, 0x7f, 0xdd, 0xf9, 0x5e, 0xbd, 0x97, 0x95, 0x95, 0xf9, 0xde, 0xcb, 0x97, 0xef, 0xbd,
-	0x84, 0xa5, 0x4d, 0x2f, 0xd9, 0x6a, 0xaf, 0x4f, 0xbb, 0x61, 0x73, 0xc6, 0x89, 0x36, 0xc3, 0x56,
-	0x14, 0xde, 0x62, 0x3f, 0x9e, 0x75, 0x1b, 0x33, 0x3b, 0xcf, 0xcf, 0xb4, 0xb6, 0x37, 0x67, 0x9c,
-	0x96, 0x17, 0xcf, 0x38, 0xad, 0x96, 0xef, 0xb9, 0x4e, 0xe2, 0x85, 0xc1, 0xcc, 0xce, 0x73, 0x8e,
-	0xdf, 0xda, 0x72, 0x9e, 0x9b, 0xd9, 0x24, 0x01, 0x89, 0x9c, 0x84, 0x34, 0xa6, 0x5b, 0x51, 0x98,
-	0x84, 0xe8, 0x5b, 0x35, 0xb5, 0x69, 0x49, 0x8d, 0xfd, 0x78, 0xdd, 0x6d, 0x4c, 0xef, 0x3c, 0x3f,
-	0xdd, 0xda, 0xde, 0x9c, 0xa6, 0xd4, 0xa6, 0x0d, 0x6a, 0xd3, 0x92, 0xda, 0xd9, 0x67, 0x8d, 0xbe,
-	0x6c, 0x86, 0x9b, 0xe1, 0x0c, 0x23, 0xba, 0xde, 0xde, 0x60, 0xff, 0xd8, 0x1f, 0xf6, 0x8b, 0x33,
-	0x3b, 0x6b, 0x6f, 0xbf, 0x14, 0x4f, 0x7b, 0x21, 0xed, 0xde, 0x8c, 0x1b, 0x46, 0x64, 0x66, 0xa7,
-	0xab, 0x43, 0x67, 0x2f, 0x6b, 0x1c, 0xb2, 0x9b, 0x90, 0x20, 0xf6, 0xc2, 0x20, 0x7e, 0x96, 0x76,
-	0x81, 0x44, 0x3b, 0x24, 0x32, 0x5f, 0xcf, 0x40, 0xc8, 0xa3, 0xf4, 0x82, 0xa6, 0xd4, 0x74, 0xdc,
-	0x2d, 0x2f, 0x20, 0x51, 0x47, 0x3f, 0xde, 0x24, 0x89, 0x93, 0xf7, 0xd4, 0x4c, 0xaf, 0xa7, 0xa2,
-	0x76, 0x90, 0x78, 0x4d, 0xd2, 0xf5, 0xc0, 0x07, 0x0e, 0x7a, 0x20, 0x76, 0xb7, 0x48, 0xd3, 0xe9,
-	0x7a, 0xee, 0xf9, 0x5e, 0xcf, 0xb5, 0x13, 0xcf, 0x9f, 0xf1, 0x82, 0x24, 0x4e, 0xa2, 0xec, 0x43,
-	0xf6, 0xdf, 0xb7, 0x60, 0x74, 0xf6, 0x66, 0x7d, 0xb6, 0x9d, 0x6c, 0xcd, 0x87, 0xc1, 0x86, 0xb7,
-	0x89, 0x5e, 0x84, 0x61, 0xd7, 0x6f, 0xc7, 0x09, 0x89, 0xae, 0x39, 0x4d, 0x32, 0x69, 0x9d, 0xb3,
-	0xde, 0x57, 0x9b, 0x3b, 0xf9, 0x9b, 0x7b, 0x53, 0xef, 0xb9, 0xb3, 0x37, 0x35, 0x3c, 0xaf, 0x41,
-	0xd8, 0xc4, 0x43, 0xdf, 0x08, 0x43, 0x51, 0xe8, 0x93, 0x59, 0x7c, 0x6d, 0xb2, 0xc4, 0x1e, 0x19,
-	0x17, 0x8f, 0x0c, 0x61, 0xde, 0x8c, 0x25, 0x9c, 0xa2, 0xb6, 0xa2, 0x70, 0xc3, 0xf3, 0xc9, 0x64,
-	0x39, 0x8d, 0xba, 0xca, 0x9b, 0xb1, 0x84, 0xdb, 0x3f, 0x56, 0x82, 0xf1, 0xd9, 0x56, 0xeb, 0x32,
-	0x71, 0xfc, 0x64, 0xab, 0x9e, 0x38, 0x49, 0x3b, 0x46, 0x9b, 0x30, 0x18, 0xb3, 0x5f, 0xa2, 0x6f,
-	0x2b, 0xe2, 0xe9, 0x41, 0x0e, 0xbf, 0xbb, 0x37, 0xf5, 0x6d, 0x79, 0x33, 0x7a, 0xd3, 0x4b, 0xc2,
-	0x56, 0xfc, 0x2c, 0x09, 0x36, 0xbd, 0x80, 0xb0, 0x71, 0xd9, 0x62, 0x54, 0xa7, 0x4d, 0xe2, 0xf3,
-	0x61, 0x83, 0x60, 0x41, 0x9e, 0xf6, 0xb3, 0x49, 0xe2, 0xd8, 0xd9, 0x24, 0xd9, 0x57, 0x5a, 0xe6,
-	0xcd, 0x58, 0xc2, 0x51, 0x04, 0xc8, 0x77, 0xe2, 0x64, 0x2d, 0x72, 0x82, 0xd8, 0xa3, 0x53, 0x7a,
-	0xcd, 0x6b, 0xf2, 0xb7, 0x1b, 0x3e, 0xff, 0x37, 0xa6, 0xf9, 0x87, 0x99, 0x36, 0x3f, 0x8c, 0x5e,
-	0x07, 0x74, 0xde, 0x4c, 0xef, 0x3c, 0x37, 0x4d, 0x9f, 0x98, 0x7b, 0xe4, 0xce, 0xde, 0x14, 0x5a,
-	0xea, 0xa2, 0x84, 0x73, 0xa8, 0xdb, 0xbf, 0x5f, 0x02, 0x98, 0x6d, 0xb5, 0x56, 0xa3, 0xf0, 0x16,
-	0x71, 0x13, 0xf4, 0x51, 0xa8, 0x52, 0x52, 0x0d, 0x27, 0x71, 0xd8, 0xc0, 0x0c, 0x9f, 0xff, 0xa6,
-	0xfe, 0x18, 0xaf, 0xac, 0xd3, 0xe7, 0x97, 0x49, 0xe2, 0xcc, 0x21, 0xf1, 0x82, 0xa0, 0xdb, 0xb0,
-	0xa2, 0x8a, 0x02, 0x18, 0x88, 0x5b, 0xc4, 0x65, 0x83, 0x31, 0x7c, 0x7e, 0x69, 0xfa, 0x28, 0x2b,
-	0x7d, 0x5a, 0xf7, 0xbc, 0xde, 0x22, 0xee, 0xdc, 0x88, 0xe0, 0x3c, 0x40, 0xff, 0x61, 0xc6, 0x07,
-	0xed, 0xa8, 0x0f, 0xcd, 0x07, 0xf2, 0x5a, 0x61, 0x1c, 0x19, 0xd5, 0xb9, 0xb1, 0xf4, 0xc4, 0x91,
-	0xdf, 0xdd, 0xfe, 0x13, 0x0b, 0xc6, 0x34, 0xf2, 0x92, 0x17, 0x27, 0xe8, 0xbb, 0xba, 0x06, 0x77,
-	0xba, 0xbf, 0xc1, 0xa5, 0x4f, 0xb3, 0xa1, 0x3d, 0x21, 0x98, 0x55, 0x65, 0x8b, 0x31, 0xb0, 0x4d,
-	0xa8, 0x78, 0x09, 0x69, 0xc6, 0x93, 0xa5, 0x73, 0xe5, 0xf7, 0x0d, 0x9f, 0xbf, 0x5c, 0xd4, 0x7b,
-	0xce, 0x8d, 0x0a, 0xa6, 0x95, 0x45, 0x4a, 0x1e, 0x73, 0x2e, 0xf6, 0x5f, 0x8e, 0x9a, 0xef, 0x47,
-	0x07, 0x1c, 0x3d, 0x07, 0xc3, 0x71, 0xd8, 0x8e, 0x5c, 0x82, 0x49, 0x2b, 0xa4, 0x0b, 0xab, 0x4c,
-	0xa7, 0x3b, 0x5d, 0xf0, 0x75, 0xdd, 0x8c, 0x4d, 0x1c, 0xf4, 0x05, 0x0b, 0x46, 0x1a, 0x24, 0x4e,
-	0xbc, 0x80, 0xf1, 0x97, 0x9d, 0x5f, 0x3b, 0x72, 0xe7, 0x65, 0xe3, 0x82, 0x26, 0x3e, 0x77, 0x4a,
-	0xbc, 0xc8, 0x88, 0xd1, 0x18, 0xe3, 0x14, 0x7f, 0x2a, 0xb8, 0x1a, 0x24, 0x76, 0x23, 0xaf, 0x45,
-	0xff, 0x0b, 0xd1, 0xa2, 0x04, 0xd7, 0x82, 0x06, 0x61, 0x13, 0x0f, 0x05, 0x50, 0xa1, 0x82, 0x29,
-	0x9e, 0x1c, 0x60, 0xfd, 0x5f, 0x3c, 0x5a, 0xff, 0xc5, 0xa0, 0x52, 0x99, 0xa7, 0x47, 0x9f, 0xfe,
-	0x8b, 0x31, 0x67, 0x83, 0x3e, 0x6f, 0xc1, 0xa4, 0x10, 0x9c, 0x98, 0xf0, 0x01, 0xbd, 0xb9, 0xe5,
-	0x25, 0xc4, 0xf7, 0xe2, 0x64, 0xb2, 0xc2, 0xfa, 0x30, 0xd3, 0xdf, 0xdc, 0xba, 0x14, 0x85, 0xed,
-	0xd6, 0x55, 0x2f, 0x68, 0xcc, 0x9d, 0x13, 0x9c, 0x26, 0xe7, 0x7b, 0x10, 0xc6, 0x3d, 0x59, 0xa2,
-	0x1f, 0xb1, 0xe0, 0x6c, 0xe0, 0x34, 0x49, 0xdc, 0x72, 0xe8, 0xa7, 0xe5, 0xe0, 0x39, 0xdf, 0x71,
-	0xb7, 0x59, 0x8f, 0x06, 0xef, 0xad, 0x47, 0xb6, 0xe8, 0xd1, 0xd9, 0x6b, 0x3d, 0x49, 0xe3, 0x7d,
-	0xd8, 0xa2, 0x9f, 0xb2, 0x60, 0x22, 0x8c, 0x5a, 0x5b, 0x4e, 0x40, 0x1a, 0x12, 0x1a, 0x4f, 0x0e,
-	0xb1, 0xa5, 0xf7, 0x91, 0xa3, 0x7d, 0xa2, 0x95, 0x2c, 0xd9, 0xe5, 0x30, 0xf0, 0x92, 0x30, 0xaa,
-	0x93, 0x24, 0xf1, 0x82, 0xcd, 0x78, 0xee, 0xf4, 0x9d, 0xbd, 0xa9, 0x89, 0x2e, 0x2c, 0xdc, 0xdd,
-	0x1f, 0xf4, 0xdd, 0x30, 0x1c, 0x77, 0x02, 0xf7, 0xa6, 0x17, 0x34, 0xc2, 0xdb, 0xf1, 0x64, 0xb5,
-	0x88, 0xe5, 0x5b, 0x57, 0x04, 0xc5, 0x02, 0xd4, 0x0c, 0xb0, 0xc9, 0x2d, 0xff, 0xc3, 0xe9, 0xa9,
-	0x54, 0x2b, 0xfa, 0xc3, 0xe9, 0xc9, 0xb4, 0x0f, 0x5b, 0xf4, 0x03, 0x16, 0x8c, 0xc6, 0xde, 0x66,
-	0xe0, 0x24, 0xed, 0x88, 0x5c, 0x25, 0x9d, 0x78, 0x12, 0x58, 0x47, 0xae, 0x1c, 0x71, 0x54, 0x0c,
-	0x92, 0x73, 0xa7, 0x45, 0x1f, 0x47, 0xcd, 0xd6, 0x18, 0xa7, 0xf9, 0xe6, 0x2d, 0x34, 0x3d, 0xad,
-	0x87, 0x8b, 0x5d, 0x68, 0x7a, 0x52, 0xf7, 0x64, 0x89, 0xbe, 0x03, 0x4e, 0xf0, 0x26, 0x35, 0xb2,
-	0xf1, 0xe4, 0x08, 0x13, 0xb4, 0xa7, 0xee, 0xec, 0x4d, 0x9d, 0xa8, 0x67, 0x60, 0xb8, 0x0b, 0x1b,
-	0xbd, 0x01, 0x53, 0x2d, 0x12, 0x35, 0xbd, 0x64, 0x25, 0xf0, 0x3b, 0x52, 0x7c, 0xbb, 0x61, 0x8b,
-	0x34, 0x44, 0x77, 0xe2, 0xc9, 0xd1, 0x73, 0xd6, 0xfb, 0xaa, 0x73, 0xef, 0x15, 0xdd, 0x9c, 0x5a,
-	0xdd, 0x1f, 0x1d, 0x1f, 0x44, 0x0f, 0xfd, 0x86, 0x05, 0x67, 0x0d, 0x29, 0x5b, 0x27, 0xd1, 0x8e,
-	0xe7, 0x92, 0x59, 0xd7, 0x0d, 0xdb, 0x41, 0x12, 0x4f, 0x8e, 0xb1, 0x61, 0x5c, 0x3f, 0x0e, 0x99,
-	0x9f, 0x66, 0xa5, 0xe7, 0x65, 0x4f, 0x94, 0x18, 0xef, 0xd3, 0x53, 0xfb, 0xb7, 0x4a, 0x70, 0x22,
-	0x6b, 0x01, 0xa0, 0x7f, 0x6c, 0xc1, 0xf8, 0xad, 0xdb, 0xc9, 0x5a, 0xb8, 0x4d, 0x82, 0x78, 0xae,
-	0x43, 0xe5, 0x34, 0xd3, 0x7d, 0xc3, 0xe7, 0xdd, 0x62, 0x6d, 0x8d, 0xe9, 0x2b, 0x69, 0x2e, 0x17,
-	0x82, 0x24, 0xea, 0xcc, 0x3d, 0x2a, 0xde, 0x69, 0xfc, 0xca, 0xcd, 0x35, 0x13, 0x8a, 0xb3, 0x9d,
-	0x3a, 0xfb, 0x59, 0x0b, 0x4e, 0xe5, 0x91, 0x40, 0x27, 0xa0, 0xbc, 0x4d, 0x3a, 0xdc, 0x12, 0xc6,
-	0xf4, 0x27, 0x7a, 0x0d, 0x2a, 0x3b, 0x8e, 0xdf, 0x26, 0xc2, 0x4c, 0xbb, 0x74, 0xb4, 0x17, 0x51,
-	0x3d, 0xc3, 0x9c, 0xea, 0xb7, 0x94, 0x5e, 0xb2, 0xec, 0xdf, 0x2e, 0xc3, 0xb0, 0xf1, 0xd1, 0xee,
-	0x83, 0xe9, 0x19, 0xa6, 0x4c, 0xcf, 0xe5, 0xc2, 0xe6, 0x5b, 0x4f, 0xdb, 0xf3, 0x76, 0xc6, 0xf6,
-	0x5c, 0x29, 0x8e, 0xe5, 0xbe, 0xc6, 0x27, 0x4a, 0xa0, 0x16, 0xb6, 0xe8, 0x16, 0x8d, 0xda, 0x30,
-	0x03, 0x45, 0x7c, 0xc2, 0x15, 0x49, 0x6e, 0x6e, 0xf4, 0xce, 0xde, 0x54, 0x4d, 0xfd, 0xc5, 0x9a,
-	0x91, 0xfd, 0x07, 0x16, 0x9c, 0x32, 0xfa, 0x38, 0x1f, 0x06, 0x0d, 0xb6, 0xd1, 0x40, 0xe7, 0x60,
-	0x20, 0xe9, 0xb4, 0xe4, 0x36, 0x50, 0x8d, 0xd4, 0x5a, 0xa7, 0x45, 0x30, 0x83, 0x3c, 0xec, 0xbb,
-	0xa4, 0x1f, 0xb1, 0xe0, 0x91, 0x7c, 0x01, 0x83, 0x9e, 0x86, 0x41, 0xee, 0x03, 0x10, 0x6f, 0xa7,
-	0x3f, 0x09, 0x6b, 0xc5, 0x02, 0x8a, 0x66, 0xa0, 0xa6, 0x14, 0x9e, 0x78, 0xc7, 0x09, 0x81, 0x5a,
-	0xd3, 0x5a, 0x52, 0xe3, 0xd0, 0x41, 0xa3, 0x7f, 0x84, 0x09, 0xaa, 0x06, 0x8d, 0x6d, 0x9a, 0x19,
-	0xc4, 0xfe, 0x3d, 0x0b, 0xbe, 0xbe, 0x1f, 0xb1, 0x77, 0x7c, 0x7d, 0xac, 0xc3, 0xe9, 0x06, 0xd9,
-	0x70, 0xda, 0x7e, 0x92, 0xe6, 0x28, 0x3a, 0xfd, 0x84, 0x78, 0xf8, 0xf4, 0x42, 0x1e, 0x12, 0xce,
-	0x7f, 0xd6, 0xfe, 0x2f, 0x16, 0xdb, 0xae, 0xcb, 0xd7, 0xba, 0x0f, 0x5b, 0xa7, 0x20, 0xbd, 0x75,
-	0x5a, 0x2c, 0x6c, 0x99, 0xf6, 0xd8, 0x3b, 0x7d, 0xde, 0x82, 0xb3, 0x06, 0xd6, 0xb2, 0x93, 0xb8,
-	0x5b, 0x17, 0x76, 0x5b, 0x11, 0x89, 0x63, 0x3a, 0xa5, 0x9e, 0x30, 0xc4, 0xf1, 0xdc, 0xb0, 0xa0,
-	0x50, 0xbe, 0x4a, 0x3a, 0x5c, 0x36, 0x3f, 0x03, 0x55, 0xbe, 0xe6, 0xc2, 0x48, 0x7c, 0x24, 0xf5,
-	0x6e, 0x2b, 0xa2, 0x1d, 0x2b, 0x0c, 0x64, 0xc3, 0x20, 0x93, 0xb9, 0x54, 0x06, 0x51, 0x33, 0x01,
-	0xe8, 0x77, 0xbf, 0xc1, 0x5a, 0xb0, 0x80, 0xd8, 0x71, 0xaa, 0x3b, 0xab, 0x11, 0x61, 0xf3, 0xa1,
-	0x71, 0xd1, 0x23, 0x7e, 0x23, 0xa6, 0xdb, 0x3a, 0x27, 0x08, 0xc2, 0x44, 0xec, 0xd0, 0x8c, 0x6d,
-	0xdd, 0xac, 0x6e, 0xc6, 0x26, 0x0e, 0x65, 0xea, 0x3b, 0xeb, 0xc4, 0xe7, 0x23, 0x2a, 0x98, 0x2e,
-	0xb1, 0x16, 0x2c, 0x20, 0xf6, 0x9d, 0x12, 0xdb, 0x40, 0x2a, 0x89, 0x46, 0xee, 0x87, 0xf7, 0x21,
-	0x4a, 0xa9, 0x80, 0xd5, 0xe2, 0xe4, 0x31, 0xe9, 0xed, 0x81, 0x78, 0x33, 0xa3, 0x05, 0x70, 0xa1,
-	0x5c, 0xf7, 0xf7, 0x42, 0x7c, 0xbc, 0x0c, 0x53, 0xe9, 0x07, 0xba, 0x94, 0x08, 0xdd, 0xf2, 0x1a,
-	0x8c, 0xb2, 0xbe, 0x3a, 0x03, 0x1f, 0x9b, 0x78, 0x3d, 0xe4, 0x70, 0xe9, 0x38, 0xe5, 0xb0, 0xa9,
-	0x26, 0xca, 0x07, 0xa8, 0x89, 0xa7, 0xd5, 0xa8, 0x0f, 0x64, 0x64, 0x5e, 0x5a, 0x55, 0x9e, 0x83,
-	0x81, 0x38, 0x21, 0xad, 0xc9, 0x4a, 0x5a, 0xcc, 0xd6, 0x13, 0xd2, 0xc2, 0x0c, 0x82, 0xbe, 0x0d,
-	0xc6, 0x13, 0x27, 0xda, 0x24, 0x49, 0x44, 0x76, 0x3c, 0xe6, 0xd7, 0x65, 0xfb, 0xd9, 0xda, 0xdc,
-	0x49, 0x6a, 0x75, 0xad, 0x31, 0x10, 0x96, 0x20, 0x9c, 0xc5, 0xb5, 0xff, 0x47, 0x09, 0x1e, 0x4d,
-	0x7f, 0x02, 0xad, 0x18, 0xbf, 0x3d, 0xa5, 0x18, 0xdf, 0x6f, 0x2a, 0xc6, 0xbb, 0x7b, 0x53, 0x8f,
-	0xf5, 0x78, 0xec, 0xab, 0x46, 0x6f, 0xa2, 0x4b, 0x99, 0x8f, 0x30, 0xd3, 0xe5, 0x65, 0x7d, 0xa2,
-	0xc7, 0x3b, 0x66, 0xbe, 0xd2, 0xd3, 0x30, 0x18, 0x11, 0x27, 0x0e, 0x03, 0xf1, 0x9d, 0xd4, 0xd7,
-	0xc4, 0xac, 0x15, 0x0b, 0xa8, 0xfd, 0xbb, 0xb5, 0xec, 0x60, 0x5f, 0xe2, 0xbe, 0xea, 0x30, 0x42,
-	0x1e, 0x0c, 0xb0, 0x5d, 0x1b, 0x97, 0x2c, 0x57, 0x8f, 0xb6, 0x0a, 0xa9, 0x16, 0x51, 0xa4, 0xe7,
-	0xaa, 0xf4, 0xab, 0xd1, 0x26, 0xcc, 0x58, 0xa0, 0x5d, 0xa8, 0xba, 0x72, 0x33, 0x55, 0x2a, 0xc2,
-	0xed, 0x28, 0xb6, 0x52, 0x9a, 0xe3, 0x08, 0x15, 0xf7, 0x6a, 0x07, 0xa6, 0xb8, 0x21, 0x02, 0xe5,
-	0x4d, 0x2f, 0x11, 0x9f, 0xf5, 0x88, 0xdb, 0xe5, 0x4b, 0x9e, 0xf1, 0x8a, 0x43, 0x54, 0x07, 0x5d,
-	0xf2, 0x12, 0x4c, 0xe9, 0xa3, 0x4f, 0x5b, 0x30, 0x1c, 0xbb, 0xcd, 0xd5, 0x28, 0xdc, 0xf1, 0x1a,
-	0x24, 0x12, 0x36, 0xe6, 0x11, 0x25, 0x5b, 0x7d, 0x7e, 0x59, 0x12, 0xd4, 0x7c, 0xb9, 0xfb, 0x42,
-	0x43, 0xb0, 0xc9, 0x97, 0xee, 0xbd, 0x1e, 0x15, 0xef, 0xbe, 0x40, 0x5c, 0xb6, 0xe2, 0xe4, 0x9e,
-	0x99, 0xcd, 0x94, 0x23, 0xdb, 0xdc, 0x0b, 0x6d, 0x77, 0x9b, 0xae, 0x37, 0xdd, 0xa1, 0xc7, 0xee,
-	0xec, 0x4d, 0x3d, 0x3a, 0x9f, 0xcf, 0x13, 0xf7, 0xea, 0x0c, 0x1b, 0xb0, 0x56, 0xdb, 0xf7, 0x31,
-	0x79, 0xa3, 0x4d, 0x98, 0x47, 0xac, 0x80, 0x01, 0x5b, 0xd5, 0x04, 0x33, 0x03, 0x66, 0x40, 0xb0,
-	0xc9, 0x17, 0xbd, 0x01, 0x83, 0x4d, 0x27, 0x89, 0xbc, 0x5d, 0xe1, 0x06, 0x3b, 0xe2, 0x2e, 0x68,
-	0x99, 0xd1, 0xd2, 0xcc, 0x99, 0xa2, 0xe7, 0x8d, 0x58, 0x30, 0x42, 0x4d, 0xa8, 0x34, 0x49, 0xb4,
-	0x49, 0x26, 0xab, 0x45, 0xb8, 0xfc, 0x97, 0x29, 0x29, 0xcd, 0xb0, 0x46, 0x8d, 0x2b, 0xd6, 0x86,
-	0x39, 0x17, 0xf4, 0x1a, 0x54, 0x63, 0xe2, 0x13, 0x97, 0x9a, 0x47, 0x35, 0xc6, 0xf1, 0xf9, 0x3e,
-	0x4d, 0x45, 0x6a, 0x97, 0xd4, 0xc5, 0xa3, 0x7c, 0x81, 0xc9, 0x7f, 0x58, 0x91, 0xa4, 0x03, 0xd8,
-	0xf2, 0xdb, 0x9b, 0x5e, 0x30, 0x09, 0x45, 0x0c, 0xe0, 0x2a, 0xa3, 0x95, 0x19, 0x40, 0xde, 0x88,
-	0x05, 0x23, 0xfb, 0xbf, 0x5b, 0x80, 0xd2, 0x42, 0xed, 0x3e, 0xd8, 0xc4, 0x6f, 0xa4, 0x6d, 0xe2,
-	0xa5, 0x22, 0x8d, 0x96, 0x1e, 0x66, 0xf1, 0x2f, 0xd6, 0x20, 0xa3, 0x0e, 0xae, 0x91, 0x38, 0x21,
-	0x8d, 0x77, 0x45, 0xf8, 0xbb, 0x22, 0xfc, 0x5d, 0x11, 0xae, 0x44, 0xf8, 0x7a, 0x46, 0x84, 0x7f,
-	0xd0, 0x58, 0xf5, 0x3a, 0xf6, 0xe0, 0x75, 0x15, 0x9c, 0x60, 0xf6, 0xc0, 0x40, 0xa0, 0x92, 0xe0,
-	0x4a, 0x7d, 0xe5, 0x5a, 0xae, 0xcc, 0x7e, 0x3d, 0x2d, 0xb3, 0x8f, 0xca, 0xe2, 0xaf, 0x83, 0x94,
-	0xfe, 0x0d, 0x0b, 0xde, 0x9b, 0x96, 0x5e, 0x72, 0xe6, 0x2c, 0x6e, 0x06, 0x61, 0x44, 0x16, 0xbc,
-	0x8d, 0x0d, 0x12, 0x91, 0xc0, 0x25, 0xb1, 0xf2, 0xed, 0x58, 0xbd, 0x7c, 0x3b, 0xe8, 0x05, 0x18,
-	0xb9, 0x15, 0x87, 0xc1, 0x6a, 0xe8, 0x05, 0x42, 0x04, 0xd1, 0x1d, 0xc7, 0x89, 0x3b, 0x7b, 0x53,
-	0x23, 0x74, 0x44, 0x65, 0x3b, 0x4e, 0x61, 0xa1, 0x79, 0x98, 0xb8, 0xf5, 0xc6, 0xaa, 0x93, 0x18,
-	0xde, 0x04, 0xb9, 0xef, 0x67, 0xe7, 0x51, 0x57, 0x5e, 0xc9, 0x00, 0x71, 0x37, 0xbe, 0xfd, 0xf7,
-	0x4a, 0x70, 0x26, 0xf3, 0x22, 0xa1, 0xef, 0x87, 0xed, 0x84, 0xee, 0x89, 0xd0, 0x4f, 0x58, 0x70,
-	0xa2, 0x99, 0x76, 0x58, 0xc4, 0xc2, 0xdd, 0xfd, 0x9d, 0x85, 0xe9, 0x88, 0x8c, 0x47, 0x64, 0x6e,
-	0x52, 0x8c, 0xd0, 0x89, 0x0c, 0x20, 0xc6, 0x5d, 0x7d, 0x41, 0xaf, 0x41, 0xad, 0xe9, 0xec, 0x5e,
-	0x6f, 0x35, 0x9c, 0x44, 0x6e, 0x47, 0x7b, 0x7b, 0x11, 0xda, 0x89, 0xe7, 0x4f, 0xf3, 0xa8, 0x96,
-	0xe9, 0xc5, 0x20, 0x59, 0x89, 0xea, 0x49, 0xe4, 0x05, 0x9b, 0xdc, 0xc9, 0xb9, 0x2c, 0xc9, 0x60,
-	0x4d, 0xd1, 0xfe, 0x71, 0x2b, 0xab, 0xa4, 0xd4, 0xe8, 0x44, 0x4e, 0x42, 0x36, 0x3b, 0xe8, 0x2d,
-	0xa8, 0xd0, 0x7d, 0xa3, 0x1c, 0x95, 0x9b, 0x45, 0x6a, 0x4e, 0xe3, 0x4b, 0x68, 0x25, 0x4a, 0xff,
-	0xc5, 0x98, 0x33, 0xb5, 0x7f, 0xa2, 0x96, 0x35, 0x16, 0xd8, 0xd9, 0xfc, 0x79, 0x80, 0xcd, 0x70,
-	0x8d, 0x34, 0x5b, 0x3e, 0x1d, 0x16, 0x8b, 0x1d, 0xf0, 0x28, 0x57, 0xc9, 0x25, 0x05, 0xc1, 0x06,
-	0x16, 0xfa, 0x41, 0x0b, 0x60, 0x53, 0xce, 0x79, 0x69, 0x08, 0x5c, 0x2f, 0xf2, 0x75, 0xf4, 0x8a,
-	0xd2, 0x7d, 0x51, 0x0c, 0xb1, 0xc1, 0x1c, 0x7d, 0x9f, 0x05, 0xd5, 0x44, 0x76, 0x9f, 0xab, 0xc6,
-	0xb5, 0x22, 0x7b, 0x22, 0x5f, 0x5a, 0xdb, 0x44, 0x6a, 0x48, 0x14, 0x5f, 0xf4, 0xfd, 0x16, 0x40,
-	0xdc, 0x09, 0xdc, 0xd5, 0xd0, 0xf7, 0xdc, 0x8e, 0xd0, 0x98, 0x37, 0x0a, 0x75, 0xe7, 0x28, 0xea,
-	0x73, 0x63, 0x74, 0x34, 0xf4, 0x7f, 0x6c, 0x70, 0x46, 0x1f, 0x83, 0x6a, 0x2c, 0xa6, 0x9b, 0xd0,
-	0x91, 0x6b, 0xc5, 0x3a, 0x95, 0x38, 0x6d, 0x21, 0x5e, 0xc5, 0x3f, 0xac, 0x78, 0xa2, 0x1f, 0xb5,
-	0x60, 0xbc, 0x95, 0x76, 0x13, 0x0a, 0x75, 0x58, 0x9c, 0x0c, 0xc8, 0xb8, 0x21, 0xb9, 0xb7, 0x25,
-	0xd3, 0x88, 0xb3, 0xbd, 0xa0, 0x12, 0x50, 0xcf, 0xe0, 0x95, 0x16, 0x77, 0x59, 0x0e, 0x69, 0x09,
-	0x78, 0x29, 0x0b, 0xc4, 0xdd, 0xf8, 0x68, 0x15, 0x4e, 0xd1, 0xde, 0x75, 0xb8, 0xf9, 0x29, 0xd5,
-	0x4b, 0xcc, 0x94, 0x61, 0x75, 0xee, 0x71, 0x31, 0x43, 0xd8, 0x59, 0x47, 0x16, 0x07, 0xe7, 0x3e,
-	0x89, 0x7e, 0xdb, 0x82, 0xc7, 0x3d, 0xa6, 0x06, 0x4c, 0x87, 0xbd, 0xd6, 0x08, 0xe2, 0xa0, 0x9d,
-	0x14, 0x2a, 0x2b, 0x7a, 0xa9, 0x9f, 0xb9, 0xaf, 0x17, 0x6f, 0xf0, 0xf8, 0xe2, 0x3e, 0x5d, 0xc2,
-	0xfb, 0x76, 0x18, 0x7d, 0x33, 0x8c, 0xca, 0x75, 0xb1, 0x4a, 0x45, 0x30, 0x53, 0xb4, 0xb5, 0xb9,
-	0x89, 0x3b, 0x7b, 0x53, 0xa3, 0x6b, 0x26, 0x00, 0xa7, 0xf1, 0xec, 0x7f, 0x5d, 0x4e, 0x9d, 0x12,
-	0x29, 0x1f, 0x26, 0x13, 0x37, 0xae, 0xf4, 0xff, 0x48, 0xe9, 0x59, 0xa8, 0xb8, 0x51, 0xde, 0x25,
-	0x2d, 0x6e, 0x54, 0x53, 0x8c, 0x0d, 0xe6, 0xd4, 0x28, 0x9d, 0x70, 0xb2, 0x9e, 0x52, 0x21, 0x01,
-	0x5f, 0x2b, 0xb2, 0x4b, 0xdd, 0x67, 0x7a, 0x67, 0x44, 0xd7, 0x26, 0xba, 0x40, 0xb8, 0xbb, 0x4b,
-	0xe8, 0x7b, 0xa0, 0x16, 0xa9, 0xc8, 0x96, 0x72, 0x11, 0x5b, 0x35, 0x39, 0x6d, 0x44, 0x77, 0xd4,
-	0x01, 0x90, 0x8e, 0x61, 0xd1, 0x1c, 0xed, 0xcf, 0x94, 0x52, 0x07, 0x63, 0x86, 0xec, 0xe8, 0xe3,
-	0xd0, 0xef, 0x0b, 0x16, 0x0c, 0x47, 0xa1, 0xef, 0x7b, 0xc1, 0x26, 0x95, 0x73, 0x42, 0x59, 0x7f,
-	0xf8, 0x58, 0xf4, 0xa5, 0x10, 0x68, 0xcc, 0xb2, 0xc6, 0x9a, 0x27, 0x36, 0x3b, 0x80, 0x5e, 0x86,
-	0xd1, 0x06, 0xf1, 0x09, 0x7d, 0x76, 0x25, 0xa2, 0x7b, 0x22, 0xee, 0x64, 0x56, 0x91, 0x22, 0x0b,
-	0x26, 0x10, 0xa7, 0x71, 0xed, 0x3f, 0xb1, 0x60, 0xb2, 0x97, 0x30, 0x47, 0x04, 0x1e, 0x93, 0x92,
-	0x4a, 0x8d, 0xe3, 0x4a, 0x20, 0xe9, 0x09, 0x7d, 0xfc, 0x94, 0xe0, 0xf3, 0xd8, 0x6a, 0x6f, 0x54,
-	0xbc, 0x1f, 0x1d, 0xf4, 0x2a, 0x9c, 0x30, 0x06, 0x25, 0x56, 0xa3, 0x5a, 0x9b, 0x9b, 0xa6, 0xd6,
-	0xd3, 0x6c, 0x06, 0x76, 0x77, 0x6f, 0xea, 0x91, 0x6c, 0x9b, 0xd0, 0x36, 0x5d, 0x74, 0xec, 0x9f,
-	0xee, 0xfa, 0xd4, 0xca, 0x50, 0x78, 0xc7, 0xea, 0x72, 0x45, 0x7c, 0xe7, 0x71, 0x28, 0x67, 0xe6,
-	0xb4, 0x50, 0x31, 0x1c, 0xbd, 0x71, 0x1e, 0xe0, 0x99, 0xbf, 0xfd, 0x6f, 0x07, 0x60, 0x9f, 0x9e,
-	0xf5, 0x61, 0xf9, 0x1f, 0xfa, 0x10, 0xf6, 0x73, 0x96, 0x3a, 0x6d, 0xe3, 0x02, 0xa0, 0x71, 0x5c,
-	0x63, 0xcf, 0x37, 0x5f, 0x31, 0x8f, 0x3b, 0x51, 0x2e, 0xf8, 0xf4, 0xb9, 0x1e, 0xfa, 0x92, 0x95,
-	0x3e, 0x2f, 0xe4, 0x11, 0x91, 0xde, 0xb1, 0xf5, 0xc9, 0x38, 0x84, 0xe4, 0x1d, 0xd3, 0x47, 0x57,
-	0xbd, 0x8e, 0x27, 0xa7, 0x01, 0x36, 0xbc, 0xc0, 0xf1, 0xbd, 0x37, 0xe9, 0xd6, 0xaa, 0xc2, 0xac,
-	0x03, 0x66, 0x6e, 0x5d, 0x54, 0xad, 0xd8, 0xc0, 0x38, 0xfb, 0x37, 0x61, 0xd8, 0x78, 0xf3, 0x9c,
-	0x70, 0x99, 0x53, 0x66, 0xb8, 0x4c, 0xcd, 0x88, 0x72, 0x39, 0xfb, 0x41, 0x38, 0x91, 0xed, 0xe0,
-	0x61, 0x9e, 0xb7, 0xff, 0xcf, 0x50, 0xf6, 0x00, 0x6f, 0x8d, 0x44, 0x4d, 0xda, 0xb5, 0x77, 0xbd,
-	0x62, 0xef, 0x7a, 0xc5, 0xde, 0xf5, 0x8a, 0x99, 0x07, 0x1b, 0xc2, 0xe3, 0x33, 0x74, 0x9f, 0x3c,
-	0x3e, 0x29, 0x1f, 0x56, 0xb5, 0x70, 0x1f, 0x96, 0xfd, 0xe9, 0x2e, 0xb7, 0xff, 0x5a, 0x44, 0x08,
-	0x0a, 0xa1, 0x12, 0x84, 0x0d, 0x22, 0x0d, 0xe4, 0x2b, 0xc5, 0x58, 0x7b, 0xd7, 0xc2, 0x86, 0x11,
-	0x6b, 0x4e, 0xff, 0xc5, 0x98, 0xf3, 0xb1, 0x3f, 0x35, 0x08, 0x29, 0x5b, 0x94, 0x7f, 0xf7, 0x6f,
-	0x84, 0xa1, 0x88, 0xb4, 0xc2, 0xeb, 0x78, 0x49, 0xe8, 0x32, 0x9d, 0xaa, 0xc3, 0x9b, 0xb1, 0x84,
-	0x53, 0x9d, 0xd7, 0x72, 0x92, 0x2d, 0xa1, 0xcc, 0x94, 0xce, 0x5b, 0x75, 0x92, 0x2d, 0xcc, 0x20,
-	0xe8, 0x83, 0x30, 0x96, 0xa4, 0xce, 0xd1, 0xc5, 0x79, 0xf1, 0x23, 0x02, 0x77, 0x2c, 0x7d, 0xca,
-	0x8e, 0x33, 0xd8, 0xe8, 0x0d, 0x18, 0xd8, 0x22, 0x7e, 0x53, 0x7c, 0xfa, 0x7a, 0x71, 0xba, 0x86,
-	0xbd, 0xeb, 0x65, 0xe2, 0x37, 0xb9, 0x24, 0xa4, 0xbf, 0x30, 0x63, 0x45, 0xe7, 0x7d, 0x6d, 0xbb,
-	0x1d, 0x27, 0x61, 0xd3, 0x7b, 0x53, 0xba, 0x49, 0xbf, 0xb3, 0x60, 0xc6, 0x57, 0x25, 0x7d, 0xee,
-	0x8f, 0x52, 0x7f, 0xb1, 0xe6, 0xcc, 0xfa, 0xd1, 0xf0, 0x22, 0x36, 0x65, 0x3a, 0xc2, 0xdb, 0x59,
-	0x74, 0x3f, 0x16, 0x24, 0x7d, 0xde, 0x0f, 0xf5, 0x17, 0x6b, 0xce, 0xa8, 0xa3, 0xd6, 0xdf, 0x30,
-	0xeb, 0xc3, 0xf5, 0x82, 0xfb, 0xc0, 0xd7, 0x5e, 0xee, 0x3a, 0x7c, 0x0a, 0x2a, 0xee, 0x96, 0x13,
-	0x25, 0x93, 0x23, 0x6c, 0xd2, 0xa8, 0x59, 0x3c, 0x4f, 0x1b, 0x31, 0x87, 0xa1, 0x27, 0xa0, 0x1c,
-	0x91, 0x0d, 0x16, 0xda, 0x6c, 0x04, 0x55, 0x61, 0xb2, 0x81, 0x69, 0xbb, 0xb2, 0xcb, 0xc6, 0x7a,
-	0x46, 0xdb, 0xfd, 0x64, 0x29, 0x6d, 0xd8, 0xa5, 0x47, 0x86, 0xaf, 0x07, 0xb7, 0x1d, 0xc5, 0xd2,
-	0xbb, 0x66, 0xac, 0x07, 0xd6, 0x8c, 0x25, 0x1c, 0x7d, 0xc2, 0x82, 0xa1, 0x5b, 0x71, 0x18, 0x04,
-	0x24, 0x11, 0x4a, 0xf4, 0x46, 0xc1, 0x83, 0x75, 0x85, 0x53, 0xd7, 0x7d, 0x10, 0x0d, 0x58, 0xf2,
-	0xa5, 0xdd, 0x25, 0xbb, 0xae, 0xdf, 0x6e, 0x74, 0x45, 0xd2, 0x5c, 0xe0, 0xcd, 0x58, 0xc2, 0x29,
-	0xaa, 0x17, 0x70, 0xd4, 0x81, 0x34, 0xea, 0x62, 0x20, 0x50, 0x05, 0xdc, 0xfe, 0xf9, 0x2a, 0x9c,
-	0xce, 0x5d, 0x3e, 0xd4, 0xe4, 0x62, 0x46, 0xcd, 0x45, 0xcf, 0x27, 0x32, 0x86, 0x8c, 0x99, 0x5c,
-	0x37, 0x54, 0x2b, 0x36, 0x30, 0xd0, 0xf7, 0x02, 0xb4, 0x9c, 0xc8, 0x69, 0x12, 0xe5, 0xfd, 0x3e,
-	0xb2, 0x65, 0x43, 0xfb, 0xb1, 0x2a, 0x69, 0x6a, 0x0f, 0x80, 0x6a, 0x8a, 0xb1, 0xc1, 0x12, 0xbd,
-	0x08, 0xc3, 0x11, 0xf1, 0x89, 0x13, 0xb3, 0xd8, 0xf9, 0x6c, 0x22, 0x10, 0xd6, 0x20, 0x6c, 0xe2,
-	0xa1, 0xa7, 0x55, 0xb8, 0x5d, 0x26, 0xec, 0x28, 0x1d, 0x72, 0x87, 0xbe, 0x68, 0xc1, 0xd8, 0x86,
-	0xe7, 0x13, 0xcd, 0x5d, 0xa4, 0xed, 0xac, 0x1c, 0xfd, 0x25, 0x2f, 0x9a, 0x74, 0xb5, 0x0c, 0x4d,
-	0x35, 0xc7, 0x38, 0xc3, 0x9e, 0x7e, 0xe6, 0x1d, 0x12, 0x31, 0xe1, 0x3b, 0x98, 0xfe, 0xcc, 0x37,
-	0x78, 0x33, 0x96, 0x70, 0x34, 0x0b, 0xe3, 0x2d, 0x27, 0x8e, 0xe7, 0x23, 0xd2, 0x20, 0x41, 0xe2,
-	0x39, 0x3e, 0x4f, 0xaa, 0xa9, 0xea, 0x58, 0xf4, 0xd5, 0x34, 0x18, 0x67, 0xf1, 0xd1, 0x87, 0xe0,
-	0x51, 0xee, 0x5e, 0x5a, 0xf6, 0xe2, 0xd8, 0x0b, 0x36, 0xf5, 0x34, 0x10, 0x5e, 0xb6, 0x29, 0x41,
-	0xea, 0xd1, 0xc5, 0x7c, 0x34, 0xdc, 0xeb, 0x79, 0xf4, 0x0c, 0x54, 0xe3, 0x6d, 0xaf, 0x35, 0x1f,
-	0x35, 0x62, 0x76, 0xb4, 0x54, 0xd5, 0x3e, 0xdd, 0xba, 0x68, 0xc7, 0x0a, 0x03, 0xb9, 0x30, 0xc2,
-	0x3f, 0x09, 0x8f, 0x17, 0x14, 0x12, 0xf4, 0xd9, 0x9e, 0x8a, 0x5c, 0xe4, 0xcf, 0x4e, 0x63, 0xe7,
-	0xf6, 0x05, 0x79, 0xd0, 0xc5, 0xcf, 0x65, 0x6e, 0x18, 0x64, 0x70, 0x8a, 0x68, 0x7a, 0x4f, 0x37,
-	0xdc, 0xc7, 0x9e, 0xee, 0x45, 0x18, 0xde, 0x6e, 0xaf, 0x13, 0x31, 0xf2, 0x42, 0xb0, 0xa9, 0xd9,
-	0x77, 0x55, 0x83, 0xb0, 0x89, 0xc7, 0x42, 0x35, 0x5b, 0x9e, 0xf8, 0x17, 0x4f, 0x8e, 0x1a, 0xa1,
-	0x9a, 0xab, 0x8b, 0xb2, 0x19, 0x9b, 0x38, 0xb4, 0x6b, 0x74, 0x2c, 0xd6, 0x48, 0xcc, 0x32, 0x31,
-	0xe8, 0x70, 0xa9, 0xae, 0xd5, 0x25, 0x00, 0x6b, 0x1c, 0xb4, 0x0a, 0xa7, 0xe8, 0x9f, 0x3a, 0xcb,
-	0x1f, 0xbe, 0xe1, 0xf8, 0x5e, 0x83, 0xc7, 0x0d, 0x8e, 0xa7, 0x9d, 0xa3, 0xf5, 0x1c, 0x1c, 0x9c,
-	0xfb, 0xa4, 0xfd, 0x63, 0xa5, 0xb4, 0xe7, 0xc4, 0x14, 0x61, 0x28, 0xa6, 0x82, 0x2a, 0xb9, 0xe1,
-	0x44, 0xd2, 0xe0, 0x39, 0x62, 0x66, 0x94, 0xa0, 0x7b, 0xc3, 0x89, 0x4c, 0x91, 0xc7, 0x18, 0x60,
-	0xc9, 0x09, 0xdd, 0x82, 0x81, 0xc4, 0x77, 0x0a, 0x4a, 0xa5, 0x34, 0x38, 0x6a, 0x2f, 0xd8, 0xd2,
-	0x6c, 0x8c, 0x19, 0x0f, 0xf4, 0x38, 0xdd, 0xbd, 0xad, 0xcb, 0x63, 0x3a, 0xb1, 0xe1, 0x5a, 0x8f,
-	0x31, 0x6b, 0xb5, 0xff, 0xf6, 0x68, 0x8e, 0xd6, 0x51, 0x86, 0x00, 0x3a, 0x0f, 0x40, 0x27, 0xcd,
-	0x6a, 0x44, 0x36, 0xbc, 0x5d, 0x61, 0x88, 0x29, 0xc9, 0x76, 0x4d, 0x41, 0xb0, 0x81, 0x25, 0x9f,
-	0xa9, 0xb7, 0x37, 0xe8, 0x33, 0xa5, 0xee, 0x67, 0x38, 0x04, 0x1b, 0x58, 0xe8, 0x05, 0x18, 0xf4,
-	0x9a, 0xce, 0xa6, 0x8a, 0x22, 0x7e, 0x9c, 0x8a, 0xb4, 0x45, 0xd6, 0x72, 0x77, 0x6f, 0x6a, 0x4c,
-	0x75, 0x88, 0x35, 0x61, 0x81, 0x8b, 0x7e, 0xda, 0x82, 0x11, 0x37, 0x6c, 0x36, 0xc3, 0x80, 0x6f,
-	0x9f, 0x85, 0x2f, 0xe0, 0xd6, 0x71, 0x99, 0x49, 0xd3, 0xf3, 0x06, 0x33, 0xee, 0x0c, 0x50, 0x39,
-	0x9f, 0x26, 0x08, 0xa7, 0x7a, 0x65, 0x4a, 0xbe, 0xca, 0x01, 0x92, 0xef, 0x17, 0x2c, 0x98, 0xe0,
-	0xcf, 0x1a, 0xbb, 0x7a, 0x91, 0xde, 0x18, 0x1e, 0xf3, 0x6b, 0x75, 0x39, 0x3a, 0x94, 0xa7, 0xb8,
-	0x0b, 0x8e, 0xbb, 0x3b, 0x89, 0x2e, 0xc1, 0xc4, 0x46, 0x18, 0xb9, 0xc4, 0x1c, 0x08, 0x21, 0xb6,
-	0x15, 0xa1, 0x8b, 0x59, 0x04, 0xdc, 0xfd, 0x0c, 0xba, 0x01, 0x8f, 0x18, 0x8d, 0xe6, 0x38, 0x70,
-	0xc9, 0xfd, 0xa4, 0xa0, 0xf6, 0xc8, 0xc5, 0x5c, 0x2c, 0xdc, 0xe3, 0xe9, 0xb4, 0x90, 0xac, 0xf5,
-	0x21, 0x24, 0x5f, 0x87, 0x33, 0x6e, 0xf7, 0xc8, 0xec, 0xc4, 0xed, 0xf5, 0x98, 0xcb, 0xf1, 0xea,
-	0xdc, 0xd7, 0x09, 0x02, 0x67, 0xe6, 0x7b, 0x21, 0xe2, 0xde, 0x34, 0xd0, 0x5b, 0x50, 0x8d, 0x08,
-	0xfb, 0x2a, 0xb1, 0xc8, 0xf5, 0x3b, 0xa2, 0xb7, 0x43, 0x5b, 0xf0, 0x9c, 0xac, 0xd6, 0x4c, 0xa2,
-	0x21, 0xc6, 0x8a, 0x23, 0xba, 0x0d, 0x43, 0x2d, 0x27, 0x71, 0xb7, 0x44, 0x86, 0xdf, 0x91, 0x1d,
-	0xfb, 0x8a, 0x39, 0x3b, 0x87, 0x31, 0xea, 0x25, 0x70, 0x26, 0x58, 0x72, 0xa3, 0xb6, 0x9a, 0x1b,
-	0x36, 0x5b, 0x61, 0x40, 0x82, 0x44, 0x2a, 0x91, 0x31, 0x7e, 0x58, 0x22, 0x5b, 0xb1, 0x81, 0xd1,
-	0xa5, 0xcb, 0x35, 0xda, 0xe4, 0xc4, 0x3e, 0xba, 0xdc, 0xa0, 0xd6, 0xeb, 0x79, 0xaa, 0x6c, 0x98,
-	0x5b, 0xf1, 0xa6, 0x97, 0x6c, 0x85, 0xed, 0x44, 0xee, 0x92, 0x85, 0xa2, 0x52, 0xca, 0x66, 0x29,
-	0x07, 0x07, 0xe7, 0x3e, 0x99, 0xd5, 0xac, 0xe3, 0xf7, 0xa6, 0x59, 0x4f, 0xf4, 0xa1, 0x59, 0xeb,
-	0x70, 0x9a, 0xf5, 0x40, 0x58, 0xc9, 0xd2, 0x69, 0x19, 0x4f, 0x22, 0xd6, 0x79, 0x95, 0x1c, 0xb3,
-	0x94, 0x87, 0x84, 0xf3, 0x9f, 0x3d, 0xfb, 0xed, 0x30, 0xd1, 0x25, 0xe4, 0x0e, 0xe5, 0x90, 0x5c,
-	0x80, 0x47, 0xf2, 0xc5, 0xc9, 0xa1, 0xdc, 0x92, 0x3f, 0x9f, 0x09, 0x6a, 0x37, 0xb6, 0x68, 0x7d,
-	0xb8, 0xb8, 0x1d, 0x28, 0x93, 0x60, 0x47, 0x68, 0xd7, 0x8b, 0x47, 0x9b, 0xd5, 0x17, 0x82, 0x1d,
-	0x2e, 0x0d, 0x99, 0x1f, 0xef, 0x42, 0xb0, 0x83, 0x29, 0x6d, 0xf4, 0xc3, 0x56, 0x6a, 0x03, 0xc1,
-	0x1d, 0xe3, 0x1f, 0x39, 0x96, 0x3d, 0x69, 0xdf, 0x7b, 0x0a, 0xfb, 0xdf, 0x95, 0xe0, 0xdc, 0x41,
-	0x44, 0xfa, 0x18, 0xbe, 0xa7, 0x60, 0x30, 0x66, 0x61, 0x2a, 0x42, 0x5d, 0x0d, 0xd3, 0x55, 0xcc,
-	0x03, 0x57, 0x5e, 0xc7, 0x02, 0x84, 0x7c, 0x28, 0x37, 0x9d, 0x96, 0xf0, 0x97, 0x2e, 0x1e, 0x35,
-	0xf9, 0x8f, 0xfe, 0x77, 0xfc, 0x65, 0xa7, 0xc5, 0xe7, 0xbc, 0xd1, 0x80, 0x29, 0x1b, 0x94, 0x40,
-	0xc5, 0x89, 0x22, 0x47, 0xc6, 0x44, 0x5c, 0x2d, 0x86, 0xdf, 0x2c, 0x25, 0xc9, 0x8f, 0x94, 0x53,
-	0x4d, 0x98, 0x33, 0xb3, 0x7f, 0xb4, 0x9a, 0xca, 0x14, 0x63, 0x81, 0x2e, 0x31, 0x0c, 0x0a, 0x37,
-	0xa9, 0x55, 0x74, 0xce, 0x25, 0x4f, 0xc5, 0x66, 0x1e, 0x08, 0x51, 0xd0, 0x42, 0xb0, 0x42, 0x9f,
-	0xb5, 0x58, 0xd9, 0x08, 0x99, 0x7e, 0x27, 0x76, 0xf5, 0xc7, 0x53, 0xc5, 0xc2, 0x2c, 0x46, 0x21,
-	0x1b, 0xb1, 0xc9, 0x5d, 0x94, 0xc6, 0x61, 0xbb, 0x99, 0xee, 0xd2, 0x38, 0x6c, 0x77, 0x22, 0xe1,
-	0x68, 0x37, 0x27, 0xa0, 0xa5, 0x80, 0xd2, 0x03, 0x7d, 0x84, 0xb0, 0x7c, 0xc9, 0x82, 0x09, 0x2f,
-	0x1b, 0x99, 0x20, 0xf6, 0xc0, 0x37, 0x8b, 0xf1, 0x69, 0x76, 0x07, 0x3e, 0x28, 0x43, 0xa7, 0x0b,
-	0x84, 0xbb, 0x3b, 0x83, 0x1a, 0x30, 0xe0, 0x05, 0x1b, 0xa1, 0x30, 0xef, 0xe6, 0x8e, 0xd6, 0xa9,
-	0xc5, 0x60, 0x23, 0xd4, 0xab, 0x99, 0xfe, 0xc3, 0x8c, 0x3a, 0x5a, 0x82, 0x53, 0x32, 0x59, 0xe8,
-	0xb2, 0x17, 0x27, 0x61, 0xd4, 0x59, 0xf2, 0x9a, 0x5e, 0xc2, 0x4c, 0xb3, 0xf2, 0xdc, 0x24, 0x55,
-	0x6f, 0x38, 0x07, 0x8e, 0x73, 0x9f, 0x42, 0x6f, 0xc2, 0x90, 0x8c, 0x06, 0xa8, 0x16, 0xe1, 0x4f,
-	0xe8, 0x9e, 0xff, 0x6a, 0x32, 0xd5, 0x45, 0x38, 0x80, 0x64, 0x88, 0x3e, 0x63, 0xc1, 0x18, 0xff,
-	0x7d, 0xb9, 0xd3, 0xe0, 0xf9, 0x89, 0xb5, 0x22, 0x42, 0xfe, 0xeb, 0x29, 0x9a, 0x73, 0xe8, 0xce,
-	0xde, 0xd4, 0x58, 0xba, 0x0d, 0x67, 0xf8, 0xda, 0xff, 0x64, 0x04, 0xba, 0xe3, 0x27, 0xd2, 0xc1,
-	0x12, 0xd6, 0xfd, 0x0e, 0x96, 0xa0, 0xbb, 0xca, 0x58, 0xc7, 0x39, 0x14, 0xb0, 0xcc, 0x04, 0x57,
-	0x7d, 0x0c, 0xdd, 0x09, 0x5c, 0xcc, 0x78, 0xa0, 0x36, 0x0c, 0xf2, 0xca, 0x54, 0x42, 0x03, 0x1c,
-	0xfd, 0xe4, 0xdb, 0xac, 0x70, 0xa5, 0xdd, 0x5a, 0xbc, 0x15, 0x0b, 0x66, 0x68, 0x17, 0x86, 0xb6,
-	0xf8, 0x74, 0x14, 0x7b, 0xbd, 0xe5, 0xa3, 0x8e, 0x6f, 0x6a, 0x8e, 0xeb, 0xc9, 0x27, 0x1a, 0xb0,
-	0x64, 0xc7, 0x62, 0xf3, 0x8c, 0xe8, 0x21, 0x2e, 0x48, 0x8a, 0x4b, 0xb5, 0xec, 0x3f, 0x74, 0xe8,
-	0xa3, 0x30, 0x12, 0x11, 0x37, 0x0c, 0x5c, 0xcf, 0x27, 0x8d, 0x59, 0x79, 0x20, 0x76, 0x98, 0x0c,
-	0x3b, 0xe6, 0x4d, 0xc2, 0x06, 0x0d, 0x9c, 0xa2, 0xc8, 0xd6, 0x99, 0xca, 0xba, 0xa7, 0x1f, 0x84,
-	0x88, 0x83, 0x8f, 0xa5, 0x82, 0x72, 0xfc, 0x19, 0x4d, 0xbe, 0xce, 0xd2, 0x6d, 0x38, 0xc3, 0x17,
-	0xbd, 0x0a, 0x10, 0xae, 0xf3, 0x00, 0xbc, 0xd9, 0x44, 0x9c, 0x82, 0x1c, 0xe6, 0x55, 0xc7, 0x78,
-	0xa6, 0xae, 0xa4, 0x80, 0x0d, 0x6a, 0xe8, 0x2a, 0x00, 0x5f, 0x39, 0x6b, 0x9d, 0x96, 0xdc, 0x10,
-	0xca, 0x14, 0x49, 0xa8, 0x2b, 0xc8, 0xdd, 0xbd, 0xa9, 0x6e, 0x9f, 0x33, 0x8b, 0x32, 0x32, 0x1e,
-	0x47, 0xdf, 0x0d, 0x43, 0x71, 0xbb, 0xd9, 0x74, 0xd4, 0x19, 0x49, 0x81, 0xb9, 0xbf, 0x9c, 0xae,
-	0x21, 0x18, 0x79, 0x03, 0x96, 0x1c, 0xd1, 0x2d, 0x2a, 0xe2, 0x85, 0x84, 0xe2, 0xab, 0x88, 0x5b,
-	0x28, 0xdc, 0x13, 0xf8, 0x01, 0xb9, 0x8b, 0xc1, 0x39, 0x38, 0x77, 0xf7, 0xa6, 0x1e, 0x49, 0xb7,
-	0x2f, 0x85, 0x22, 0x1b, 0x37, 0x97, 0x26, 0xba, 0x22, 0x8b, 0x70, 0xd1, 0xd7, 0x96, 0xb5, 0x61,
-	0xde, 0xa7, 0x8b, 0x70, 0xb1, 0xe6, 0xde, 0x63, 0x66, 0x3e, 0x8c, 0x96, 0xe1, 0xa4, 0x1b, 0x06,
-	0x49, 0x14, 0xfa, 0x3e, 0x2f, 0xd0, 0xc7, 0xf7, 0xe6, 0xfc, 0x0c, 0xe5, 0x31, 0xd1, 0xed, 0x93,
-	0xf3, 0xdd, 0x28, 0x38, 0xef, 0x39, 0x6a, 0x93, 0x67, 0xf5, 0xc3, 0x58, 0x21, 0xc7, 0xeb, 0x29,
-	0x9a, 0x42, 0x42, 0x29, 0xb7, 0xf7, 0x01, 0x9a, 0x22, 0x48, 0x1f, 0xb2, 0x8a, 0x2f, 0xf6, 0x02,
-	0x8c, 0x90, 0xdd, 0x84, 0x44, 0x81, 0xe3, 0x5f, 0xc7, 0x4b, 0xf2, 0xc0, 0x82, 0x2d, 0xcc, 0x0b,
-	0x46, 0x3b, 0x4e, 0x61, 0x21, 0x5b, 0x79, 0xc9, 0x8c, 0xb4, 0x77, 0xee, 0x25, 0x93, 0x3e, 0x31,
-	0xfb, 0xe7, 0xca, 0x29, 0x9b, 0xf5, 0x81, 0x1c, 0xe9, 0xb2, 0xfa, 0x4a, 0xb2, 0x10, 0x15, 0x03,
-	0x88, 0xbd, 0x58, 0x91, 0x9c, 0x55, 0xd4, 0xdc, 0x8a, 0xc9, 0x08, 0xa7, 0xf9, 0xa2, 0x6d, 0xa8,
-	0x6c, 0x85, 0x71, 0x22, 0x77, 0x68, 0x47, 0xdc, 0x0c, 0x5e, 0x0e, 0xe3, 0x84, 0x19, 0x5a, 0xea,
-	0xb5, 0x69, 0x4b, 0x8c, 0x39, 0x0f, 0xba, 0xf7, 0x8f, 0xb7, 0x9c, 0xa8, 0x11, 0xcf, 0xb3, 0x22,
-	0x15, 0x03, 0xcc, 0xc2, 0x52, 0xf6, 0x74, 0x5d, 0x83, 0xb0, 0x89, 0x67, 0xff, 0xa9, 0x95, 0x3a,
-	0xd5, 0xba, 0xc9, 0x32, 0x0e, 0x76, 0x48, 0x40, 0x45, 0x94, 0x19, 0xe3, 0xf8, 0xcd, 0x99, 0xfc,
-	0xed, 0xf7, 0xf6, 0xaa, 0xa5, 0x79, 0x9b, 0x52, 0x98, 0x66, 0x24, 0x8c, 0x70, 0xc8, 0x8f, 0x5b,
-	0xe9, 0x44, 0xfc, 0x52, 0x11, 0x5b, 0x37, 0xb3, 0x18, 0xc5, 0x81, 0x39, 0xfd, 0xf6, 0x0f, 0x5b,
-	0x30, 0x34, 0xe7, 0xb8, 0xdb, 0xe1, 0xc6, 0x06, 0x7a, 0x06, 0xaa, 0x8d, 0x76, 0x64, 0xd6, 0x04,
-	0x50, 0xce, 0xaa, 0x05, 0xd1, 0x8e, 0x15, 0x06, 0x9d, 0xfa, 0x1b, 0x8e, 0x2b, 0x4b, 0x52, 0x94,
-	0xf9, 0xd4, 0xbf, 0xc8, 0x5a, 0xb0, 0x80, 0xd0, 0xe1, 0x6f, 0x3a, 0xbb, 0xf2, 0xe1, 0xec, 0x91,
-	0xda, 0xb2, 0x06, 0x61, 0x13, 0xcf, 0xfe, 0x75, 0x0b, 0x26, 0xe7, 0x9c, 0xd8, 0x73, 0x67, 0xdb,
-	0xc9, 0xd6, 0x9c, 0x97, 0xac, 0xb7, 0xdd, 0x6d, 0x92, 0xf0, 0xd2, 0x25, 0xb4, 0x97, 0xed, 0x98,
-	0xae, 0x40, 0xb5, 0x63, 0x56, 0xbd, 0xbc, 0x2e, 0xda, 0xb1, 0xc2, 0x40, 0x6f, 0xc2, 0x70, 0xcb,
-	0x89, 0xe3, 0xdb, 0x61, 0xd4, 0xc0, 0x64, 0xa3, 0x98, 0xe2, 0x46, 0x75, 0xe2, 0x46, 0x24, 0xc1,
-	0x64, 0x43, 0x04, 0xa8, 0x68, 0xfa, 0xd8, 0x64, 0x66, 0xff, 0xa0, 0x05, 0xa7, 0xe6, 0x88, 0x13,
-	0x91, 0x88, 0xd5, 0x42, 0x52, 0x2f, 0x82, 0xde, 0x80, 0x6a, 0x42, 0x5b, 0x68, 0x8f, 0xac, 0x62,
-	0x7b, 0xc4, 0x42, 0x4b, 0xd6, 0x04, 0x71, 0xac, 0xd8, 0xd8, 0x5f, 0xb0, 0xe0, 0x4c, 0x5e, 0x5f,
-	0xe6, 0xfd, 0xb0, 0xdd, 0x78, 0x10, 0x1d, 0xfa, 0xbb, 0x16, 0x8c, 0xb0, 0xe3, 0xfa, 0x05, 0x92,
-	0x38, 0x9e, 0xdf, 0x55, 0x87, 0xd1, 0xea, 0xb3, 0x0e, 0xe3, 0x39, 0x18, 0xd8, 0x0a, 0x9b, 0x24,
-	0x1b, 0x6a, 0x72, 0x39, 0x6c, 0x12, 0xcc, 0x20, 0xe8, 0x39, 0x3a, 0x09, 0xbd, 0x20, 0x71, 0xe8,
-	0x72, 0x94, 0xc7, 0x19, 0xe3, 0x7c, 0x02, 0xaa, 0x66, 0x6c, 0xe2, 0xd8, 0xff, 0xaa, 0x06, 0x43,
-	0x22, 0x2e, 0xaa, 0xef, 0x52, 0x3a, 0xd2, 0x8b, 0x53, 0xea, 0xe9, 0xc5, 0x89, 0x61, 0xd0, 0x65,
-	0xc5, 0x72, 0x85, 0x85, 0x7e, 0xb5, 0x90, 0x40, 0x3a, 0x5e, 0x7f, 0x57, 0x77, 0x8b, 0xff, 0xc7,
-	0x82, 0x15, 0x7a, 0xdb, 0x82, 0x71, 0x37, 0x0c, 0x02, 0xe2, 0x6a, 0xdb, 0x71, 0xa0, 0x88, 0x0d,
-	0xc2, 0x7c, 0x9a, 0xa8, 0x3e, 0x09, 0xce, 0x00, 0x70, 0x96, 0x3d, 0x7a, 0x19, 0x46, 0xf9, 0x98,
-	0xdd, 0x48, 0x9d, 0xc1, 0xe8, 0xf2, 0x7c, 0x26, 0x10, 0xa7, 0x71, 0xd1, 0x34, 0x3f, 0xcb, 0x12,
-	0x85, 0xf0, 0x06, 0xb5, 0xab, 0xda, 0x28, 0x81, 0x67, 0x60, 0xa0, 0x08, 0x50, 0x44, 0x36, 0x22,
-	0x12, 0x6f, 0x89, 0xb8, 0x31, 0x66, 0xb7, 0x0e, 0xdd, 0x5b, 0x11, 0x0c, 0xdc, 0x45, 0x09, 0xe7,
-	0x50, 0x47, 0xdb, 0xc2, 0x8d, 0x50, 0x2d, 0x42, 0x9e, 0x8b, 0xcf, 0xdc, 0xd3, 0x9b, 0x30, 0x05,
-	0x15, 0xa6, 0xba, 0x98, 0xbd, 0x5c, 0xe6, 0x89, 0x97, 0x4c, 0xb1, 0x61, 0xde, 0x8e, 0x16, 0xe0,
-	0x44, 0xa6, 0xb8, 0x60, 0x2c, 0xce, 0x4a, 0x54, 0x92, 0x5d, 0xa6, 0x2c, 0x61, 0x8c, 0xbb, 0x9e,
-	0x30, 0x5d, 0x4c, 0xc3, 0x07, 0xb8, 0x98, 0x3a, 0x2a, 0x3a, 0x99, 0x9f, 0x62, 0xbc, 0x52, 0xc8,
-	0x00, 0xf4, 0x15, 0x8a, 0xfc, 0xf9, 0x4c, 0x28, 0xf2, 0x28, 0xeb, 0xc0, 0x8d, 0x62, 0x3a, 0x70,
-	0xf8, 0xb8, 0xe3, 0x07, 0x19, 0x47, 0xfc, 0xbf, 0x2d, 0x90, 0xdf, 0x75, 0xde, 0x71, 0xb7, 0x08,
-	0x9d, 0x32, 0xe8, 0x83, 0x30, 0xa6, 0xbc, 0x13, 0xdc, 0x24, 0xb2, 0xd8, 0xac, 0x51, 0xb6, 0x33,
-	0x4e, 0x41, 0x71, 0x06, 0x1b, 0xcd, 0x40, 0x8d, 0x8e, 0x13, 0x7f, 0x94, 0xeb, 0x7d, 0xe5, 0x01,
-	0x99, 0x5d, 0x5d, 0x14, 0x4f, 0x69, 0x1c, 0x14, 0xc2, 0x84, 0xef, 0xc4, 0x09, 0xeb, 0x41, 0xbd,
-	0x13, 0xb8, 0xf7, 0x58, 0x82, 0x86, 0x65, 0x72, 0x2d, 0x65, 0x09, 0xe1, 0x6e, 0xda, 0xf6, 0x7f,
-	0xa8, 0xc0, 0x68, 0x4a, 0x32, 0x1e, 0xd2, 0x60, 0x78, 0x06, 0xaa, 0x52, 0x87, 0x67, 0x6b, 0x6d,
-	0x29, 0x45, 0xaf, 0x30, 0xa8, 0xd2, 0x5a, 0xd7, 0x5a, 0x35, 0x6b, 0xe0, 0x18, 0x0a, 0x17, 0x9b,
-	0x78, 0x4c, 0x28, 0x27, 0x7e, 0x3c, 0xef, 0x7b, 0x24, 0x48, 0x78, 0x37, 0x8b, 0x11, 0xca, 0x6b,
-	0x4b, 0x75, 0x93, 0xa8, 0x16, 0xca, 0x19, 0x00, 0xce, 0xb2, 0x47, 0x9f, 0xb2, 0x60, 0xd4, 0xb9,
-	0x1d, 0xeb, 0x8a, 0xee, 0x22, 0xe8, 0xf8, 0x88, 0x4a, 0x2a, 0x55, 0x24, 0x9e, 0x3b, 0xf6, 0x53,
-	0x4d, 0x38, 0xcd, 0x14, 0xbd, 0x63, 0x01, 0x22, 0xbb, 0xc4, 0x95, 0x61, 0xd1, 0xa2, 0x2f, 0x83,
-	0x45, 0xec, 0xe0, 0x2f, 0x74, 0xd1, 0xe5, 0x52, 0xbd, 0xbb, 0x1d, 0xe7, 0xf4, 0x01, 0x5d, 0x01,
-	0xd4, 0xf0, 0x62, 0x67, 0xdd, 0x27, 0xf3, 0x61, 0x53, 0x66, 0x1f, 0x8b, 0xf3, 0xf4, 0xb3, 0x62,
-	0x9c, 0xd1, 0x42, 0x17, 0x06, 0xce, 0x79, 0x8a, 0xcd, 0xb2, 0x28, 0xdc, 0xed, 0x5c, 0x8f, 0x7c,
-	0xa6, 0x25, 0xcc, 0x59, 0x26, 0xda, 0xb1, 0xc2, 0xb0, 0xff, 0xac, 0xac, 0x96, 0xb2, 0xce, 0x01,
-	0x70, 0x8c, 0x58, 0x64, 0xeb, 0xde, 0x63, 0x91, 0x75, 0xa4, 0x54, 0x77, 0x4e, 0x7d, 0x2a, 0x05,
-	0xb7, 0xf4, 0x80, 0x52, 0x70, 0xbf, 0xcf, 0x4a, 0xd5, 0xb3, 0x1b, 0x3e, 0xff, 0x6a, 0xb1, 0xf9,
-	0x07, 0xd3, 0x3c, 0x8a, 0x2b, 0xa3, 0x57, 0x32, 0xc1, 0x7b, 0xcf, 0x40, 0x75, 0xc3, 0x77, 0x58,
-	0x15, 0x16, 0xb6, 0x50, 0x8d, 0x08, 0xb3, 0x8b, 0xa2, 0x1d, 0x2b, 0x0c, 0x2a, 0xf5, 0x0d, 0xa2,
-	0x87, 0x92, 0xda, 0xff, 0xb9, 0x0c, 0xc3, 0x86, 0xc6, 0xcf, 0x35, 0xdf, 0xac, 0x87, 0xcc, 0x7c,
-	0x2b, 0x1d, 0xc2, 0x7c, 0xfb, 0x5e, 0xa8, 0xb9, 0x52, 0x1b, 0x15, 0x53, 0x9f, 0x3f, 0xab, 0xe3,
-	0xb4, 0x42, 0x52, 0x4d, 0x58, 0xf3, 0x44, 0x97, 0x52, 0x69, 0x9e, 0x29, 0xbf, 0x40, 0x5e, 0x1e,
-	0xa6, 0xd0, 0x68, 0xdd, 0xcf, 0x64, 0xe3, 0x03, 0x2a, 0x07, 0xc7, 0x07, 0xd8, 0x7f, 0x60, 0xa9,
-	0x8f, 0x7b, 0x1f, 0xea, 0xf9, 0xdc, 0x4a, 0xd7, 0xf3, 0xb9, 0x50, 0xc8, 0x30, 0xf7, 0x28, 0xe4,
-	0x73, 0x0d, 0x86, 0xe6, 0xc3, 0x66, 0xd3, 0x09, 0x1a, 0xe8, 0x1b, 0x60, 0xc8, 0xe5, 0x3f, 0x85,
-	0x0f, 0x8d, 0x1d, 0x56, 0x0b, 0x28, 0x96, 0x30, 0xf4, 0x38, 0x0c, 0x38, 0xd1, 0xa6, 0xf4, 0x9b,
-	0xb1, 0x20, 0xb8, 0xd9, 0x68, 0x33, 0xc6, 0xac, 0xd5, 0xfe, 0x0b, 0x0b, 0xc6, 0xe8, 0x23, 0x1e,
-	0x7b, 0x29, 0xf6, 0x3a, 0x4f, 0xc3, 0xa0, 0xd3, 0x4e, 0xb6, 0xc2, 0xae, 0x7d, 0xd8, 0x2c, 0x6b,
-	0xc5, 0x02, 0x4a, 0xf7, 0x61, 0xaa, 0x10, 0x84, 0xb1, 0x0f, 0x5b, 0xa0, 0x73, 0x99, 0x41, 0xa8,
-	0x29, 0x1b, 0xb7, 0xd7, 0xf3, 0x4e, 0x4b, 0xeb, 0xbc, 0x19, 0x4b, 0x38, 0x25, 0xb6, 0x1e, 0x36,
-	0x3a, 0x22, 0xb4, 0x57, 0x11, 0x9b, 0x0b, 0x1b, 0x1d, 0xcc, 0x20, 0xe8, 0x09, 0x28, 0xc7, 0x5b,
-	0x8e, 0x3c, 0x97, 0x97, 0x51, 0xe6, 0xf5, 0xcb, 0xb3, 0x98, 0xb6, 0xab, 0xa4, 0x89, 0xc8, 0xcf,
-	0xc6, 0xd8, 0xa6, 0x93, 0x26, 0x22, 0xdf, 0xfe, 0x17, 0x03, 0xc0, 0xe2, 0x6d, 0x9c, 0x88, 0x34,
-	0xd6, 0x42, 0x56, 0x4a, 0xf8, 0x58, 0x8f, 0xb5, 0xf5, 0x46, 0xf6, 0x61, 0x3e, 0xda, 0x36, 0x8e,
-	0x37, 0xcb, 0xf7, 0xfb, 0x78, 0x33, 0xff, 0xc4, 0x7a, 0xe0, 0x21, 0x3a, 0xb1, 0xb6, 0x3f, 0x67,
-	0x01, 0x52, 0xd1, 0x53, 0x3a, 0xa4, 0x64, 0x06, 0x6a, 0x2a, 0x5c, 0x4b, 0xac, 0x17, 0x2d, 0x16,
-	0x25, 0x00, 0x6b, 0x9c, 0x3e, 0xbc, 0x17, 0x4f, 0x49, 0x9d, 0x55, 0x4e, 0xe7, 0x5c, 0x30, 0x4d,
-	0x27, 0x54, 0x98, 0xfd, 0xab, 0x25, 0x78, 0x84, 0x9b, 0x4b, 0xcb, 0x4e, 0xe0, 0x6c, 0x92, 0x26,
-	0xed, 0x55, 0xbf, 0x41, 0x42, 0x2e, 0xdd, 0x36, 0x7b, 0x32, 0x43, 0xe2, 0xa8, 0xf2, 0x8a, 0xcb,
-	0x19, 0x2e, 0x59, 0x16, 0x03, 0x2f, 0xc1, 0x8c, 0x38, 0x8a, 0xa1, 0x2a, 0x2f, 0x33, 0x12, 0xfa,
-	0xa7, 0x20, 0x46, 0x4a, 0x14, 0x0b, 0xcb, 0x82, 0x60, 0xc5, 0x88, 0x9a, 0x0f, 0x7e, 0xe8, 0x6e,
-	0xd3, 0x25, 0x9f, 0x35, 0x1f, 0x96, 0x44, 0x3b, 0x56, 0x18, 0x76, 0x13, 0xc6, 0xe5, 0x18, 0xb6,
-	0xae, 0x92, 0x0e, 0x26, 0x1b, 0x54, 0xe7, 0xba, 0xb2, 0xc9, 0xb8, 0x5f, 0x49, 0xe9, 0xdc, 0x79,
-	0x13, 0x88, 0xd3, 0xb8, 0xb2, 0xba, 0x70, 0x29, 0xbf, 0xba, 0xb0, 0xfd, 0xab, 0x16, 0x64, 0x95,
-	0xbe, 0x51, 0x4b, 0xd5, 0xda, 0xb7, 0x96, 0xea, 0x21, 0xaa, 0x91, 0x7e, 0x17, 0x0c, 0x3b, 0x09,
-	0xb5, 0xea, 0xb8, 0x07, 0xa6, 0x7c, 0x6f, 0x27, 0x87, 0xcb, 0x61, 0xc3, 0xdb, 0xf0, 0x98, 0xe7,
-	0xc5, 0x24, 0x67, 0xbf, 0x63, 0x41, 0x6d, 0x21, 0xea, 0x1c, 0x3e, 0x55, 0xad, 0x3b, 0x11, 0xad,
-	0x74, 0xa8, 0x44, 0x34, 0x99, 0xea, 0x56, 0xee, 0x95, 0xea, 0x66, 0xff, 0xe5, 0x00, 0x4c, 0x74,
-	0xe5, 0x5e, 0xa2, 0x97, 0x60, 0x44, 0x7d, 0x25, 0xe9, 0x76, 0xad, 0x99, 0xc1, 0xcb, 0x1a, 0x86,
-	0x53, 0x98, 0x7d, 0x2c, 0xd5, 0x45, 0x38, 0x19, 0x91, 0x37, 0xda, 0xa4, 0x4d, 0x66, 0x37, 0x12,
-	0x12, 0xd5, 0x89, 0x1b, 0x06, 0x0d, 0x5e, 0x8c, 0xb8, 0x3c, 0xf7, 0xe8, 0x9d, 0xbd, 0xa9, 0x93,
-	0xb8, 0x1b, 0x8c, 0xf3, 0x9e, 0x41, 0x2d, 0x18, 0xf5, 0xcd, 0xfd, 0x82, 0xd8, 0xa6, 0xde, 0xd3,
-	0x56, 0x43, 0xcd, 0xd6, 0x54, 0x33, 0x4e, 0x33, 0x48, 0x6f, 0x3a, 0x2a, 0x0f, 0x68, 0xd3, 0xf1,
-	0x49, 0xbd, 0xe9, 0xe0, 0xb1, 0x40, 0x1f, 0x2e, 0x38, 0xf7, 0xb6, 0x9f, 0x5d, 0xc7, 0x51, 0xf6,
-	0x11, 0xaf, 0x40, 0x55, 0xc6, 0x49, 0xf6, 0x15, 0x5f, 0x68, 0xd2, 0xe9, 0x21, 0xdb, 0xef, 0x96,
-	0x20, 0x67, 0xab, 0x4c, 0xd7, 0x9a, 0xb6, 0xf7, 0x52, 0x6b, 0xed, 0x70, 0x36, 0x1f, 0xda, 0xe5,
-	0x31, 0xa2, 0x5c, 0xcb, 0x7f, 0xa8, 0xe8, 0xad, 0xbe, 0x0e, 0x1b, 0x55, 0x12, 0x50, 0x85, 0x8e,
-	0x9e, 0x07, 0xd0, 0x66, 0xba, 0xb0, 0xf5, 0x54, 0xd0, 0x87, 0xb6, 0xe6, 0xb1, 0x81, 0x85, 0x5e,
-	0x84, 0x61, 0x2f, 0x88, 0x13, 0xc7, 0xf7, 0x2f, 0x7b, 0x41, 0x22, 0xec, 0x3f, 0x65, 0xce, 0x2c,
-	0x6a, 0x10, 0x36, 0xf1, 0xce, 0x7e, 0xc0, 0xf8, 0x2e, 0x87, 0xf9, 0x9e, 0x5b, 0x70, 0xe6, 0x92,
-	0x97, 0xa8, 0xe4, 0x43, 0x35, 0x8f, 0xa8, 0x15, 0xae, 0x64, 0x90, 0xd5, 0x33, 0xdd, 0xd6, 0x48,
-	0xfe, 0x2b, 0xa5, 0x73, 0x15, 0xb3, 0xc9, 0x7f, 0xb6, 0x0b, 0xa7, 0x2e, 0x79, 0xc9, 0x45, 0xcf,
-	0x27, 0xc7, 0xc8, 0xe4, 0x57, 0x06, 0x61, 0xc4, 0xcc, 0xc9, 0x3f, 0x8c, 0xc4, 0xfe, 0x02, 0xb5,
-	0x4f, 0xc5, 0x40, 0x78, 0xea, 0x20, 0xfb, 0xe6, 0x91, 0x0b, 0x04, 0xe4, 0x0f, 0xae, 0x61, 0xa2,
-	0x6a, 0x9e, 0xd8, 0xec, 0x00, 0xba, 0x0d, 0x95, 0x0d, 0x96, 0xc7, 0x56, 0x2e, 0x22, 0x04, 0x29,
-	0x6f, 0xf0, 0xf5, 0x8a, 0xe4, 0x99, 0x70, 0x9c, 0x1f, 0x35, 0x2b, 0xa2, 0x74, 0xfa, 0xb4, 0x91,
-	0x5d, 0x20, 0xf4, 0x95, 0xc2, 0xe8, 0xa5, 0x15, 0x2a, 0xf7, 0xa0, 0x15, 0x52, 0x32, 0x7a, 0xf0,
-	0x01, 0xc9, 0x68, 0x96, 0x93, 0x98, 0x6c, 0x31, 0xa3, 0x57, 0xa4, 0x43, 0x0d, 0xb1, 0x41, 0x30,
-	0x72, 0x12, 0x53, 0x60, 0x9c, 0xc5, 0x47, 0x1f, 0x53, 0x52, 0xbe, 0x5a, 0xc4, 0x41, 0x81, 0x39,
-	0xa3, 0x8f, 0x5b, 0xc0, 0x7f, 0xae, 0x04, 0x63, 0x97, 0x82, 0xf6, 0xea, 0xa5, 0xd5, 0xf6, 0xba,
-	0xef, 0xb9, 0x57, 0x49, 0x87, 0x4a, 0xf1, 0x6d, 0xd2, 0x59, 0x5c, 0x10, 0x2b, 0x48, 0xcd, 0x99,
-	0xab, 0xb4, 0x11, 0x73, 0x18, 0x95, 0x5b, 0x1b, 0x5e, 0xb0, 0x49, 0xa2, 0x56, 0xe4, 0x09, 0x1f,
-	0xbe, 0x21, 0xb7, 0x2e, 0x6a, 0x10, 0x36, 0xf1, 0x28, 0xed, 0xf0, 0x76, 0xa0, 0x0a, 0x24, 0x29,
-	0xda, 0x2b, 0xb4, 0x11, 0x73, 0x18, 0x45, 0x4a, 0xa2, 0xb6, 0x70, 0x91, 0x19, 0x48, 0x6b, 0xb4,
-	0x11, 0x73, 0x98, 0xd8, 0x7d, 0xb3, 0x08, 0xaf, 0x4a, 0xd7, 0xee, 0x9b, 0x05, 0x47, 0x48, 0x38,
-	0x45, 0xdd, 0x26, 0x9d, 0x05, 0x27, 0x71, 0xb2, 0x9b, 0xe7, 0xab, 0xbc, 0x19, 0x4b, 0x38, 0xab,
-	0x98, 0x9c, 0x1e, 0x8e, 0xaf, 0xba, 0x8a, 0xc9, 0xe9, 0xee, 0xf7, 0x70, 0xb4, 0xfc, 0x9d, 0x12,
-	0x8c, 0xbc, 0x7b, 0xad, 0x69, 0xce, 0x85, 0x3d, 0x37, 0x61, 0xa2, 0x2b, 0x13, 0xba, 0x0f, 0xcb,
-	0xe7, 0xc0, 0x4a, 0x15, 0x36, 0x86, 0x61, 0x4a, 0x58, 0x56, 0x0a, 0x9c, 0x87, 0x09, 0xbe, 0x78,
-	0x29, 0x27, 0x96, 0xd8, 0xaa, 0xb2, 0xdb, 0xd9, 0x21, 0xd5, 0x8d, 0x2c, 0x10, 0x77, 0xe3, 0xdb,
-	0x9f, 0xb7, 0x60, 0x34, 0x95, 0x9c, 0x5e, 0x90, 0x8d, 0xc6, 0x56, 0x77, 0xc8, 0xa2, 0x93, 0x59,
-	0xb6, 0x48, 0x99, 0xa9, 0x61, 0xbd, 0xba, 0x35, 0x08, 0x9b, 0x78, 0xf6, 0x6f, 0x95, 0xa1, 0x2a,
-	0x23, 0xa9, 0xfa, 0xe8, 0xca, 0x67, 0x2d, 0x18, 0x55, 0x07, 0x83, 0xcc, 0x93, 0x5b, 0x2a, 0x22,
-	0x57, 0x8e, 0xf6, 0x40, 0xf9, 0x45, 0x82, 0x8d, 0x50, 0x6f, 0x18, 0xb0, 0xc9, 0x0c, 0xa7, 0x79,
-	0xa3, 0x1b, 0x00, 0x71, 0x27, 0x4e, 0x48, 0xd3, 0xf0, 0x29, 0xdb, 0xc6, 0x2c, 0x9b, 0x76, 0xc3,
-	0x88, 0xd0, 0x39, 0x75, 0x2d, 0x6c, 0x90, 0xba, 0xc2, 0xd4, 0x16, 0x9e, 0x6e, 0xc3, 0x06, 0x25,
-	0xf4, 0xa6, 0x3a, 0xc6, 0x1e, 0x28, 0x42, 0xaf, 0xcb, 0xf1, 0xed, 0xe7, 0x1c, 0xfb, 0x08, 0xe7,
-	0xc6, 0xf6, 0xcf, 0x96, 0xe0, 0x44, 0x76, 0x24, 0xd1, 0x87, 0x61, 0x44, 0x0e, 0x9a, 0xe1, 0x3e,
-	0x90, 0xe1, 0x6b, 0x23, 0xd8, 0x80, 0xdd, 0xdd, 0x9b, 0x9a, 0xea, 0xbe, 0x1f, 0x7b, 0xda, 0x44,
-	0xc1, 0x29, 0x62, 0xfc, 0x50, 0x59, 0x44, 0x3f, 0xcc, 0x75, 0x66, 0x5b, 0x2d, 0x71, 0x32, 0x6c,
-	0x1c, 0x2a, 0x9b, 0x50, 0x9c, 0xc1, 0x46, 0xab, 0x70, 0xca, 0x68, 0xb9, 0x46, 0xbc, 0xcd, 0xad,
-	0xf5, 0x30, 0x92, 0xfb, 0xd5, 0xc7, 0x75, 0xb0, 0x6c, 0x37, 0x0e, 0xce, 0x7d, 0x92, 0x1a, 0x46,
-	0xae, 0xd3, 0x72, 0x5c, 0x2f, 0xe9, 0x08, 0xdf, 0xbe, 0x12, 0xe3, 0xf3, 0xa2, 0x1d, 0x2b, 0x0c,
-	0xfb, 0x1f, 0x0e, 0xc0, 0x09, 0x1e, 0x1d, 0x4a, 0x54, 0xf0, 0x33, 0xfa, 0x30, 0xd4, 0xe2, 0xc4,
-	0x89, 0xb8, 0xb3, 0xc2, 0x3a, 0xb4, 0xe8, 0xd2, 0x19, 0xf5, 0x92, 0x08, 0xd6, 0xf4, 0xd0, 0xab,
-	0xac, 0x1c, 0x99, 0x17, 0x6f, 0x31, 0xea, 0xa5, 0x7b, 0x73, 0x85, 0x5c, 0x54, 0x14, 0xb0, 0x41,
-	0x0d, 0x7d, 0x2b, 0x54, 0x5a, 0x5b, 0x4e, 0x2c, 0xfd, 0x74, 0x4f, 0x4b, 0x39, 0xb1, 0x4a, 0x1b,
-	0xef, 0xee, 0x4d, 0x9d, 0xce, 0xbe, 0x2a, 0x03, 0x60, 0xfe, 0x90, 0x29, 0xe5, 0x07, 0x0e, 0xbe,
-	0x6f, 0xa7, 0x11, 0x75, 0xea, 0x97, 0x67, 0xb3, 0x37, 0xb4, 0x2c, 0xb0, 0x56, 0x2c, 0xa0, 0x54,
-	0x26, 0x6d, 0x71, 0x96, 0x0d, 0x8a, 0x3c, 0x98, 0xb6, 0x38, 0x2e, 0x6b, 0x10, 0x36, 0xf1, 0xd0,
-	0xe7, 0xba, 0x63, 0x87, 0x87, 0x8e, 0x21, 0xb7, 0xa4, 0xdf, 0xa8, 0xe1, 0x0b, 0x50, 0x13, 0x5d,
-	0x5d, 0x0b, 0xd1, 0x4b, 0x30, 0xc2, 0xdd, 0x40, 0x73, 0x91, 0x13, 0xb8, 0x5b, 0x59, 0xe7, 0xcd,
-	0x9a, 0x01, 0xc3, 0x29, 0x4c, 0x7b, 0x19, 0x06, 0xfa, 0x14, 0xb2, 0x7d, 0xed, 0xc9, 0x5f, 0x81,
-	0x2a, 0x25, 0x27, 0x37, 0x68, 0x45, 0x90, 0x0c, 0xa1, 0x2a, 0x6f, 0x6f, 0x44, 0x36, 0x94, 0x3d,
-	0x47, 0xc6, 0x88, 0xa8, 0x25, 0xb4, 0x18, 0xc7, 0x6d, 0x36, 0xed, 0x28, 0x10, 0x3d, 0x05, 0x65,
-	0xb2, 0xdb, 0xca, 0x06, 0x83, 0x5c, 0xd8, 0x6d, 0x79, 0x11, 0x89, 0x29, 0x12, 0xd9, 0x6d, 0xa1,
-	0xb3, 0x50, 0xf2, 0x1a, 0x62, 0x46, 0x82, 0xc0, 0x29, 0x2d, 0x2e, 0xe0, 0x92, 0xd7, 0xb0, 0x77,
-	0xa1, 0xa6, 0xae, 0x8b, 0x44, 0xdb, 0xd2, 0xa4, 0xb2, 0x8a, 0x88, 0x0e, 0x96, 0x74, 0x7b, 0x18,
-	0x53, 0x6d, 0x00, 0x5d, 0xaa, 0xa1, 0x28, 0x15, 0x7c, 0x0e, 0x06, 0xdc, 0x50, 0x14, 0xd9, 0xa9,
-	0x6a, 0x32, 0xcc, 0x96, 0x62, 0x10, 0xfb, 0x26, 0x8c, 0x5d, 0x0d, 0xc2, 0xdb, 0xec, 0x56, 0x27,
-	0x56, 0xc4, 0x98, 0x12, 0xde, 0xa0, 0x3f, 0xb2, 0x96, 0x3b, 0x83, 0x62, 0x0e, 0x53, 0xe5, 0x55,
-	0x4b, 0xbd, 0xca, 0xab, 0xda, 0x1f, 0xb7, 0x60, 0x44, 0xe5, 0x7c, 0x5f, 0xda, 0xd9, 0xa6, 0x74,
-	0x37, 0xa3, 0xb0, 0xdd, 0xca, 0xd2, 0x65, 0x37, 0xd3, 0x62, 0x0e, 0x33, 0x8b, 0x21, 0x94, 0x0e,
-	0x28, 0x86, 0x70, 0x0e, 0x06, 0xb6, 0xbd, 0xa0, 0x91, 0x75, 0x76, 0x5e, 0xf5, 0x82, 0x06, 0x66,
-	0x10, 0xfb, 0xaf, 0x2c, 0x38, 0xa1, 0xba, 0x20, 0x6d, 0xa6, 0x97, 0x60, 0x64, 0xbd, 0xed, 0xf9,
-	0x0d, 0x59, 0x9d, 0x39, 0xb3, 0x5c, 0xe6, 0x0c, 0x18, 0x4e, 0x61, 0xa2, 0xf3, 0x00, 0xeb, 0x5e,
-	0xe0, 0x44, 0x9d, 0x55, 0x6d, 0xa4, 0x29, 0xbd, 0x3d, 0xa7, 0x20, 0xd8, 0xc0, 0x42, 0x6f, 0x41,
-	0x75, 0x47, 0x9e, 0xca, 0x96, 0x0b, 0xcd, 0xe1, 0x17, 0xe3, 0xa1, 0x57, 0x82, 0x3a, 0xe6, 0x55,
-	0x1c, 0xed, 0x2f, 0x96, 0x61, 0x2c, 0x9d, 0x77, 0xdf, 0x87, 0xe7, 0xe4, 0x29, 0xa8, 0xb0, 0x54,
-	0xfc, 0xec, 0xc4, 0xe2, 0xe5, 0x94, 0x39, 0x0c, 0xc5, 0x30, 0xc8, 0x45, 0x49, 0x31, 0x77, 0x8b,
-	0xaa, 0x4e, 0x2a, 0xff, 0x2c, 0x8b, 0xe0, 0x16, 0xee, 0x6e, 0xc1, 0x0a, 0x7d, 0xca, 0x82, 0xa1,
-	0xb0, 0x65, 0xd6, 0xf5, 0xfc, 0x50, 0x91, 0x35, 0x09, 0x44, 0xe2, 0xaf, 0xb0, 0x86, 0xd4, 0xc4,
-	0x93, 0x93, 0x41, 0xb2, 0x3e, 0xfb, 0x2d, 0x30, 0x62, 0x62, 0x1e, 0x64, 0x10, 0x55, 0x4d, 0x83,
-	0xe8, 0xb3, 0xe6, 0x94, 0x14, 0x55, 0x17, 0xfa, 0x58, 0xec, 0xd7, 0xa1, 0xe2, 0xaa, 0x30, 0xb7,
-	0x7b, 0xba, 0x51, 0x40, 0x55, 0x25, 0x63, 0x21, 0x04, 0x9c, 0x9a, 0xfd, 0x07, 0x96, 0x31, 0x3f,
-	0x30, 0x89, 0x17, 0x1b, 0x28, 0x82, 0xf2, 0xe6, 0xce, 0xb6, 0x30, 0x32, 0xae, 0x14, 0x34, 0xbc,
-	0x97, 0x76, 0xb6, 0xf5, 0x0a, 0x33, 0x5b, 0x31, 0x65, 0xd6, 0xc7, 0x21, 0x42, 0xaa, 0x38, 0x47,
-	0xf9, 0xe0, 0xe2, 0x1c, 0xf6, 0x3b, 0x25, 0x98, 0xe8, 0x9a, 0x54, 0xe8, 0x4d, 0xa8, 0x44, 0xf4,
-	0x2d, 0xc5, 0xeb, 0x2d, 0x15, 0x56, 0x4e, 0x23, 0x5e, 0x6c, 0x68, 0xe5, 0x9d, 0x6e, 0xc7, 0x9c,
-	0x25, 0xba, 0x02, 0x48, 0x07, 0x63, 0xaa, 0x13, 0x0c, 0xfe, 0xca, 0x2a, 0x62, 0x6b, 0xb6, 0x0b,
-	0x03, 0xe7, 0x3c, 0x85, 0x5e, 0xce, 0x1e, 0x84, 0x64, 0x2a, 0x45, 0xef, 0x77, 0xa6, 0x61, 0xbf,
-	0x6d, 0x4e, 0xc1, 0x1b, 0x5a, 0x98, 0x1e, 0x75, 0x73, 0xda, 0x25, 0x59, 0xcb, 0xfd, 0x4a, 0x56,
-	0xfb, 0x97, 0x4a, 0x30, 0x9a, 0xaa, 0xfc, 0x8a, 0x7c, 0xa8, 0x12, 0x9f, 0x9d, 0xd8, 0x4a, 0xed,
-	0x7b, 0xd4, 0x4b, 0x60, 0x94, 0x9c, 0xbc, 0x20, 0xe8, 0x62, 0xc5, 0xe1, 0xe1, 0x88, 0x2d, 0x7b,
-	0x09, 0x46, 0x64, 0x87, 0x3e, 0xe4, 0x34, 0xfd, 0xec, 0xf0, 0x5d, 0x30, 0x60, 0x38, 0x85, 0x69,
-	0xff, 0x5a, 0x19, 0x26, 0xf9, 0x11, 0x77, 0x43, 0x2d, 0x06, 0x15, 0xaa, 0xf2, 0x43, 0xba, 0x3e,
-	0xb3, 0x55, 0xc4, 0x4d, 0xe7, 0xbd, 0x18, 0xf5, 0x15, 0x12, 0xfd, 0x13, 0x99, 0x90, 0x68, 0xbe,
-	0x55, 0xdf, 0x3c, 0xa6, 0x1e, 0x7d, 0x75, 0xc5, 0x48, 0xff, 0xd3, 0x12, 0x8c, 0x67, 0x2e, 0xb4,
-	0x43, 0x5f, 0x4c, 0xdf, 0x81, 0x62, 0x15, 0x71, 0xfc, 0xb7, 0xef, 0x1d, 0x67, 0x87, 0xbb, 0x09,
-	0xe5, 0x01, 0x2d, 0x15, 0xfb, 0xf7, 0x4a, 0x30, 0x96, 0xbe, 0x89, 0xef, 0x21, 0x1c, 0xa9, 0xf7,
-	0x43, 0x8d, 0x5d, 0x36, 0x75, 0x95, 0x74, 0xe4, 0x29, 0x23, 0xbf, 0xd7, 0x47, 0x36, 0x62, 0x0d,
-	0x7f, 0x28, 0x2e, 0x98, 0xb1, 0xff, 0x99, 0x05, 0xa7, 0xf9, 0x5b, 0x66, 0xe7, 0xe1, 0xdf, 0xca,
-	0x1b, 0xdd, 0xd7, 0x8a, 0xed, 0x60, 0xa6, 0xae, 0xf8, 0x41, 0xe3, 0xcb, 0xee, 0x7b, 0x17, 0xbd,
-	0x4d, 0x4f, 0x85, 0x87, 0xb0, 0xb3, 0x87, 0x9a, 0x0c, 0xf6, 0x7f, 0x2c, 0xc1, 0xf0, 0xca, 0xfc,
-	0xa2, 0x12, 0xe1, 0x33, 0x50, 0x73, 0x23, 0xe2, 0x68, 0xf7, 0x8f, 0x19, 0x40, 0x25, 0x01, 0x58,
-	0xe3, 0xd0, 0x5d, 0x14, 0x0f, 0x40, 0x8c, 0xb3, 0xbb, 0x28, 0x1e, 0x9f, 0x18, 0x63, 0x09, 0x47,
-	0xcf, 0x40, 0x95, 0xa5, 0x06, 0x5f, 0x8f, 0xa4, 0xc6, 0xd1, 0x5b, 0x6b, 0xd6, 0x8e, 0x97, 0xb0,
-	0xc2, 0xa0, 0x84, 0x1b, 0xa1, 0x1b, 0x53, 0xe4, 0x8c, 0x47, 0x66, 0x81, 0x36, 0xe3, 0x25, 0x2c,
-	0xe1, 0xac, 0xb2, 0x23, 0xf3, 0x5a, 0x50, 0xe4, 0x4a, 0xba, 0xd3, 0xdc, 0xbd, 0x41, 0xd1, 0x35,
-	0xce, 0x61, 0x2a, 0x80, 0x66, 0xd2, 0xf3, 0x86, 0xfa, 0x4b, 0xcf, 0xb3, 0x7f, 0xaf, 0x0c, 0x35,
-	0xed, 0x54, 0xf3, 0x44, 0x3d, 0x8c, 0x42, 0xea, 0xd6, 0xd7, 0x3b, 0x81, 0xab, 0x48, 0xf3, 0x68,
-	0x02, 0xa3, 0x1c, 0xc6, 0x0f, 0x58, 0x30, 0xec, 0x05, 0x5e, 0xe2, 0x39, 0xcc, 0x37, 0x58, 0xcc,
-	0xfd, 0xdf, 0x8a, 0xdd, 0x22, 0xa7, 0x1c, 0x46, 0xe6, 0x91, 0xbf, 0x62, 0x86, 0x4d, 0xce, 0xe8,
-	0xa3, 0x22, 0x1b, 0xac, 0x5c, 0x58, 0x51, 0x99, 0x6a, 0x26, 0x05, 0xac, 0x45, 0x6d, 0xec, 0x24,
-	0x2a, 0xa8, 0x16, 0x13, 0xa6, 0xa4, 0xd4, 0xfd, 0x29, 0x6a, 0x17, 0xc3, 0x9a, 0x31, 0x67, 0x64,
-	0xc7, 0x80, 0xba, 0xc7, 0xe2, 0x90, 0x99, 0x36, 0x33, 0x50, 0x73, 0xda, 0x49, 0xd8, 0xa4, 0xc3,
-	0x24, 0x02, 0x06, 0x74, 0x2e, 0x91, 0x04, 0x60, 0x8d, 0x63, 0x7f, 0xb1, 0x02, 0x99, 0xea, 0x14,
-	0x68, 0x17, 0x6a, 0xaa, 0x3e, 0x45, 0x31, 0x99, 0xab, 0x7a, 0x46, 0xa9, 0xce, 0xa8, 0x26, 0xac,
-	0x99, 0xa1, 0x4d, 0xe9, 0x66, 0xe5, 0xab, 0xfd, 0x95, 0xac, 0x9b, 0xf5, 0x3b, 0xfa, 0x3b, 0x75,
-	0xa3, 0x73, 0x75, 0x86, 0xd7, 0x23, 0x9c, 0x3e, 0xd0, 0x23, 0x7b, 0xd0, 0x0d, 0xe8, 0x9f, 0x10,
-	0xb7, 0x95, 0x61, 0x12, 0xb7, 0xfd, 0x44, 0xcc, 0x86, 0x57, 0x0a, 0x5c, 0x65, 0x9c, 0xb0, 0xae,
-	0xf2, 0xc4, 0xff, 0x63, 0x83, 0x69, 0xda, 0x6f, 0x3e, 0x78, 0xac, 0x7e, 0xf3, 0xa1, 0x42, 0xfd,
-	0xe6, 0xe7, 0x01, 0xd8, 0xdc, 0xe6, 0x19, 0x01, 0x55, 0xe6, 0xce, 0x54, 0x2a, 0x06, 0x2b, 0x08,
-	0x36, 0xb0, 0xec, 0x6f, 0x82, 0x74, 0x99, 0x32, 0x34, 0x25, 0xab, 0xa2, 0xf1, 0x13, 0x41, 0x96,
-	0x8c, 0x99, 0x2a, 0x60, 0xf6, 0x0b, 0x16, 0x98, 0xb5, 0xd4, 0xd0, 0x1b, 0xbc, 0x68, 0x9b, 0x55,
-	0xc4, 0x09, 0x93, 0x41, 0x77, 0x7a, 0xd9, 0x69, 0x65, 0xa2, 0x9d, 0x64, 0xe5, 0xb6, 0xb3, 0x1f,
-	0x80, 0xaa, 0x84, 0x1e, 0xca, 0x58, 0xfe, 0x18, 0x9c, 0x94, 0x85, 0x1d, 0xe4, 0x61, 0x90, 0x88,
-	0x3a, 0x38, 0xd8, 0xc7, 0x28, 0x1d, 0x87, 0xa5, 0x5e, 0x8e, 0x43, 0xb5, 0x1b, 0x2e, 0xf7, 0x2c,
-	0xc7, 0xfe, 0x8b, 0x16, 0x9c, 0xcb, 0x76, 0x20, 0x5e, 0x0e, 0x03, 0x2f, 0x09, 0xa3, 0x3a, 0x49,
-	0x12, 0x2f, 0xd8, 0x64, 0xb5, 0x75, 0x6f, 0x3b, 0x91, 0xbc, 0x5f, 0x89, 0x09, 0xca, 0x9b, 0x4e,
-	0x14, 0x60, 0xd6, 0x8a, 0x3a, 0x30, 0xc8, 0x43, 0xa8, 0xc5, 0x2e, 0xe8, 0x88, 0x6b, 0x23, 0x67,
-	0x38, 0xf4, 0x36, 0x8c, 0x87, 0x6f, 0x63, 0xc1, 0xd0, 0xfe, 0xb2, 0x05, 0x68, 0x65, 0x87, 0x44,
-	0x91, 0xd7, 0x30, 0x82, 0xbe, 0xd9, 0xad, 0x9f, 0xc6, 0xed, 0x9e, 0x66, 0xd9, 0x91, 0xcc, 0xad,
-	0x9f, 0xc6, 0xbf, 0xfc, 0x5b, 0x3f, 0x4b, 0x87, 0xbb, 0xf5, 0x13, 0xad, 0xc0, 0xe9, 0x26, 0xdf,
-	0xc6, 0xf1, 0x9b, 0xf4, 0xf8, 0x9e, 0x4e, 0x65, 0xc8, 0x9f, 0xb9, 0xb3, 0x37, 0x75, 0x7a, 0x39,
-	0x0f, 0x01, 0xe7, 0x3f, 0x67, 0x7f, 0x00, 0x10, 0x8f, 0xf5, 0x9e, 0xcf, 0x0b, 0x57, 0xed, 0xe9,
-	0xe6, 0xb0, 0x7f, 0xbc, 0x02, 0xe3, 0x99, 0xdb, 0x37, 0xe8, 0x16, 0xba, 0x3b, 0x3e, 0xf6, 0xc8,
-	0xfa, 0xbb, 0xbb, 0x7b, 0x7d, 0x45, 0xdc, 0x06, 0x50, 0xf1, 0x82, 0x56, 0x3b, 0x29, 0xa6, 0x40,
-	0x07, 0xef, 0xc4, 0x22, 0x25, 0x68, 0x9c, 0x4b, 0xd0, 0xbf, 0x98, 0xb3, 0x29, 0x32, 0x7e, 0x37,
-	0xb5, 0xc9, 0x19, 0x78, 0x40, 0x6e, 0x96, 0x4f, 0xe8, 0x68, 0xda, 0x4a, 0x11, 0x3e, 0xe4, 0xcc,
-	0x64, 0x39, 0xee, 0x50, 0xab, 0x9f, 0x2b, 0xc1, 0xb0, 0xf1, 0xd1, 0xd0, 0x4f, 0xa6, 0x2b, 0x8d,
-	0x5a, 0xc5, 0xbd, 0x12, 0xa3, 0x3f, 0xad, 0x6b, 0x89, 0xf2, 0x57, 0x7a, 0xba, 0xbb, 0xc8, 0xe8,
-	0xdd, 0xbd, 0xa9, 0x13, 0x99, 0x32, 0xa2, 0xa9, 0xc2, 0xa3, 0x67, 0xbf, 0x07, 0xc6, 0x33, 0x64,
-	0x72, 0x5e, 0x79, 0xcd, 0x7c, 0xe5, 0x23, 0xbb, 0xfb, 0xcc, 0x21, 0xfb, 0x19, 0x3a, 0x64, 0xa2,
-	0x2e, 0x40, 0xe8, 0x93, 0x3e, 0x7c, 0x9d, 0x99, 0xfd, 0x45, 0xa9, 0xcf, 0xf2, 0x1f, 0xef, 0x83,
-	0x6a, 0x2b, 0xf4, 0x3d, 0xd7, 0x53, 0x85, 0xca, 0x59, 0xc1, 0x91, 0x55, 0xd1, 0x86, 0x15, 0x14,
-	0xdd, 0x86, 0xda, 0xad, 0xdb, 0x09, 0x3f, 0x66, 0x14, 0x47, 0x19, 0x45, 0x9d, 0x2e, 0x2a, 0xa3,
-	0x45, 0x9d, 0x63, 0x62, 0xcd, 0x0b, 0xd9, 0x30, 0xc8, 0x94, 0xa0, 0xcc, 0x11, 0x64, 0xc7, 0x2c,
-	0x4c, 0x3b, 0xc6, 0x58, 0x40, 0xec, 0x7f, 0x3f, 0x0c, 0xa7, 0xf2, 0xae, 0x40, 0x42, 0x6f, 0xc1,
-	0x20, 0xef, 0x63, 0x31, 0xb7, 0xec, 0xe5, 0xf1, 0xb8, 0xc4, 0x08, 0x8a, 0x6e, 0xb1, 0xdf, 0x58,
-	0xf0, 0x14, 0xdc, 0x7d, 0x67, 0x5d, 0xcc, 0x90, 0xe3, 0xe1, 0xbe, 0xe4, 0x68, 0xee, 0x4b, 0x0e,
-	0xe7, 0xee, 0x3b, 0xeb, 0x68, 0x17, 0x2a, 0x9b, 0x5e, 0x42, 0x1c, 0xe1, 0x9c, 0xb9, 0x79, 0x2c,
-	0xcc, 0x89, 0xc3, 0xad, 0x34, 0xf6, 0x13, 0x73, 0x86, 0xe8, 0x4b, 0x16, 0x8c, 0xaf, 0xa7, 0xeb,
-	0x0e, 0x09, 0xe1, 0xe9, 0x1c, 0xc3, 0x35, 0x57, 0x69, 0x46, 0xfc, 0xda, 0xdb, 0x4c, 0x23, 0xce,
-	0x76, 0x07, 0x7d, 0xd2, 0x82, 0xa1, 0x0d, 0xcf, 0x37, 0xee, 0x11, 0x39, 0x86, 0x8f, 0x73, 0x91,
-	0x31, 0xd0, 0x3b, 0x0e, 0xfe, 0x3f, 0xc6, 0x92, 0x73, 0x2f, 0x4d, 0x35, 0x78, 0x54, 0x4d, 0x35,
-	0xf4, 0x80, 0x34, 0xd5, 0x67, 0x2c, 0xa8, 0xa9, 0x91, 0x16, 0xf5, 0x5b, 0x3e, 0x7c, 0x8c, 0x9f,
-	0x9c, 0x7b, 0xa4, 0xd4, 0x5f, 0xac, 0x99, 0xa3, 0xb7, 0x2d, 0x18, 0x76, 0xde, 0x6c, 0x47, 0xa4,
-	0x41, 0x76, 0xc2, 0x56, 0x2c, 0x0a, 0xab, 0xbe, 0x56, 0x7c, 0x67, 0x66, 0x29, 0x93, 0x05, 0xb2,
-	0xb3, 0xd2, 0x8a, 0x45, 0xfe, 0xb2, 0x6e, 0xc0, 0x66, 0x17, 0xd0, 0xf7, 0x6b, 0x3d, 0x0e, 0x45,
-	0x94, 0xd7, 0xce, 0xeb, 0x4d, 0x5f, 0xe9, 0xf8, 0x04, 0x1e, 0x73, 0xc3, 0x20, 0xf1, 0x82, 0x36,
-	0x59, 0x09, 0x30, 0x69, 0x85, 0xd7, 0xc2, 0xe4, 0x62, 0xd8, 0x0e, 0x1a, 0x17, 0xa2, 0x28, 0x8c,
-	0x58, 0x81, 0x1a, 0xe3, 0x72, 0xd5, 0xf9, 0xde, 0xa8, 0x78, 0x3f, 0x3a, 0x47, 0xb1, 0x19, 0xf6,
-	0x4a, 0x30, 0x75, 0xc0, 0x60, 0xa3, 0x97, 0x60, 0x24, 0x8c, 0x36, 0x9d, 0xc0, 0x7b, 0xd3, 0xac,
-	0xb9, 0xa6, 0x0c, 0xd2, 0x15, 0x03, 0x86, 0x53, 0x98, 0x66, 0x31, 0x9e, 0xd2, 0x01, 0xc5, 0x78,
-	0xce, 0xc1, 0x40, 0x44, 0x5a, 0x61, 0x76, 0x5f, 0xc5, 0x52, 0x0e, 0x19, 0x04, 0x3d, 0x01, 0x65,
-	0xa7, 0xe5, 0x09, 0xe7, 0xa2, 0xda, 0x2e, 0xce, 0xae, 0x2e, 0x62, 0xda, 0x9e, 0xaa, 0x0d, 0x56,
-	0xb9, 0x2f, 0xb5, 0xc1, 0xa8, 0xc6, 0x14, 0xc7, 0x67, 0x83, 0x5a, 0x63, 0xa6, 0x8f, 0xb5, 0xec,
-	0x77, 0xca, 0xf0, 0xc4, 0xbe, 0x4b, 0x4b, 0x87, 0xac, 0x5b, 0xfb, 0x84, 0xac, 0xcb, 0xe1, 0x29,
-	0x1d, 0x34, 0x3c, 0xe5, 0x1e, 0xc3, 0xf3, 0x49, 0x2a, 0x31, 0x64, 0xad, 0xba, 0x62, 0x2e, 0x88,
-	0xef, 0x55, 0xfa, 0x4e, 0x08, 0x0b, 0x09, 0xc5, 0x9a, 0x2f, 0xdd, 0x2e, 0xa5, 0x0a, 0xd1, 0x54,
-	0x8a, 0xd0, 0x98, 0x3d, 0xeb, 0xc5, 0x71, 0x31, 0xd1, 0xab, 0xba, 0x8d, 0xfd, 0xcb, 0x03, 0xf0,
-	0x54, 0x1f, 0x8a, 0xce, 0x9c, 0xc5, 0x56, 0x9f, 0xb3, 0xf8, 0xab, 0xfc, 0x33, 0x7d, 0x3a, 0xf7,
-	0x33, 0xe1, 0xe2, 0x3f, 0xd3, 0xfe, 0x5f, 0x88, 0x9d, 0x40, 0x04, 0x31, 0x71, 0xdb, 0x11, 0x4f,
-	0xdf, 0x31, 0xf2, 0x91, 0x17, 0x45, 0x3b, 0x56, 0x18, 0x74, 0xfb, 0xeb, 0x3a, 0x74, 0xf9, 0x0f,
-	0x15, 0x54, 0x78, 0xc4, 0x4c, 0x6d, 0xe6, 0xd6, 0xd7, 0xfc, 0x2c, 0x95, 0x00, 0x9c, 0x8d, 0xfd,
-	0xeb, 0x16, 0x9c, 0xed, 0x6d, 0x8d, 0xa0, 0xe7, 0x60, 0x78, 0x9d, 0x05, 0x53, 0x2e, 0xb3, 0x90,
-	0x29, 0x31, 0x75, 0xd8, 0xfb, 0xea, 0x66, 0x6c, 0xe2, 0xa0, 0x79, 0x98, 0x30, 0xa3, 0x30, 0x97,
-	0x8d, 0x58, 0x2b, 0xe6, 0x2f, 0x59, 0xcb, 0x02, 0x71, 0x37, 0x3e, 0x9a, 0x06, 0x48, 0xbc, 0xc4,
-	0x27, 0xfc, 0x69, 0x3e, 0xd1, 0x98, 0x43, 0x71, 0x4d, 0xb5, 0x62, 0x03, 0xc3, 0xfe, 0x4a, 0x39,
-	0xff, 0x35, 0xb8, 0x95, 0x7b, 0x98, 0xd9, 0x2f, 0xe6, 0x76, 0xa9, 0x0f, 0x09, 0x5d, 0xbe, 0xdf,
-	0x12, 0x7a, 0xa0, 0x97, 0x84, 0x46, 0x0b, 0x70, 0xc2, 0xb8, 0xae, 0x95, 0x97, 0xae, 0xe1, 0x87,
-	0x52, 0xaa, 0xee, 0xdc, 0x6a, 0x06, 0x8e, 0xbb, 0x9e, 0x78, 0xc8, 0xa7, 0xea, 0x6f, 0x94, 0xe0,
-	0x4c, 0xcf, 0x8d, 0xc5, 0x7d, 0xd2, 0x40, 0xe6, 0xe7, 0x1f, 0xb8, 0x3f, 0x9f, 0xdf, 0xfc, 0x28,
-	0x95, 0x03, 0x3f, 0x4a, 0x3f, 0xea, 0xfc, 0xf7, 0x4b, 0x3d, 0x17, 0x0b, 0xdd, 0x88, 0x7e, 0xcd,
-	0x8e, 0xe4, 0xcb, 0x30, 0xea, 0xb4, 0x5a, 0x1c, 0x8f, 0x65, 0x66, 0x64, 0x6a, 0x61, 0xce, 0x9a,
-	0x40, 0x9c, 0xc6, 0xed, 0x6b, 0x60, 0xff, 0xd8, 0x82, 0x1a, 0x26, 0x1b, 0x5c, 0xc2, 0xa1, 0x5b,
-	0x62, 0x88, 0xac, 0x22, 0x2e, 0x24, 0xa0, 0x03, 0x1b, 0x7b, 0xac, 0x4a, 0x7f, 0xde, 0x60, 0x1f,
-	0xb5, 0xb2, 0x82, 0xba, 0xe4, 0xb5, 0xdc, 0xfb, 0x92, 0x57, 0xfb, 0x57, 0x6a, 0xf4, 0xf5, 0x5a,
-	0xe1, 0x7c, 0x44, 0x1a, 0x31, 0xfd, 0xbe, 0xed, 0xc8, 0x17, 0x93, 0x44, 0x7d, 0xdf, 0xeb, 0x78,
-	0x09, 0xd3, 0xf6, 0xd4, 0xf9, 0x64, 0xe9, 0x50, 0x95, 0x00, 0xcb, 0x07, 0x56, 0x02, 0x7c, 0x19,
-	0x46, 0xe3, 0x78, 0x6b, 0x35, 0xf2, 0x76, 0x9c, 0x84, 0x5c, 0x25, 0xb2, 0x64, 0x90, 0xae, 0x8a,
-	0x55, 0xbf, 0xac, 0x81, 0x38, 0x8d, 0x8b, 0x2e, 0xc1, 0x84, 0xae, 0xc7, 0x47, 0xa2, 0x84, 0xa5,
-	0x3c, 0xf2, 0x99, 0xa0, 0xca, 0xc1, 0xe8, 0x0a, 0x7e, 0x02, 0x01, 0x77, 0x3f, 0x43, 0x65, 0x6e,
-	0xaa, 0x91, 0x76, 0x64, 0x30, 0x2d, 0x73, 0x53, 0x74, 0x68, 0x5f, 0xba, 0x9e, 0x40, 0xcb, 0x70,
-	0x92, 0x4f, 0x8c, 0xd9, 0x56, 0xcb, 0x78, 0xa3, 0xa1, 0x74, 0x15, 0xf8, 0x4b, 0xdd, 0x28, 0x38,
-	0xef, 0x39, 0xf4, 0x22, 0x0c, 0xab, 0xe6, 0xc5, 0x05, 0x71, 0xb4, 0xa6, 0x5c, 0x7b, 0x8a, 0xcc,
-	0x62, 0x03, 0x9b, 0x78, 0xe8, 0x43, 0xf0, 0xa8, 0xfe, 0xcb, 0x53, 0xe8, 0xf9, 0x79, 0xf3, 0x82,
-	0x28, 0x75, 0xaa, 0x2e, 0x19, 0xbb, 0x94, 0x8b, 0xd6, 0xc0, 0xbd, 0x9e, 0x47, 0xeb, 0x70, 0x56,
-	0x81, 0x2e, 0x04, 0x09, 0x4b, 0x72, 0x8d, 0xc9, 0x9c, 0x13, 0xb3, 0xc8, 0x09, 0x60, 0xef, 0x69,
-	0x0b, 0xea, 0x67, 0x2f, 0x79, 0xc9, 0xe5, 0x3c, 0x4c, 0xbc, 0x84, 0xf7, 0xa1, 0x82, 0x66, 0xa0,
-	0x46, 0x02, 0x67, 0xdd, 0x27, 0x2b, 0xf3, 0x8b, 0x62, 0x47, 0xaa, 0xb3, 0x23, 0x24, 0x00, 0x6b,
-	0x1c, 0x15, 0xdf, 0x3f, 0xd2, 0x2b, 0xbe, 0x1f, 0xad, 0xc2, 0xa9, 0x4d, 0xb7, 0x45, 0xad, 0x4c,
-	0xcf, 0x25, 0xb3, 0x2e, 0x0b, 0x28, 0xa6, 0x1f, 0x86, 0x97, 0xe7, 0x57, 0x89, 0x52, 0x97, 0xe6,
-	0x57, 0xbb, 0x70, 0x70, 0xee, 0x93, 0x2c, 0xf0, 0x3c, 0x0a, 0x77, 0x3b, 0x93, 0x27, 0x33, 0x81,
-	0xe7, 0xb4, 0x11, 0x73, 0x18, 0xba, 0x02, 0x88, 0x25, 0x0b, 0x5e, 0x4e, 0x92, 0x96, 0x32, 0x6b,
-	0x27, 0x4f, 0xa5, 0x0b, 0x1f, 0x5e, 0xec, 0xc2, 0xc0, 0x39, 0x4f, 0x51, 0xab, 0x27, 0x08, 0x19,
-	0xf5, 0xc9, 0x47, 0xd3, 0x56, 0xcf, 0x35, 0xde, 0x8c, 0x25, 0x1c, 0x7d, 0x17, 0x4c, 0xb6, 0x63,
-	0xc2, 0x36, 0xcc, 0x37, 0xc3, 0x68, 0xdb, 0x0f, 0x9d, 0xc6, 0x22, 0xbb, 0x4d, 0x36, 0xe9, 0x4c,
-	0x4e, 0x32, 0xe6, 0xe7, 0xc4, 0xb3, 0x93, 0xd7, 0x7b, 0xe0, 0xe1, 0x9e, 0x14, 0xb2, 0x95, 0x3b,
-	0xcf, 0xf4, 0x59, 0xb9, 0x73, 0x15, 0x4e, 0x49, 0xbd, 0xb6, 0x32, 0xbf, 0xa8, 0x5e, 0x7a, 0xf2,
-	0x6c, 0xfa, 0x7a, 0xba, 0xc5, 0x1c, 0x1c, 0x9c, 0xfb, 0xa4, 0xfd, 0x47, 0x16, 0x8c, 0x2a, 0x09,
-	0x76, 0x1f, 0x92, 0x96, 0xfd, 0x74, 0xd2, 0xf2, 0xa5, 0xa3, 0xeb, 0x00, 0xd6, 0xf3, 0x1e, 0x29,
-	0x36, 0x3f, 0x3a, 0x0a, 0xa0, 0xf5, 0x84, 0x52, 0xd1, 0x56, 0x4f, 0x15, 0xfd, 0xd0, 0xca, 0xe8,
-	0xbc, 0x4a, 0x8c, 0x95, 0x07, 0x5b, 0x89, 0xb1, 0x0e, 0xa7, 0xe5, 0x94, 0xe2, 0x47, 0xca, 0x97,
-	0xc3, 0x58, 0x89, 0x7c, 0xe3, 0xbe, 0xc1, 0xc5, 0x3c, 0x24, 0x9c, 0xff, 0x6c, 0xca, 0xb6, 0x1b,
-	0x3a, 0xd0, 0xb6, 0x53, 0x52, 0x6e, 0x69, 0x43, 0xde, 0x06, 0x9a, 0x91, 0x72, 0x4b, 0x17, 0xeb,
-	0x58, 0xe3, 0xe4, 0xab, 0xba, 0x5a, 0x41, 0xaa, 0x0e, 0x0e, 0xad, 0xea, 0xa4, 0xd0, 0x1d, 0xee,
-	0x29, 0x74, 0xe5, 0xd1, 0xd5, 0x48, 0xcf, 0xa3, 0xab, 0x0f, 0xc2, 0x98, 0x17, 0x6c, 0x91, 0xc8,
-	0x4b, 0x48, 0x83, 0xad, 0x05, 0x26, 0x90, 0xab, 0xda, 0xd0, 0x59, 0x4c, 0x41, 0x71, 0x06, 0x3b,
-	0xad, 0x29, 0xc6, 0xfa, 0xd0, 0x14, 0x3d, 0xf4, 0xf3, 0x78, 0x31, 0xfa, 0xf9, 0xc4, 0xd1, 0xf5,
-	0xf3, 0xc4, 0xb1, 0xea, 0x67, 0x54, 0x88, 0x7e, 0xee, 0x4b, 0xf5, 0x19, 0x9b, 0xf4, 0x53, 0x07,
-	0x6c, 0xd2, 0x7b, 0x29, 0xe7, 0xd3, 0xf7, 0xac, 0x9c, 0xf3, 0xf5, 0xee, 0x23, 0xef, 0xea, 0xdd,
-	0x42, 0xf4, 0xee, 0x67, 0x4a, 0x70, 0x5a, 0x6b, 0x26, 0x2a, 0x0f, 0xbc, 0x0d, 0x2a, 0x9b, 0xd9,
-	0x15, 0xdb, 0xfc, 0xc0, 0xdb, 0x48, 0x95, 0xd7, 0xc5, 0x02, 0x14, 0x04, 0x1b, 0x58, 0x2c, 0xe3,
-	0x9c, 0x44, 0xec, 0x72, 0x97, 0xac, 0xda, 0x9a, 0x17, 0xed, 0x58, 0x61, 0xd0, 0x41, 0xa0, 0xbf,
-	0x45, 0xc1, 0x93, 0x6c, 0xd9, 0xf0, 0x79, 0x0d, 0xc2, 0x26, 0x1e, 0x7a, 0x1f, 0x67, 0xc2, 0x44,
-	0x26, 0x55, 0x5d, 0x23, 0x7c, 0x5b, 0xa9, 0xa4, 0xa4, 0x82, 0xca, 0xee, 0xb0, 0x8a, 0x08, 0x95,
-	0xee, 0xee, 0xb0, 0xd8, 0x51, 0x85, 0x61, 0xff, 0x2f, 0x0b, 0xce, 0xe4, 0x0e, 0xc5, 0x7d, 0x30,
-	0x47, 0x76, 0xd3, 0xe6, 0x48, 0xbd, 0xa8, 0x2d, 0xa9, 0xf1, 0x16, 0x3d, 0x4c, 0x93, 0xff, 0x64,
-	0xc1, 0x98, 0xc6, 0xbf, 0x0f, 0xaf, 0xea, 0xa5, 0x5f, 0xb5, 0xb8, 0xdd, 0x77, 0xad, 0xeb, 0xdd,
-	0x7e, 0xad, 0x04, 0xaa, 0x94, 0xff, 0xac, 0x9b, 0xf4, 0x97, 0x6e, 0xd6, 0x81, 0x41, 0x16, 0x41,
-	0x12, 0x17, 0x13, 0x1d, 0x97, 0xe6, 0xcf, 0xa2, 0x51, 0xf4, 0x81, 0x1e, 0xfb, 0x1b, 0x63, 0xc1,
-	0x90, 0x5d, 0x3d, 0xc4, 0xab, 0xa4, 0x37, 0x44, 0xe2, 0xb4, 0xbe, 0x7a, 0x48, 0xb4, 0x63, 0x85,
-	0x41, 0x15, 0xa6, 0xe7, 0x86, 0xc1, 0xbc, 0xef, 0xc4, 0xb1, 0xb0, 0xe1, 0x94, 0xc2, 0x5c, 0x94,
-	0x00, 0xac, 0x71, 0x58, 0x70, 0x89, 0x17, 0xb7, 0x7c, 0xa7, 0x63, 0xf8, 0x58, 0x8c, 0xc2, 0x5e,
-	0x0a, 0x84, 0x4d, 0x3c, 0xbb, 0x09, 0x93, 0xe9, 0x97, 0x58, 0x20, 0x1b, 0x2c, 0xb2, 0xbb, 0xaf,
-	0xe1, 0x9c, 0x81, 0x9a, 0xc3, 0x9e, 0x5a, 0x6a, 0x3b, 0x42, 0x26, 0xe8, 0xf8, 0x66, 0x09, 0xc0,
-	0x1a, 0xc7, 0xfe, 0x66, 0x38, 0x99, 0x33, 0x66, 0x7d, 0x04, 0xd0, 0xfd, 0x52, 0x09, 0xc6, 0xd3,
-	0x4f, 0xc6, 0x2c, 0xf7, 0x91, 0xf7, 0xd9, 0x8b, 0xdd, 0x70, 0x87, 0x44, 0x1d, 0xda, 0x0d, 0x2b,
-	0x93, 0xfb, 0xd8, 0x85, 0x81, 0x73, 0x9e, 0x62, 0xb7, 0x6a, 0x34, 0xd4, 0xab, 0xcb, 0xe9, 0x71,
-	0xa3, 0xc8, 0xe9, 0xa1, 0x47, 0xd6, 0x0c, 0xfa, 0x51, 0x2c, 0xb1, 0xc9, 0x9f, 0xda, 0x3f, 0x2c,
-	0x73, 0x63, 0xae, 0xed, 0xf9, 0x89, 0x17, 0x88, 0x57, 0x16, 0x13, 0x47, 0xd9, 0x3f, 0xcb, 0xdd,
-	0x28, 0x38, 0xef, 0x39, 0xfb, 0xcb, 0x03, 0xa0, 0x2a, 0xa0, 0xb0, 0xa0, 0xcc, 0x82, 0x42, 0x5a,
-	0x0f, 0x9b, 0x41, 0xab, 0xbe, 0xf4, 0xc0, 0x7e, 0x51, 0x52, 0xdc, 0x4b, 0x66, 0xba, 0xd3, 0xd5,
-	0x80, 0xad, 0x69, 0x10, 0x36, 0xf1, 0x68, 0x4f, 0x7c, 0x6f, 0x87, 0xf0, 0x87, 0x06, 0xd3, 0x3d,
-	0x59, 0x92, 0x00, 0xac, 0x71, 0x58, 0x11, 0x6d, 0x6f, 0x63, 0x43, 0xb8, 0x7c, 0x74, 0x11, 0x6d,
-	0x6f, 0x63, 0x03, 0x33, 0x08, 0xbf, 0x77, 0x29, 0xdc, 0x16, 0x36, 0xbf, 0x71, 0xef, 0x52, 0xb8,
-	0x8d, 0x19, 0x84, 0x7e, 0xa5, 0x20, 0x8c, 0x9a, 0x8e, 0xef, 0xbd, 0x49, 0x1a, 0x8a, 0x8b, 0xb0,
-	0xf5, 0xd5, 0x57, 0xba, 0xd6, 0x8d, 0x82, 0xf3, 0x9e, 0xa3, 0x13, 0xba, 0x15, 0x91, 0x86, 0xe7,
-	0x26, 0x26, 0x35, 0x48, 0x4f, 0xe8, 0xd5, 0x2e, 0x0c, 0x9c, 0xf3, 0x14, 0x9a, 0x85, 0x71, 0x59,
-	0xc1, 0x46, 0x56, 0x7d, 0x1c, 0x4e, 0x97, 0x8e, 0xc3, 0x69, 0x30, 0xce, 0xe2, 0x53, 0x89, 0xd5,
-	0x14, 0xb5, 0x68, 0xd9, 0xd6, 0xc0, 0x90, 0x58, 0xb2, 0x46, 0x2d, 0x56, 0x18, 0xf6, 0x27, 0xca,
-	0x54, 0xc3, 0xf6, 0x28, 0xf9, 0x7c, 0xdf, 0x42, 0xa8, 0xd3, 0x33, 0x72, 0xa0, 0x8f, 0x19, 0xf9,
-	0x02, 0x8c, 0xdc, 0x8a, 0xc3, 0x40, 0x85, 0x27, 0x57, 0x7a, 0x86, 0x27, 0x1b, 0x58, 0xf9, 0xe1,
-	0xc9, 0x83, 0x45, 0x85, 0x27, 0x0f, 0xdd, 0x63, 0x78, 0xf2, 0xbf, 0xa9, 0x80, 0xba, 0x58, 0xf3,
-	0x1a, 0x49, 0x6e, 0x87, 0xd1, 0xb6, 0x17, 0x6c, 0xb2, 0x6a, 0x2c, 0x5f, 0xb2, 0x64, 0x41, 0x97,
-	0x25, 0x33, 0x6d, 0x77, 0xa3, 0xa0, 0xcb, 0x11, 0x53, 0xcc, 0xa6, 0xd7, 0x0c, 0x46, 0x3c, 0xcc,
-	0x25, 0x53, 0x38, 0x46, 0x78, 0xf0, 0x53, 0x3d, 0x42, 0xdf, 0x03, 0x20, 0xfd, 0xe3, 0x1b, 0x52,
-	0x02, 0x2f, 0x16, 0xd3, 0x3f, 0x4c, 0x36, 0xb4, 0x7d, 0xbb, 0xa6, 0x98, 0x60, 0x83, 0x21, 0xfa,
-	0x8c, 0x4e, 0x69, 0xe6, 0x79, 0x4c, 0x1f, 0x3d, 0x96, 0xb1, 0xe9, 0x27, 0xa1, 0x19, 0xc3, 0x90,
-	0x17, 0x6c, 0xd2, 0x79, 0x22, 0xc2, 0x38, 0xdf, 0x9b, 0x57, 0xec, 0x6b, 0x29, 0x74, 0x1a, 0x73,
-	0x8e, 0xef, 0x04, 0x2e, 0x89, 0x16, 0x39, 0xba, 0xde, 0xf3, 0x88, 0x06, 0x2c, 0x09, 0x75, 0xdd,
-	0xfe, 0x59, 0xe9, 0xe7, 0xf6, 0xcf, 0xb3, 0xdf, 0x0e, 0x13, 0x5d, 0x1f, 0xf3, 0x50, 0xf9, 0xcb,
-	0x47, 0x28, 0xf3, 0xf5, 0xcb, 0x83, 0x5a, 0x69, 0x5d, 0x0b, 0x1b, 0xfc, 0x32, 0xc9, 0x48, 0x7f,
-	0x51, 0x61, 0xbf, 0x16, 0x38, 0x45, 0x94, 0x9a, 0x31, 0x1a, 0xb1, 0xc9, 0x92, 0xce, 0xd1, 0x96,
-	0x13, 0x91, 0xe0, 0xb8, 0xe7, 0xe8, 0xaa, 0x62, 0x82, 0x0d, 0x86, 0x68, 0x2b, 0x95, 0x68, 0x77,
-	0xf1, 0xe8, 0x89, 0x76, 0xac, 0xf4, 0x6a, 0xde, 0x9d, 0x6b, 0x6f, 0x5b, 0x30, 0x16, 0xa4, 0x66,
-	0x6e, 0x31, 0xb1, 0xf5, 0xf9, 0xab, 0x82, 0xdf, 0xcb, 0x9c, 0x6e, 0xc3, 0x19, 0xfe, 0x79, 0x2a,
-	0xad, 0x72, 0x48, 0x95, 0xa6, 0x2f, 0xb3, 0x1d, 0xec, 0x75, 0x99, 0x2d, 0x0a, 0xd4, 0x2d, 0xe3,
-	0x43, 0x45, 0x94, 0x2b, 0x49, 0x5d, 0x31, 0x0e, 0x39, 0xd7, 0x8b, 0xdf, 0x34, 0xf3, 0x70, 0x0f,
-	0x7f, 0xdb, 0xf4, 0x68, 0xaf, 0x7c, 0x5d, 0xfb, 0xff, 0x0e, 0xc0, 0x09, 0x39, 0x22, 0x32, 0x2f,
-	0x87, 0xea, 0x47, 0xce, 0x57, 0xdb, 0xca, 0x4a, 0x3f, 0x5e, 0x96, 0x00, 0xac, 0x71, 0xa8, 0x3d,
-	0xd6, 0x8e, 0xc9, 0x4a, 0x8b, 0x04, 0x4b, 0xde, 0x7a, 0x2c, 0xce, 0xc2, 0xd5, 0x42, 0xb9, 0xae,
-	0x41, 0xd8, 0xc4, 0x63, 0xc9, 0xc2, 0xae, 0x59, 0xb1, 0x43, 0x27, 0x0b, 0x0b, 0x43, 0x55, 0xc2,
-	0xd1, 0x8f, 0xe5, 0xde, 0x41, 0x51, 0x4c, 0x36, 0x6b, 0x57, 0x3a, 0xd2, 0xe1, 0x2e, 0x9f, 0x40,
-	0xff, 0xc8, 0x82, 0xd3, 0xbc, 0x55, 0x8e, 0xe4, 0xf5, 0x56, 0xc3, 0x49, 0x48, 0x5c, 0xcc, 0x7d,
-	0x5d, 0x39, 0xfd, 0xd3, 0x2e, 0xed, 0x3c, 0xb6, 0x38, 0xbf, 0x37, 0xe8, 0x8b, 0x16, 0x8c, 0x6f,
-	0xa7, 0x2a, 0x6e, 0x49, 0xd5, 0x71, 0xd4, 0x72, 0x34, 0x29, 0xa2, 0x7a, 0xa9, 0xa5, 0xdb, 0x63,
-	0x9c, 0xe5, 0x6e, 0xff, 0x85, 0x05, 0xa6, 0x18, 0xbd, 0xff, 0x85, 0xba, 0x0e, 0x6f, 0x0a, 0x4a,
-	0xeb, 0xb2, 0xd2, 0xd3, 0xba, 0x7c, 0x02, 0xca, 0x6d, 0xaf, 0x21, 0xf6, 0x17, 0xfa, 0xf4, 0x7d,
-	0x71, 0x01, 0xd3, 0x76, 0xfb, 0x4f, 0x2a, 0xda, 0x27, 0x21, 0x92, 0x45, 0xbf, 0x26, 0x5e, 0x7b,
-	0x43, 0x55, 0xe0, 0xe5, 0x6f, 0x7e, 0xad, 0xab, 0x02, 0xef, 0xb7, 0x1e, 0x3e, 0x17, 0x98, 0x0f,
-	0x50, 0xaf, 0x02, 0xbc, 0x43, 0x07, 0x24, 0x02, 0xdf, 0x82, 0x2a, 0xdd, 0x82, 0x31, 0xe7, 0x62,
-	0x35, 0xd5, 0xa9, 0xea, 0x65, 0xd1, 0x7e, 0x77, 0x6f, 0xea, 0x5b, 0x0e, 0xdf, 0x2d, 0xf9, 0x34,
-	0x56, 0xf4, 0x51, 0x0c, 0x35, 0xfa, 0x9b, 0xe5, 0x2c, 0x8b, 0xcd, 0xdd, 0x75, 0x25, 0x33, 0x25,
-	0xa0, 0x90, 0x84, 0x68, 0xcd, 0x07, 0x05, 0x50, 0xa3, 0x88, 0x9c, 0x29, 0xdf, 0x03, 0xae, 0xaa,
-	0xcc, 0x61, 0x09, 0xb8, 0xbb, 0x37, 0xf5, 0xf2, 0xe1, 0x99, 0xaa, 0xc7, 0xb1, 0x66, 0x61, 0xa8,
-	0xc6, 0xe1, 0x9e, 0xf7, 0xbc, 0xff, 0xbf, 0x01, 0x3d, 0xbf, 0x45, 0x71, 0xe6, 0xaf, 0x89, 0xf9,
-	0xfd, 0x52, 0x66, 0x7e, 0x9f, 0xeb, 0x9a, 0xdf, 0x63, 0x74, 0xcc, 0x72, 0x4a, 0x46, 0xdf, 0x6f,
-	0x63, 0xe1, 0x60, 0x9f, 0x04, 0xb3, 0x92, 0xde, 0x68, 0x7b, 0x11, 0x89, 0x57, 0xa3, 0x76, 0xe0,
-	0x05, 0x9b, 0x6c, 0xca, 0x56, 0x4d, 0x2b, 0x29, 0x05, 0xc6, 0x59, 0x7c, 0xba, 0xf1, 0xa7, 0xf3,
-	0xe2, 0xa6, 0xb3, 0xc3, 0x67, 0x9e, 0x51, 0x18, 0xb3, 0x2e, 0xda, 0xb1, 0xc2, 0x40, 0x5b, 0xf0,
-	0xb8, 0x24, 0xb0, 0x40, 0x7c, 0x42, 0x5f, 0x88, 0x45, 0x15, 0x46, 0x4d, 0x1e, 0xf3, 0xcf, 0x03,
-	0x43, 0xbe, 0x5e, 0x50, 0x78, 0x1c, 0xef, 0x83, 0x8b, 0xf7, 0xa5, 0x64, 0xff, 0x21, 0x8b, 0x23,
-	0x30, 0x4a, 0x37, 0xd0, 0xd9, 0xe7, 0x7b, 0x4d, 0x4f, 0xd6, 0xef, 0x54, 0xb3, 0x6f, 0x89, 0x36,
-	0x62, 0x0e, 0x43, 0xb7, 0x61, 0x68, 0x9d, 0xdf, 0xff, 0x5e, 0xcc, 0xbd, 0x4b, 0xe2, 0x32, 0x79,
-	0x56, 0xbb, 0x5b, 0xde, 0x2c, 0x7f, 0x57, 0xff, 0xc4, 0x92, 0x1b, 0xbf, 0xf1, 0x81, 0x5d, 0x9d,
-	0x2c, 0x1c, 0x77, 0xc6, 0x8d, 0x0f, 0xfc, 0x46, 0x65, 0x09, 0xb7, 0x7f, 0xb7, 0x02, 0xe3, 0x32,
-	0x2c, 0xec, 0xb2, 0x17, 0xb3, 0x48, 0x02, 0xf3, 0xee, 0x83, 0xd2, 0x81, 0x77, 0x1f, 0x7c, 0x04,
-	0xa0, 0x41, 0x5a, 0x7e, 0xd8, 0x61, 0x76, 0xe4, 0xc0, 0xa1, 0xed, 0x48, 0xb5, 0xf5, 0x58, 0x50,
-	0x54, 0xb0, 0x41, 0x51, 0xd4, 0x37, 0xe5, 0x57, 0x29, 0x64, 0xea, 0x9b, 0x1a, 0x17, 0xb9, 0x0d,
-	0xde, 0xdf, 0x8b, 0xdc, 0x3c, 0x18, 0xe7, 0x5d, 0x54, 0xb5, 0x14, 0xee, 0xa1, 0x64, 0x02, 0xcb,
-	0x46, 0x5b, 0x48, 0x93, 0xc1, 0x59, 0xba, 0xe6, 0x2d, 0x6d, 0xd5, 0xfb, 0x7d, 0x4b, 0xdb, 0xfb,
-	0xa1, 0x26, 0xbf, 0x73, 0x3c, 0x59, 0xd3, 0x75, 0x7e, 0xe4, 0x34, 0x88, 0xb1, 0x86, 0x77, 0x95,
-	0x85, 0x81, 0x07, 0x55, 0x16, 0xc6, 0x7e, 0xbb, 0x4c, 0x37, 0x20, 0xbc, 0x5f, 0x87, 0xbe, 0xe4,
-	0xf0, 0xb2, 0x71, 0xc9, 0xe1, 0xe1, 0xbe, 0x67, 0x35, 0x73, 0x19, 0xe2, 0xe3, 0x30, 0x90, 0x38,
-	0x9b, 0x32, 0x79, 0x96, 0x41, 0xd7, 0x9c, 0xcd, 0x18, 0xb3, 0xd6, 0xc3, 0x94, 0x83, 0x7e, 0x19,
-	0x46, 0x63, 0x6f, 0x33, 0x70, 0x92, 0x76, 0x44, 0x8c, 0x73, 0x47, 0x1d, 0x5c, 0x63, 0x02, 0x71,
-	0x1a, 0x17, 0x7d, 0xd2, 0x02, 0x88, 0x88, 0xda, 0xde, 0x0c, 0x16, 0x31, 0x87, 0x94, 0x18, 0x90,
-	0x74, 0xcd, 0x72, 0x1e, 0x6a, 0x5b, 0x63, 0xb0, 0xb5, 0x3f, 0x6d, 0xc1, 0x44, 0xd7, 0x53, 0xa8,
-	0x05, 0x83, 0x2e, 0xbb, 0x8a, 0xb2, 0x98, 0x12, 0x96, 0xe9, 0x6b, 0x2d, 0xb9, 0x1e, 0xe3, 0x6d,
-	0x58, 0xf0, 0xb1, 0x7f, 0x65, 0x04, 0x4e, 0xd5, 0xe7, 0x97, 0xe5, 0x05, 0x46, 0xc7, 0x96, 0x0d,
-	0x9c, 0xc7, 0xe3, 0xfe, 0x65, 0x03, 0xf7, 0xe0, 0xee, 0x1b, 0xd9, 0xc0, 0xbe, 0x91, 0x0d, 0x9c,
-	0x4e, 0xcd, 0x2c, 0x17, 0x91, 0x9a, 0x99, 0xd7, 0x83, 0x7e, 0x52, 0x33, 0x8f, 0x2d, 0x3d, 0x78,
-	0xdf, 0x0e, 0x1d, 0x2a, 0x3d, 0x58, 0xe5, 0x4e, 0x17, 0x92, 0x09, 0xd6, 0xe3, 0x53, 0xe5, 0xe6,
-	0x4e, 0xab, 0xbc, 0x55, 0x9e, 0xe5, 0x28, 0x94, 0xde, 0x6b, 0xc5, 0x77, 0xa0, 0x8f, 0xbc, 0x55,
-	0x91, 0x68, 0x69, 0xe6, 0x4a, 0x0f, 0x15, 0x91, 0x2b, 0x9d, 0xd7, 0x9d, 0x03, 0x73, 0xa5, 0x5f,
-	0x86, 0x51, 0xd7, 0x0f, 0x03, 0xb2, 0x1a, 0x85, 0x49, 0xe8, 0x86, 0xf2, 0xe2, 0x6f, 0x7d, 0x87,
-	0xa3, 0x09, 0xc4, 0x69, 0xdc, 0x5e, 0x89, 0xd6, 0xb5, 0xa3, 0x26, 0x5a, 0xc3, 0x03, 0x4a, 0xb4,
-	0x36, 0x52, 0x89, 0x87, 0x8b, 0x48, 0x25, 0xce, 0xfb, 0x22, 0x7d, 0xa5, 0x12, 0xbf, 0xc3, 0xef,
-	0xbd, 0xa7, 0xfb, 0x16, 0x2e, 0x85, 0xd9, 0x69, 0xde, 0xf0, 0xf9, 0xd7, 0x8f, 0x61, 0xc2, 0xde,
-	0xac, 0x6b, 0x36, 0xea, 0x2e, 0x7c, 0xdd, 0x84, 0xd3, 0x1d, 0x39, 0x4a, 0xfa, 0xf1, 0x8f, 0x97,
-	0xe0, 0xeb, 0x0e, 0xec, 0x02, 0xba, 0x0d, 0x90, 0x38, 0x9b, 0x62, 0xa2, 0x8a, 0x33, 0xaf, 0x23,
-	0xc6, 0x03, 0xaf, 0x49, 0x7a, 0x22, 0x35, 0x4e, 0x91, 0xc7, 0x06, 0x2b, 0x16, 0x06, 0x1c, 0xfa,
-	0x5d, 0xd5, 0xa7, 0x71, 0xe8, 0x13, 0xcc, 0x20, 0xd4, 0x10, 0x8a, 0xc8, 0x26, 0x35, 0xee, 0xcb,
-	0x69, 0x43, 0x08, 0xb3, 0x56, 0x2c, 0xa0, 0xe8, 0x45, 0x18, 0x76, 0x7c, 0x9f, 0xa7, 0xe9, 0x91,
-	0x58, 0x5c, 0xae, 0xaa, 0x6b, 0xce, 0x6a, 0x10, 0x36, 0xf1, 0xec, 0x3f, 0x2f, 0xc1, 0xd4, 0x01,
-	0x32, 0xa5, 0x2b, 0x3d, 0xbb, 0xd2, 0x77, 0x7a, 0xb6, 0x48, 0x33, 0x1a, 0xec, 0x91, 0x66, 0xf4,
-	0x22, 0x0c, 0x27, 0xc4, 0x69, 0x8a, 0x08, 0xc2, 0x6c, 0x29, 0xc5, 0x35, 0x0d, 0xc2, 0x26, 0x1e,
-	0x95, 0x62, 0x63, 0x8e, 0xeb, 0x92, 0x38, 0x96, 0x79, 0x44, 0xc2, 0x21, 0x5e, 0x58, 0x92, 0x12,
-	0x3b, 0x67, 0x98, 0x4d, 0xb1, 0xc0, 0x19, 0x96, 0xd9, 0x01, 0xaf, 0xf5, 0x39, 0xe0, 0x3f, 0x55,
-	0x82, 0x27, 0xf6, 0xd5, 0x6e, 0x7d, 0xa7, 0x78, 0xb5, 0x63, 0x12, 0x65, 0x27, 0xce, 0xf5, 0x98,
-	0x44, 0x98, 0x41, 0xf8, 0x28, 0xb5, 0x5a, 0x2a, 0xfa, 0xbb, 0xf8, 0x9c, 0x48, 0x3e, 0x4a, 0x29,
-	0x16, 0x38, 0xc3, 0xf2, 0x5e, 0xa7, 0xe5, 0xef, 0x0e, 0xc0, 0x53, 0x7d, 0xd8, 0x00, 0x05, 0xe6,
-	0x8e, 0xa6, 0xf3, 0xa2, 0xcb, 0x0f, 0x28, 0x2f, 0xfa, 0xde, 0x86, 0xeb, 0xdd, 0x74, 0xea, 0xbe,
-	0x72, 0x54, 0x7f, 0xa6, 0x04, 0x67, 0x7b, 0x1b, 0x2c, 0xe8, 0xdb, 0x60, 0x3c, 0x52, 0xa1, 0x84,
-	0x66, 0x4a, 0xf5, 0x49, 0xee, 0x0e, 0x4b, 0x81, 0x70, 0x16, 0x17, 0x4d, 0x03, 0xb4, 0x9c, 0x64,
-	0x2b, 0xbe, 0xb0, 0xeb, 0xc5, 0x89, 0xa8, 0x41, 0x37, 0xc6, 0x0f, 0x69, 0x65, 0x2b, 0x36, 0x30,
-	0x28, 0x3b, 0xf6, 0x6f, 0x21, 0xbc, 0x16, 0x26, 0xfc, 0x21, 0xbe, 0xf5, 0x3c, 0x29, 0x6f, 0x6c,
-	0x34, 0x40, 0x38, 0x8b, 0x4b, 0xd9, 0xb1, 0x30, 0x00, 0xde, 0xd1, 0x01, 0x9d, 0x84, 0xbd, 0xa4,
-	0x5a, 0xb1, 0x81, 0x91, 0x4d, 0x16, 0xaf, 0x1c, 0x9c, 0x2c, 0x6e, 0xff, 0xcb, 0x12, 0x9c, 0xe9,
-	0x69, 0xf0, 0xf6, 0x27, 0xa6, 0x1e, 0xbe, 0x84, 0xed, 0x7b, 0x5c, 0x61, 0x87, 0x4a, 0xf4, 0xb5,
-	0xff, 0xb8, 0xc7, 0x4c, 0x13, 0x49, 0xbc, 0xf7, 0x5e, 0xef, 0xe4, 0xe1, 0x1b, 0xcf, 0xae, 0xbc,
-	0xdd, 0x81, 0x43, 0xe4, 0xed, 0x66, 0x3e, 0x46, 0xa5, 0x4f, 0xed, 0xf0, 0xdf, 0x06, 0x7a, 0x0e,
-	0x2f, 0xdd, 0x20, 0xf7, 0x75, 0xd8, 0xb0, 0x00, 0x27, 0xbc, 0x80, 0xdd, 0xc1, 0x5b, 0x6f, 0xaf,
-	0x8b, 0xb2, 0x64, 0xbc, 0xf6, 0xae, 0xca, 0x9a, 0x59, 0xcc, 0xc0, 0x71, 0xd7, 0x13, 0x0f, 0x61,
-	0x1e, 0xf5, 0xbd, 0x0d, 0xe9, 0x21, 0x25, 0xf7, 0x0a, 0x9c, 0x96, 0x43, 0xb1, 0xe5, 0x44, 0xa4,
-	0x21, 0x94, 0x6d, 0x2c, 0xf2, 0xa4, 0xce, 0xf0, 0x5c, 0xab, 0x1c, 0x04, 0x9c, 0xff, 0x1c, 0xbb,
-	0x30, 0x35, 0x6c, 0x79, 0xae, 0xd8, 0x0a, 0xea, 0x0b, 0x53, 0x69, 0x23, 0xe6, 0x30, 0xad, 0x2f,
-	0x6a, 0xf7, 0x47, 0x5f, 0x7c, 0x04, 0x6a, 0x6a, 0xbc, 0x79, 0x2e, 0x84, 0x9a, 0xe4, 0x5d, 0xb9,
-	0x10, 0x6a, 0x86, 0x1b, 0x58, 0x74, 0x76, 0xd0, 0x8d, 0x4a, 0x66, 0xb5, 0x52, 0x7e, 0xb4, 0xdd,
-	0x7e, 0x1e, 0x46, 0x94, 0x2f, 0xb0, 0xdf, 0x6b, 0x6b, 0xed, 0xbf, 0x2a, 0x41, 0xe6, 0x86, 0x36,
-	0xb4, 0x0b, 0xb5, 0x86, 0xbc, 0xf1, 0xbf, 0x98, 0xda, 0xcf, 0x0b, 0x92, 0x9c, 0x3e, 0x33, 0x53,
-	0x4d, 0x58, 0x33, 0x43, 0x6f, 0xf1, 0x32, 0xcb, 0x82, 0x75, 0xa9, 0x88, 0x5c, 0xfa, 0xba, 0xa2,
-	0x67, 0xde, 0x4b, 0x29, 0xdb, 0xb0, 0xc1, 0x0f, 0x25, 0x50, 0xdb, 0x92, 0x37, 0xd1, 0x15, 0x23,
-	0xee, 0xd4, 0xc5, 0x76, 0xdc, 0x44, 0x53, 0x7f, 0xb1, 0x66, 0x64, 0xff, 0x51, 0x09, 0x4e, 0xa5,
-	0x3f, 0x80, 0x38, 0xe3, 0xfc, 0x59, 0x0b, 0x1e, 0xf5, 0x9d, 0x38, 0xa9, 0xb7, 0xd9, 0x46, 0x61,
-	0xa3, 0xed, 0xaf, 0x64, 0x2a, 0x72, 0x1f, 0xd5, 0xd9, 0xa2, 0x08, 0x67, 0x6f, 0x2e, 0x9c, 0x7b,
-	0xec, 0xce, 0xde, 0xd4, 0xa3, 0x4b, 0xf9, 0xcc, 0x71, 0xaf, 0x5e, 0xa1, 0xb7, 0x2d, 0x38, 0xe1,
-	0xb6, 0xa3, 0x88, 0x04, 0x89, 0xee, 0x2a, 0xff, 0x8a, 0xd7, 0x0a, 0x19, 0x48, 0xdd, 0xc1, 0x53,
-	0x54, 0xa0, 0xce, 0x67, 0x78, 0xe1, 0x2e, 0xee, 0xf6, 0x0f, 0x51, 0xcd, 0xd9, 0xf3, 0x3d, 0xff,
-	0x9a, 0x5d, 0xb5, 0xf8, 0xa7, 0x83, 0x30, 0x9a, 0x2a, 0x3b, 0x9e, 0x3a, 0xec, 0xb3, 0x0e, 0x3c,
-	0xec, 0x63, 0x99, 0x7d, 0xed, 0x40, 0xde, 0x42, 0x6f, 0x64, 0xf6, 0xb5, 0x03, 0x82, 0x39, 0x4c,
-	0x0c, 0x29, 0x6e, 0x07, 0xe2, 0xf4, 0xd1, 0x1c, 0x52, 0xdc, 0x0e, 0xb0, 0x80, 0xa2, 0x8f, 0x5b,
-	0x30, 0xc2, 0x16, 0x9f, 0x38, 0x55, 0x15, 0x0a, 0xed, 0x4a, 0x01, 0xcb, 0x5d, 0x96, 0xd8, 0x67,
-	0x61, 0xa6, 0x66, 0x0b, 0x4e, 0x71, 0x44, 0x9f, 0xb2, 0xa0, 0xa6, 0xae, 0xbc, 0x15, 0x67, 0x23,
-	0xf5, 0x62, 0xab, 0xba, 0x67, 0xa4, 0x9e, 0x2a, 0xaf, 0x8d, 0x35, 0x63, 0x14, 0xab, 0x73, 0xcc,
-	0xa1, 0xe3, 0x39, 0xc7, 0x84, 0x9c, 0x33, 0xcc, 0xf7, 0x43, 0xad, 0xe9, 0x04, 0xde, 0x06, 0x89,
-	0x13, 0x7e, 0xb4, 0x28, 0x2f, 0xf1, 0x90, 0x8d, 0x58, 0xc3, 0xa9, 0xb1, 0x1f, 0xb3, 0x17, 0x4b,
-	0x8c, 0xb3, 0x40, 0x66, 0xec, 0xd7, 0x75, 0x33, 0x36, 0x71, 0xcc, 0x83, 0x4b, 0x78, 0xa0, 0x07,
-	0x97, 0xc3, 0x07, 0x1c, 0x5c, 0xd6, 0xe1, 0xb4, 0xd3, 0x4e, 0xc2, 0xcb, 0xc4, 0xf1, 0x67, 0x93,
-	0x84, 0x34, 0x5b, 0x49, 0xcc, 0x2b, 0xd5, 0x8f, 0x30, 0x17, 0xb0, 0x0a, 0x8c, 0xab, 0x13, 0x7f,
-	0xa3, 0x0b, 0x09, 0xe7, 0x3f, 0x6b, 0xff, 0x73, 0x0b, 0x4e, 0xe7, 0x4e, 0x85, 0x87, 0x37, 0x25,
-	0xc1, 0xfe, 0x91, 0x0a, 0x9c, 0xcc, 0xb9, 0x94, 0x00, 0x75, 0xcc, 0x45, 0x62, 0x15, 0x11, 0xdd,
-	0x97, 0x0e, 0x56, 0x93, 0xdf, 0x26, 0x67, 0x65, 0x1c, 0x2e, 0x16, 0x41, 0xc7, 0x03, 0x94, 0xef,
-	0x6f, 0x3c, 0x80, 0x31, 0xd7, 0x07, 0x1e, 0xe8, 0x5c, 0xaf, 0x1c, 0x30, 0xd7, 0x7f, 0xce, 0x82,
-	0xc9, 0x66, 0x8f, 0x1b, 0xc6, 0xc4, 0x79, 0xd2, 0x8d, 0xe3, 0xb9, 0xbf, 0x6c, 0xee, 0xf1, 0x3b,
-	0x7b, 0x53, 0x3d, 0x2f, 0x76, 0xc3, 0x3d, 0x7b, 0x65, 0x7f, 0xb9, 0x0c, 0xcc, 0x5e, 0x63, 0x85,
-	0xa7, 0x3b, 0xe8, 0x63, 0xe6, 0xdd, 0x26, 0x56, 0x51, 0xf7, 0x70, 0x70, 0xe2, 0xea, 0x6e, 0x14,
-	0x3e, 0x82, 0x79, 0x57, 0xa5, 0x64, 0x25, 0x61, 0xa9, 0x0f, 0x49, 0xe8, 0xcb, 0x4b, 0x64, 0xca,
-	0xc5, 0x5f, 0x22, 0x53, 0xcb, 0x5e, 0x20, 0xb3, 0xff, 0x27, 0x1e, 0x78, 0x28, 0x3f, 0xf1, 0xaf,
-	0x59, 0x5c, 0xf0, 0x64, 0xbe, 0x82, 0x36, 0x37, 0xac, 0x7d, 0xcc, 0x8d, 0x67, 0xa0, 0x1a, 0x0b,
-	0xc9, 0x2c, 0xcc, 0x12, 0x1d, 0x35, 0x26, 0xda, 0xb1, 0xc2, 0xa0, 0xbb, 0x2e, 0xc7, 0xf7, 0xc3,
-	0xdb, 0x17, 0x9a, 0xad, 0xa4, 0x23, 0x0c, 0x14, 0xb5, 0x2d, 0x98, 0x55, 0x10, 0x6c, 0x60, 0xa1,
-	0x6f, 0x80, 0x21, 0x5e, 0x21, 0xa2, 0x21, 0xbc, 0x3b, 0xc3, 0x74, 0x21, 0xf2, 0xfa, 0x11, 0x0d,
-	0x2c, 0x61, 0xf6, 0x16, 0x18, 0xfb, 0x8a, 0x7b, 0xbf, 0xc8, 0xfa, 0xe0, 0xbb, 0x29, 0xed, 0x7f,
-	0x50, 0x12, 0xac, 0xf8, 0x3e, 0x41, 0x87, 0x11, 0x5a, 0x87, 0x0c, 0x23, 0x7c, 0x0b, 0xc0, 0x0d,
-	0x9b, 0x2d, 0xba, 0x73, 0x5e, 0x0b, 0x8b, 0xd9, 0x6e, 0xcd, 0x2b, 0x7a, 0x7a, 0x5c, 0x75, 0x1b,
-	0x36, 0xf8, 0xa5, 0x84, 0x7b, 0xf9, 0x40, 0xe1, 0x9e, 0x92, 0x73, 0x03, 0xfb, 0xcb, 0x39, 0xfb,
-	0xcf, 0x2d, 0x48, 0xd9, 0x7d, 0xa8, 0x05, 0x15, 0xda, 0xdd, 0x8e, 0x10, 0x19, 0x2b, 0xc5, 0x19,
-	0x99, 0x54, 0x56, 0x8b, 0x75, 0xc8, 0x7e, 0x62, 0xce, 0x08, 0xf9, 0x22, 0x64, 0xb2, 0x90, 0xed,
-	0x8f, 0xc9, 0xf0, 0x72, 0x18, 0x6e, 0xf3, 0x70, 0x22, 0x1d, 0x7e, 0x69, 0xbf, 0x04, 0x13, 0x5d,
-	0x9d, 0x62, 0x97, 0x5f, 0x87, 0x72, 0x0f, 0x6f, 0xac, 0x1f, 0x56, 0xaa, 0x01, 0x73, 0x98, 0xfd,
-	0x33, 0x16, 0x9c, 0xc8, 0x92, 0x47, 0xef, 0x58, 0x30, 0x11, 0x67, 0xe9, 0x1d, 0xd7, 0xd8, 0xa9,
-	0xd4, 0x88, 0x2e, 0x10, 0xee, 0xee, 0x84, 0xfd, 0x3f, 0x85, 0x3e, 0xb8, 0xe9, 0x05, 0x8d, 0xf0,
-	0xb6, 0xb2, 0x94, 0xac, 0x9e, 0x96, 0x12, 0x15, 0x10, 0xee, 0x16, 0x69, 0xb4, 0xfd, 0xae, 0x02,
-	0x12, 0x75, 0xd1, 0x8e, 0x15, 0x06, 0xcb, 0x97, 0x6f, 0x8b, 0x9d, 0x6b, 0x66, 0x52, 0x2e, 0x88,
-	0x76, 0xac, 0x30, 0xd0, 0x0b, 0x30, 0x62, 0xbc, 0xa4, 0x9c, 0x97, 0x6c, 0xdb, 0x61, 0xe8, 0xf0,
-	0x18, 0xa7, 0xb0, 0xd0, 0x34, 0x80, 0xb2, 0xba, 0xa4, 0xce, 0x66, 0xae, 0x76, 0x25, 0x1a, 0x63,
-	0x6c, 0x60, 0xb0, 0xea, 0x14, 0x7e, 0x3b, 0x66, 0x67, 0xc9, 0x83, 0xfa, 0x2a, 0x86, 0x79, 0xd1,
-	0x86, 0x15, 0x94, 0x8a, 0xb7, 0xa6, 0x13, 0xb4, 0x1d, 0x9f, 0x8e, 0x90, 0x70, 0x9e, 0xa9, 0x65,
-	0xb8, 0xac, 0x20, 0xd8, 0xc0, 0xa2, 0x6f, 0x9c, 0x78, 0x4d, 0xf2, 0x6a, 0x18, 0xc8, 0x90, 0x76,
-	0x1d, 0x5e, 0x20, 0xda, 0xb1, 0xc2, 0x40, 0x2f, 0xc1, 0xb0, 0x13, 0x34, 0xb8, 0x89, 0x18, 0x46,
-	0xe2, 0x94, 0x52, 0xed, 0x3f, 0xaf, 0xc7, 0x64, 0x56, 0x43, 0xb1, 0x89, 0x9a, 0xbd, 0x87, 0x02,
-	0xfa, 0xbc, 0xe7, 0xee, 0xcf, 0x2c, 0x18, 0xd7, 0xe5, 0x86, 0x98, 0x8f, 0x2d, 0xe5, 0x5c, 0xb4,
-	0x0e, 0x74, 0x2e, 0xa6, 0xab, 0x8e, 0x94, 0xfa, 0xaa, 0x3a, 0x62, 0x16, 0x04, 0x29, 0xef, 0x5b,
-	0x10, 0xe4, 0x1b, 0x60, 0x68, 0x9b, 0x74, 0x8c, 0xca, 0x21, 0x4c, 0x3b, 0x5c, 0xe5, 0x4d, 0x58,
-	0xc2, 0x90, 0x0d, 0x83, 0xae, 0xa3, 0xaa, 0x0f, 0x8e, 0x88, 0xe8, 0xb4, 0x59, 0x86, 0x24, 0x20,
-	0xf6, 0x0a, 0xd4, 0xd4, 0xb1, 0xbe, 0xf4, 0xf5, 0x59, 0xf9, 0xbe, 0xbe, 0xbe, 0x6e, 0xcc, 0x9f,
-	0x5b, 0xff, 0xcd, 0xaf, 0x3c, 0xf9, 0x9e, 0xdf, 0xf9, 0xca, 0x93, 0xef, 0xf9, 0xc3, 0xaf, 0x3c,
-	0xf9, 0x9e, 0x8f, 0xdf, 0x79, 0xd2, 0xfa, 0xcd, 0x3b, 0x4f, 0x5a, 0xbf, 0x73, 0xe7, 0x49, 0xeb,
-	0x0f, 0xef, 0x3c, 0x69, 0x7d, 0xf9, 0xce, 0x93, 0xd6, 0xdb, 0xff, 0xf5, 0xc9, 0xf7, 0xbc, 0x9a,
-	0x9b, 0x44, 0x41, 0x7f, 0x3c, 0xeb, 0x36, 0x66, 0x76, 0x9e, 0x67, 0x71, 0xfc, 0x74, 0x3d, 0xcf,
-	0x18, 0x93, 0x78, 0x46, 0xae, 0xe7, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x56, 0x2d, 0x0f, 0x04,
-	0xae, 0x00, 0x01, 0x00,
+	// 13388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x70, 0x25, 0xd9,
+	0x59, 0x18, 0xee, 0xbe, 0x0f, 0xe9, 0xea, 0xe8, 0x35, 0xd3, 0x33, 0xb3, 0x7b, 0x77, 0xf6, 0xa1,
+	0xa1, 0x17, 0xd6, 0xe6, 0x61, 0x0d, 0x5e, 0x1b, 0xb3, 0x3f, 0x0c, 0x06, 0x5d, 0x69, 0x1e, 0xda,
+	0x91, 0x46, 0xda, 0x4f, 0xda, 0x19, 0xfc, 0x58, 0xdb, 0xad, 0x7b, 0x8f, 0xa4, 0x5e, 0xf5, 0xed,
+	0xbe, 0xdb, 0xdd, 0x57, 0x33, 0x5a, 0x8c, 0x31, 0x0f, 0x83, 0xf9, 0x19, 0x83, 0x03, 0x09, 0x18,
+	0x12, 0x08, 0x14, 0x24, 0x95, 0xaa, 0x94, 0x0b, 0x12, 0xfe, 0x08, 0x55, 0x40, 0xa8, 0x0a, 0x14,
+	0x81, 0x40, 0x80, 0x50, 0x40, 0x48, 0x02, 0x13, 0x3c, 0x09, 0x15, 0x8a, 0x54, 0x51, 0x95, 0x47,
+	0x55, 0x52, 0x9b, 0x47, 0xa5, 0xbe, 0xf3, 0xee, 0xc7, 0x95, 0xee, 0x1d, 0xb5, 0x34, 0x63, 0xb3,
+	0x7f, 0x49, 0xf7, 0x7c, 0x5f, 0x7f, 0xdf, 0xe9, 0xd3, 0xe7, 0x7c, 0xdf, 0x39, 0xdf, 0xf9, 0x1e,
+	0x64, 0x65, 0xc7, 0x4b, 0x76, 0xfb, 0x5b, 0xf3, 0xed, 0xb0, 0x7b, 0xd9, 0x8d, 0x76, 0xc2, 0x5e,
+	0x14, 0xbe, 0xca, 0xfe, 0x79, 0x7b, 0xbb, 0x73, 0x79, 0xff, 0x9d, 0x97, 0x7b, 0x7b, 0x3b, 0x97,
+	0xdd, 0x9e, 0x17, 0x5f, 0x76, 0x7b, 0x3d, 0xdf, 0x6b, 0xbb, 0x89, 0x17, 0x06, 0x97, 0xf7, 0xdf,
+	0xe1, 0xfa, 0xbd, 0x5d, 0xf7, 0x1d, 0x97, 0x77, 0x68, 0x40, 0x23, 0x37, 0xa1, 0x9d, 0xf9, 0x5e,
+	0x14, 0x26, 0xa1, 0xfd, 0xf5, 0x9a, 0xda, 0xbc, 0xa4, 0xc6, 0xfe, 0xf9, 0x70, 0xbb, 0x33, 0xbf,
+	0xff, 0xce, 0xf9, 0xde, 0xde, 0xce, 0x3c, 0x52, 0x9b, 0x37, 0xa8, 0xcd, 0x4b, 0x6a, 0x17, 0xdf,
+	0x6e, 0xf4, 0x65, 0x27, 0xdc, 0x09, 0x2f, 0x33, 0xa2, 0x5b, 0xfd, 0x6d, 0xf6, 0x8b, 0xfd, 0x60,
+	0xff, 0x71, 0x66, 0x17, 0x9d, 0xbd, 0x17, 0xe2, 0x79, 0x2f, 0xc4, 0xee, 0x5d, 0x6e, 0x87, 0x11,
+	0xbd, 0xbc, 0x9f, 0xeb, 0xd0, 0xc5, 0xeb, 0x1a, 0x87, 0xde, 0x4d, 0x68, 0x10, 0x7b, 0x61, 0x10,
+	0xbf, 0x1d, 0xbb, 0x40, 0xa3, 0x7d, 0x1a, 0x99, 0xaf, 0x67, 0x20, 0x14, 0x51, 0x7a, 0x97, 0xa6,
+	0xd4, 0x75, 0xdb, 0xbb, 0x5e, 0x40, 0xa3, 0x03, 0xfd, 0x78, 0x97, 0x26, 0x6e, 0xd1, 0x53, 0x97,
+	0x07, 0x3d, 0x15, 0xf5, 0x83, 0xc4, 0xeb, 0xd2, 0xdc, 0x03, 0xef, 0x3e, 0xea, 0x81, 0xb8, 0xbd,
+	0x4b, 0xbb, 0x6e, 0xee, 0xb9, 0x77, 0x0e, 0x7a, 0xae, 0x9f, 0x78, 0xfe, 0x65, 0x2f, 0x48, 0xe2,
+	0x24, 0xca, 0x3e, 0xe4, 0xfc, 0x1d, 0x8b, 0x4c, 0x2f, 0xdc, 0xde, 0x58, 0xe8, 0x27, 0xbb, 0x8b,
+	0x61, 0xb0, 0xed, 0xed, 0xd8, 0x5f, 0x43, 0x26, 0xdb, 0x7e, 0x3f, 0x4e, 0x68, 0x74, 0xd3, 0xed,
+	0xd2, 0xa6, 0x75, 0xc9, 0x7a, 0xdb, 0x44, 0xeb, 0xdc, 0x6f, 0xdc, 0x9b, 0x7b, 0xcb, 0xfd, 0x7b,
+	0x73, 0x93, 0x8b, 0x1a, 0x04, 0x26, 0x9e, 0xfd, 0xe5, 0x64, 0x3c, 0x0a, 0x7d, 0xba, 0x00, 0x37,
+	0x9b, 0x15, 0xf6, 0xc8, 0xac, 0x78, 0x64, 0x1c, 0x78, 0x33, 0x48, 0x38, 0xa2, 0xf6, 0xa2, 0x70,
+	0xdb, 0xf3, 0x69, 0xb3, 0x9a, 0x46, 0x5d, 0xe7, 0xcd, 0x20, 0xe1, 0xce, 0x8f, 0x56, 0xc8, 0xec,
+	0x42, 0xaf, 0x77, 0x9d, 0xba, 0x7e, 0xb2, 0xbb, 0x91, 0xb8, 0x49, 0x3f, 0xb6, 0x77, 0xc8, 0x58,
+	0xcc, 0xfe, 0x13, 0x7d, 0x5b, 0x13, 0x4f, 0x8f, 0x71, 0xf8, 0x1b, 0xf7, 0xe6, 0xbe, 0xa1, 0x68,
+	0x46, 0xef, 0x78, 0x49, 0xd8, 0x8b, 0xdf, 0x4e, 0x83, 0x1d, 0x2f, 0xa0, 0x6c, 0x5c, 0x76, 0x19,
+	0xd5, 0x79, 0x93, 0xf8, 0x62, 0xd8, 0xa1, 0x20, 0xc8, 0x63, 0x3f, 0xbb, 0x34, 0x8e, 0xdd, 0x1d,
+	0x9a, 0x7d, 0xa5, 0x55, 0xde, 0x0c, 0x12, 0x6e, 0x47, 0xc4, 0xf6, 0xdd, 0x38, 0xd9, 0x8c, 0xdc,
+	0x20, 0xf6, 0x70, 0x4a, 0x6f, 0x7a, 0x5d, 0xfe, 0x76, 0x93, 0xcf, 0x7f, 0xc5, 0x3c, 0xff, 0x30,
+	0xf3, 0xe6, 0x87, 0xd1, 0xeb, 0x00, 0xe7, 0xcd, 0xfc, 0xfe, 0x3b, 0xe6, 0xf1, 0x89, 0xd6, 0x63,
+	0xf7, 0xef, 0xcd, 0xd9, 0x2b, 0x39, 0x4a, 0x50, 0x40, 0xdd, 0xf9, 0xa3, 0x0a, 0x21, 0x0b, 0xbd,
+	0xde, 0x7a, 0x14, 0xbe, 0x4a, 0xdb, 0x89, 0xfd, 0x11, 0xd2, 0x40, 0x52, 0x1d, 0x37, 0x71, 0xd9,
+	0xc0, 0x4c, 0x3e, 0xff, 0xd5, 0xc3, 0x31, 0x5e, 0xdb, 0xc2, 0xe7, 0x57, 0x69, 0xe2, 0xb6, 0x6c,
+	0xf1, 0x82, 0x44, 0xb7, 0x81, 0xa2, 0x6a, 0x07, 0xa4, 0x16, 0xf7, 0x68, 0x9b, 0x0d, 0xc6, 0xe4,
+	0xf3, 0x2b, 0xf3, 0xc7, 0x59, 0xe9, 0xf3, 0xba, 0xe7, 0x1b, 0x3d, 0xda, 0x6e, 0x4d, 0x09, 0xce,
+	0x35, 0xfc, 0x05, 0x8c, 0x8f, 0xbd, 0xaf, 0x3e, 0x34, 0x1f, 0xc8, 0x9b, 0xa5, 0x71, 0x64, 0x54,
+	0x5b, 0x33, 0xe9, 0x89, 0x23, 0xbf, 0xbb, 0xf3, 0xa7, 0x16, 0x99, 0xd1, 0xc8, 0x2b, 0x5e, 0x9c,
+	0xd8, 0x1f, 0xcc, 0x0d, 0xee, 0xfc, 0x70, 0x83, 0x8b, 0x4f, 0xb3, 0xa1, 0x3d, 0x23, 0x98, 0x35,
+	0x64, 0x8b, 0x31, 0xb0, 0x5d, 0x52, 0xf7, 0x12, 0xda, 0x8d, 0x9b, 0x95, 0x4b, 0xd5, 0xb7, 0x4d,
+	0x3e, 0x7f, 0xbd, 0xac, 0xf7, 0x6c, 0x4d, 0x0b, 0xa6, 0xf5, 0x65, 0x24, 0x0f, 0x9c, 0x8b, 0xf3,
+	0x7b, 0x33, 0xe6, 0xfb, 0xe1, 0x80, 0xdb, 0xef, 0x20, 0x93, 0x71, 0xd8, 0x8f, 0xda, 0x14, 0x68,
+	0x2f, 0xc4, 0x85, 0x55, 0xc5, 0xe9, 0x8e, 0x0b, 0x7e, 0x43, 0x37, 0x83, 0x89, 0x63, 0x7f, 0xbf,
+	0x45, 0xa6, 0x3a, 0x34, 0x4e, 0xbc, 0x80, 0xf1, 0x97, 0x9d, 0xdf, 0x3c, 0x76, 0xe7, 0x65, 0xe3,
+	0x92, 0x26, 0xde, 0x3a, 0x2f, 0x5e, 0x64, 0xca, 0x68, 0x8c, 0x21, 0xc5, 0x1f, 0x05, 0x57, 0x87,
+	0xc6, 0xed, 0xc8, 0xeb, 0xe1, 0xef, 0x66, 0x35, 0x2d, 0xb8, 0x96, 0x34, 0x08, 0x4c, 0x3c, 0x3b,
+	0x20, 0x75, 0x14, 0x4c, 0x71, 0xb3, 0xc6, 0xfa, 0xbf, 0x7c, 0xbc, 0xfe, 0x8b, 0x41, 0x45, 0x99,
+	0xa7, 0x47, 0x1f, 0x7f, 0xc5, 0xc0, 0xd9, 0xd8, 0x9f, 0xb6, 0x48, 0x53, 0x08, 0x4e, 0xa0, 0x7c,
+	0x40, 0x6f, 0xef, 0x7a, 0x09, 0xf5, 0xbd, 0x38, 0x69, 0xd6, 0x59, 0x1f, 0x2e, 0x0f, 0x37, 0xb7,
+	0xae, 0x45, 0x61, 0xbf, 0x77, 0xc3, 0x0b, 0x3a, 0xad, 0x4b, 0x82, 0x53, 0x73, 0x71, 0x00, 0x61,
+	0x18, 0xc8, 0xd2, 0xfe, 0x21, 0x8b, 0x5c, 0x0c, 0xdc, 0x2e, 0x8d, 0x7b, 0x6e, 0x9b, 0x4a, 0x70,
+	0xcb, 0x77, 0xdb, 0x7b, 0xac, 0x47, 0x63, 0x0f, 0xd6, 0x23, 0x47, 0xf4, 0xe8, 0xe2, 0xcd, 0x81,
+	0xa4, 0xe1, 0x10, 0xb6, 0xf6, 0x4f, 0x5b, 0xe4, 0x6c, 0x18, 0xf5, 0x76, 0xdd, 0x80, 0x76, 0x24,
+	0x34, 0x6e, 0x8e, 0xb3, 0xa5, 0xf7, 0xa1, 0xe3, 0x7d, 0xa2, 0xb5, 0x2c, 0xd9, 0xd5, 0x30, 0xf0,
+	0x92, 0x30, 0xda, 0xa0, 0x49, 0xe2, 0x05, 0x3b, 0x71, 0xeb, 0xc2, 0xfd, 0x7b, 0x73, 0x67, 0x73,
+	0x58, 0x90, 0xef, 0x8f, 0xfd, 0x2d, 0x64, 0x32, 0x3e, 0x08, 0xda, 0xb7, 0xbd, 0xa0, 0x13, 0xde,
+	0x89, 0x9b, 0x8d, 0x32, 0x96, 0xef, 0x86, 0x22, 0x28, 0x16, 0xa0, 0x66, 0x00, 0x26, 0xb7, 0xe2,
+	0x0f, 0xa7, 0xa7, 0xd2, 0x44, 0xd9, 0x1f, 0x4e, 0x4f, 0xa6, 0x43, 0xd8, 0xda, 0xdf, 0x63, 0x91,
+	0xe9, 0xd8, 0xdb, 0x09, 0xdc, 0xa4, 0x1f, 0xd1, 0x1b, 0xf4, 0x20, 0x6e, 0x12, 0xd6, 0x91, 0x17,
+	0x8f, 0x39, 0x2a, 0x06, 0xc9, 0xd6, 0x05, 0xd1, 0xc7, 0x69, 0xb3, 0x35, 0x86, 0x34, 0xdf, 0xa2,
+	0x85, 0xa6, 0xa7, 0xf5, 0x64, 0xb9, 0x0b, 0x4d, 0x4f, 0xea, 0x81, 0x2c, 0xed, 0x6f, 0x22, 0x67,
+	0x78, 0x93, 0x1a, 0xd9, 0xb8, 0x39, 0xc5, 0x04, 0xed, 0xf9, 0xfb, 0xf7, 0xe6, 0xce, 0x6c, 0x64,
+	0x60, 0x90, 0xc3, 0xb6, 0x5f, 0x23, 0x73, 0x3d, 0x1a, 0x75, 0xbd, 0x64, 0x2d, 0xf0, 0x0f, 0xa4,
+	0xf8, 0x6e, 0x87, 0x3d, 0xda, 0x11, 0xdd, 0x89, 0x9b, 0xd3, 0x97, 0xac, 0xb7, 0x35, 0x5a, 0x6f,
+	0x15, 0xdd, 0x9c, 0x5b, 0x3f, 0x1c, 0x1d, 0x8e, 0xa2, 0x67, 0xff, 0xba, 0x45, 0x2e, 0x1a, 0x52,
+	0x76, 0x83, 0x46, 0xfb, 0x5e, 0x9b, 0x2e, 0xb4, 0xdb, 0x61, 0x3f, 0x48, 0xe2, 0xe6, 0x0c, 0x1b,
+	0xc6, 0xad, 0x93, 0x90, 0xf9, 0x69, 0x56, 0x7a, 0x5e, 0x0e, 0x44, 0x89, 0xe1, 0x90, 0x9e, 0xda,
+	0x1f, 0x24, 0xcd, 0x88, 0xbe, 0xd6, 0xf7, 0x22, 0x7a, 0xfd, 0xa0, 0x13, 0x31, 0x94, 0x85, 0x5e,
+	0x2f, 0x0a, 0xf7, 0x5d, 0xbf, 0x39, 0xcb, 0x06, 0x4d, 0x7d, 0x5b, 0x18, 0x80, 0x07, 0x03, 0x29,
+	0x38, 0xbf, 0x59, 0x21, 0x67, 0xb2, 0xfb, 0x0b, 0xfb, 0xef, 0x5b, 0x64, 0xf6, 0xd5, 0x3b, 0xc9,
+	0x66, 0xb8, 0x47, 0x83, 0xb8, 0x75, 0x80, 0x5a, 0x80, 0x69, 0xd6, 0xc9, 0xe7, 0xdb, 0xe5, 0xee,
+	0x64, 0xe6, 0x5f, 0x4c, 0x73, 0xb9, 0x12, 0x24, 0xd1, 0x41, 0xeb, 0x71, 0xf1, 0x3e, 0xb3, 0x2f,
+	0xde, 0xde, 0x34, 0xa1, 0x90, 0xed, 0xd4, 0xc5, 0x4f, 0x59, 0xe4, 0x7c, 0x11, 0x09, 0xfb, 0x0c,
+	0xa9, 0xee, 0xd1, 0x03, 0xbe, 0xcf, 0x06, 0xfc, 0xd7, 0x7e, 0x85, 0xd4, 0xf7, 0x5d, 0xbf, 0x4f,
+	0xc5, 0x26, 0xf0, 0xda, 0xf1, 0x5e, 0x44, 0xf5, 0x0c, 0x38, 0xd5, 0xaf, 0xab, 0xbc, 0x60, 0x39,
+	0x7f, 0x59, 0x23, 0x93, 0xc6, 0x94, 0x38, 0x85, 0x8d, 0x6d, 0x98, 0xda, 0xd8, 0xae, 0x96, 0x36,
+	0x9b, 0x07, 0xee, 0x6c, 0xef, 0x64, 0x76, 0xb6, 0x6b, 0xe5, 0xb1, 0x3c, 0x74, 0x6b, 0x6b, 0x27,
+	0x64, 0x22, 0xec, 0x51, 0x3e, 0x79, 0x9b, 0xb5, 0x32, 0x3e, 0xe1, 0x9a, 0x24, 0xd7, 0x9a, 0xbe,
+	0x7f, 0x6f, 0x6e, 0x42, 0xfd, 0x04, 0xcd, 0xc8, 0xfe, 0xac, 0x45, 0x6c, 0x3c, 0xb4, 0x76, 0xfa,
+	0x3e, 0xed, 0x28, 0x8c, 0x66, 0x9d, 0xf1, 0x5f, 0x3f, 0xa6, 0x62, 0xc8, 0xd1, 0xe5, 0x87, 0xa8,
+	0x7c, 0x3b, 0x14, 0xf4, 0xc1, 0xf9, 0xd7, 0x16, 0x39, 0x6f, 0x0c, 0xdf, 0x62, 0x18, 0x74, 0xd8,
+	0x09, 0xcb, 0xbe, 0x44, 0x6a, 0xc9, 0x41, 0x4f, 0x9e, 0x7f, 0xd5, 0x47, 0xdc, 0x3c, 0xe8, 0x51,
+	0x60, 0x90, 0x47, 0xfd, 0x78, 0xf8, 0x9b, 0x16, 0xb9, 0x90, 0x92, 0xac, 0x3d, 0x1a, 0x74, 0x68,
+	0xd0, 0x3e, 0xc0, 0x57, 0x0b, 0xdc, 0x6e, 0xee, 0xd5, 0xd8, 0x99, 0x9e, 0x41, 0xec, 0xcb, 0x64,
+	0x42, 0xa9, 0x78, 0xf1, 0x72, 0x67, 0x05, 0xda, 0x84, 0xde, 0x17, 0x68, 0x1c, 0xfb, 0x15, 0xd2,
+	0x88, 0xa9, 0x4f, 0xdb, 0x49, 0x18, 0x89, 0xd7, 0x7a, 0xe7, 0x90, 0xe7, 0x23, 0x77, 0x8b, 0xfa,
+	0x1b, 0xe2, 0xd1, 0xd6, 0x14, 0x1e, 0x90, 0xe4, 0x2f, 0x50, 0x24, 0x9d, 0x1f, 0xb2, 0xc8, 0x63,
+	0xc5, 0x5a, 0xc2, 0x7e, 0x8e, 0x8c, 0x71, 0x43, 0x8e, 0x78, 0x1d, 0x3d, 0xf3, 0x59, 0x2b, 0x08,
+	0xe8, 0xe8, 0xaf, 0x24, 0x47, 0xa9, 0x3a, 0x68, 0x94, 0x9c, 0x3f, 0xb0, 0xc8, 0x97, 0x0e, 0xa3,
+	0xbb, 0x4e, 0xae, 0x8f, 0x1b, 0xe4, 0x42, 0x87, 0x6e, 0xbb, 0x7d, 0x3f, 0x49, 0x73, 0x14, 0x9d,
+	0x7e, 0x5a, 0x3c, 0x7c, 0x61, 0xa9, 0x08, 0x09, 0x8a, 0x9f, 0x75, 0xfe, 0xbd, 0x45, 0x66, 0x8d,
+	0xd7, 0x3a, 0x85, 0xf3, 0x6f, 0x90, 0x3e, 0xff, 0x2e, 0x97, 0x26, 0x0d, 0x07, 0x1c, 0x80, 0x3f,
+	0x6d, 0x91, 0x8b, 0x06, 0xd6, 0xaa, 0x9b, 0xb4, 0x77, 0xaf, 0xdc, 0xed, 0x45, 0x34, 0x8e, 0x71,
+	0x4a, 0x3d, 0x6d, 0x68, 0xbd, 0xd6, 0xa4, 0xa0, 0x50, 0xbd, 0x41, 0x0f, 0xb8, 0x0a, 0xfc, 0x2a,
+	0xd2, 0xe0, 0xa2, 0x2d, 0x8c, 0xc4, 0x47, 0x52, 0xef, 0xb6, 0x26, 0xda, 0x41, 0x61, 0xd8, 0x0e,
+	0x19, 0x63, 0xaa, 0x0d, 0x45, 0x3d, 0xee, 0xf5, 0x08, 0x7e, 0xf7, 0x5b, 0xac, 0x05, 0x04, 0xc4,
+	0x89, 0x53, 0xdd, 0x59, 0x8f, 0x28, 0x9b, 0x0f, 0x9d, 0xab, 0x1e, 0xf5, 0x3b, 0x31, 0x9e, 0xcd,
+	0xdd, 0x20, 0x08, 0x13, 0x71, 0xcc, 0x36, 0xce, 0xe6, 0x0b, 0xba, 0x19, 0x4c, 0x1c, 0x64, 0xea,
+	0xe3, 0xc2, 0xe2, 0x23, 0x2a, 0x98, 0xb2, 0xa5, 0x16, 0x83, 0x80, 0x38, 0xf7, 0x2b, 0x64, 0xc6,
+	0xe0, 0xba, 0x41, 0x4f, 0xc3, 0x84, 0x14, 0xa5, 0x34, 0xed, 0x7a, 0x79, 0x6a, 0x8f, 0x0e, 0x36,
+	0x23, 0xbd, 0x9e, 0x51, 0xb6, 0x50, 0x2a, 0xd7, 0xc3, 0x4d, 0x49, 0x1f, 0xaf, 0x92, 0xb9, 0xf4,
+	0x03, 0x39, 0x5d, 0x8d, 0x76, 0x0b, 0x83, 0x51, 0xd6, 0xe0, 0x6a, 0xe0, 0x83, 0x89, 0x37, 0x40,
+	0xa7, 0x54, 0x4e, 0x52, 0xa7, 0x98, 0x2a, 0xaf, 0x7a, 0x84, 0xca, 0x7b, 0x4e, 0x8d, 0x7a, 0x2d,
+	0x23, 0xf3, 0xd2, 0x3b, 0x92, 0x4b, 0xa4, 0x16, 0x27, 0xb4, 0xd7, 0xac, 0xa7, 0xc5, 0xec, 0x46,
+	0x42, 0x7b, 0xc0, 0x20, 0xf6, 0x37, 0x90, 0xd9, 0xc4, 0x8d, 0x76, 0x68, 0x12, 0xd1, 0x7d, 0x8f,
+	0x19, 0xe7, 0x99, 0x51, 0x62, 0xa2, 0x75, 0x0e, 0x37, 0xb7, 0x9b, 0x0c, 0x04, 0x12, 0x04, 0x59,
+	0x5c, 0xe7, 0x2f, 0x2b, 0xe4, 0xf1, 0xf4, 0x27, 0xd0, 0x4a, 0xfe, 0x1b, 0x53, 0x4a, 0xfe, 0x2b,
+	0x4d, 0x25, 0xff, 0xc6, 0xbd, 0xb9, 0x27, 0x07, 0x3c, 0xf6, 0x05, 0xb3, 0x07, 0xb0, 0xaf, 0x65,
+	0x3e, 0xc2, 0xe5, 0x9c, 0xa9, 0xfc, 0xe9, 0x01, 0xef, 0x98, 0xf9, 0x4a, 0xcf, 0x91, 0xb1, 0x88,
+	0xba, 0xb1, 0xd8, 0xb4, 0x19, 0x5f, 0x13, 0x58, 0x2b, 0x08, 0xa8, 0xf3, 0xfb, 0x13, 0xd9, 0xc1,
+	0xbe, 0xc6, 0x2f, 0x1c, 0xc2, 0xc8, 0xf6, 0x48, 0x8d, 0x1d, 0xbd, 0xb9, 0x64, 0xb9, 0x71, 0xbc,
+	0x55, 0x88, 0x5a, 0x44, 0x91, 0x6e, 0x35, 0xf0, 0xab, 0x61, 0x13, 0x30, 0x16, 0xf6, 0x5d, 0xd2,
+	0x68, 0xcb, 0x13, 0x71, 0xa5, 0x0c, 0xdb, 0xb1, 0x38, 0x0f, 0x6b, 0x8e, 0x6c, 0xa7, 0xa2, 0x8e,
+	0xd1, 0x8a, 0x9b, 0x4d, 0x49, 0x75, 0xc7, 0x4b, 0xc4, 0x67, 0x3d, 0xa6, 0xcd, 0xe3, 0x9a, 0x67,
+	0xbc, 0xe2, 0x38, 0xea, 0xa0, 0x6b, 0x5e, 0x02, 0x48, 0xdf, 0xfe, 0x84, 0x45, 0x26, 0xe3, 0x76,
+	0x77, 0x3d, 0x0a, 0xf7, 0xbd, 0x0e, 0x8d, 0x9a, 0xb5, 0x32, 0x24, 0xdb, 0xc6, 0xe2, 0xaa, 0x24,
+	0xa8, 0xf9, 0x72, 0x1b, 0x94, 0x86, 0x80, 0xc9, 0x17, 0x8f, 0xb8, 0x8f, 0x8b, 0x77, 0x5f, 0xa2,
+	0x6d, 0xb6, 0xe2, 0xa4, 0xe1, 0xa3, 0x59, 0x2f, 0xe3, 0x68, 0xb3, 0xd4, 0x6f, 0xef, 0xe1, 0x7a,
+	0xd3, 0x1d, 0x7a, 0xf2, 0xfe, 0xbd, 0xb9, 0xc7, 0x17, 0x8b, 0x79, 0xc2, 0xa0, 0xce, 0xb0, 0x01,
+	0xeb, 0xf5, 0x7d, 0x1f, 0xcf, 0xf6, 0x94, 0x99, 0x35, 0x4b, 0x18, 0xb0, 0x75, 0x4d, 0x30, 0x33,
+	0x60, 0x06, 0x04, 0x4c, 0xbe, 0xf6, 0x6b, 0x64, 0xac, 0xeb, 0x26, 0x91, 0x77, 0xb7, 0x39, 0x5e,
+	0xc6, 0x61, 0x73, 0x95, 0xd1, 0xd2, 0xcc, 0x99, 0xa2, 0xe7, 0x8d, 0x20, 0x18, 0xe1, 0xed, 0x42,
+	0x97, 0x46, 0x3b, 0xb4, 0xd9, 0x28, 0xe3, 0xde, 0x66, 0x15, 0x49, 0x69, 0x86, 0x13, 0xb8, 0xb9,
+	0x62, 0x6d, 0xc0, 0xb9, 0xa4, 0x8e, 0x02, 0x13, 0xa5, 0x1f, 0x05, 0x70, 0x00, 0x7b, 0x7e, 0x7f,
+	0xc7, 0x0b, 0x9a, 0xa4, 0x8c, 0x01, 0x5c, 0x67, 0xb4, 0x32, 0x03, 0xc8, 0x1b, 0x41, 0x30, 0x72,
+	0xfe, 0xdc, 0x22, 0x76, 0x5a, 0xa8, 0x9d, 0xc2, 0x9e, 0xf8, 0xb5, 0xf4, 0x9e, 0x78, 0xa5, 0xcc,
+	0x4d, 0xcb, 0x80, 0x6d, 0xf1, 0x2f, 0x4e, 0x90, 0x8c, 0x3a, 0xb8, 0x49, 0xe3, 0x84, 0x76, 0xde,
+	0x14, 0xe1, 0x6f, 0x8a, 0xf0, 0x37, 0x45, 0xb8, 0xfc, 0x61, 0x6f, 0x65, 0x44, 0xf8, 0x7b, 0x8d,
+	0x55, 0xaf, 0x1d, 0x48, 0x3e, 0xac, 0x3c, 0x4c, 0xcc, 0x1e, 0x18, 0x08, 0x28, 0x09, 0x5e, 0xdc,
+	0x58, 0xbb, 0x59, 0x28, 0xb3, 0x3f, 0x9c, 0x96, 0xd9, 0xc7, 0x65, 0xf1, 0xd7, 0x41, 0x4a, 0xff,
+	0xba, 0x45, 0xde, 0x9a, 0x96, 0x5e, 0x72, 0xe6, 0x2c, 0xef, 0x04, 0x61, 0x44, 0x97, 0xbc, 0xed,
+	0x6d, 0x1a, 0xd1, 0x00, 0x2f, 0x52, 0x8e, 0xb6, 0x80, 0xbd, 0x8b, 0x4c, 0xbd, 0x1a, 0x87, 0xc1,
+	0x7a, 0xe8, 0x05, 0x42, 0x04, 0xe1, 0x89, 0xe3, 0x0c, 0x5e, 0x41, 0xe3, 0x88, 0xca, 0x76, 0x48,
+	0x61, 0xd9, 0x8b, 0xe4, 0xec, 0xab, 0xaf, 0xad, 0xbb, 0x89, 0x61, 0x4d, 0x90, 0xe7, 0x7e, 0x76,
+	0xa9, 0xf8, 0xe2, 0x4b, 0x19, 0x20, 0xe4, 0xf1, 0x9d, 0xbf, 0x5d, 0x21, 0x4f, 0x64, 0x5e, 0x24,
+	0xf4, 0xfd, 0xb0, 0x9f, 0xe0, 0x99, 0xc8, 0xfe, 0x09, 0x8b, 0x9c, 0xe9, 0xa6, 0x0d, 0x16, 0xb1,
+	0xb8, 0x55, 0xf8, 0xe6, 0xd2, 0x74, 0x44, 0xc6, 0x22, 0xd2, 0x6a, 0x8a, 0x11, 0x3a, 0x93, 0x01,
+	0xc4, 0x90, 0xeb, 0x8b, 0xfd, 0x0a, 0x99, 0xe8, 0xba, 0x77, 0x5f, 0xee, 0x75, 0xdc, 0x44, 0x1e,
+	0x47, 0x07, 0x5b, 0x11, 0xfa, 0x89, 0xe7, 0xcf, 0x73, 0xd7, 0xa4, 0xf9, 0xe5, 0x20, 0x59, 0x8b,
+	0x36, 0x92, 0xc8, 0x0b, 0x76, 0xb8, 0x2d, 0x79, 0x55, 0x92, 0x01, 0x4d, 0xd1, 0xf9, 0x71, 0x8b,
+	0x3c, 0x3d, 0x60, 0x74, 0x22, 0x37, 0xa1, 0x3b, 0x07, 0xf6, 0x47, 0x49, 0x1d, 0xcf, 0x8d, 0x72,
+	0x54, 0x6e, 0x97, 0xa9, 0x39, 0x8d, 0x2f, 0xa1, 0x95, 0x28, 0xfe, 0x8a, 0x81, 0x33, 0x75, 0x7e,
+	0x62, 0x22, 0xbb, 0x59, 0x60, 0x0e, 0x16, 0xcf, 0x13, 0xb2, 0x13, 0x6e, 0xd2, 0x6e, 0xcf, 0x77,
+	0x13, 0x3e, 0xef, 0x1a, 0xda, 0x54, 0x72, 0x4d, 0x41, 0xc0, 0xc0, 0xb2, 0xbf, 0xd7, 0x22, 0x64,
+	0x47, 0xce, 0x79, 0xb9, 0x11, 0x78, 0xb9, 0xcc, 0xd7, 0xd1, 0x2b, 0x4a, 0xf7, 0x45, 0x31, 0x04,
+	0x83, 0xb9, 0xfd, 0x1d, 0x16, 0x69, 0x24, 0xb2, 0xfb, 0x5c, 0x35, 0x6e, 0x96, 0xd9, 0x13, 0xf9,
+	0xd2, 0x7a, 0x4f, 0xa4, 0x86, 0x44, 0xf1, 0xb5, 0xbf, 0xdb, 0x22, 0x04, 0x6f, 0xc0, 0xd7, 0x43,
+	0xdf, 0x6b, 0x1f, 0x08, 0x8d, 0x79, 0xab, 0x54, 0x73, 0x8e, 0xa2, 0xde, 0x9a, 0xc1, 0xd1, 0xd0,
+	0xbf, 0xc1, 0xe0, 0x6c, 0x7f, 0x8c, 0x34, 0x62, 0x31, 0xdd, 0x9a, 0xf5, 0xf2, 0x07, 0x43, 0x4e,
+	0x65, 0x21, 0x5e, 0xc5, 0x2f, 0x50, 0x3c, 0xed, 0x1f, 0xb1, 0xc8, 0x6c, 0x2f, 0x6d, 0x26, 0x14,
+	0xea, 0xb0, 0x3c, 0x19, 0x90, 0x31, 0x43, 0x72, 0x6b, 0x4b, 0xa6, 0x11, 0xb2, 0xbd, 0x40, 0x09,
+	0xa8, 0x67, 0xf0, 0x5a, 0x8f, 0x9b, 0x2c, 0xc7, 0xb5, 0x04, 0xbc, 0x96, 0x05, 0x42, 0x1e, 0xdf,
+	0x5e, 0x27, 0xe7, 0xb1, 0x77, 0x07, 0x7c, 0xfb, 0x29, 0xd5, 0x4b, 0xcc, 0x94, 0x61, 0xa3, 0xf5,
+	0x94, 0x98, 0x21, 0xe7, 0x17, 0x0a, 0x70, 0xa0, 0xf0, 0x49, 0xfb, 0x77, 0x2d, 0xf2, 0x94, 0xc7,
+	0xd4, 0x80, 0x69, 0xb0, 0xd7, 0x1a, 0x41, 0x78, 0x4b, 0xd0, 0x52, 0x65, 0xc5, 0x20, 0xf5, 0xd3,
+	0xfa, 0x52, 0xf1, 0x06, 0x4f, 0x2d, 0x1f, 0xd2, 0x25, 0x38, 0xb4, 0xc3, 0xf6, 0xd7, 0x92, 0x69,
+	0xb9, 0x2e, 0xd6, 0x51, 0x04, 0x33, 0x45, 0x3b, 0xd1, 0x3a, 0x8b, 0x6e, 0x11, 0x9b, 0x26, 0x00,
+	0xd2, 0x78, 0xce, 0xbf, 0xa8, 0x92, 0xf3, 0xd9, 0xe9, 0xc6, 0x6c, 0x3c, 0x28, 0x6e, 0xda, 0xd2,
+	0xfe, 0x23, 0xa5, 0x67, 0xa9, 0xe2, 0x46, 0x59, 0x97, 0xb4, 0xb8, 0x51, 0x4d, 0x31, 0x18, 0xcc,
+	0x71, 0x53, 0x7a, 0xd6, 0xcd, 0x5a, 0x4a, 0x85, 0x04, 0x7c, 0xa5, 0xcc, 0x2e, 0xe5, 0xaf, 0x4e,
+	0x9f, 0x10, 0x5d, 0x3b, 0x9b, 0x03, 0x41, 0xbe, 0x4b, 0xf6, 0xb7, 0x92, 0x89, 0x48, 0xb9, 0x27,
+	0x55, 0xcb, 0x38, 0xaa, 0xc9, 0x69, 0x23, 0xba, 0xa3, 0x2e, 0x80, 0xb4, 0x23, 0x92, 0xe6, 0xe8,
+	0x7c, 0xb2, 0x42, 0x1e, 0xcb, 0x7e, 0x4c, 0x21, 0x23, 0x8e, 0xbe, 0xc0, 0xfc, 0x7e, 0x8b, 0x4c,
+	0x46, 0xa1, 0xef, 0x7b, 0xc1, 0x0e, 0xca, 0x39, 0xa1, 0xac, 0x3f, 0x70, 0x22, 0xfa, 0x52, 0x08,
+	0x34, 0xb6, 0xb3, 0x06, 0xcd, 0x13, 0xcc, 0x0e, 0xd8, 0xef, 0x21, 0xd3, 0x1d, 0xea, 0x53, 0x7c,
+	0x76, 0x2d, 0xc2, 0x33, 0x11, 0x37, 0x32, 0x2b, 0x77, 0x9f, 0x25, 0x13, 0x08, 0x69, 0x5c, 0xf4,
+	0xda, 0x6c, 0x0e, 0x12, 0xe6, 0x36, 0x25, 0x4f, 0x4a, 0x49, 0xa5, 0xc6, 0x71, 0x2d, 0x90, 0xf4,
+	0x84, 0x3e, 0x7e, 0x56, 0xf0, 0x79, 0x72, 0x7d, 0x30, 0x2a, 0x1c, 0x46, 0xc7, 0x7e, 0x3f, 0x39,
+	0x63, 0x0c, 0x4a, 0xac, 0x46, 0x75, 0xa2, 0x35, 0x8f, 0xbb, 0xa7, 0x85, 0x0c, 0xec, 0x8d, 0x7b,
+	0x73, 0x8f, 0x65, 0xdb, 0x84, 0xb6, 0xc9, 0xd1, 0x71, 0x7e, 0x26, 0xf7, 0xa9, 0xd5, 0x46, 0xe1,
+	0xb3, 0x56, 0xce, 0x14, 0xf1, 0xcd, 0x27, 0xa1, 0x9c, 0x99, 0xd1, 0x42, 0x39, 0xe2, 0x0c, 0xc6,
+	0x79, 0x88, 0xae, 0x15, 0xce, 0x6f, 0xd7, 0xc8, 0x21, 0x3d, 0x3b, 0x89, 0xbb, 0xef, 0xef, 0xb3,
+	0xd4, 0x6d, 0x1b, 0x17, 0x00, 0x9d, 0x93, 0x1a, 0x7b, 0x7e, 0xf8, 0x8a, 0xb9, 0x7b, 0x8f, 0x32,
+	0xc1, 0xa7, 0xef, 0xf5, 0xec, 0x9f, 0xb4, 0xd2, 0xf7, 0x85, 0xdc, 0xad, 0xd5, 0x3b, 0xb1, 0x3e,
+	0x19, 0x97, 0x90, 0xbc, 0x63, 0xfa, 0xea, 0x6a, 0xd0, 0xf5, 0xe4, 0x3c, 0x21, 0xdb, 0x5e, 0xe0,
+	0xfa, 0xde, 0xeb, 0x78, 0xb4, 0xaa, 0xb3, 0xdd, 0x01, 0xdb, 0x6e, 0x5d, 0x55, 0xad, 0x60, 0x60,
+	0x5c, 0xfc, 0xff, 0xc8, 0xa4, 0xf1, 0xe6, 0x05, 0x5e, 0x49, 0xe7, 0x4d, 0xaf, 0xa4, 0x09, 0xc3,
+	0x99, 0xe8, 0xe2, 0x7b, 0xc9, 0x99, 0x6c, 0x07, 0x47, 0x79, 0xde, 0xf9, 0x9f, 0xe3, 0xd9, 0x0b,
+	0xbc, 0x4d, 0x1a, 0x75, 0xb1, 0x6b, 0x6f, 0x5a, 0xc5, 0xde, 0xb4, 0x8a, 0xbd, 0x69, 0x15, 0x33,
+	0x2f, 0x36, 0x84, 0xc5, 0x67, 0xfc, 0x94, 0x2c, 0x3e, 0x29, 0x1b, 0x56, 0xa3, 0x7c, 0xa7, 0xa3,
+	0x4f, 0xe4, 0xcc, 0xfe, 0x9b, 0x11, 0xa5, 0x76, 0x48, 0xea, 0x41, 0xd8, 0xa1, 0x72, 0x83, 0xfc,
+	0x62, 0x39, 0xbb, 0xbd, 0x9b, 0x61, 0xc7, 0x08, 0x18, 0xc0, 0x5f, 0x31, 0x70, 0x3e, 0xce, 0x77,
+	0x8d, 0x91, 0xd4, 0x5e, 0x94, 0x7f, 0x77, 0x8c, 0xb7, 0xa2, 0xbd, 0xf0, 0x65, 0x58, 0x69, 0x5a,
+	0xe9, 0x9b, 0x67, 0xe0, 0xcd, 0x20, 0xe1, 0xa8, 0xf3, 0x7a, 0x6e, 0xb2, 0xdb, 0xac, 0xa4, 0x75,
+	0x1e, 0xda, 0x9d, 0x80, 0x41, 0xec, 0xf7, 0x92, 0x99, 0x24, 0x75, 0x8f, 0x2e, 0xee, 0x8b, 0x1f,
+	0x13, 0xb8, 0x33, 0xe9, 0x5b, 0x76, 0xc8, 0x60, 0xdb, 0xaf, 0x91, 0xda, 0x2e, 0xf5, 0xbb, 0xe2,
+	0xd3, 0x6f, 0x94, 0xa7, 0x6b, 0xd8, 0xbb, 0x5e, 0xa7, 0x7e, 0x97, 0x4b, 0x42, 0xfc, 0x0f, 0x18,
+	0x2b, 0x9c, 0xf7, 0x13, 0x7b, 0xfd, 0x38, 0x09, 0xbb, 0xde, 0xeb, 0xd2, 0x4c, 0xfa, 0xcd, 0x25,
+	0x33, 0xbe, 0x21, 0xe9, 0x73, 0x7b, 0x94, 0xfa, 0x09, 0x9a, 0x33, 0xeb, 0x47, 0xc7, 0x8b, 0xd8,
+	0x94, 0x39, 0x68, 0x92, 0x13, 0xe9, 0xc7, 0x92, 0xa4, 0xcf, 0xfb, 0xa1, 0x7e, 0x82, 0xe6, 0x6c,
+	0x1f, 0xa8, 0xf5, 0x37, 0x79, 0xc9, 0x2a, 0xf7, 0xe0, 0xc6, 0xfa, 0xc0, 0xd7, 0x5e, 0xe1, 0x3a,
+	0x7c, 0x96, 0xd4, 0xdb, 0xbb, 0x6e, 0x94, 0x34, 0xa7, 0xd8, 0xa4, 0x51, 0xb3, 0x78, 0x11, 0x1b,
+	0x81, 0xc3, 0xd0, 0xa9, 0x2a, 0xa2, 0xdb, 0xcd, 0xe9, 0xb4, 0x53, 0x15, 0xd0, 0x6d, 0xc0, 0x76,
+	0xb5, 0x2f, 0x9b, 0x19, 0xe8, 0x6d, 0xf7, 0x53, 0x15, 0x72, 0x31, 0xd7, 0x2b, 0x35, 0x14, 0x7c,
+	0x3d, 0xb4, 0xfb, 0x51, 0x2c, 0xad, 0x6b, 0xc6, 0x7a, 0x60, 0xcd, 0x20, 0xe1, 0xf6, 0xb7, 0x5b,
+	0x64, 0x1c, 0xcd, 0xb6, 0x01, 0x4d, 0x9a, 0x95, 0xb2, 0x6d, 0x48, 0xac, 0x5b, 0x2f, 0x72, 0xea,
+	0xba, 0x0f, 0xa2, 0x01, 0x24, 0x5f, 0xec, 0x2e, 0xbd, 0xdb, 0xf6, 0xfb, 0x9d, 0x9c, 0x27, 0xcd,
+	0x15, 0xde, 0x0c, 0x12, 0x8e, 0xa8, 0x5e, 0xc0, 0x51, 0x6b, 0x69, 0xd4, 0xe5, 0x40, 0xa0, 0x0a,
+	0xb8, 0xf3, 0xf3, 0x0d, 0x72, 0x21, 0xd7, 0x19, 0x5c, 0x34, 0xb8, 0xe5, 0x62, 0x9b, 0x9a, 0xab,
+	0x9e, 0x4f, 0xa5, 0x0f, 0x19, 0xdb, 0x72, 0xdd, 0x52, 0xad, 0x60, 0x60, 0xd8, 0xdf, 0x46, 0x48,
+	0xcf, 0x8d, 0xdc, 0x2e, 0x55, 0xd6, 0xef, 0x63, 0xef, 0x6c, 0xb0, 0x1f, 0xeb, 0x92, 0xa6, 0xb6,
+	0x00, 0xa8, 0xa6, 0x18, 0x0c, 0x96, 0xe8, 0x15, 0x15, 0x51, 0x9f, 0xba, 0x31, 0x0b, 0x80, 0xc8,
+	0x46, 0x73, 0x81, 0x06, 0x81, 0x89, 0x87, 0x8e, 0x2a, 0xc2, 0xdd, 0x2e, 0xe3, 0x76, 0x94, 0x76,
+	0xb9, 0xb3, 0x7f, 0xc0, 0x22, 0x33, 0x18, 0x61, 0xaa, 0xb9, 0x8b, 0xd8, 0xab, 0xb5, 0xe3, 0xbf,
+	0xe4, 0x55, 0x93, 0xae, 0x96, 0xa1, 0xa9, 0xe6, 0x18, 0x32, 0xec, 0xf1, 0x33, 0xef, 0xd3, 0x88,
+	0x09, 0xdf, 0xb1, 0xf4, 0x67, 0xbe, 0xc5, 0x9b, 0x41, 0xc2, 0xed, 0x05, 0x32, 0xdb, 0x73, 0xe3,
+	0x78, 0x31, 0xa2, 0x1d, 0x1a, 0x24, 0x9e, 0xeb, 0xf3, 0xc8, 0xa8, 0x86, 0x76, 0xf9, 0x5f, 0x4f,
+	0x83, 0x21, 0x8b, 0x6f, 0xbf, 0x8f, 0x3c, 0xce, 0xcd, 0x4b, 0xab, 0x5e, 0x1c, 0x7b, 0xc1, 0x8e,
+	0x9e, 0x06, 0xc2, 0xca, 0x36, 0x27, 0x48, 0x3d, 0xbe, 0x5c, 0x8c, 0x06, 0x83, 0x9e, 0x47, 0xff,
+	0xc8, 0x78, 0xcf, 0xeb, 0x2d, 0x46, 0x9d, 0x98, 0x5d, 0x2d, 0x35, 0xb4, 0x4d, 0x77, 0x43, 0xb4,
+	0x83, 0xc2, 0xb0, 0xdb, 0x64, 0x8a, 0x7f, 0x12, 0xee, 0x2f, 0x28, 0x24, 0xe8, 0xdb, 0x07, 0x2a,
+	0x72, 0x11, 0x04, 0x3d, 0x0f, 0xee, 0x9d, 0x2b, 0xf2, 0xa2, 0x8b, 0xdf, 0xcb, 0xdc, 0x32, 0xc8,
+	0x40, 0x8a, 0x68, 0xfa, 0x4c, 0x37, 0x39, 0xc4, 0x99, 0xee, 0x6b, 0xc8, 0xe4, 0x5e, 0x7f, 0x8b,
+	0x8a, 0x91, 0x6f, 0x4e, 0xa5, 0x67, 0xdf, 0x0d, 0x0d, 0x02, 0x13, 0x8f, 0xb9, 0x6a, 0xf6, 0x3c,
+	0xf1, 0x0b, 0x83, 0x71, 0xb4, 0xab, 0xe6, 0xfa, 0xb2, 0x6c, 0x06, 0x13, 0x07, 0xbb, 0x86, 0x63,
+	0xb1, 0x49, 0x63, 0x16, 0x4e, 0x83, 0xc3, 0xa5, 0xba, 0xb6, 0x21, 0x01, 0xa0, 0x71, 0xd0, 0x38,
+	0x8a, 0x3f, 0x36, 0x58, 0x10, 0xf8, 0x2d, 0xd7, 0xf7, 0x3a, 0xdc, 0x6f, 0x70, 0x36, 0x6d, 0x1c,
+	0xdd, 0x28, 0xc0, 0x81, 0xc2, 0x27, 0x31, 0xc8, 0xba, 0x39, 0x48, 0x84, 0xd9, 0x31, 0x0a, 0xaa,
+	0xe4, 0x96, 0x1b, 0xc9, 0x0d, 0xcf, 0x31, 0xc3, 0xdb, 0x04, 0xdd, 0x5b, 0x6e, 0x64, 0x8a, 0x3c,
+	0xc6, 0x00, 0x24, 0x27, 0xfb, 0x55, 0x52, 0x4b, 0x7c, 0xb7, 0xa4, 0x78, 0x58, 0x83, 0xa3, 0xb6,
+	0x82, 0xad, 0x2c, 0xc4, 0xc0, 0x78, 0xd8, 0x4f, 0xe1, 0xe9, 0x6d, 0x4b, 0x5e, 0xd3, 0x89, 0x03,
+	0xd7, 0x56, 0x0c, 0xac, 0xd5, 0xf9, 0x9b, 0xd3, 0x05, 0x5a, 0x47, 0x6d, 0x04, 0xf0, 0x5a, 0x07,
+	0x27, 0xcd, 0x7a, 0x44, 0xb7, 0xbd, 0xbb, 0x62, 0x23, 0xa6, 0x24, 0xdb, 0x4d, 0x05, 0x01, 0x03,
+	0x4b, 0x3e, 0xb3, 0xd1, 0xdf, 0xc6, 0x67, 0x2a, 0xf9, 0x67, 0x38, 0x04, 0x0c, 0x2c, 0xfb, 0x5d,
+	0x64, 0xcc, 0xeb, 0xba, 0x3b, 0xca, 0x8b, 0xf8, 0x29, 0x14, 0x69, 0xcb, 0xac, 0xe5, 0x8d, 0x7b,
+	0x73, 0x33, 0xaa, 0x43, 0xac, 0x09, 0x04, 0xae, 0xfd, 0x33, 0x16, 0x99, 0x6a, 0x87, 0xdd, 0x6e,
+	0x18, 0xf0, 0xe3, 0xb3, 0xb0, 0x05, 0xbc, 0x7a, 0x52, 0xdb, 0xa4, 0xf9, 0x45, 0x83, 0x19, 0x37,
+	0x06, 0xa8, 0xc0, 0x5d, 0x13, 0x04, 0xa9, 0x5e, 0x99, 0x92, 0xaf, 0x7e, 0x84, 0xe4, 0xfb, 0x05,
+	0x8b, 0x9c, 0xe5, 0xcf, 0x1a, 0xa7, 0x7a, 0x11, 0xa3, 0x1a, 0x9e, 0xf0, 0x6b, 0xe5, 0x0c, 0x1d,
+	0xca, 0x52, 0x9c, 0x83, 0x43, 0xbe, 0x93, 0xf6, 0x35, 0x72, 0x76, 0x3b, 0x8c, 0xda, 0xd4, 0x1c,
+	0x08, 0x21, 0xb6, 0x15, 0xa1, 0xab, 0x59, 0x04, 0xc8, 0x3f, 0x63, 0xdf, 0x22, 0x8f, 0x19, 0x8d,
+	0xe6, 0x38, 0x70, 0xc9, 0xfd, 0x8c, 0xa0, 0xf6, 0xd8, 0xd5, 0x42, 0x2c, 0x18, 0xf0, 0x74, 0x5a,
+	0x48, 0x4e, 0x0c, 0x21, 0x24, 0x3f, 0x4c, 0x9e, 0x68, 0xe7, 0x47, 0x66, 0x3f, 0xee, 0x6f, 0xc5,
+	0x5c, 0x8e, 0x37, 0x5a, 0x5f, 0x22, 0x08, 0x3c, 0xb1, 0x38, 0x08, 0x11, 0x06, 0xd3, 0xb0, 0x3f,
+	0x4a, 0x1a, 0x11, 0x65, 0x5f, 0x25, 0x16, 0x01, 0x9b, 0xc7, 0xb4, 0x76, 0xe8, 0x1d, 0x3c, 0x27,
+	0xab, 0x35, 0x93, 0x68, 0x88, 0x41, 0x71, 0xb4, 0xef, 0x90, 0xf1, 0x1e, 0xde, 0x98, 0x88, 0x30,
+	0xcd, 0x63, 0x1b, 0xf6, 0x15, 0x73, 0x76, 0x0f, 0x63, 0x24, 0xbd, 0xe0, 0x4c, 0x40, 0x72, 0xc3,
+	0xbd, 0x5a, 0x3b, 0xec, 0xf6, 0xc2, 0x80, 0x06, 0x89, 0x54, 0x22, 0x33, 0xfc, 0xb2, 0x44, 0xb6,
+	0x82, 0x81, 0x91, 0xd3, 0xe5, 0x1a, 0xad, 0x79, 0xf6, 0x10, 0x5d, 0x6e, 0x50, 0x1b, 0xf4, 0x3c,
+	0x2a, 0x1b, 0x66, 0x56, 0xbc, 0xed, 0x25, 0xbb, 0x68, 0xc7, 0x97, 0xc7, 0xed, 0x99, 0xb4, 0xb2,
+	0x59, 0x29, 0xc0, 0x81, 0xc2, 0x27, 0xb3, 0x9a, 0x75, 0xf6, 0xc1, 0x34, 0xeb, 0x99, 0x21, 0x34,
+	0xeb, 0x06, 0xb9, 0xc0, 0x7a, 0x20, 0x76, 0xc9, 0xd2, 0x68, 0x19, 0x37, 0x6d, 0xd6, 0x79, 0x15,
+	0x1c, 0xb3, 0x52, 0x84, 0x04, 0xc5, 0xcf, 0x5e, 0xfc, 0x46, 0x72, 0x36, 0x27, 0xe4, 0x46, 0x32,
+	0x48, 0x2e, 0x91, 0xc7, 0x8a, 0xc5, 0xc9, 0x48, 0x66, 0xc9, 0x9f, 0xcf, 0x38, 0xb5, 0x1b, 0x47,
+	0xb4, 0x21, 0x4c, 0xdc, 0x2e, 0xa9, 0xd2, 0x60, 0x5f, 0x68, 0xd7, 0xab, 0xc7, 0x9b, 0xd5, 0x57,
+	0x82, 0x7d, 0x2e, 0x0d, 0x99, 0x1d, 0xef, 0x4a, 0xb0, 0x0f, 0x48, 0xdb, 0xfe, 0x41, 0x2b, 0x75,
+	0x80, 0xe0, 0x86, 0xf1, 0x0f, 0x9d, 0xc8, 0x99, 0x74, 0xe8, 0x33, 0x85, 0xf3, 0x2f, 0x2b, 0xe4,
+	0xd2, 0x51, 0x44, 0x86, 0x18, 0xbe, 0x67, 0xd1, 0xab, 0x1e, 0xdd, 0x54, 0x84, 0xba, 0x9a, 0xc4,
+	0x55, 0xcc, 0x1d, 0x57, 0x3e, 0x0c, 0x02, 0x64, 0xfb, 0xa4, 0xda, 0x75, 0x7b, 0xc2, 0x5e, 0xba,
+	0x7c, 0xdc, 0x18, 0x4b, 0xfc, 0xed, 0xfa, 0xab, 0x6e, 0x8f, 0xcf, 0x79, 0xa3, 0x01, 0x90, 0x8d,
+	0x9d, 0x90, 0xba, 0x1b, 0x45, 0xae, 0xf4, 0x89, 0xb8, 0x51, 0x0e, 0xbf, 0x05, 0x24, 0xc9, 0xaf,
+	0x94, 0x53, 0x4d, 0xc0, 0x99, 0xa1, 0xaf, 0xcb, 0x6c, 0xe6, 0x4e, 0xc6, 0x8e, 0xc9, 0x98, 0x30,
+	0x93, 0x5a, 0x65, 0x87, 0xb6, 0x32, 0xb2, 0xdc, 0x02, 0xc1, 0xff, 0x07, 0xc1, 0xca, 0xfe, 0x94,
+	0xc5, 0x72, 0x7f, 0xc8, 0xf0, 0xbb, 0x66, 0xa5, 0x64, 0x9f, 0x0c, 0x33, 0x15, 0x89, 0x99, 0x51,
+	0x44, 0x36, 0x82, 0xc9, 0x5d, 0xe4, 0x37, 0x62, 0xa7, 0x99, 0x7c, 0x7e, 0x23, 0x6c, 0x06, 0x09,
+	0xb7, 0xef, 0x16, 0x38, 0xb4, 0x94, 0x90, 0x3f, 0x62, 0x08, 0x17, 0x96, 0x9f, 0xb4, 0xc8, 0x59,
+	0x2f, 0xeb, 0x99, 0xd0, 0xac, 0x97, 0xe1, 0x32, 0x35, 0xd8, 0xf1, 0x41, 0x6d, 0x74, 0x72, 0x20,
+	0xc8, 0x77, 0xc6, 0xee, 0x90, 0x9a, 0x17, 0x6c, 0x87, 0x62, 0x7b, 0xd7, 0x3a, 0x5e, 0xa7, 0x96,
+	0x83, 0xed, 0x50, 0xaf, 0x66, 0xfc, 0x05, 0x8c, 0xba, 0xbd, 0x42, 0xce, 0xcb, 0x60, 0xa1, 0xeb,
+	0x5e, 0x8c, 0xb6, 0xa4, 0x15, 0xaf, 0xeb, 0x25, 0x6c, 0x6b, 0x56, 0x6d, 0x35, 0x51, 0xbd, 0x41,
+	0x01, 0x1c, 0x0a, 0x9f, 0xb2, 0x5f, 0x27, 0xe3, 0xd2, 0x1b, 0xa0, 0x51, 0x86, 0x3d, 0x21, 0x3f,
+	0xff, 0xd5, 0x64, 0xe2, 0xbf, 0x63, 0x90, 0x0c, 0xed, 0x4f, 0x5a, 0x64, 0x86, 0xff, 0xcf, 0x13,
+	0x14, 0x28, 0xd7, 0xce, 0x63, 0x6e, 0x5c, 0x36, 0x52, 0x34, 0x5b, 0x36, 0x1a, 0x33, 0xd2, 0x6d,
+	0x90, 0xe1, 0x6b, 0x7f, 0x17, 0x5a, 0x45, 0x59, 0xc4, 0x71, 0xbc, 0x16, 0x88, 0x0c, 0x20, 0x1b,
+	0x25, 0x2e, 0x47, 0x19, 0xcb, 0xac, 0x77, 0xa8, 0x4b, 0x92, 0x1b, 0x68, 0xc6, 0xce, 0xaf, 0x4d,
+	0x93, 0xb3, 0x0b, 0x87, 0xfb, 0x6c, 0x58, 0xa7, 0xed, 0xb3, 0x81, 0x87, 0xdb, 0x58, 0xbb, 0x5b,
+	0x94, 0xb0, 0xda, 0x05, 0x57, 0x7d, 0x1b, 0x8e, 0x8e, 0x15, 0x8c, 0x87, 0xdd, 0x27, 0x63, 0x3c,
+	0xcb, 0x59, 0xb3, 0x5a, 0xc6, 0xad, 0x4c, 0x26, 0x15, 0x9b, 0xb6, 0xae, 0xf1, 0x56, 0x10, 0xcc,
+	0xec, 0xbb, 0x64, 0x7c, 0x97, 0xaf, 0x0a, 0x71, 0xe4, 0x5c, 0x3d, 0xee, 0xf8, 0xa6, 0x96, 0x9a,
+	0x5e, 0x03, 0xa2, 0x01, 0x24, 0x3b, 0xe6, 0x22, 0x68, 0x38, 0x31, 0x71, 0x79, 0x56, 0x5e, 0xc4,
+	0xe7, 0xf0, 0x1e, 0x4c, 0x1f, 0x21, 0x53, 0x11, 0x6d, 0x87, 0x41, 0xdb, 0xf3, 0x69, 0x67, 0x41,
+	0xde, 0xcb, 0x8d, 0x12, 0xe8, 0xc7, 0x8c, 0x5a, 0x60, 0xd0, 0x80, 0x14, 0x45, 0xb6, 0xdc, 0x55,
+	0x8e, 0x05, 0xfc, 0x20, 0x54, 0xdc, 0xbf, 0xac, 0x94, 0x94, 0xd1, 0x81, 0xd1, 0xe4, 0xcb, 0x3d,
+	0xdd, 0x06, 0x19, 0xbe, 0xf6, 0xfb, 0x09, 0x09, 0xb7, 0xb8, 0x1f, 0xe0, 0x42, 0xd2, 0x6c, 0x8c,
+	0xfc, 0xaa, 0x33, 0x3c, 0x60, 0x58, 0x52, 0x00, 0x83, 0x9a, 0x7d, 0x83, 0x10, 0xbe, 0x72, 0xf0,
+	0xb6, 0xb4, 0x39, 0x91, 0x8a, 0xd4, 0x24, 0x1b, 0x0a, 0xf2, 0xc6, 0xbd, 0xb9, 0xbc, 0xe9, 0x1b,
+	0x01, 0x60, 0x3c, 0x6e, 0x7f, 0x0b, 0x19, 0x8f, 0xfb, 0xdd, 0xae, 0xab, 0xae, 0x6a, 0x4a, 0x0c,
+	0x41, 0xe6, 0x74, 0x0d, 0xf9, 0xcc, 0x1b, 0x40, 0x72, 0xb4, 0x5f, 0x45, 0x4d, 0x23, 0x04, 0x25,
+	0x5f, 0x45, 0xec, 0x7f, 0x61, 0x90, 0x7c, 0xb7, 0x3c, 0x4c, 0x41, 0x01, 0x0e, 0x7a, 0x0a, 0xa5,
+	0xdb, 0x57, 0xc2, 0xb6, 0xb0, 0xe9, 0x15, 0xd1, 0xb4, 0x5f, 0x24, 0x93, 0xfa, 0xb5, 0x65, 0x9e,
+	0xa1, 0xb7, 0xe9, 0x84, 0x6e, 0xac, 0x79, 0xf0, 0x98, 0x99, 0x0f, 0xdb, 0xab, 0xe4, 0x5c, 0x3b,
+	0x0c, 0x92, 0x28, 0xf4, 0x7d, 0x9e, 0xec, 0x91, 0x9b, 0x08, 0xf8, 0x55, 0xce, 0x93, 0xa2, 0xdb,
+	0xe7, 0x16, 0xf3, 0x28, 0x50, 0xf4, 0x1c, 0x1e, 0x0d, 0xb2, 0x6a, 0x6a, 0xa6, 0x94, 0x5b, 0xfe,
+	0x14, 0x4d, 0x21, 0xa1, 0x94, 0xf5, 0xfd, 0x08, 0x85, 0xf5, 0x63, 0xe8, 0x70, 0xd8, 0x4f, 0xc2,
+	0xae, 0x9b, 0xd0, 0x0e, 0x3a, 0xa8, 0x6d, 0xb9, 0xed, 0xbd, 0xe6, 0x6c, 0x29, 0x57, 0x69, 0x59,
+	0xb2, 0xa2, 0x6b, 0xcc, 0x1f, 0x36, 0x07, 0x84, 0x7c, 0x37, 0x9c, 0x20, 0x7d, 0x11, 0x2d, 0xa6,
+	0xd3, 0xbb, 0xc8, 0x14, 0x86, 0x7a, 0x44, 0x81, 0xeb, 0xbf, 0x0c, 0x2b, 0xf2, 0x52, 0x87, 0x49,
+	0x8d, 0x2b, 0x46, 0x3b, 0xa4, 0xb0, 0x30, 0x35, 0x80, 0xb0, 0x24, 0x1a, 0xa9, 0x01, 0xb8, 0x25,
+	0x51, 0xda, 0x0d, 0x9d, 0x9f, 0xab, 0xa6, 0xf6, 0xf5, 0x0f, 0xe5, 0xda, 0x9b, 0x25, 0x12, 0x93,
+	0x19, 0xd7, 0x18, 0xa0, 0x59, 0x29, 0x9d, 0xb3, 0xf2, 0x2c, 0x5c, 0x33, 0x19, 0x41, 0x9a, 0xaf,
+	0xbd, 0x47, 0xea, 0xbb, 0x61, 0x9c, 0xc8, 0x53, 0xec, 0x31, 0x0f, 0xcc, 0xd7, 0xc3, 0x38, 0x61,
+	0x9b, 0x51, 0xf5, 0xda, 0xd8, 0x12, 0x03, 0xe7, 0x81, 0xf6, 0x91, 0x78, 0xd7, 0x8d, 0x3a, 0xf1,
+	0x22, 0x4b, 0xe4, 0x51, 0x63, 0xbb, 0x50, 0x75, 0xe6, 0xd8, 0xd0, 0x20, 0x30, 0xf1, 0x9c, 0xff,
+	0x94, 0xce, 0xf6, 0x72, 0x9b, 0x45, 0x65, 0xec, 0xd3, 0x00, 0xe5, 0xa7, 0xe9, 0x07, 0xfa, 0xb5,
+	0x99, 0x18, 0xf7, 0xb7, 0x0e, 0x4a, 0x1a, 0x7b, 0x07, 0x29, 0xcc, 0x33, 0x12, 0x86, 0xcb, 0xe8,
+	0xc7, 0xad, 0x74, 0xb2, 0x82, 0x4a, 0x19, 0xc7, 0x5b, 0xa3, 0xdf, 0x47, 0xe7, 0x3d, 0x70, 0xde,
+	0x43, 0xf2, 0x8b, 0x06, 0xaf, 0xfd, 0xee, 0xb0, 0xb4, 0x78, 0xd9, 0x0c, 0x2b, 0x3c, 0x59, 0x1e,
+	0x08, 0x28, 0x06, 0x09, 0x3d, 0x3e, 0x60, 0x3d, 0xda, 0x1d, 0x32, 0xc5, 0x44, 0x55, 0xa7, 0xe5,
+	0xb6, 0xf7, 0x16, 0xa4, 0x3b, 0xd7, 0x28, 0x6a, 0x4c, 0x19, 0xbb, 0xc1, 0xa0, 0x03, 0x29, 0xaa,
+	0xf6, 0x45, 0x52, 0xf1, 0x3a, 0x6c, 0xdc, 0xaa, 0x2d, 0x22, 0xf0, 0x2b, 0xcb, 0x4b, 0x50, 0xf1,
+	0x3a, 0x23, 0xa4, 0x57, 0x70, 0x7e, 0xd0, 0x22, 0xe3, 0x48, 0x31, 0xdc, 0xde, 0xc6, 0x0b, 0xb7,
+	0x4e, 0x3f, 0x32, 0xb3, 0x47, 0x28, 0xb3, 0xe6, 0x92, 0x68, 0x07, 0x85, 0x81, 0x02, 0x60, 0xdb,
+	0x6d, 0xcb, 0xe4, 0x25, 0x55, 0x2e, 0x00, 0xae, 0xb2, 0x16, 0x10, 0x10, 0x9c, 0x84, 0x5d, 0xf7,
+	0xae, 0x7c, 0x38, 0x7b, 0xf9, 0xba, 0xaa, 0x41, 0x60, 0xe2, 0x39, 0xbf, 0x66, 0x91, 0x66, 0xcb,
+	0x8d, 0xbd, 0x36, 0xa6, 0x13, 0x6e, 0x79, 0xc9, 0x56, 0xbf, 0xbd, 0x47, 0x13, 0x9e, 0xe4, 0x06,
+	0x7b, 0xd9, 0x8f, 0x69, 0x64, 0xd8, 0x56, 0x54, 0x2f, 0x5f, 0x16, 0xed, 0xa0, 0x30, 0xec, 0xd7,
+	0xc9, 0x24, 0x5e, 0x59, 0xde, 0x09, 0xa3, 0x0e, 0xd0, 0xed, 0x72, 0xb2, 0x8d, 0x6d, 0xd0, 0x76,
+	0x44, 0x13, 0xa0, 0xdb, 0xc2, 0x95, 0x49, 0xd3, 0x07, 0x93, 0x99, 0xf3, 0xbd, 0x16, 0x39, 0xdf,
+	0xa2, 0x6e, 0x44, 0x23, 0x96, 0x9c, 0x4c, 0xbd, 0x88, 0xfd, 0x1a, 0x69, 0x24, 0xd8, 0x82, 0x3d,
+	0xb2, 0xca, 0xed, 0x11, 0x73, 0x42, 0xda, 0x14, 0xc4, 0x41, 0xb1, 0x71, 0xbe, 0xdf, 0x22, 0x4f,
+	0x14, 0xf5, 0x65, 0xd1, 0x0f, 0xfb, 0x9d, 0x87, 0xd1, 0xa1, 0x1f, 0xb3, 0xc8, 0x14, 0x73, 0xec,
+	0x58, 0xa2, 0x89, 0xeb, 0xf9, 0xb9, 0xb4, 0xab, 0xd6, 0x90, 0x69, 0x57, 0x2f, 0x91, 0xda, 0x6e,
+	0xd8, 0xa5, 0x59, 0xa7, 0xa4, 0xeb, 0x21, 0x9a, 0xd9, 0x10, 0x82, 0x26, 0xdf, 0xae, 0xeb, 0x05,
+	0x89, 0x8b, 0x4b, 0x4e, 0x5e, 0x7c, 0xcd, 0xf2, 0x09, 0xa8, 0x9a, 0xc1, 0xc4, 0x71, 0xfe, 0x39,
+	0x21, 0xe3, 0xc2, 0x83, 0x6e, 0xe8, 0xa4, 0x4b, 0xd2, 0xde, 0x57, 0x19, 0x68, 0xef, 0x8b, 0xc9,
+	0x58, 0x9b, 0xe5, 0xc6, 0x6e, 0x56, 0xcb, 0xb0, 0xae, 0x89, 0x0e, 0xf2, 0x74, 0xdb, 0xba, 0x5b,
+	0xfc, 0x37, 0x08, 0x56, 0xf6, 0x67, 0x2c, 0x32, 0xdb, 0x0e, 0x83, 0x80, 0xb6, 0xf5, 0xf6, 0xbe,
+	0x56, 0xc6, 0x19, 0x6e, 0x31, 0x4d, 0x54, 0xfb, 0x0c, 0x64, 0x00, 0x90, 0x65, 0x8f, 0xee, 0xf9,
+	0x7c, 0xcc, 0x6e, 0xa5, 0x6e, 0xeb, 0x74, 0x36, 0x4e, 0x13, 0x08, 0x69, 0x5c, 0xbc, 0xd4, 0x08,
+	0x74, 0xde, 0xcb, 0x31, 0x7d, 0xa9, 0x61, 0x64, 0xbc, 0x34, 0x30, 0x30, 0x5d, 0x4a, 0x44, 0xb7,
+	0x23, 0x1a, 0xef, 0x0a, 0x0f, 0x43, 0x76, 0xb4, 0x18, 0x7f, 0xb0, 0x74, 0x29, 0x90, 0xa3, 0x04,
+	0x05, 0xd4, 0xed, 0x3d, 0x61, 0x70, 0x6a, 0x94, 0xa1, 0xd5, 0xc4, 0x67, 0x1e, 0x68, 0x77, 0x9a,
+	0x23, 0x75, 0xa6, 0xc0, 0xd9, 0x91, 0xa6, 0xca, 0x43, 0x74, 0x99, 0x7a, 0x07, 0xde, 0x6e, 0x2f,
+	0x91, 0x33, 0x99, 0x5c, 0xa2, 0xb1, 0xb8, 0x55, 0x53, 0xe1, 0x98, 0x99, 0x2c, 0xa4, 0x31, 0xe4,
+	0x9e, 0x30, 0x8d, 0x91, 0x93, 0x47, 0x18, 0x23, 0x0f, 0x94, 0x1f, 0x3b, 0xbf, 0xef, 0x7a, 0xa9,
+	0x94, 0x01, 0x18, 0xca, 0x69, 0xfd, 0xd3, 0x19, 0xa7, 0xf5, 0xe9, 0x4b, 0xd5, 0xe3, 0xbb, 0x65,
+	0xc9, 0x0e, 0x3c, 0x80, 0x87, 0xfa, 0x77, 0x5a, 0x42, 0xf8, 0xd0, 0xc0, 0x0d, 0xda, 0xb4, 0x39,
+	0x53, 0xc6, 0x61, 0x51, 0xf4, 0x67, 0x55, 0xd3, 0x35, 0xc4, 0x19, 0x6f, 0x00, 0x93, 0xeb, 0xc3,
+	0xf4, 0x7b, 0xff, 0xef, 0x16, 0x91, 0xb3, 0x6b, 0xd1, 0x6d, 0xef, 0x52, 0x9c, 0xb8, 0xe8, 0x26,
+	0xaa, 0xcc, 0x58, 0x7c, 0x7b, 0x6a, 0xb1, 0xb9, 0xab, 0x0e, 0x59, 0x90, 0x82, 0x42, 0x06, 0x1b,
+	0x6f, 0x98, 0x71, 0x74, 0xf8, 0xa3, 0x7c, 0xf7, 0xa1, 0x4c, 0x65, 0x0b, 0xeb, 0xcb, 0xe2, 0x29,
+	0x8d, 0x63, 0x87, 0xe4, 0xac, 0xef, 0xc6, 0x09, 0xeb, 0x01, 0x5a, 0xb5, 0x1e, 0x30, 0x65, 0x12,
+	0x3b, 0x69, 0xad, 0x64, 0x09, 0x41, 0x9e, 0xb6, 0xf3, 0xaf, 0xea, 0x64, 0x3a, 0x25, 0x9f, 0x47,
+	0xdc, 0xb6, 0x7c, 0x15, 0x69, 0xc8, 0x9d, 0x44, 0x36, 0x37, 0x9c, 0xda, 0x6e, 0x28, 0x0c, 0x54,
+	0x9d, 0x5b, 0x5a, 0xb7, 0x67, 0xb7, 0x59, 0x86, 0xda, 0x07, 0x13, 0x8f, 0xa9, 0x86, 0xc4, 0x8f,
+	0x17, 0x7d, 0x8f, 0x06, 0x09, 0xef, 0x66, 0x39, 0xaa, 0x61, 0x73, 0x65, 0xc3, 0x24, 0xaa, 0x55,
+	0x43, 0x06, 0x00, 0x59, 0xf6, 0x68, 0xef, 0x9d, 0x76, 0xef, 0xc4, 0xba, 0x8c, 0x44, 0xb3, 0x5e,
+	0x86, 0xaa, 0x4c, 0x55, 0xa6, 0xe0, 0x17, 0x51, 0xa9, 0x26, 0x48, 0x33, 0x65, 0x89, 0x46, 0xe9,
+	0x5d, 0xda, 0x96, 0x6e, 0xfc, 0xa2, 0x2f, 0x63, 0x65, 0xac, 0xde, 0x2b, 0x39, 0xba, 0x5c, 0xb7,
+	0xe4, 0xdb, 0xa1, 0xa0, 0x0f, 0xf6, 0x8b, 0xc4, 0xee, 0x78, 0xb1, 0xbb, 0xe5, 0xa3, 0xe7, 0x85,
+	0x8c, 0x96, 0x17, 0xfe, 0x1f, 0x17, 0xc5, 0x38, 0xdb, 0x4b, 0x39, 0x0c, 0x28, 0x78, 0x8a, 0xcd,
+	0xb2, 0x28, 0xbc, 0x7b, 0xf0, 0x72, 0xe4, 0x37, 0x1b, 0x99, 0x59, 0x26, 0xda, 0x41, 0x61, 0x38,
+	0x7f, 0x51, 0x55, 0x4b, 0x59, 0xc7, 0xac, 0xb8, 0x86, 0xef, 0xbc, 0xf5, 0xe0, 0xbe, 0xf3, 0x8a,
+	0x6f, 0x41, 0x0e, 0x88, 0x54, 0xc8, 0x78, 0xe5, 0x21, 0x85, 0x8c, 0x7f, 0x87, 0x95, 0xca, 0xbf,
+	0x38, 0xf9, 0xfc, 0xfb, 0xcb, 0x8d, 0x97, 0x99, 0xe7, 0x5e, 0x87, 0x19, 0xed, 0x96, 0x71, 0x36,
+	0xfd, 0x2a, 0xd2, 0xd8, 0xf6, 0x5d, 0x96, 0x35, 0xa8, 0x59, 0x4b, 0x7b, 0x44, 0x5e, 0x15, 0xed,
+	0xa0, 0x30, 0x50, 0xea, 0x1b, 0x44, 0x47, 0x92, 0xda, 0xff, 0xb6, 0x4a, 0x26, 0x8d, 0x7d, 0x47,
+	0xe1, 0x26, 0xd2, 0x7a, 0xc4, 0x36, 0x91, 0x95, 0x11, 0x36, 0x91, 0xdf, 0x46, 0x26, 0xda, 0x52,
+	0x1b, 0x95, 0x53, 0x14, 0x24, 0xab, 0xe3, 0xb4, 0x42, 0x52, 0x4d, 0xa0, 0x79, 0xa2, 0x13, 0x97,
+	0x41, 0x26, 0x65, 0xa3, 0x29, 0x8a, 0x1b, 0x16, 0x1a, 0x2d, 0xff, 0x4c, 0xd6, 0x9f, 0xa5, 0x7e,
+	0xb4, 0x3f, 0x0b, 0xa6, 0x2a, 0x96, 0x1f, 0xf7, 0x14, 0xf2, 0x4f, 0xbd, 0x9a, 0xce, 0x3f, 0x75,
+	0xa5, 0x94, 0x61, 0x1e, 0x90, 0x78, 0xea, 0xb7, 0x2d, 0x62, 0xe7, 0x37, 0x47, 0xa6, 0x39, 0xc4,
+	0x3a, 0x22, 0xb9, 0xe2, 0x6d, 0x32, 0x41, 0xef, 0xf6, 0xbc, 0x88, 0xc6, 0x0b, 0xc9, 0x03, 0xe4,
+	0xc0, 0x64, 0x61, 0x15, 0x57, 0x24, 0x01, 0xd0, 0xb4, 0xd0, 0x59, 0xb3, 0xe7, 0xf6, 0x63, 0xca,
+	0x0c, 0x6a, 0xcd, 0x6a, 0x3a, 0x6f, 0xc7, 0xba, 0x82, 0x80, 0x81, 0xe5, 0xdc, 0x24, 0xe3, 0xe8,
+	0xe2, 0xe3, 0x06, 0x1d, 0xfb, 0xcb, 0xc8, 0x78, 0x9b, 0xff, 0x2b, 0xcc, 0xb3, 0xcc, 0x57, 0x44,
+	0x40, 0x41, 0xc2, 0xd0, 0x07, 0xd5, 0x8d, 0x76, 0xa4, 0x49, 0x96, 0xf9, 0xa0, 0x2e, 0x44, 0x3b,
+	0x31, 0xb0, 0x56, 0xe7, 0xbf, 0x58, 0x64, 0x06, 0x1f, 0xf1, 0x92, 0x55, 0xf9, 0x75, 0x9e, 0x23,
+	0x63, 0x6e, 0x3f, 0xd9, 0x0d, 0x73, 0x87, 0xdb, 0x05, 0xd6, 0x0a, 0x02, 0x8a, 0x87, 0x5b, 0x95,
+	0x87, 0xc5, 0x38, 0xdc, 0x2e, 0xe1, 0xd2, 0x64, 0x10, 0x1c, 0xe4, 0xb8, 0xbf, 0x55, 0xe4, 0xac,
+	0xb0, 0xc1, 0x9b, 0x41, 0xc2, 0x91, 0xd8, 0x56, 0xd8, 0x39, 0x68, 0xd6, 0xd2, 0xc4, 0x5a, 0x61,
+	0xe7, 0x00, 0x18, 0x04, 0x83, 0x3c, 0xe2, 0x5d, 0x57, 0xba, 0xc5, 0x08, 0x84, 0xea, 0xc6, 0xf5,
+	0x05, 0xc0, 0x76, 0x15, 0xb3, 0x14, 0xf9, 0xcd, 0xb1, 0xc3, 0x62, 0x96, 0x22, 0xdf, 0xf9, 0xc7,
+	0x35, 0xc2, 0xdc, 0xdd, 0xdc, 0x88, 0x76, 0x36, 0x43, 0x96, 0x30, 0xfd, 0x44, 0xbd, 0x4a, 0xb4,
+	0x75, 0xe0, 0x51, 0xf6, 0x2c, 0x31, 0xbc, 0x0b, 0xaa, 0xa7, 0xed, 0x5d, 0x50, 0xec, 0x30, 0x52,
+	0x7b, 0x84, 0x1c, 0x46, 0x9c, 0xef, 0x43, 0x39, 0x22, 0x9d, 0x17, 0xb5, 0x47, 0xd7, 0x65, 0x32,
+	0xa1, 0xbc, 0x25, 0xc5, 0x7a, 0xd1, 0x52, 0x5e, 0x02, 0x40, 0xe3, 0x0c, 0x61, 0x12, 0x7a, 0x56,
+	0xaa, 0xe0, 0x6a, 0x3a, 0xe4, 0x89, 0x29, 0x6e, 0xa1, 0x91, 0x9d, 0x7f, 0x56, 0x21, 0x8f, 0xf1,
+	0xdd, 0xdf, 0xaa, 0x1b, 0xb8, 0x3b, 0xb4, 0x8b, 0xbd, 0x1a, 0xd6, 0x47, 0xaf, 0x8d, 0xb6, 0x08,
+	0x4f, 0x0a, 0xb3, 0xe3, 0x8a, 0x5f, 0x2e, 0x67, 0xb8, 0x64, 0x59, 0x0e, 0xbc, 0x04, 0x18, 0x71,
+	0x3b, 0x26, 0x0d, 0x59, 0x10, 0xae, 0x59, 0x2d, 0x93, 0x91, 0xd2, 0x2c, 0x62, 0xa3, 0x44, 0x41,
+	0x31, 0xc2, 0xdd, 0x90, 0x1f, 0xb6, 0xf7, 0x70, 0xc9, 0x67, 0x77, 0x43, 0x2b, 0xa2, 0x1d, 0x14,
+	0x86, 0xd3, 0x25, 0xb3, 0x72, 0x0c, 0x7b, 0x98, 0x82, 0x9b, 0x6e, 0xe3, 0x16, 0xa2, 0x2d, 0x9b,
+	0x8c, 0x1a, 0x75, 0x6a, 0x0b, 0xb1, 0x68, 0x02, 0x21, 0x8d, 0x2b, 0x93, 0x7b, 0x57, 0x8a, 0x93,
+	0x7b, 0x3b, 0xdf, 0x5d, 0x23, 0xd9, 0x3d, 0x8c, 0x91, 0xca, 0xd8, 0x3a, 0x34, 0x95, 0xf1, 0x08,
+	0xc9, 0x80, 0x3f, 0x48, 0x26, 0xdd, 0x04, 0x37, 0xa9, 0xdc, 0xac, 0x55, 0x7d, 0xb0, 0x1b, 0xf3,
+	0xd5, 0xb0, 0xe3, 0x6d, 0x7b, 0x48, 0x01, 0x4c, 0x72, 0xe2, 0xc2, 0x36, 0xa6, 0xed, 0x7e, 0xe2,
+	0xed, 0xd3, 0xab, 0xae, 0xe7, 0xf7, 0x23, 0x11, 0x11, 0x55, 0x4d, 0x5d, 0xd8, 0x66, 0x51, 0xa0,
+	0xe8, 0x39, 0xdb, 0x27, 0x67, 0x5e, 0xeb, 0xbb, 0x91, 0x1b, 0x24, 0x5e, 0x40, 0x3b, 0x2f, 0x07,
+	0x89, 0xe7, 0x37, 0xeb, 0x23, 0xf7, 0x98, 0x15, 0xb9, 0x79, 0x29, 0x43, 0x07, 0x72, 0x94, 0xf1,
+	0x28, 0xa9, 0x9c, 0x47, 0xb8, 0xe7, 0xd7, 0xfb, 0x4a, 0xdd, 0xab, 0x0a, 0x97, 0x11, 0xbe, 0x63,
+	0x1f, 0xe8, 0x48, 0xe2, 0xfc, 0x8e, 0x45, 0x9e, 0x3c, 0xe4, 0xc9, 0x2f, 0xb8, 0x49, 0xe1, 0xfc,
+	0x70, 0x9d, 0x4c, 0x2c, 0x45, 0x07, 0xa3, 0x87, 0x0f, 0xe7, 0x83, 0x83, 0x2b, 0x23, 0x05, 0x07,
+	0xcb, 0xf0, 0xe3, 0xea, 0xc0, 0xf0, 0x63, 0x19, 0x3e, 0x5c, 0x7b, 0x58, 0xe1, 0xc3, 0xf5, 0x47,
+	0x24, 0x7c, 0x78, 0xec, 0x11, 0x08, 0x1f, 0x1e, 0x3f, 0xe5, 0xf0, 0x61, 0xe7, 0xbf, 0xd6, 0xc8,
+	0xd9, 0x5c, 0x36, 0x04, 0xfb, 0x05, 0x32, 0xa5, 0x04, 0xb7, 0xbc, 0xde, 0x9a, 0x30, 0xc3, 0x89,
+	0x34, 0x0c, 0x52, 0x98, 0x43, 0x68, 0xef, 0x65, 0x72, 0x0e, 0x2b, 0x39, 0xd1, 0x3e, 0x5d, 0xd8,
+	0x4e, 0x68, 0xb4, 0x41, 0xd1, 0x6f, 0x8b, 0x97, 0x07, 0xa8, 0xb6, 0x1e, 0x47, 0xd9, 0x08, 0x79,
+	0x30, 0x14, 0x3d, 0x63, 0xf7, 0xc8, 0xb4, 0x6f, 0x5a, 0x44, 0x9a, 0xb5, 0x07, 0x37, 0xa6, 0x28,
+	0x05, 0x96, 0x6a, 0x86, 0x34, 0x83, 0xb4, 0x59, 0xa5, 0xfe, 0x90, 0xcc, 0x2a, 0xdf, 0xa9, 0xcd,
+	0x2a, 0x5c, 0x46, 0x7f, 0xa0, 0xe4, 0x6c, 0x18, 0xc3, 0xd8, 0x55, 0x8e, 0x63, 0x29, 0x79, 0x89,
+	0x34, 0x64, 0xe4, 0xc2, 0x50, 0x1e, 0xff, 0x26, 0x9d, 0x01, 0xdb, 0xbd, 0x37, 0x2a, 0xa4, 0xc0,
+	0x18, 0x88, 0x92, 0x56, 0x1f, 0x01, 0x53, 0x92, 0x76, 0xb4, 0x63, 0xa0, 0x7d, 0x97, 0x47, 0x6d,
+	0x54, 0xcb, 0xd0, 0x89, 0xf9, 0x7e, 0xea, 0x40, 0x0e, 0xb5, 0x29, 0x52, 0xc1, 0x1c, 0xcf, 0x13,
+	0xa2, 0x0d, 0x11, 0xe2, 0xf8, 0xa7, 0x0e, 0xc1, 0xda, 0x5e, 0x01, 0x06, 0x16, 0xda, 0xb6, 0xbd,
+	0x20, 0x4e, 0x5c, 0xdf, 0xbf, 0xee, 0x05, 0x89, 0x38, 0x12, 0xaa, 0x13, 0xce, 0xb2, 0x06, 0x81,
+	0x89, 0x77, 0xf1, 0xdd, 0xc6, 0x77, 0x19, 0xe5, 0x7b, 0xee, 0x92, 0x27, 0xae, 0x79, 0x89, 0x12,
+	0x6d, 0x6a, 0x1e, 0xa1, 0x9d, 0x41, 0x69, 0x20, 0x6b, 0xa0, 0x06, 0x32, 0xc2, 0xf1, 0x2b, 0xe9,
+	0xec, 0x01, 0xd9, 0x70, 0x7c, 0xa7, 0x4d, 0xce, 0x5f, 0xf3, 0x12, 0x0c, 0x75, 0x3e, 0x41, 0x26,
+	0xbf, 0x32, 0x46, 0xa6, 0xcc, 0x2c, 0x39, 0xa3, 0xe8, 0x6b, 0x4c, 0xeb, 0x26, 0x05, 0xbb, 0xa7,
+	0xdc, 0xa6, 0x6e, 0x1f, 0x3b, 0x65, 0x4f, 0xf1, 0xe0, 0x1a, 0xa7, 0x56, 0xcd, 0x13, 0xcc, 0x0e,
+	0xd8, 0x77, 0x48, 0x7d, 0x9b, 0x45, 0x96, 0x57, 0xcb, 0xf0, 0xc6, 0x2d, 0x1a, 0x7c, 0xbd, 0x22,
+	0x79, 0x6c, 0x3a, 0xe7, 0x87, 0x27, 0x8d, 0x28, 0x9d, 0xd0, 0xc4, 0x88, 0xf7, 0xe3, 0xed, 0xa0,
+	0x30, 0x06, 0x69, 0x85, 0xfa, 0x03, 0x68, 0x85, 0x94, 0x8c, 0x1e, 0x7b, 0x48, 0x32, 0x9a, 0x65,
+	0x09, 0x48, 0x76, 0xd9, 0x39, 0x58, 0x04, 0x28, 0x8f, 0xb3, 0x41, 0x30, 0xb2, 0x04, 0xa4, 0xc0,
+	0x90, 0xc5, 0xb7, 0x3f, 0xa6, 0xa4, 0x7c, 0xa3, 0x8c, 0x0b, 0x59, 0x73, 0x46, 0x9f, 0xb4, 0x80,
+	0xff, 0xbe, 0x0a, 0x99, 0xb9, 0x16, 0xf4, 0xd7, 0xaf, 0xad, 0xf7, 0xb7, 0x7c, 0xaf, 0x7d, 0x83,
+	0x1e, 0xa0, 0x14, 0xdf, 0xa3, 0x07, 0xcb, 0x4b, 0x62, 0x05, 0xa9, 0x39, 0x73, 0x03, 0x1b, 0x81,
+	0xc3, 0x50, 0x6e, 0x6d, 0x7b, 0xc1, 0x0e, 0x8d, 0x7a, 0x91, 0x27, 0x6e, 0x29, 0x0d, 0xb9, 0x75,
+	0x55, 0x83, 0xc0, 0xc4, 0x43, 0xda, 0xe1, 0x9d, 0x40, 0xa5, 0x2c, 0x54, 0xb4, 0xd7, 0xb0, 0x11,
+	0x38, 0x0c, 0x91, 0x92, 0xa8, 0x2f, 0x2e, 0x01, 0x0c, 0xa4, 0x4d, 0x6c, 0x04, 0x0e, 0x13, 0x06,
+	0x39, 0xe6, 0xec, 0x5c, 0xcf, 0x19, 0xe4, 0xb0, 0x19, 0x24, 0x1c, 0x51, 0xf7, 0xe8, 0xc1, 0x12,
+	0x1a, 0x80, 0x33, 0xf6, 0xb4, 0x1b, 0xbc, 0x19, 0x24, 0x9c, 0xd5, 0x30, 0x48, 0x0f, 0xc7, 0x17,
+	0x5c, 0x0d, 0x83, 0x74, 0xf7, 0x07, 0x98, 0x92, 0x7f, 0xb8, 0x42, 0xa6, 0xde, 0xac, 0x16, 0x9f,
+	0xa7, 0xee, 0xdc, 0x26, 0x67, 0x73, 0xb9, 0x49, 0x86, 0xd8, 0xf9, 0x1c, 0x99, 0x3b, 0xca, 0x01,
+	0x32, 0x89, 0x84, 0x65, 0xee, 0xde, 0x45, 0x72, 0x96, 0x2f, 0x5e, 0xe4, 0xc4, 0x52, 0x4d, 0xa8,
+	0x7c, 0x33, 0xec, 0x1a, 0xfe, 0x56, 0x16, 0x08, 0x79, 0x7c, 0x2c, 0xd0, 0x36, 0x9d, 0x4a, 0x17,
+	0x53, 0xd2, 0x1e, 0x8d, 0xad, 0xee, 0x90, 0x05, 0xea, 0xb0, 0xf8, 0x4d, 0x6e, 0xcf, 0xd7, 0xab,
+	0x5b, 0x83, 0xc0, 0xc4, 0x73, 0x7e, 0xb3, 0x4a, 0x1a, 0xd2, 0x6f, 0x77, 0x88, 0xae, 0x7c, 0xca,
+	0x22, 0xd3, 0xca, 0xf5, 0x01, 0x9f, 0x11, 0x0b, 0xe0, 0xe6, 0xf1, 0x3d, 0x87, 0x95, 0xa9, 0x14,
+	0xef, 0xaa, 0xd4, 0x81, 0x01, 0x4c, 0x66, 0x90, 0xe6, 0x6d, 0xdf, 0xc2, 0x18, 0xc3, 0x38, 0xa1,
+	0x5d, 0xe3, 0xd6, 0xcc, 0x31, 0x66, 0xd9, 0x7c, 0x3b, 0x8c, 0x28, 0xce, 0x29, 0xf4, 0x76, 0xde,
+	0x50, 0x98, 0x7a, 0x87, 0xa7, 0xdb, 0xc0, 0xa0, 0x84, 0x75, 0xd5, 0x7c, 0x33, 0xad, 0x04, 0x94,
+	0xe3, 0x17, 0x3d, 0x8c, 0xbf, 0xd0, 0x31, 0x3c, 0x63, 0x9c, 0x9f, 0xad, 0x90, 0x33, 0xd9, 0x91,
+	0xb4, 0x3f, 0x80, 0xd1, 0x3a, 0xba, 0xde, 0x72, 0xc6, 0x59, 0x7a, 0x0a, 0x0c, 0xd8, 0x1b, 0xf7,
+	0xe6, 0xe6, 0xb4, 0xd3, 0xf4, 0x65, 0x1c, 0xbc, 0xcb, 0xfb, 0x86, 0x5f, 0x39, 0x4e, 0x83, 0x14,
+	0x31, 0xee, 0x36, 0x23, 0xbc, 0xcc, 0x5a, 0x07, 0x0b, 0xbd, 0x9e, 0xf0, 0x7d, 0x31, 0xdc, 0x66,
+	0x4c, 0x28, 0x64, 0xb0, 0x31, 0x08, 0xdf, 0x68, 0xb9, 0x49, 0xbd, 0x9d, 0xdd, 0xad, 0x30, 0x92,
+	0xe7, 0xd5, 0xa7, 0x74, 0xdc, 0x48, 0x1e, 0x07, 0x0a, 0x9f, 0xc4, 0x8d, 0x51, 0xdb, 0xed, 0xb9,
+	0x6d, 0x2f, 0x39, 0x10, 0x56, 0x41, 0x25, 0xc6, 0x17, 0x45, 0x3b, 0x28, 0x0c, 0xe7, 0x7f, 0xd4,
+	0xc9, 0x19, 0x1e, 0x28, 0x41, 0x55, 0x1c, 0x90, 0xfd, 0x01, 0x32, 0x11, 0x27, 0x6e, 0xc4, 0x4d,
+	0x55, 0xa3, 0xbb, 0x4a, 0xeb, 0x1c, 0x37, 0x92, 0x08, 0x68, 0x7a, 0x18, 0x4f, 0xb4, 0xed, 0x05,
+	0x5e, 0xbc, 0xcb, 0xa8, 0x57, 0x1e, 0xcc, 0x10, 0x76, 0x55, 0x51, 0x00, 0x83, 0x9a, 0xfd, 0xf5,
+	0xa4, 0xde, 0xdb, 0x75, 0x63, 0x69, 0xba, 0x7f, 0x4e, 0xca, 0x89, 0x75, 0x6c, 0xc4, 0x88, 0x98,
+	0xec, 0xab, 0x32, 0x00, 0xf0, 0x87, 0x4c, 0x29, 0x5f, 0x3b, 0xba, 0x02, 0x5e, 0x27, 0x3a, 0xd8,
+	0xb8, 0xbe, 0x90, 0xad, 0x99, 0xb6, 0xc4, 0x5a, 0x41, 0x40, 0x51, 0x26, 0xed, 0x72, 0x96, 0x1d,
+	0x44, 0x1e, 0x4b, 0xef, 0x38, 0xae, 0x6b, 0x10, 0x98, 0x78, 0x98, 0x76, 0x36, 0x1b, 0x46, 0x33,
+	0x7e, 0x02, 0xd1, 0x9e, 0xc3, 0x06, 0xd0, 0xb0, 0x33, 0x62, 0x2f, 0x0a, 0xf7, 0x71, 0xa6, 0x09,
+	0xaf, 0x14, 0x7d, 0x46, 0x54, 0x10, 0x30, 0xb0, 0xf0, 0x33, 0xcb, 0x5f, 0x0b, 0x49, 0x73, 0xe2,
+	0xc1, 0x3e, 0xf3, 0x82, 0xa2, 0x00, 0x06, 0x35, 0xd4, 0x43, 0x6e, 0x87, 0x07, 0xe3, 0xb9, 0x3e,
+	0x1f, 0x71, 0x5e, 0x8a, 0x5e, 0xe8, 0xa1, 0x85, 0x2c, 0x10, 0xf2, 0xf8, 0xce, 0xe7, 0x2c, 0x62,
+	0x8b, 0x0f, 0x60, 0x24, 0xbe, 0xb4, 0x5f, 0x64, 0xfe, 0x37, 0x3c, 0x41, 0x29, 0x97, 0x14, 0xf3,
+	0x86, 0xff, 0x0d, 0x6b, 0x7f, 0xe3, 0xde, 0xdc, 0xc5, 0xfc, 0x93, 0x12, 0x0a, 0xea, 0x79, 0xbc,
+	0x8f, 0x70, 0x7b, 0x5e, 0xf6, 0x3e, 0x62, 0x61, 0x7d, 0x19, 0xb0, 0x1d, 0x57, 0xaa, 0xc7, 0x2c,
+	0xf2, 0x11, 0x15, 0xda, 0x4a, 0xad, 0xd4, 0x65, 0xd1, 0x0e, 0x0a, 0xc3, 0xf9, 0x2d, 0x8b, 0x4c,
+	0x08, 0xae, 0x9b, 0x21, 0x9a, 0xd0, 0xb8, 0x29, 0xb6, 0x15, 0xb9, 0x41, 0x7b, 0x37, 0x6b, 0x42,
+	0xdb, 0x34, 0x60, 0x90, 0xc2, 0x64, 0xee, 0x8f, 0x66, 0x52, 0xd1, 0x52, 0xca, 0x75, 0xe6, 0x87,
+	0xe3, 0xf0, 0x94, 0xa2, 0xce, 0xdf, 0xb2, 0xc8, 0x63, 0x72, 0xfa, 0xaf, 0xba, 0x81, 0xb7, 0x4d,
+	0xe3, 0x64, 0xc5, 0x3d, 0x08, 0xfb, 0x89, 0xfd, 0xde, 0x54, 0x50, 0xcb, 0x57, 0x64, 0x82, 0x5a,
+	0x2e, 0x16, 0x3f, 0x65, 0xc4, 0xb1, 0xbc, 0x87, 0x4c, 0x4b, 0x1b, 0xac, 0xbe, 0xd7, 0x6d, 0x68,
+	0x85, 0x7a, 0xc3, 0x04, 0x42, 0x1a, 0xd7, 0xf9, 0x20, 0x39, 0x27, 0x19, 0x98, 0xb3, 0xe2, 0x39,
+	0x32, 0x16, 0xf4, 0xbb, 0x5b, 0x62, 0x4e, 0x54, 0xf5, 0x7a, 0xbf, 0xc9, 0x5a, 0x41, 0x40, 0xf1,
+	0x8b, 0xf7, 0x23, 0x3f, 0xfb, 0xc5, 0xf1, 0x08, 0x8f, 0xed, 0xce, 0x2a, 0xa9, 0x0d, 0xb9, 0xcd,
+	0x18, 0xca, 0x2a, 0xf5, 0x12, 0x69, 0x20, 0x39, 0x69, 0xa2, 0x28, 0x83, 0x64, 0x48, 0x1a, 0xb2,
+	0x70, 0xbb, 0xed, 0x90, 0xaa, 0xe7, 0x4a, 0x3f, 0x50, 0x3d, 0x35, 0xe3, 0xb8, 0xcf, 0x56, 0x24,
+	0x02, 0xed, 0x67, 0x49, 0x95, 0xde, 0xed, 0x65, 0x1d, 0x3e, 0xb5, 0xbb, 0x05, 0x42, 0x45, 0x5c,
+	0x0c, 0x97, 0xc9, 0x99, 0xb8, 0x18, 0xe7, 0x2e, 0x99, 0x90, 0x0c, 0x59, 0x34, 0x16, 0x3f, 0x54,
+	0x58, 0x65, 0x44, 0x63, 0x49, 0xba, 0x03, 0x8e, 0x13, 0x7d, 0x42, 0x74, 0xfa, 0xb0, 0xb2, 0x36,
+	0xa1, 0x97, 0x48, 0xad, 0x1d, 0x76, 0xe4, 0x7a, 0x56, 0x64, 0xd8, 0x69, 0x82, 0x41, 0x9c, 0xdb,
+	0x64, 0xe6, 0x46, 0x10, 0xde, 0x61, 0x95, 0x46, 0x59, 0x61, 0x0d, 0x24, 0xbc, 0x8d, 0xff, 0x64,
+	0xcf, 0xae, 0x0c, 0x0a, 0x1c, 0xa6, 0x52, 0xfe, 0x57, 0x06, 0xa5, 0xfc, 0x77, 0x3e, 0x6e, 0x91,
+	0x29, 0x75, 0x0f, 0x71, 0x6d, 0x7f, 0x0f, 0xe9, 0xee, 0x44, 0x61, 0xbf, 0x97, 0xa5, 0x7b, 0x0d,
+	0x1b, 0x81, 0xc3, 0xcc, 0x04, 0x5d, 0x95, 0x23, 0x12, 0x74, 0x5d, 0x22, 0xb5, 0x3d, 0x2f, 0xe8,
+	0x64, 0x2f, 0x7b, 0x6e, 0x78, 0x41, 0x07, 0x18, 0xc4, 0xf9, 0xbf, 0x16, 0x39, 0xa3, 0xba, 0x20,
+	0x4f, 0x0d, 0x2f, 0x90, 0xa9, 0xad, 0xbe, 0xe7, 0x77, 0xc4, 0xef, 0xac, 0xa8, 0x6a, 0x19, 0x30,
+	0x48, 0x61, 0xa2, 0xde, 0xd9, 0xf2, 0x02, 0x37, 0x3a, 0x58, 0xd7, 0xc7, 0x14, 0xa5, 0x77, 0x5a,
+	0x0a, 0x02, 0x06, 0x16, 0xe6, 0x95, 0xda, 0x97, 0x9e, 0x57, 0xd5, 0x52, 0xf3, 0x4a, 0x89, 0xf1,
+	0xd0, 0x2b, 0x41, 0xb9, 0x72, 0x29, 0x8e, 0xce, 0x0f, 0x54, 0xc9, 0x4c, 0x3a, 0x17, 0xd4, 0x10,
+	0xb6, 0xc3, 0x67, 0x49, 0x9d, 0xa5, 0x87, 0xca, 0x4e, 0x2c, 0xf6, 0x3c, 0x70, 0x18, 0x06, 0xaa,
+	0x70, 0x31, 0x2e, 0x76, 0xf9, 0x6b, 0x25, 0xbd, 0x95, 0xba, 0xa1, 0x60, 0xd7, 0x37, 0xe2, 0xba,
+	0x4f, 0xb0, 0x62, 0xf7, 0xb5, 0x61, 0xcf, 0xcc, 0x35, 0xff, 0xbe, 0x32, 0xf3, 0x64, 0x89, 0x64,
+	0x34, 0x71, 0xe6, 0xbe, 0x56, 0x4e, 0x06, 0xc9, 0xfa, 0xe2, 0xd7, 0x91, 0x29, 0x13, 0xf3, 0xa8,
+	0x23, 0x41, 0xc3, 0x3c, 0x12, 0x7c, 0xca, 0x9c, 0x92, 0x22, 0x13, 0xd8, 0x10, 0x8b, 0xfd, 0x65,
+	0x52, 0x6f, 0x2b, 0x57, 0xf6, 0x07, 0xaa, 0x72, 0xa5, 0x32, 0xe5, 0x22, 0x19, 0xe0, 0xd4, 0xd0,
+	0xcf, 0x6f, 0xc6, 0xe8, 0x4d, 0xbc, 0xdc, 0xb1, 0x23, 0x52, 0xdd, 0xd9, 0xdf, 0x13, 0xdb, 0xec,
+	0x17, 0x4b, 0x1a, 0xde, 0x6b, 0xfb, 0x7b, 0x7a, 0x85, 0x99, 0xad, 0x80, 0xcc, 0x86, 0xb8, 0x46,
+	0x4b, 0x25, 0x8c, 0xab, 0x1e, 0x9d, 0x30, 0xce, 0xf9, 0x6c, 0x85, 0x9c, 0xcd, 0x4d, 0x2a, 0xfb,
+	0x75, 0x52, 0x8f, 0xf0, 0x2d, 0x9b, 0x56, 0x19, 0xdb, 0xd7, 0xf4, 0xc8, 0xe9, 0xed, 0x6b, 0xba,
+	0x1d, 0x38, 0x4b, 0xf4, 0xca, 0xd6, 0x61, 0x1f, 0xea, 0x0e, 0x8f, 0xbf, 0xb2, 0xf2, 0xca, 0x5e,
+	0xc8, 0x61, 0x40, 0xc1, 0x53, 0xb8, 0xa7, 0x48, 0x5f, 0x05, 0x66, 0xaa, 0x97, 0x1c, 0x76, 0xab,
+	0xe7, 0x7c, 0xc6, 0x9c, 0x82, 0xb7, 0xb4, 0x30, 0x3d, 0xae, 0x79, 0x26, 0x27, 0x59, 0xab, 0xc3,
+	0x4a, 0x56, 0xe7, 0x97, 0x2a, 0x64, 0x3a, 0x55, 0x8d, 0xc0, 0xf6, 0x49, 0x83, 0xfa, 0xcc, 0x8d,
+	0x49, 0x6a, 0xdf, 0xe3, 0x16, 0x26, 0x54, 0x72, 0xf2, 0x8a, 0xa0, 0x0b, 0x8a, 0xc3, 0xa3, 0xe1,
+	0x3f, 0xfe, 0x02, 0x99, 0x92, 0x1d, 0x7a, 0x9f, 0xdb, 0xf5, 0xb3, 0xc3, 0x77, 0xc5, 0x80, 0x41,
+	0x0a, 0xd3, 0xf9, 0xd5, 0x2a, 0x69, 0x72, 0xbf, 0xaf, 0x8e, 0x5a, 0x0c, 0xca, 0x7f, 0xf3, 0xff,
+	0xd7, 0x35, 0x43, 0xf8, 0x40, 0x6e, 0x1d, 0xb7, 0x0e, 0x70, 0x31, 0xa3, 0xa1, 0x82, 0xaf, 0x7e,
+	0x22, 0x13, 0x7c, 0xc5, 0x8d, 0x55, 0x3b, 0x27, 0xd4, 0xa3, 0xd1, 0xa3, 0xb1, 0x1e, 0x66, 0x1c,
+	0xd4, 0x3f, 0xa8, 0x90, 0xd9, 0x4c, 0x91, 0x65, 0xcc, 0x1d, 0x6d, 0xd6, 0xe5, 0xb3, 0xca, 0xb8,
+	0x00, 0x3f, 0xb4, 0xee, 0xee, 0x68, 0xd5, 0xf9, 0x1e, 0xd2, 0x52, 0x71, 0xfe, 0xa0, 0x42, 0x66,
+	0xd2, 0xd5, 0xa1, 0x1f, 0xc1, 0x91, 0xfa, 0x4a, 0x32, 0xc1, 0x0a, 0xa0, 0xde, 0xa0, 0x07, 0xf2,
+	0x9e, 0x9d, 0xd7, 0x9a, 0x94, 0x8d, 0xa0, 0xe1, 0x8f, 0x44, 0xd1, 0x43, 0xe7, 0x1f, 0x5a, 0xe4,
+	0x02, 0x7f, 0xcb, 0xec, 0x3c, 0xfc, 0x1b, 0x45, 0xa3, 0xfb, 0x4a, 0xb9, 0x1d, 0xcc, 0xd4, 0xba,
+	0x39, 0x6a, 0x7c, 0x71, 0xf3, 0x72, 0x5e, 0xf4, 0x36, 0x3d, 0x15, 0x1e, 0xc1, 0xce, 0x8e, 0x34,
+	0x19, 0x9c, 0x3f, 0xac, 0x90, 0xc9, 0xb5, 0xc5, 0x65, 0x25, 0xc2, 0xd1, 0xab, 0x38, 0xa2, 0xae,
+	0x36, 0x80, 0x9a, 0x5e, 0xc5, 0x12, 0x00, 0x1a, 0x07, 0x4f, 0x51, 0xdc, 0x2b, 0x3f, 0xce, 0x9e,
+	0xa2, 0xb8, 0xd3, 0x7e, 0x0c, 0x12, 0xce, 0xac, 0x3e, 0x5d, 0x77, 0x87, 0xa2, 0xa7, 0x7c, 0x35,
+	0x7d, 0x71, 0xcd, 0x52, 0xb5, 0xa0, 0xb1, 0x40, 0x61, 0x20, 0xe1, 0x4e, 0xd8, 0x8e, 0x11, 0x39,
+	0x63, 0x93, 0x5c, 0xc2, 0x66, 0xf4, 0x0d, 0x10, 0x70, 0xec, 0x34, 0xb7, 0xdb, 0x21, 0x72, 0x3d,
+	0xdd, 0x69, 0x6e, 0xe0, 0x43, 0x74, 0x8d, 0x33, 0x4a, 0x56, 0xfa, 0x4c, 0x22, 0x80, 0xf1, 0xe1,
+	0x12, 0x01, 0x38, 0x7f, 0x50, 0x25, 0x13, 0xda, 0xac, 0xec, 0x89, 0xe4, 0x68, 0xa5, 0xd4, 0x52,
+	0xc2, 0xb0, 0x4e, 0x45, 0x9a, 0xfb, 0xd3, 0x18, 0xb9, 0xd1, 0xbe, 0xc7, 0x42, 0x17, 0x15, 0x2f,
+	0xf1, 0x5c, 0x66, 0x1d, 0x2f, 0xc7, 0xc8, 0xa5, 0xd8, 0x2d, 0x73, 0xca, 0x61, 0x64, 0x3a, 0xbd,
+	0x28, 0x66, 0x60, 0x72, 0xb6, 0x3f, 0x22, 0xe2, 0xce, 0xab, 0xa5, 0x25, 0x3a, 0x6c, 0x64, 0x82,
+	0xcd, 0x7b, 0xb8, 0xc7, 0x4e, 0xa2, 0x92, 0xf2, 0x83, 0x02, 0x92, 0x52, 0x35, 0xfd, 0xd4, 0x29,
+	0x86, 0x35, 0x03, 0x67, 0xe4, 0xc4, 0xc4, 0xce, 0x8f, 0xc5, 0x88, 0xd1, 0xb4, 0x18, 0x2f, 0x2c,
+	0x93, 0xb5, 0x08, 0x0b, 0x9d, 0x8e, 0x17, 0x96, 0x00, 0xd0, 0x38, 0xce, 0x0f, 0xd4, 0x49, 0x26,
+	0x55, 0x99, 0x7d, 0x97, 0x4c, 0xa8, 0x64, 0x65, 0xe5, 0xe4, 0xc8, 0xd0, 0x33, 0x4a, 0x75, 0x46,
+	0x35, 0x81, 0x66, 0x66, 0xef, 0xc8, 0x8b, 0x06, 0xbe, 0xda, 0x5f, 0xca, 0x5e, 0x34, 0x7c, 0xd3,
+	0x70, 0xf7, 0xce, 0x38, 0x57, 0x2f, 0xf3, 0x1c, 0xd9, 0xf3, 0x47, 0xde, 0x49, 0x1c, 0x91, 0x36,
+	0x06, 0x4b, 0x9f, 0xb0, 0x24, 0xa0, 0x40, 0xe3, 0xbe, 0x9f, 0x88, 0xd9, 0xf0, 0x52, 0x89, 0xab,
+	0x8c, 0x13, 0xd6, 0x99, 0x47, 0xf9, 0x6f, 0x30, 0x98, 0xa6, 0x6f, 0x8e, 0xc6, 0x4e, 0xf4, 0xe6,
+	0x68, 0xbc, 0xd4, 0x9b, 0xa3, 0xe7, 0x09, 0x61, 0x73, 0x9b, 0x47, 0xfd, 0x35, 0x98, 0x39, 0x53,
+	0xa9, 0x18, 0x50, 0x10, 0x30, 0xb0, 0x9c, 0xaf, 0x26, 0xe9, 0xd4, 0xb9, 0x98, 0xf6, 0x81, 0x67,
+	0xea, 0xe5, 0x77, 0xe2, 0x2c, 0xed, 0x43, 0x2a, 0xa9, 0xee, 0x2f, 0x58, 0xc4, 0xcc, 0xef, 0x6b,
+	0xbf, 0xc6, 0x13, 0x09, 0x5b, 0x65, 0xdc, 0xb1, 0x1a, 0x74, 0xe7, 0x57, 0xdd, 0x5e, 0xc6, 0xdf,
+	0x4f, 0x66, 0x13, 0x46, 0x27, 0x3c, 0x09, 0x1d, 0x69, 0xb3, 0xfc, 0x31, 0x72, 0x4e, 0x26, 0xd2,
+	0x92, 0xd7, 0xa1, 0xc2, 0xef, 0xe6, 0x68, 0x1b, 0xa3, 0x34, 0x1c, 0x56, 0x06, 0x19, 0x0e, 0xd5,
+	0x69, 0xb8, 0x3a, 0xb0, 0x44, 0xd0, 0x2f, 0x5a, 0xe4, 0x52, 0xb6, 0x03, 0xf1, 0x6a, 0x18, 0x78,
+	0x49, 0x18, 0x6d, 0xd0, 0x24, 0xf1, 0x82, 0x1d, 0x56, 0xef, 0xe1, 0x8e, 0x1b, 0xc9, 0x9a, 0x9f,
+	0x4c, 0x50, 0xde, 0x76, 0xa3, 0x00, 0x58, 0x2b, 0xfa, 0x41, 0xf3, 0xb8, 0x22, 0x71, 0x0a, 0x3a,
+	0xe6, 0xda, 0x28, 0x18, 0x0e, 0x7d, 0x0c, 0xe3, 0x31, 0x4d, 0x20, 0x18, 0x3a, 0x7f, 0x66, 0x11,
+	0x7b, 0x6d, 0x9f, 0x46, 0x91, 0xd7, 0x31, 0x22, 0xa1, 0x58, 0x25, 0x7a, 0xa3, 0xe2, 0xbc, 0x99,
+	0xe6, 0x2d, 0x53, 0x89, 0xde, 0xf8, 0x55, 0x5c, 0x89, 0xbe, 0x32, 0x5a, 0x25, 0x7a, 0x7b, 0x8d,
+	0x5c, 0xe8, 0xf2, 0x63, 0x1c, 0xaf, 0xee, 0xcc, 0xcf, 0x74, 0x2a, 0x17, 0xcf, 0x13, 0x98, 0x3d,
+	0x7d, 0xb5, 0x08, 0x01, 0x8a, 0x9f, 0x73, 0xde, 0x4d, 0x6c, 0xee, 0xfc, 0xbd, 0x58, 0xe4, 0xb0,
+	0x3d, 0xd0, 0xcc, 0xe1, 0xfc, 0x78, 0x9d, 0xcc, 0x66, 0x2a, 0xc2, 0xe1, 0x11, 0x3a, 0xef, 0x21,
+	0x7e, 0x6c, 0xfd, 0x9d, 0xef, 0xde, 0x50, 0x3e, 0xe7, 0x01, 0xa9, 0x7b, 0x41, 0xaf, 0x9f, 0x94,
+	0x93, 0x10, 0x8d, 0x77, 0x62, 0x19, 0x09, 0x1a, 0xf7, 0x12, 0xf8, 0x13, 0x38, 0x9b, 0x32, 0x3d,
+	0xd8, 0x53, 0x87, 0x9c, 0xda, 0x43, 0x32, 0xb3, 0x7c, 0xbb, 0xf6, 0x27, 0xaf, 0x97, 0x61, 0x43,
+	0xce, 0x4c, 0x96, 0x93, 0x76, 0x36, 0xfc, 0xb9, 0x0a, 0x99, 0x34, 0x3e, 0x9a, 0xfd, 0x53, 0xe9,
+	0xec, 0xf7, 0x56, 0x79, 0xaf, 0xc4, 0xe8, 0xcf, 0xeb, 0xfc, 0xf6, 0xfc, 0x95, 0x9e, 0xcb, 0x27,
+	0xbe, 0x7f, 0xe3, 0xde, 0xdc, 0x99, 0x4c, 0x6a, 0xfb, 0x54, 0x32, 0xfc, 0x8b, 0xdf, 0x4a, 0x66,
+	0x33, 0x64, 0x0a, 0x5e, 0x79, 0xd3, 0x7c, 0xe5, 0x63, 0x9b, 0xfb, 0xcc, 0x21, 0xfb, 0x1c, 0x0e,
+	0x99, 0xc8, 0x40, 0x14, 0xfa, 0x74, 0x08, 0x5b, 0x67, 0xe6, 0x7c, 0x51, 0x19, 0x32, 0xd1, 0xd8,
+	0xdb, 0x48, 0xa3, 0x17, 0xfa, 0x5e, 0xdb, 0x53, 0xc5, 0x73, 0x58, 0x6a, 0xb3, 0x75, 0xd1, 0x06,
+	0x0a, 0x6a, 0xdf, 0x21, 0x13, 0xaf, 0xde, 0x49, 0xf8, 0x35, 0x63, 0xb3, 0x56, 0xea, 0xed, 0xa2,
+	0xda, 0xb4, 0xc8, 0x96, 0x18, 0x34, 0x2f, 0x4c, 0xc9, 0xc7, 0x94, 0xa0, 0xcc, 0x03, 0xc0, 0xae,
+	0x59, 0x98, 0x76, 0x8c, 0x41, 0x40, 0x9c, 0xdf, 0x99, 0x24, 0xe7, 0x8b, 0xca, 0x72, 0xda, 0x1f,
+	0x25, 0x63, 0xbc, 0x8f, 0xe5, 0x54, 0x7e, 0x2e, 0xe2, 0x71, 0x8d, 0x11, 0x14, 0xdd, 0x62, 0xff,
+	0x83, 0xe0, 0x29, 0xb8, 0xfb, 0xee, 0x56, 0xb3, 0x72, 0x82, 0xdc, 0x57, 0x5c, 0xcd, 0x7d, 0xc5,
+	0xe5, 0xdc, 0x7d, 0x77, 0xcb, 0xbe, 0x4b, 0xea, 0x3b, 0x5e, 0x42, 0x5d, 0x61, 0x9c, 0xb9, 0x7d,
+	0x22, 0xcc, 0xa9, 0xcb, 0x77, 0x69, 0xec, 0x5f, 0xe0, 0x0c, 0x31, 0x1a, 0x7a, 0x76, 0x2b, 0x9d,
+	0xe1, 0x50, 0x08, 0x4f, 0xb7, 0xfc, 0x4e, 0x64, 0x52, 0x29, 0xb6, 0xce, 0xa1, 0xf3, 0x76, 0xa6,
+	0x11, 0xb2, 0xdd, 0x41, 0x27, 0x8e, 0xf1, 0x6d, 0xcf, 0x37, 0x6a, 0xdb, 0x9d, 0xc0, 0xc7, 0xb9,
+	0xca, 0x18, 0xe8, 0x13, 0x07, 0xff, 0x1d, 0x83, 0xe4, 0x3c, 0x48, 0x53, 0x8d, 0x1d, 0x57, 0x53,
+	0x8d, 0x3f, 0x24, 0x4d, 0xf5, 0x49, 0x8b, 0x4c, 0xa8, 0x91, 0x16, 0x99, 0xe2, 0x3e, 0x70, 0x82,
+	0x9f, 0x9c, 0x5b, 0xa4, 0xd4, 0x4f, 0xd0, 0xcc, 0x31, 0xbb, 0xcb, 0xa4, 0xfb, 0x7a, 0x3f, 0xa2,
+	0x1d, 0xba, 0x1f, 0xf6, 0x62, 0xe1, 0x40, 0xf5, 0x4a, 0xf9, 0x9d, 0x59, 0x40, 0x26, 0x4b, 0x74,
+	0x7f, 0xad, 0x17, 0x8b, 0x1c, 0x25, 0xba, 0x01, 0xcc, 0x2e, 0x60, 0xfa, 0x75, 0xa9, 0xc7, 0x49,
+	0x19, 0x25, 0x5f, 0x8a, 0x7a, 0x33, 0x54, 0xca, 0x1d, 0x4a, 0x9e, 0x6c, 0x87, 0x41, 0xe2, 0x05,
+	0x7d, 0xba, 0x16, 0x00, 0xed, 0x85, 0x37, 0xc3, 0xe4, 0x6a, 0xd8, 0x0f, 0x3a, 0x57, 0xa2, 0x28,
+	0x8c, 0x9a, 0x93, 0xe9, 0x82, 0xff, 0x8b, 0x83, 0x51, 0xe1, 0x30, 0x3a, 0xc7, 0xd9, 0x33, 0xdc,
+	0xab, 0x90, 0xb9, 0x23, 0x06, 0x1b, 0x6f, 0x9f, 0xc2, 0x68, 0xc7, 0x0d, 0xa4, 0x97, 0x52, 0xc6,
+	0x2d, 0x62, 0xcd, 0x80, 0x41, 0x0a, 0xd3, 0x4c, 0xfb, 0x57, 0x39, 0x22, 0xed, 0xdf, 0x25, 0x52,
+	0x8b, 0x30, 0x16, 0x3f, 0x73, 0xae, 0xc2, 0x97, 0x05, 0x06, 0x91, 0x3e, 0x6a, 0xb5, 0x01, 0x3e,
+	0x6a, 0x66, 0x16, 0xd2, 0xfa, 0xa9, 0x64, 0x21, 0x45, 0x8d, 0x29, 0xae, 0xcf, 0xc6, 0xb4, 0xc6,
+	0x4c, 0x5f, 0x6b, 0x39, 0x9f, 0xad, 0x92, 0xa7, 0x0f, 0x5d, 0x5a, 0x3a, 0x68, 0xc3, 0x3a, 0x24,
+	0x68, 0x43, 0x0e, 0x4f, 0xe5, 0xa8, 0xe1, 0xa9, 0x0e, 0x18, 0x9e, 0xef, 0x44, 0x89, 0x21, 0xb3,
+	0xe2, 0x0a, 0x25, 0x71, 0xcc, 0x40, 0x9a, 0x41, 0x49, 0x76, 0x85, 0xb0, 0x90, 0x50, 0xd0, 0x7c,
+	0xf1, 0xb8, 0x94, 0x4a, 0x36, 0x57, 0x2f, 0x43, 0x63, 0x0e, 0xcc, 0x4c, 0xcb, 0xc5, 0xc4, 0xa0,
+	0x0c, 0x76, 0xce, 0x2f, 0xd7, 0xc8, 0xb3, 0x43, 0x28, 0x3a, 0x73, 0x16, 0x5b, 0x43, 0xce, 0xe2,
+	0x2f, 0xf0, 0xcf, 0xf4, 0x89, 0xc2, 0xcf, 0x04, 0xe5, 0x7f, 0xa6, 0xc3, 0xbf, 0x50, 0xca, 0xef,
+	0x74, 0xec, 0x28, 0xbf, 0x53, 0x3c, 0xfe, 0xb6, 0x5d, 0x5c, 0xfe, 0xe3, 0x25, 0x25, 0x17, 0x33,
+	0xf3, 0x7d, 0xf0, 0xdd, 0xd7, 0xe2, 0x02, 0x4a, 0x00, 0xce, 0x06, 0x13, 0x4d, 0x5f, 0x1c, 0xbc,
+	0x1b, 0xc1, 0xe4, 0x5a, 0x5b, 0xcc, 0x91, 0x75, 0x95, 0xb9, 0x4c, 0x89, 0xa9, 0xc3, 0xde, 0x57,
+	0x37, 0x83, 0x89, 0x83, 0xf6, 0x12, 0xd3, 0x03, 0x76, 0xd5, 0xf0, 0xb5, 0x62, 0xf6, 0x92, 0xcd,
+	0x2c, 0x10, 0xf2, 0xf8, 0x98, 0xe3, 0x36, 0xf1, 0x12, 0x9f, 0xae, 0xaa, 0x64, 0x51, 0x22, 0xc7,
+	0xed, 0xa6, 0x6a, 0x05, 0x03, 0xc3, 0xf9, 0x7c, 0xb5, 0xf8, 0x35, 0xf8, 0x2e, 0x77, 0x94, 0xd9,
+	0x7f, 0x84, 0x17, 0xb1, 0x29, 0xa1, 0xab, 0xa7, 0x2d, 0xa1, 0x6b, 0x83, 0x24, 0x34, 0x66, 0xb8,
+	0x35, 0xfc, 0x7d, 0x79, 0x7a, 0x3a, 0x7e, 0x29, 0xa5, 0x32, 0xdc, 0xae, 0x67, 0xe0, 0x90, 0x7b,
+	0xe2, 0x11, 0x9f, 0xaa, 0xbf, 0x5e, 0x21, 0x4f, 0x0c, 0x3c, 0x58, 0x9c, 0x92, 0x06, 0x32, 0x3f,
+	0x7f, 0xed, 0x74, 0x3e, 0xbf, 0xf9, 0x51, 0xea, 0x47, 0x7e, 0x94, 0x61, 0xd4, 0xf9, 0x1f, 0x55,
+	0x06, 0x2e, 0x16, 0x3c, 0x88, 0x7e, 0xd1, 0x8e, 0xe4, 0x7b, 0xc8, 0xb4, 0xdb, 0xeb, 0x71, 0x3c,
+	0x16, 0x9b, 0x94, 0xc9, 0xba, 0xbd, 0x60, 0x02, 0x21, 0x8d, 0x3b, 0xd4, 0xc0, 0xfe, 0x89, 0x45,
+	0x26, 0x80, 0x6e, 0x73, 0x09, 0x87, 0xd5, 0xa9, 0xd8, 0x10, 0x59, 0x65, 0x54, 0xa7, 0xc2, 0x81,
+	0x8d, 0x3d, 0x96, 0x7a, 0xa4, 0x68, 0xb0, 0x8f, 0x9b, 0x59, 0xe6, 0x59, 0x52, 0x6f, 0xef, 0xba,
+	0x51, 0x92, 0x0d, 0xba, 0x65, 0xf9, 0xe9, 0x81, 0xc3, 0x9c, 0x5f, 0x99, 0xc0, 0xd7, 0xeb, 0x85,
+	0x58, 0xfd, 0x3c, 0x96, 0xce, 0xf7, 0x56, 0xb1, 0xf3, 0x7d, 0xea, 0x7e, 0xb2, 0x32, 0x52, 0xb6,
+	0xdf, 0xea, 0x91, 0xd9, 0x7e, 0x31, 0xf3, 0x65, 0xbc, 0xbb, 0x1e, 0x79, 0xfb, 0x6e, 0x82, 0x17,
+	0x01, 0xcd, 0x5a, 0xfa, 0x43, 0x6e, 0x6c, 0x5c, 0xd7, 0x40, 0x48, 0xe3, 0x62, 0xe2, 0x49, 0x9d,
+	0x73, 0x97, 0x46, 0x09, 0x0b, 0xfa, 0xe5, 0x33, 0x41, 0xe5, 0x48, 0xd3, 0x59, 0x7a, 0x05, 0x02,
+	0xe4, 0x9f, 0x41, 0x99, 0x9b, 0x6a, 0xc4, 0x8e, 0x8c, 0xa5, 0x65, 0x6e, 0x8a, 0x0e, 0xf6, 0x25,
+	0xf7, 0x04, 0x66, 0x98, 0xe2, 0x13, 0x63, 0xa1, 0xd7, 0x33, 0xde, 0x68, 0x3c, 0x5d, 0x12, 0xe8,
+	0x5a, 0x1e, 0x05, 0x8a, 0x9e, 0x43, 0xd3, 0x9e, 0x6a, 0x5e, 0x5e, 0x12, 0x57, 0x6b, 0xca, 0xb4,
+	0xa7, 0xc8, 0x2c, 0x77, 0xc0, 0xc4, 0xc3, 0xc2, 0xb7, 0xfa, 0x27, 0x4f, 0x22, 0xc1, 0xef, 0x9b,
+	0x97, 0x44, 0x52, 0x75, 0x55, 0xf8, 0xf6, 0x5a, 0x21, 0x5a, 0x07, 0x06, 0x3d, 0x6f, 0x6f, 0x91,
+	0x8b, 0x0a, 0x74, 0x25, 0x48, 0x58, 0x98, 0x77, 0x4c, 0x5b, 0x6e, 0xcc, 0x3c, 0x27, 0x08, 0x7b,
+	0x4f, 0x47, 0x50, 0xbf, 0x78, 0xcd, 0x4b, 0xae, 0x17, 0x61, 0xc2, 0x0a, 0x1c, 0x42, 0x05, 0xaf,
+	0xb7, 0x69, 0xe0, 0x6e, 0xf9, 0x74, 0x6d, 0x71, 0x59, 0x9c, 0x48, 0x75, 0x74, 0x84, 0x04, 0x80,
+	0xc6, 0x51, 0xfe, 0xfd, 0x53, 0x83, 0xfc, 0xfb, 0x31, 0x54, 0x70, 0xa7, 0xdd, 0xc3, 0x5d, 0xa6,
+	0xd7, 0xa6, 0x0b, 0x6d, 0xe6, 0x50, 0x8c, 0x1f, 0x86, 0xd7, 0x6a, 0x52, 0xa1, 0x82, 0xd7, 0x16,
+	0xd7, 0x73, 0x38, 0x50, 0xf8, 0x24, 0x73, 0x3c, 0xc7, 0x4c, 0xc2, 0xcd, 0x73, 0x19, 0xc7, 0x73,
+	0x6c, 0x04, 0x0e, 0x43, 0x37, 0x5a, 0x16, 0x2e, 0x7b, 0x3d, 0x49, 0x7a, 0x6a, 0x5b, 0xdb, 0x3c,
+	0x9f, 0x4e, 0x6e, 0x7c, 0x35, 0x87, 0x01, 0x05, 0x4f, 0xe1, 0xae, 0x27, 0x08, 0x19, 0xf5, 0xe6,
+	0xe3, 0xe9, 0x5d, 0xcf, 0x4d, 0xde, 0x0c, 0x12, 0x6e, 0x7f, 0x90, 0x34, 0xfb, 0x31, 0x65, 0x07,
+	0xe6, 0xdb, 0x61, 0xb4, 0xe7, 0x87, 0x6e, 0x67, 0xb9, 0x43, 0x83, 0x04, 0xc3, 0x1a, 0x9b, 0x8c,
+	0xf9, 0x25, 0xf1, 0x6c, 0xf3, 0xe5, 0x01, 0x78, 0x30, 0x90, 0x42, 0x36, 0x3b, 0xf7, 0x13, 0x43,
+	0x66, 0xe7, 0x5e, 0x27, 0xe7, 0xa5, 0x5e, 0x5b, 0x5b, 0x5c, 0x56, 0x2f, 0xdd, 0xbc, 0x98, 0x2e,
+	0x99, 0xbc, 0x5c, 0x80, 0x03, 0x85, 0x4f, 0x3a, 0xff, 0xce, 0x22, 0xd3, 0x4a, 0x82, 0x9d, 0x42,
+	0xd8, 0xbe, 0x9f, 0x0e, 0xdb, 0xbf, 0x76, 0x7c, 0x1d, 0xc0, 0x7a, 0x3e, 0x20, 0xc4, 0xe6, 0x47,
+	0xa6, 0x09, 0xd1, 0x7a, 0x42, 0xa9, 0x68, 0x6b, 0xa0, 0x8a, 0x7e, 0x64, 0x65, 0x74, 0x51, 0xb6,
+	0xe5, 0xfa, 0xc3, 0xcd, 0xb6, 0xbc, 0x41, 0x2e, 0xc8, 0x29, 0xc5, 0xaf, 0x94, 0x31, 0xf2, 0x59,
+	0x8a, 0x7c, 0xa3, 0x06, 0xf6, 0x72, 0x11, 0x12, 0x14, 0x3f, 0x9b, 0xda, 0xdb, 0x8d, 0x1f, 0xb9,
+	0xb7, 0x53, 0x52, 0x6e, 0x65, 0x5b, 0x56, 0xa8, 0xcf, 0x48, 0xb9, 0x95, 0xab, 0x1b, 0xa0, 0x71,
+	0x8a, 0x55, 0xdd, 0x44, 0x49, 0xaa, 0x8e, 0x8c, 0xac, 0xea, 0xa4, 0xd0, 0x9d, 0x1c, 0x28, 0x74,
+	0xe5, 0xd5, 0xd5, 0xd4, 0xc0, 0xab, 0xab, 0xf7, 0x92, 0x19, 0x2f, 0xd8, 0xa5, 0x91, 0x97, 0xd0,
+	0x0e, 0x5b, 0x0b, 0x4c, 0x20, 0x37, 0xf4, 0x46, 0x67, 0x39, 0x05, 0x85, 0x0c, 0x76, 0x5a, 0x53,
+	0xcc, 0x0c, 0xa1, 0x29, 0x06, 0xe8, 0xe7, 0xd9, 0x72, 0xf4, 0xf3, 0x99, 0xe3, 0xeb, 0xe7, 0xb3,
+	0x27, 0xaa, 0x9f, 0xed, 0x52, 0xf4, 0xf3, 0x50, 0xaa, 0xcf, 0x38, 0xa4, 0x9f, 0x3f, 0xe2, 0x90,
+	0x3e, 0x48, 0x39, 0x5f, 0x78, 0x60, 0xe5, 0x5c, 0xac, 0x77, 0x1f, 0x7b, 0x53, 0xef, 0x96, 0xa2,
+	0x77, 0x3f, 0x59, 0x21, 0x17, 0xb4, 0x66, 0x42, 0x79, 0xe0, 0x6d, 0xa3, 0x6c, 0xa6, 0xe8, 0x09,
+	0xc6, 0x2f, 0xbc, 0x8d, 0x64, 0x11, 0x3a, 0x5d, 0x86, 0x82, 0x80, 0x81, 0xc5, 0x72, 0x2e, 0xd0,
+	0x88, 0x05, 0x21, 0x67, 0xd5, 0xd6, 0xa2, 0x68, 0x07, 0x85, 0x81, 0x83, 0x80, 0xff, 0x8b, 0x94,
+	0x3f, 0xd9, 0xd2, 0x20, 0x8b, 0x1a, 0x04, 0x26, 0x1e, 0x5e, 0x76, 0xb7, 0xa5, 0xc8, 0x44, 0xd5,
+	0x35, 0xc5, 0x8f, 0x95, 0x4a, 0x4a, 0x2a, 0xa8, 0xec, 0x0e, 0xcb, 0x09, 0x52, 0xcf, 0x77, 0x07,
+	0xdb, 0x41, 0x61, 0x38, 0xff, 0xcd, 0x22, 0x4f, 0x14, 0x0e, 0xc5, 0x29, 0x6c, 0x47, 0xee, 0xa6,
+	0xb7, 0x23, 0x1b, 0x65, 0x1d, 0x49, 0x8d, 0xb7, 0x18, 0xb0, 0x35, 0xf9, 0x37, 0x16, 0x99, 0xd1,
+	0xf8, 0xa7, 0xf0, 0xaa, 0x5e, 0xfa, 0x55, 0xcb, 0x3b, 0x7d, 0x4f, 0xe4, 0xde, 0xed, 0x57, 0x2b,
+	0x44, 0x95, 0xeb, 0x59, 0x68, 0x27, 0xc3, 0x85, 0x9b, 0x61, 0x96, 0x50, 0x37, 0x72, 0xbb, 0x71,
+	0x39, 0xde, 0x71, 0x69, 0xfe, 0xcc, 0x1b, 0x45, 0x5f, 0xe8, 0xb1, 0x9f, 0x31, 0x08, 0x86, 0xac,
+	0xc8, 0x21, 0xaf, 0x84, 0xd2, 0xc9, 0x26, 0x42, 0x10, 0x55, 0x53, 0x3a, 0xa0, 0x30, 0x50, 0x61,
+	0x7a, 0xed, 0x30, 0x58, 0xf4, 0xdd, 0x38, 0x16, 0x7b, 0x38, 0xa5, 0x30, 0x97, 0x25, 0x00, 0x34,
+	0x0e, 0x73, 0x2e, 0xf1, 0xe2, 0x9e, 0xef, 0x1e, 0x18, 0x36, 0x16, 0x23, 0xb5, 0x9d, 0x02, 0x81,
+	0x89, 0xe7, 0x74, 0x49, 0x33, 0xfd, 0x12, 0x4b, 0x74, 0x9b, 0x79, 0x76, 0x0f, 0x35, 0x9c, 0xe8,
+	0xdf, 0xcc, 0x9e, 0x5a, 0xe9, 0xbb, 0xcd, 0x4a, 0xba, 0x97, 0x0b, 0x12, 0x00, 0x1a, 0xc7, 0xf9,
+	0x5a, 0x72, 0xae, 0x60, 0xcc, 0x86, 0x70, 0xa0, 0xfb, 0xa5, 0x0a, 0x99, 0x4d, 0x3f, 0x19, 0xb3,
+	0xd8, 0x47, 0xde, 0x67, 0x2f, 0x6e, 0x87, 0xfb, 0x34, 0x3a, 0xc0, 0x6e, 0x58, 0x99, 0xd8, 0xc7,
+	0x1c, 0x06, 0x14, 0x3c, 0xc5, 0xea, 0x77, 0x75, 0xd4, 0xab, 0xcb, 0xe9, 0x71, 0xab, 0xcc, 0xe9,
+	0xa1, 0x47, 0xd6, 0xf8, 0x2e, 0x9a, 0x25, 0x98, 0xfc, 0x71, 0xff, 0xc3, 0x22, 0x37, 0x30, 0xbc,
+	0x31, 0xf1, 0x02, 0xf1, 0xca, 0x62, 0xe2, 0xa8, 0xfd, 0xcf, 0x6a, 0x1e, 0x05, 0x8a, 0x9e, 0x73,
+	0xfe, 0xac, 0x46, 0x54, 0x0e, 0x20, 0xe6, 0x94, 0x59, 0x92, 0x4b, 0xeb, 0xa8, 0x11, 0xb4, 0xea,
+	0x4b, 0xd7, 0x0e, 0xf3, 0x92, 0xe2, 0x56, 0x32, 0xd3, 0x9c, 0xae, 0x06, 0x6c, 0x53, 0x83, 0xc0,
+	0xc4, 0xc3, 0x9e, 0xf8, 0xde, 0x3e, 0xe5, 0x0f, 0x8d, 0xa5, 0x7b, 0xb2, 0x22, 0x01, 0xa0, 0x71,
+	0xb0, 0x27, 0x1d, 0x6f, 0x7b, 0xbb, 0x39, 0x9e, 0xee, 0x09, 0x8e, 0x0e, 0x30, 0x08, 0xaf, 0xf0,
+	0x18, 0xee, 0x89, 0x3d, 0xbf, 0x51, 0xe1, 0x31, 0xdc, 0x03, 0x06, 0xc1, 0xaf, 0x14, 0x84, 0x51,
+	0xd7, 0xf5, 0xbd, 0xd7, 0x69, 0x47, 0x71, 0x11, 0x7b, 0x7d, 0xf5, 0x95, 0x6e, 0xe6, 0x51, 0xa0,
+	0xe8, 0x39, 0x9c, 0xd0, 0xbd, 0x88, 0x76, 0xbc, 0x76, 0x62, 0x52, 0x23, 0xe9, 0x09, 0xbd, 0x9e,
+	0xc3, 0x80, 0x82, 0xa7, 0x30, 0x79, 0xa2, 0xcc, 0xe1, 0x24, 0xf3, 0x9e, 0x4e, 0xa6, 0x93, 0x27,
+	0x42, 0x1a, 0x0c, 0x59, 0x7c, 0x94, 0x58, 0x5d, 0x91, 0x8b, 0xbb, 0x39, 0x95, 0x96, 0x58, 0x32,
+	0x47, 0x37, 0x28, 0x0c, 0xe7, 0xdb, 0xab, 0xa8, 0x61, 0x07, 0xd4, 0x41, 0x38, 0x35, 0x17, 0xea,
+	0xf4, 0x8c, 0xac, 0x0d, 0x31, 0x23, 0xd1, 0x3d, 0x39, 0x0e, 0x03, 0xe5, 0x9e, 0x5c, 0x1f, 0xe8,
+	0x9e, 0x6c, 0x60, 0x15, 0xbb, 0x27, 0x8f, 0x95, 0xe5, 0x9e, 0x3c, 0xfe, 0x80, 0xee, 0xc9, 0xbf,
+	0x55, 0x27, 0xaa, 0xca, 0xfa, 0x4d, 0x9a, 0xdc, 0x09, 0xa3, 0x3d, 0x2f, 0xd8, 0x61, 0xd9, 0x58,
+	0x7e, 0xd2, 0x92, 0xc9, 0x74, 0x56, 0xcc, 0xb0, 0xdd, 0xed, 0x92, 0x8a, 0x51, 0xa7, 0x98, 0xcd,
+	0x6f, 0x1a, 0x8c, 0xb8, 0x9b, 0x4b, 0x26, 0x69, 0x0f, 0x07, 0x41, 0xaa, 0x47, 0xf6, 0xb7, 0x12,
+	0x22, 0xed, 0xe3, 0xdb, 0x52, 0x02, 0x2f, 0x97, 0xd3, 0x3f, 0xbc, 0x9f, 0x50, 0xfb, 0xdb, 0x4d,
+	0xc5, 0x04, 0x0c, 0x86, 0xe8, 0x18, 0x25, 0xef, 0x1a, 0x78, 0x1c, 0xd3, 0x47, 0x4e, 0x64, 0x6c,
+	0x86, 0x09, 0x68, 0x06, 0x32, 0xee, 0x05, 0x3b, 0x38, 0x4f, 0x84, 0x1b, 0xe7, 0x5b, 0x8b, 0xd2,
+	0xdd, 0xad, 0x84, 0x6e, 0xa7, 0xe5, 0xfa, 0x6e, 0xd0, 0xc6, 0x6a, 0x59, 0x0c, 0x5d, 0x9f, 0x79,
+	0x44, 0x03, 0x48, 0x42, 0xb9, 0x6a, 0xeb, 0xf5, 0x61, 0xaa, 0xad, 0x5f, 0xfc, 0x46, 0x72, 0x36,
+	0xf7, 0x31, 0x47, 0x8a, 0x5f, 0x3e, 0x46, 0xa2, 0xbb, 0x5f, 0x1e, 0xd3, 0x4a, 0x0b, 0x53, 0xfb,
+	0xb1, 0xe2, 0xdd, 0x91, 0xfe, 0xa2, 0x62, 0xff, 0x5a, 0xe2, 0x14, 0x51, 0x6a, 0xc6, 0x68, 0x04,
+	0x93, 0x25, 0xce, 0xd1, 0x9e, 0x1b, 0xd1, 0xe0, 0xa4, 0xe7, 0xe8, 0xba, 0x62, 0x02, 0x06, 0x43,
+	0x7b, 0x37, 0x15, 0x68, 0x77, 0xf5, 0xf8, 0x81, 0x76, 0x2c, 0xf9, 0x70, 0x51, 0x75, 0xd7, 0xcf,
+	0x58, 0x64, 0x26, 0x48, 0xcd, 0xdc, 0x72, 0x7c, 0xeb, 0x8b, 0x57, 0x45, 0xcb, 0x46, 0x23, 0x52,
+	0xba, 0x0d, 0x32, 0xfc, 0x8b, 0x54, 0x5a, 0x7d, 0x44, 0x95, 0xe6, 0x90, 0x31, 0x16, 0x75, 0x9a,
+	0xba, 0x4e, 0x64, 0x11, 0xa9, 0x31, 0x08, 0x88, 0x1d, 0x90, 0x31, 0x9e, 0x2a, 0xb5, 0x39, 0x5e,
+	0x46, 0xba, 0x12, 0x33, 0xdf, 0x2a, 0xe7, 0xc7, 0x5b, 0x40, 0x70, 0xc1, 0xd2, 0x5f, 0x3a, 0x0e,
+	0xb7, 0xf1, 0x60, 0xa5, 0xbf, 0x8a, 0xe2, 0x75, 0x9d, 0xff, 0x55, 0x23, 0x67, 0xe4, 0x88, 0xc8,
+	0xb8, 0x1c, 0xd4, 0x8f, 0x9c, 0xaf, 0xde, 0x2b, 0x2b, 0xfd, 0x78, 0x5d, 0x02, 0x40, 0xe3, 0xe0,
+	0x7e, 0xac, 0x1f, 0x63, 0x32, 0xc1, 0x60, 0xc5, 0xdb, 0x8a, 0xc5, 0x5d, 0xb8, 0x5a, 0x28, 0x2f,
+	0x6b, 0x10, 0x98, 0x78, 0x2c, 0x58, 0xb8, 0x6d, 0x66, 0xec, 0xd0, 0xc1, 0xc2, 0x6d, 0x91, 0xf9,
+	0x46, 0xc0, 0xed, 0x1f, 0x2d, 0x2c, 0xcc, 0x54, 0x4e, 0x34, 0x6b, 0x2e, 0x1c, 0x69, 0xb4, 0x8a,
+	0x4c, 0xf6, 0xdf, 0xb3, 0xc8, 0x05, 0xde, 0x2a, 0x47, 0xf2, 0xe5, 0x5e, 0xc7, 0x4d, 0x68, 0xdc,
+	0x1c, 0x3b, 0xa1, 0xfe, 0x69, 0x93, 0x76, 0x11, 0x5b, 0x28, 0xee, 0x0d, 0x26, 0x2a, 0x98, 0xdd,
+	0x4b, 0x65, 0xdc, 0x92, 0xaa, 0xe3, 0xb8, 0xe9, 0x68, 0x52, 0x44, 0xf5, 0x52, 0x4b, 0xb7, 0xc7,
+	0x90, 0xe5, 0x8e, 0x45, 0xdf, 0x4c, 0x31, 0x7a, 0xfa, 0x89, 0xba, 0x46, 0xdf, 0x0a, 0xca, 0xdd,
+	0x65, 0x7d, 0xe0, 0xee, 0x12, 0x6f, 0xdf, 0xbd, 0x4e, 0x73, 0x2c, 0x73, 0xfb, 0xbe, 0xbc, 0x04,
+	0xd8, 0xee, 0xfc, 0x69, 0x5d, 0xdb, 0x24, 0x44, 0xb0, 0xe8, 0x17, 0xc5, 0x6b, 0x6f, 0xab, 0x1c,
+	0xd4, 0xfc, 0xcd, 0x6f, 0xe6, 0x72, 0x50, 0x7f, 0xfd, 0xe8, 0xb1, 0xc0, 0x7c, 0x80, 0x06, 0xa5,
+	0xa0, 0x1e, 0x3f, 0x22, 0x10, 0xf8, 0x55, 0xd2, 0xc0, 0x23, 0x18, 0x33, 0x2e, 0x36, 0x52, 0x9d,
+	0x6a, 0x5c, 0x17, 0xed, 0x6f, 0xdc, 0x9b, 0xfb, 0xba, 0xd1, 0xbb, 0x25, 0x9f, 0x06, 0x45, 0xdf,
+	0x8e, 0xc9, 0x04, 0xfe, 0xcf, 0x62, 0x96, 0xc5, 0xe1, 0xee, 0x65, 0x25, 0x33, 0x25, 0xa0, 0x94,
+	0x80, 0x68, 0xcd, 0xc7, 0x0e, 0xc8, 0x04, 0x22, 0x72, 0xa6, 0xfc, 0x0c, 0xb8, 0x2e, 0x99, 0x6e,
+	0x48, 0xc0, 0x1b, 0xf7, 0xe6, 0xde, 0x33, 0x3a, 0x53, 0xf5, 0x38, 0x68, 0x16, 0x86, 0x6a, 0x9c,
+	0x1c, 0xa4, 0x1a, 0x9d, 0xff, 0x5d, 0xd3, 0xf3, 0x9b, 0x7f, 0xfa, 0x2f, 0x8e, 0xf9, 0xfd, 0x42,
+	0x66, 0x7e, 0x5f, 0xca, 0xcd, 0xef, 0x19, 0x1c, 0xb3, 0x82, 0xa4, 0xe9, 0xa7, 0xbd, 0x59, 0x38,
+	0xda, 0x26, 0xc1, 0x76, 0x49, 0xaf, 0xf5, 0xbd, 0x88, 0xc6, 0xeb, 0x51, 0x3f, 0xc0, 0x2c, 0xe1,
+	0x13, 0x0c, 0xd9, 0xd8, 0x25, 0xa5, 0xc0, 0x90, 0xc5, 0xc7, 0x83, 0x3f, 0xce, 0x8b, 0xdb, 0xee,
+	0x3e, 0x9f, 0x79, 0x46, 0x62, 0xcc, 0x0d, 0xd1, 0x0e, 0x0a, 0xc3, 0xde, 0x25, 0x4f, 0x49, 0x02,
+	0x4b, 0xd4, 0xa7, 0xf8, 0x42, 0xcc, 0xab, 0x30, 0xea, 0xba, 0x89, 0x34, 0x3b, 0x34, 0x5a, 0x5f,
+	0x2a, 0x28, 0x3c, 0x05, 0x87, 0xe0, 0xc2, 0xa1, 0x94, 0x9c, 0x3f, 0x66, 0x7e, 0x04, 0x46, 0xea,
+	0x06, 0x9c, 0x7d, 0xbe, 0xd7, 0xf5, 0x64, 0xfe, 0x4e, 0x35, 0xfb, 0x56, 0xb0, 0x11, 0x38, 0xcc,
+	0xbe, 0x43, 0xc6, 0xb7, 0xdc, 0xf6, 0x5e, 0xb8, 0xbd, 0x5d, 0x4e, 0x31, 0xc2, 0x16, 0x27, 0xc6,
+	0xb2, 0xd7, 0x8f, 0x8b, 0x1f, 0x6f, 0xe8, 0x7f, 0x41, 0x72, 0xe3, 0x35, 0x4f, 0xb6, 0x23, 0x1a,
+	0xcb, 0xc2, 0xab, 0x46, 0xcd, 0x13, 0xd6, 0x0c, 0x12, 0xee, 0xfc, 0xe7, 0x31, 0x32, 0x2b, 0xdd,
+	0xc2, 0x44, 0x99, 0xb6, 0x54, 0xf5, 0x8f, 0xca, 0x91, 0xd5, 0x3f, 0x3e, 0x44, 0x48, 0x87, 0xf6,
+	0xfc, 0xf0, 0x80, 0xed, 0x23, 0x6b, 0x23, 0xef, 0x23, 0xd5, 0xd1, 0x63, 0x49, 0x51, 0x01, 0x83,
+	0xa2, 0xc8, 0x6f, 0xca, 0x8b, 0x89, 0x64, 0xf2, 0x9b, 0x1a, 0xd5, 0x4d, 0xc7, 0x4e, 0xb7, 0xba,
+	0xa9, 0x47, 0x66, 0x79, 0x17, 0x55, 0x2e, 0x85, 0x07, 0x48, 0x99, 0xc0, 0xa2, 0xd1, 0x96, 0xd2,
+	0x64, 0x20, 0x4b, 0xd7, 0x2c, 0x5d, 0xda, 0x38, 0xed, 0xd2, 0xa5, 0x5f, 0x49, 0x26, 0xe4, 0x77,
+	0xc6, 0x28, 0x29, 0x95, 0xe7, 0x47, 0x4e, 0x83, 0x18, 0x34, 0x3c, 0x97, 0x16, 0x86, 0x3c, 0xb4,
+	0xb4, 0x30, 0x5f, 0x4e, 0xc6, 0xb9, 0xd8, 0x3a, 0x10, 0x42, 0x40, 0x57, 0x2b, 0xe4, 0xcd, 0x20,
+	0xe1, 0xb6, 0x4f, 0xc6, 0x22, 0xba, 0x4f, 0xa3, 0x84, 0x59, 0x1a, 0x8f, 0x7d, 0x73, 0xc4, 0x33,
+	0x77, 0x20, 0x3d, 0x2e, 0x45, 0xf9, 0xff, 0x20, 0x78, 0x38, 0x9f, 0xa9, 0xe2, 0xc9, 0x88, 0x0f,
+	0xd8, 0xc8, 0x25, 0x89, 0xaf, 0x1b, 0x25, 0x89, 0x47, 0x9b, 0x68, 0x8d, 0x4c, 0xe9, 0xe2, 0xa7,
+	0x48, 0x2d, 0x71, 0x77, 0x64, 0x54, 0x2f, 0x83, 0x6e, 0xba, 0x58, 0x2e, 0x0b, 0x5b, 0x47, 0xc9,
+	0xd4, 0x8e, 0x5e, 0x3f, 0xde, 0x4e, 0xe0, 0x26, 0xe8, 0xea, 0xa2, 0x2f, 0x44, 0xb5, 0xd7, 0x8f,
+	0x09, 0x84, 0x34, 0x2e, 0xc6, 0x8d, 0x90, 0x88, 0xaa, 0x73, 0xd7, 0x58, 0x19, 0x93, 0x5b, 0xc9,
+	0x27, 0x49, 0xd7, 0xcc, 0x33, 0xa2, 0xce, 0x5b, 0x06, 0x5b, 0xe7, 0x13, 0x16, 0x39, 0x9b, 0x7b,
+	0xca, 0xee, 0x91, 0xb1, 0x36, 0x2b, 0x1c, 0x5d, 0x4e, 0x6e, 0xcd, 0x74, 0x11, 0x6a, 0x3e, 0x35,
+	0x78, 0x1b, 0x08, 0x3e, 0xce, 0xaf, 0x4c, 0x91, 0xf3, 0x1b, 0x8b, 0xab, 0x32, 0xd1, 0xf9, 0x89,
+	0x85, 0x29, 0x17, 0xf1, 0x38, 0xbd, 0x30, 0xe5, 0x01, 0xdc, 0x7d, 0x23, 0x4c, 0xd9, 0x37, 0xc2,
+	0x94, 0xd3, 0x31, 0xa3, 0xd5, 0x32, 0x62, 0x46, 0x8b, 0x7a, 0x30, 0x4c, 0xcc, 0xe8, 0x89, 0xc5,
+	0x2d, 0x1f, 0xda, 0xa1, 0x91, 0xe2, 0x96, 0x55, 0x50, 0x77, 0x29, 0x21, 0x6a, 0x03, 0x3e, 0x55,
+	0x61, 0x50, 0xb7, 0x0a, 0xa8, 0xe5, 0xe1, 0x97, 0xcd, 0xb1, 0x32, 0x02, 0x6a, 0x8b, 0x3a, 0x30,
+	0x44, 0x40, 0x2d, 0xff, 0x91, 0x0a, 0xe2, 0x1e, 0x2f, 0x23, 0x88, 0xbb, 0xa8, 0x3b, 0x47, 0x06,
+	0x71, 0x63, 0xc5, 0x65, 0x3f, 0x0c, 0xe8, 0x7a, 0x14, 0x26, 0x61, 0x3b, 0xf4, 0x9b, 0x8d, 0xb4,
+	0x80, 0x5c, 0x34, 0x81, 0x90, 0xc6, 0x1d, 0x14, 0x01, 0x3e, 0x71, 0xdc, 0x08, 0x70, 0xf2, 0x90,
+	0x22, 0xc0, 0x8d, 0x18, 0xe7, 0xc9, 0x32, 0x62, 0x9c, 0x8b, 0xbe, 0xc8, 0x50, 0x31, 0xce, 0x9f,
+	0xb5, 0xc8, 0xb4, 0x7b, 0x87, 0x1d, 0xa8, 0xb8, 0x14, 0x16, 0xca, 0xff, 0xc3, 0x27, 0x30, 0x61,
+	0x6f, 0x6f, 0x68, 0x36, 0xad, 0xb3, 0x2c, 0xee, 0xc4, 0x6c, 0x82, 0x74, 0x47, 0x8e, 0x13, 0x17,
+	0xfd, 0xe3, 0x15, 0xf2, 0x25, 0x47, 0x76, 0xc1, 0xbe, 0x83, 0x97, 0x5d, 0x3b, 0x62, 0xa2, 0x36,
+	0xad, 0x32, 0x1c, 0x95, 0x37, 0x25, 0x3d, 0x11, 0xb3, 0xa7, 0xc8, 0x83, 0xc1, 0x8a, 0xf9, 0x27,
+	0x87, 0x7e, 0x2e, 0x2d, 0x36, 0x84, 0x3e, 0x05, 0x06, 0xc1, 0x8d, 0x50, 0x44, 0x77, 0xf0, 0xd4,
+	0x51, 0x4d, 0x6f, 0x84, 0x80, 0xb5, 0x82, 0x80, 0xa2, 0x65, 0xd8, 0xf5, 0x7d, 0x1e, 0x3f, 0x28,
+	0xaa, 0x73, 0x1b, 0x96, 0xe1, 0x05, 0x0d, 0x02, 0x13, 0xcf, 0xf9, 0xab, 0x0a, 0x99, 0x3b, 0x42,
+	0xa6, 0xe4, 0xe2, 0xc6, 0xeb, 0x43, 0xc7, 0x8d, 0x8b, 0xf8, 0xa7, 0xb1, 0x01, 0xf1, 0x4f, 0xe8,
+	0x5d, 0x40, 0xb1, 0x3c, 0x20, 0xf7, 0x78, 0xcc, 0xe4, 0x78, 0xdc, 0xd4, 0x20, 0x30, 0xf1, 0x50,
+	0x8a, 0xcd, 0xb8, 0xed, 0x36, 0x8d, 0x63, 0x19, 0xe0, 0x24, 0x2c, 0xf5, 0xa5, 0x45, 0x4f, 0xb1,
+	0x0b, 0x90, 0x85, 0x14, 0x0b, 0xc8, 0xb0, 0xcc, 0x0e, 0xf8, 0xc4, 0x90, 0x03, 0xfe, 0xd3, 0x15,
+	0xf2, 0xf4, 0xa1, 0xda, 0x6d, 0xe8, 0xd8, 0x33, 0x74, 0x4a, 0xcf, 0x4e, 0x1c, 0x74, 0x59, 0x07,
+	0x06, 0xe1, 0xa3, 0xd4, 0xeb, 0x29, 0xb7, 0xf4, 0xf2, 0x83, 0x35, 0xf9, 0x28, 0xa5, 0x58, 0x40,
+	0x86, 0xe5, 0x83, 0x4e, 0xcb, 0xdf, 0xaf, 0x91, 0x67, 0x87, 0xd8, 0x03, 0x94, 0x18, 0xd4, 0x9a,
+	0x0e, 0xd8, 0xae, 0x3e, 0xa4, 0x80, 0xed, 0x07, 0x1b, 0xae, 0x37, 0xe3, 0xbc, 0x87, 0x0a, 0x9e,
+	0xfd, 0x5c, 0x85, 0x5c, 0x1c, 0xbc, 0x61, 0xb1, 0xbf, 0x01, 0x6d, 0x75, 0xd2, 0xc7, 0xd1, 0x8c,
+	0xf5, 0x3e, 0xc7, 0xed, 0x74, 0x29, 0x10, 0x64, 0x71, 0x31, 0x5c, 0xbb, 0xe7, 0x26, 0xbb, 0xf1,
+	0x95, 0xbb, 0x1e, 0xab, 0x71, 0x54, 0x95, 0xe1, 0xda, 0xeb, 0xaa, 0x15, 0x0c, 0x0c, 0x64, 0xc7,
+	0x7e, 0x2d, 0x61, 0x12, 0x10, 0xfe, 0x10, 0x3f, 0x7a, 0x9e, 0x93, 0xc5, 0x54, 0x0d, 0x10, 0x64,
+	0x71, 0x91, 0x1d, 0xf3, 0x4f, 0xe0, 0x1d, 0xad, 0xe9, 0xe8, 0xf0, 0x15, 0xd5, 0x0a, 0x06, 0x46,
+	0x36, 0x8a, 0xbd, 0x7e, 0x74, 0x14, 0xbb, 0xf3, 0x4f, 0x2a, 0xe4, 0x89, 0x81, 0x1b, 0xde, 0xe1,
+	0xc4, 0xd4, 0xa3, 0x17, 0x49, 0xfe, 0x80, 0x2b, 0x6c, 0xa4, 0x08, 0x64, 0xe7, 0x4f, 0x06, 0xcc,
+	0x34, 0x11, 0x5d, 0xfc, 0xe0, 0x89, 0x58, 0x1e, 0xbd, 0xf1, 0xcc, 0x05, 0x14, 0xd7, 0x46, 0x08,
+	0x28, 0xce, 0x7c, 0x8c, 0xfa, 0x90, 0xda, 0xe1, 0x3f, 0xd6, 0x06, 0x0e, 0x2f, 0x1e, 0x90, 0x87,
+	0xba, 0x05, 0x59, 0x22, 0x67, 0xbc, 0x80, 0x95, 0xc7, 0xde, 0xe8, 0x6f, 0x89, 0x7c, 0x69, 0x3c,
+	0x29, 0xb0, 0x0a, 0xe7, 0x59, 0xce, 0xc0, 0x21, 0xf7, 0xc4, 0x23, 0x18, 0xe0, 0xfd, 0x60, 0x43,
+	0x3a, 0xa2, 0xe4, 0x5e, 0x23, 0x17, 0xe4, 0x50, 0xec, 0xba, 0x11, 0xed, 0x08, 0x65, 0x1b, 0x8b,
+	0x00, 0xae, 0x27, 0x78, 0x10, 0x58, 0x01, 0x02, 0x14, 0x3f, 0x87, 0x9f, 0x2c, 0x09, 0x7b, 0x5e,
+	0xbb, 0xd9, 0x48, 0x7f, 0xb2, 0x4d, 0x6c, 0x04, 0x0e, 0xd3, 0xfa, 0x62, 0xe2, 0x74, 0xf4, 0xc5,
+	0x1f, 0x59, 0xc4, 0xc6, 0x1a, 0xb2, 0x9d, 0xbe, 0x4f, 0x3b, 0x3a, 0xa9, 0xf8, 0xc3, 0xcb, 0x01,
+	0x7d, 0x95, 0x54, 0xdc, 0x07, 0x29, 0x60, 0xa9, 0x6e, 0x08, 0x16, 0x12, 0xa8, 0xb8, 0x89, 0xf3,
+	0x21, 0x32, 0xa1, 0x26, 0x12, 0x8f, 0x3e, 0x51, 0xab, 0x37, 0x17, 0x7d, 0xa2, 0x96, 0xae, 0x81,
+	0x65, 0x3f, 0xcd, 0x4f, 0x60, 0x19, 0x31, 0x84, 0x03, 0x89, 0xed, 0xce, 0x3b, 0xc9, 0x94, 0x32,
+	0x72, 0x0e, 0x5b, 0x2a, 0xdb, 0xf9, 0xf3, 0x1a, 0xc9, 0x54, 0x85, 0xc4, 0x91, 0xc6, 0xaa, 0x96,
+	0xac, 0xb1, 0x9c, 0x91, 0x5e, 0x92, 0xe4, 0xf4, 0x48, 0xab, 0x26, 0xd0, 0xcc, 0xec, 0x8f, 0xf2,
+	0xc4, 0xd6, 0x82, 0x75, 0xa5, 0x2c, 0x2b, 0xb8, 0xe0, 0xad, 0x87, 0x57, 0xb5, 0x81, 0xc1, 0xcf,
+	0x4e, 0xc8, 0xc4, 0xae, 0xac, 0xbb, 0x58, 0x8e, 0x1c, 0x57, 0x65, 0x1c, 0xf9, 0xde, 0x53, 0xfd,
+	0x04, 0xcd, 0x08, 0xd3, 0x8c, 0x9e, 0x4f, 0x17, 0xad, 0x14, 0x37, 0x2c, 0xb5, 0x93, 0xb9, 0x61,
+	0x51, 0x41, 0x54, 0x0b, 0x05, 0x4c, 0xa1, 0xb0, 0x2b, 0xc6, 0x7d, 0x2a, 0x2f, 0xd4, 0xe9, 0xfa,
+	0xcd, 0x7a, 0xe1, 0x7d, 0xaa, 0x04, 0x43, 0x16, 0xdf, 0xb9, 0x5f, 0x25, 0xe7, 0xd3, 0xf3, 0x4c,
+	0x5c, 0x9e, 0xff, 0xac, 0x45, 0x1e, 0xf7, 0xdd, 0x38, 0xd9, 0xe8, 0xb3, 0x83, 0xde, 0x76, 0xdf,
+	0x5f, 0xcb, 0x2c, 0xf3, 0xe3, 0x1a, 0xcb, 0x14, 0xe1, 0x6c, 0x51, 0xd8, 0xd6, 0x93, 0x18, 0xb6,
+	0xb8, 0x52, 0xcc, 0x1c, 0x06, 0xf5, 0x0a, 0x2d, 0x8c, 0x67, 0xda, 0xfd, 0x28, 0xa2, 0x41, 0xa2,
+	0xbb, 0xca, 0x27, 0xeb, 0xcd, 0x52, 0xe6, 0x8b, 0xee, 0xe0, 0x79, 0x54, 0x88, 0x8b, 0x19, 0x5e,
+	0x90, 0xe3, 0x8e, 0xf5, 0xdb, 0x52, 0xb5, 0x3e, 0xab, 0x65, 0xe4, 0x84, 0x2f, 0xa8, 0x8f, 0x79,
+	0x44, 0xb1, 0xcf, 0xdf, 0xc5, 0x0d, 0xd8, 0xc0, 0xe1, 0xfe, 0x6b, 0x56, 0x4c, 0xb7, 0xb0, 0x78,
+	0x6d, 0x63, 0xc4, 0xe2, 0xb5, 0x7f, 0x38, 0x4e, 0xa6, 0x53, 0xb9, 0xf9, 0x53, 0x37, 0xe2, 0xd6,
+	0x91, 0x37, 0xe2, 0x2c, 0xfc, 0xb5, 0x1f, 0x88, 0x7a, 0x79, 0x66, 0xf8, 0x6b, 0x3f, 0xc0, 0xda,
+	0x03, 0xf8, 0x47, 0x7c, 0x17, 0xe8, 0x07, 0xe2, 0x8a, 0xde, 0xfc, 0x2e, 0xd0, 0x0f, 0x40, 0x40,
+	0xd1, 0xf7, 0x78, 0x8a, 0xc9, 0x4b, 0xe1, 0x7a, 0xd0, 0xac, 0x95, 0xe1, 0xef, 0xb1, 0x61, 0x50,
+	0xe4, 0xbe, 0xd8, 0x66, 0x0b, 0xa4, 0x38, 0xe2, 0x44, 0x9f, 0x50, 0x95, 0xd1, 0x9b, 0x63, 0x65,
+	0x04, 0x13, 0x66, 0x4b, 0x1f, 0x64, 0x14, 0x95, 0x6c, 0x61, 0xf7, 0xcb, 0xe2, 0x5f, 0x2c, 0xd2,
+	0xc8, 0xff, 0x15, 0x33, 0xac, 0x74, 0x29, 0x4d, 0x0a, 0x2e, 0xfa, 0xb1, 0xd2, 0x8d, 0xa8, 0x85,
+	0x2b, 0x27, 0x12, 0xaf, 0x74, 0x23, 0x1b, 0x41, 0xc3, 0xf1, 0xe0, 0x19, 0xb3, 0x17, 0x4b, 0x8c,
+	0x0b, 0x73, 0xb6, 0x7a, 0x37, 0x74, 0x33, 0x98, 0x38, 0xe6, 0xed, 0x3e, 0x79, 0xa8, 0xb7, 0xfb,
+	0x93, 0x47, 0xdc, 0xee, 0x6f, 0x90, 0x0b, 0x6e, 0x3f, 0x09, 0xf1, 0x02, 0x7d, 0x21, 0x41, 0x93,
+	0x7e, 0x12, 0xf3, 0x72, 0x0e, 0x53, 0xec, 0x3a, 0x42, 0x79, 0x8f, 0x6e, 0x50, 0x7f, 0x3b, 0x87,
+	0x04, 0xc5, 0xcf, 0x1a, 0xb7, 0xef, 0xd3, 0xa7, 0x70, 0xfb, 0xfe, 0x8f, 0x2c, 0x72, 0xa1, 0x70,
+	0xe2, 0x3d, 0xba, 0x51, 0x42, 0xce, 0x8f, 0x8d, 0x91, 0x73, 0x05, 0x75, 0x42, 0xec, 0x03, 0x73,
+	0x49, 0x5a, 0x65, 0x38, 0xdc, 0xa6, 0xfd, 0x47, 0xe5, 0x4c, 0x28, 0x58, 0x87, 0xa3, 0xb9, 0x07,
+	0x69, 0x17, 0x9d, 0xea, 0xe9, 0xba, 0xe8, 0x18, 0x2b, 0xab, 0xf6, 0x50, 0x57, 0x56, 0xfd, 0x88,
+	0x95, 0xf5, 0x73, 0x16, 0x69, 0x76, 0x07, 0x14, 0xfd, 0x6b, 0x8e, 0x95, 0x61, 0x9d, 0x1d, 0x54,
+	0x52, 0xb0, 0xf5, 0x14, 0x66, 0x1a, 0x18, 0x04, 0x85, 0x81, 0xbd, 0x42, 0x8d, 0x30, 0x75, 0xc7,
+	0xdd, 0xc7, 0xab, 0x4a, 0x1e, 0x2d, 0x34, 0x5e, 0xc6, 0x4e, 0x4c, 0xfa, 0xf8, 0x49, 0xaa, 0x5c,
+	0x31, 0x99, 0x2d, 0x90, 0xe2, 0x8a, 0xa6, 0x73, 0x76, 0xae, 0x60, 0x29, 0xe9, 0x0f, 0xec, 0x8f,
+	0x99, 0x55, 0x8f, 0xac, 0xb2, 0x2a, 0xf4, 0x70, 0xe2, 0xaa, 0x6a, 0x12, 0xff, 0x90, 0x45, 0x45,
+	0x94, 0xb2, 0xe2, 0xbf, 0x32, 0x84, 0xf8, 0xf7, 0x65, 0x79, 0xa9, 0x6a, 0xf9, 0xe5, 0xa5, 0x26,
+	0xb2, 0xa5, 0xa5, 0x0e, 0x9f, 0x69, 0xb5, 0x47, 0x72, 0xa6, 0xf5, 0x48, 0x1d, 0x3f, 0x79, 0x2c,
+	0xec, 0xfc, 0x6b, 0xe5, 0xcc, 0xb0, 0x38, 0x3d, 0x48, 0xac, 0x09, 0x38, 0x23, 0xe7, 0x9f, 0x56,
+	0xc8, 0xb9, 0x82, 0xef, 0xae, 0x77, 0x75, 0xd6, 0x21, 0xbb, 0x3a, 0xf4, 0x60, 0x15, 0x0a, 0x50,
+	0xec, 0xfe, 0xb4, 0x07, 0xab, 0x68, 0x07, 0x85, 0x81, 0xf6, 0x08, 0xd7, 0xf7, 0xc3, 0x3b, 0x57,
+	0xba, 0xbd, 0xe4, 0x40, 0xec, 0x03, 0xd5, 0x81, 0x79, 0x41, 0x41, 0xc0, 0xc0, 0xb2, 0xbf, 0x8c,
+	0x8c, 0xf3, 0x6c, 0x35, 0x1d, 0x61, 0xd0, 0x9d, 0x44, 0x09, 0xc4, 0x73, 0xd9, 0x74, 0x40, 0xc2,
+	0xec, 0x03, 0xd2, 0x88, 0x42, 0xdf, 0x47, 0x8f, 0xd0, 0x72, 0x86, 0x4e, 0xcf, 0x78, 0x41, 0x96,
+	0x9b, 0xe5, 0xe4, 0x2f, 0x50, 0xec, 0x9c, 0x4f, 0x55, 0x08, 0xd1, 0x9a, 0x78, 0xc4, 0xbd, 0x73,
+	0x4a, 0x72, 0x56, 0x8e, 0x90, 0x9c, 0x23, 0xd4, 0xef, 0x4a, 0xd5, 0xce, 0xaa, 0x95, 0x5c, 0x3b,
+	0xeb, 0x10, 0x17, 0x55, 0xe7, 0xd3, 0x62, 0x34, 0x84, 0xbd, 0xe3, 0x05, 0x19, 0x0d, 0xcb, 0xcd,
+	0x91, 0x59, 0x7b, 0xb8, 0x99, 0x2d, 0x17, 0x52, 0x98, 0x43, 0x54, 0x2c, 0x66, 0x6e, 0xbf, 0xbd,
+	0xf0, 0x65, 0x58, 0xc9, 0x0e, 0x07, 0xf0, 0x66, 0x90, 0x70, 0xfb, 0x2e, 0x86, 0x9c, 0x1e, 0x84,
+	0xfd, 0xa4, 0x9c, 0xe0, 0x3a, 0x79, 0x3c, 0x94, 0xbb, 0xe2, 0x15, 0x46, 0x5b, 0x26, 0xcd, 0xc4,
+	0xff, 0x41, 0xf0, 0x73, 0xfe, 0xae, 0x1c, 0x0f, 0x6e, 0x89, 0xd0, 0x1e, 0xf0, 0xd6, 0x88, 0x1e,
+	0xf0, 0x1f, 0x25, 0xa4, 0x1d, 0x76, 0x7b, 0x68, 0x5b, 0xdd, 0x0c, 0xcb, 0xb1, 0x5b, 0x2d, 0x2a,
+	0x7a, 0x7a, 0x19, 0xea, 0x36, 0x30, 0xf8, 0xa5, 0x66, 0x75, 0x75, 0xb4, 0x59, 0x5d, 0x3b, 0x7c,
+	0x56, 0x3b, 0x7f, 0x65, 0x91, 0xd4, 0x69, 0x0c, 0x65, 0x20, 0x76, 0xf7, 0xa0, 0x69, 0x95, 0xb1,
+	0x90, 0x4d, 0xd2, 0xb8, 0xa7, 0x11, 0x32, 0x90, 0xfd, 0x0b, 0x9c, 0x91, 0xed, 0x0b, 0x6f, 0xff,
+	0x4a, 0x59, 0x6a, 0x5d, 0x32, 0xc4, 0x78, 0x01, 0xee, 0x70, 0xaa, 0x23, 0x07, 0x9c, 0x17, 0xc8,
+	0xd9, 0x5c, 0xa7, 0x50, 0xdc, 0xb2, 0x5c, 0x4b, 0x59, 0x71, 0xcb, 0xb2, 0x0c, 0x01, 0x87, 0x39,
+	0x9f, 0xb3, 0xc8, 0x99, 0x2c, 0x79, 0xf4, 0xee, 0x39, 0x1b, 0x67, 0xe9, 0x9d, 0xd4, 0xd8, 0xa9,
+	0xa8, 0xbe, 0x1c, 0x08, 0xf2, 0x9d, 0x70, 0xfe, 0xb0, 0xca, 0xfb, 0x6b, 0xee, 0x69, 0xec, 0x2d,
+	0x59, 0xee, 0x90, 0xaf, 0x80, 0x95, 0x6c, 0xb9, 0xc3, 0x63, 0x05, 0xda, 0x70, 0xd2, 0x28, 0x3c,
+	0x50, 0xbb, 0xb1, 0x0f, 0x5a, 0xd5, 0xc2, 0x03, 0xfb, 0x01, 0x0c, 0xc2, 0xc6, 0xdb, 0x0b, 0x5c,
+	0xbf, 0x59, 0xcd, 0x8c, 0x37, 0x36, 0x02, 0x87, 0xa1, 0x14, 0x65, 0xe3, 0x72, 0x5c, 0x29, 0xba,
+	0x20, 0x89, 0x80, 0xa6, 0x67, 0xbf, 0x42, 0x26, 0x71, 0x81, 0xf9, 0x94, 0x0b, 0xe9, 0xfa, 0xc8,
+	0xe4, 0xd9, 0x56, 0x6b, 0x51, 0x93, 0x00, 0x93, 0x1e, 0x16, 0x38, 0x0c, 0xe8, 0xdd, 0x04, 0x5f,
+	0xf9, 0x81, 0xca, 0x27, 0xb2, 0x1b, 0xe7, 0x9b, 0x8a, 0x02, 0x18, 0xd4, 0x9c, 0xbb, 0x7c, 0x06,
+	0xa7, 0xb6, 0x16, 0x98, 0xbb, 0xae, 0xeb, 0xde, 0xc5, 0x44, 0x33, 0xbe, 0x4f, 0x7d, 0x2f, 0xee,
+	0x8a, 0x60, 0x11, 0x65, 0xcb, 0x5a, 0x4d, 0x41, 0x21, 0x83, 0x8d, 0x5f, 0xa4, 0x43, 0x7d, 0x57,
+	0xde, 0x3d, 0xa8, 0x2f, 0xb2, 0x84, 0x8d, 0xc0, 0x61, 0xce, 0xff, 0xa9, 0x73, 0x71, 0x7a, 0xdb,
+	0x0b, 0x3a, 0xe1, 0x1d, 0x75, 0x46, 0xb5, 0x06, 0x9e, 0x51, 0x71, 0x87, 0x22, 0x2e, 0x7a, 0xb2,
+	0xa7, 0x37, 0x79, 0x01, 0x04, 0x0a, 0x03, 0xb1, 0x3b, 0x7d, 0x61, 0x6d, 0xcd, 0x88, 0xb9, 0x25,
+	0xd1, 0x0e, 0x0a, 0x03, 0x43, 0xfd, 0x8d, 0x65, 0x23, 0x25, 0x1d, 0xdb, 0xc5, 0x1b, 0xa7, 0xa7,
+	0x18, 0x52, 0x58, 0x78, 0xbd, 0xaf, 0xce, 0xbb, 0xf2, 0xb4, 0xc4, 0x07, 0x5b, 0xb5, 0x82, 0x81,
+	0xc1, 0x52, 0x75, 0xf9, 0xfd, 0x98, 0xf9, 0xaf, 0x8d, 0xe9, 0xba, 0x54, 0x8b, 0xa2, 0x0d, 0x14,
+	0x14, 0xf7, 0x57, 0x5d, 0x37, 0xe8, 0xbb, 0x3e, 0x8e, 0x90, 0xb8, 0xb0, 0x53, 0x82, 0x7d, 0x55,
+	0x41, 0xc0, 0xc0, 0xc2, 0x37, 0x4e, 0xbc, 0x2e, 0x7d, 0x7f, 0x18, 0xc8, 0xf8, 0x3e, 0xed, 0xd2,
+	0x28, 0xda, 0x41, 0x61, 0xd8, 0x2f, 0x60, 0x01, 0x78, 0x71, 0x61, 0x16, 0x46, 0xc2, 0x33, 0x4a,
+	0x7d, 0x60, 0xcc, 0xe1, 0xa6, 0xa1, 0x60, 0xa2, 0x66, 0x8b, 0x72, 0x91, 0x21, 0x8b, 0x72, 0xdd,
+	0x20, 0x75, 0xb6, 0xef, 0x68, 0x4e, 0x8e, 0x3c, 0x81, 0x99, 0x98, 0x67, 0xfb, 0x17, 0xe0, 0x34,
+	0xec, 0x2b, 0xa4, 0x4a, 0x83, 0x4e, 0x73, 0x6a, 0x64, 0x52, 0xe3, 0x78, 0x07, 0x76, 0x25, 0xe8,
+	0x00, 0x3e, 0x6f, 0xbf, 0x4e, 0x1a, 0x6d, 0xd7, 0xa7, 0x41, 0xc7, 0x8d, 0x9a, 0xd3, 0x65, 0x04,
+	0x7d, 0xe8, 0x09, 0xbd, 0x28, 0xe8, 0x8a, 0x4f, 0x2c, 0x7e, 0x81, 0xe2, 0xe7, 0xdc, 0x26, 0x76,
+	0x1e, 0x7b, 0x88, 0x0c, 0x52, 0x73, 0xa4, 0xce, 0xc3, 0xa9, 0x2b, 0xba, 0x9a, 0x28, 0x46, 0x48,
+	0xc4, 0xc0, 0xdb, 0x9d, 0xbf, 0xb0, 0xc8, 0xac, 0x4e, 0x72, 0xc9, 0x2e, 0x50, 0x53, 0x37, 0xc7,
+	0xd6, 0x91, 0x37, 0xc7, 0xe9, 0x5c, 0x77, 0x95, 0xa1, 0x72, 0xdd, 0x99, 0x69, 0xe8, 0xaa, 0x87,
+	0xa6, 0xa1, 0xfb, 0x32, 0x32, 0xbe, 0x47, 0x0f, 0x8c, 0x7c, 0x75, 0xec, 0x1c, 0x70, 0x83, 0x37,
+	0x81, 0x84, 0x61, 0x74, 0x65, 0xdb, 0x55, 0x39, 0xaf, 0xa7, 0x44, 0xe8, 0xc1, 0x02, 0x43, 0x12,
+	0x10, 0x67, 0x8d, 0x4c, 0x28, 0x9f, 0x4d, 0x79, 0xdf, 0x69, 0x15, 0xdf, 0x77, 0xa2, 0x50, 0x32,
+	0xdc, 0x4f, 0xb5, 0x50, 0x62, 0x4e, 0xab, 0xc2, 0x1b, 0xb5, 0xb5, 0xf5, 0x1b, 0x9f, 0x7f, 0xe6,
+	0x2d, 0xbf, 0xf7, 0xf9, 0x67, 0xde, 0xf2, 0xc7, 0x9f, 0x7f, 0xe6, 0x2d, 0x1f, 0xbf, 0xff, 0x8c,
+	0xf5, 0x1b, 0xf7, 0x9f, 0xb1, 0x7e, 0xef, 0xfe, 0x33, 0xd6, 0x1f, 0xdf, 0x7f, 0xc6, 0xfa, 0xb3,
+	0xfb, 0xcf, 0x58, 0x9f, 0xf9, 0x0f, 0xcf, 0xbc, 0xe5, 0xfd, 0x85, 0xa1, 0xbb, 0xf8, 0xcf, 0xdb,
+	0xdb, 0x9d, 0xcb, 0xfb, 0xef, 0x64, 0x4a, 0x0d, 0xe7, 0xc8, 0x65, 0x63, 0x8e, 0x5c, 0x96, 0x73,
+	0xe4, 0xff, 0x0d, 0x00, 0x86, 0xe1, 0xc3, 0xb0, 0x7d, 0x17, 0x01, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -5894,6 +6334,14 @@ func (m *AppProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.RequireHydrationApproval {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x78
 	if len(m.DestinationServiceAccounts) > 0 {
 		for iNdEx := len(m.DestinationServiceAccounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6138,6 +6586,18 @@ func (m *Application) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ScheduledOperation != nil {
+		{
+			size, err := m.ScheduledOperation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Operation != nil {
 		{
 			size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
@@ -6228,6 +6688,51 @@ func (m *ApplicationCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationDependency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDependency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationDependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Selector != nil {
+		{
+			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationDestination) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8335,6 +8840,20 @@ func (m *ApplicationSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DependsOn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.SourceHydrator != nil {
 		{
 			size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
@@ -8456,6 +8975,18 @@ func (m *ApplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutomatedRollback != nil {
+		{
+			size, err := m.AutomatedRollback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	{
 		size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -8748,6 +9279,75 @@ func (m *ApplicationWatchEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AutomatedRollback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutomatedRollback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutomatedRollback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Window)
+	copy(dAtA[i:], m.Window)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Window)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AutomatedRollbackStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutomatedRollbackStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutomatedRollbackStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ID))
+	i--
+	dAtA[i] = 0x10
+	{
+		size, err := m.RolledBackAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Backoff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8958,6 +9558,18 @@ func (m *Cluster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Maintenance != nil {
+		{
+			size, err := m.Maintenance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	if len(m.Annotations) > 0 {
 		keysForAnnotations := make([]string, 0, len(m.Annotations))
 		for k := range m.Annotations {
@@ -9401,6 +10013,54 @@ func (m *ClusterList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClusterMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.PauseWatch {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	if m.ExpiresAt != nil {
+		{
+			size, err := m.ExpiresAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Command) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9711,6 +10371,80 @@ func (m *ConnectionState) MarshalTo(dAtA []byte) (int, error) {
 }
 
 func (m *ConnectionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.QuarantinedUntil != nil {
+		{
+			size, err := m.QuarantinedUntil.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ConsecutiveFailures))
+	i--
+	dAtA[i] = 0x20
+	if m.ModifiedAt != nil {
+		{
+			size, err := m.ModifiedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Status)
+	copy(dAtA[i:], m.Status)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConnectionStateHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionStateHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionStateHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9760,6 +10494,54 @@ func (m *DrySource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Plugin != nil {
+		{
+			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Directory != nil {
+		{
+			size, err := m.Directory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Kustomize != nil {
+		{
+			size, err := m.Kustomize.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Helm != nil {
+		{
+			size, err := m.Helm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	i -= len(m.Path)
 	copy(dAtA[i:], m.Path)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
@@ -10525,6 +11307,32 @@ func (m *HydrateOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AdditionalDrySHAs) > 0 {
+		for iNdEx := len(m.AdditionalDrySHAs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AdditionalDrySHAs[iNdEx])
+			copy(dAtA[i:], m.AdditionalDrySHAs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.AdditionalDrySHAs[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.ApprovedAt != nil {
+		{
+			size, err := m.ApprovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	i -= len(m.ApprovedBy)
+	copy(dAtA[i:], m.ApprovedBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ApprovedBy)))
+	i--
+	dAtA[i] = 0x42
 	{
 		size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -10580,6 +11388,47 @@ func (m *HydrateOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HydratePullRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HydratePullRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HydratePullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Insecure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.API)
+	copy(dAtA[i:], m.API)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.API)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Provider)
+	copy(dAtA[i:], m.Provider)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Provider)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HydrateTo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10600,6 +11449,18 @@ func (m *HydrateTo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	i -= len(m.TargetBranch)
 	copy(dAtA[i:], m.TargetBranch)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TargetBranch)))
@@ -10608,6 +11469,73 @@ func (m *HydrateTo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HydratedManifestLayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HydratedManifestLayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HydratedManifestLayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Kustomization {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HydratedPullRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HydratedPullRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HydratedPullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Number))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Info) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14238,6 +15166,26 @@ func (m *RevisionHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Revert != nil {
+		{
+			size, err := m.Revert.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	i--
+	if m.Healthy {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x58
 	{
 		size, err := m.InitiatedBy.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -15115,6 +16063,49 @@ func (m *SCMProviderGeneratorGitlab) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduledOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.At.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SecretRef) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15196,6 +16187,28 @@ func (m *SourceHydrator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.RequireApproval {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	if len(m.AdditionalDrySources) > 0 {
+		for iNdEx := len(m.AdditionalDrySources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalDrySources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.HydrateTo != nil {
 		{
 			size, err := m.HydrateTo.MarshalToSizedBuffer(dAtA[:i])
@@ -15251,6 +16264,18 @@ func (m *SourceHydratorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.CurrentOperation != nil {
 		{
 			size, err := m.CurrentOperation.MarshalToSizedBuffer(dAtA[:i])
@@ -15298,6 +16323,15 @@ func (m *SuccessfulHydrateOperation) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.AdditionalDrySHAs) > 0 {
+		for iNdEx := len(m.AdditionalDrySHAs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AdditionalDrySHAs[iNdEx])
+			copy(dAtA[i:], m.AdditionalDrySHAs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.AdditionalDrySHAs[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -15341,6 +16375,18 @@ func (m *SyncOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Revert != nil {
+		{
+			size, err := m.Revert.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	i = encodeVarintGenerated(dAtA, i, uint64(m.SelfHealAttemptsCount))
 	i--
 	dAtA[i] = 0x60
@@ -15510,6 +16556,18 @@ func (m *SyncOperationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WaveProgress != nil {
+		{
+			size, err := m.WaveProgress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.ManagedNamespaceMetadata != nil {
 		{
 			size, err := m.ManagedNamespaceMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -15597,6 +16655,18 @@ func (m *SyncPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Waves != nil {
+		{
+			size, err := m.Waves.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ManagedNamespaceMetadata != nil {
 		{
 			size, err := m.ManagedNamespaceMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -15665,6 +16735,18 @@ func (m *SyncPolicyAutomated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Rollback != nil {
+		{
+			size, err := m.Rollback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Enabled != nil {
 		i--
 		if *m.Enabled {
@@ -15702,6 +16784,61 @@ func (m *SyncPolicyAutomated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncRevert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncRevert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncRevert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ID))
+	i--
+	dAtA[i] = 0x28
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Revisions[iNdEx])
+			copy(dAtA[i:], m.Revisions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revisions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Revision)
+	copy(dAtA[i:], m.Revision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15722,6 +16859,23 @@ func (m *SyncSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Layout != nil {
+		{
+			size, err := m.Layout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0x1a
 	i -= len(m.Path)
 	copy(dAtA[i:], m.Path)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
@@ -15898,6 +17052,110 @@ func (m *SyncStrategyHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncWaveProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWaveProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWaveProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextWaveAt != nil {
+		{
+			size, err := m.NextWaveAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CompletedAt != nil {
+		{
+			size, err := m.CompletedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.AppliedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	i--
+	if m.Final {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Wave))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncWavesStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWavesStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWavesStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Delay)
+	copy(dAtA[i:], m.Delay)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Delay)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxParallelism))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncWindow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15918,6 +17176,42 @@ func (m *SyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Calendar != nil {
+		{
+			size, err := m.Calendar.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.End != nil {
+		{
+			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Start != nil {
+		{
+			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	i -= len(m.Description)
 	copy(dAtA[i:], m.Description)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Description)))
@@ -15989,6 +17283,43 @@ func (m *SyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncWindowCalendar) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindowCalendar) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindowCalendar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dates) > 0 {
+		for iNdEx := len(m.Dates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dates[iNdEx])
+			copy(dAtA[i:], m.Dates[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Dates[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TLSClientConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -16233,6 +17564,7 @@ func (m *AppProjectSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	n += 2
 	return n
 }
 
@@ -16270,6 +17602,10 @@ func (m *Application) Size() (n int) {
 		l = m.Operation.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.ScheduledOperation != nil {
+		l = m.ScheduledOperation.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16290,6 +17626,23 @@ func (m *ApplicationCondition) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationDependency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Selector != nil {
+		l = m.Selector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ApplicationDestination) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17113,6 +18466,12 @@ func (m *ApplicationSpec) Size() (n int) {
 		l = m.SourceHydrator.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.DependsOn) > 0 {
+		for _, e := range m.DependsOn {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17172,6 +18531,10 @@ func (m *ApplicationStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.SourceHydrator.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.AutomatedRollback != nil {
+		l = m.AutomatedRollback.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17237,6 +18600,31 @@ func (m *ApplicationWatchEvent) Size() (n int) {
 	return n
 }
 
+func (m *AutomatedRollback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Window)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *AutomatedRollbackStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RolledBackAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ID))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Backoff) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17363,6 +18751,10 @@ func (m *Cluster) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if m.Maintenance != nil {
+		l = m.Maintenance.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17470,6 +18862,22 @@ func (m *ClusterList) Size() (n int) {
 	return n
 }
 
+func (m *ClusterMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ExpiresAt != nil {
+		l = m.ExpiresAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
+
 func (m *Command) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17584,6 +18992,34 @@ func (m *ConfigMapKeyRef) Size() (n int) {
 }
 
 func (m *ConnectionState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ModifiedAt != nil {
+		l = m.ModifiedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.ConsecutiveFailures))
+	if m.QuarantinedUntil != nil {
+		l = m.QuarantinedUntil.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConnectionStateHistoryEntry) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -17612,6 +19048,22 @@ func (m *DrySource) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Helm != nil {
+		l = m.Helm.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Kustomize != nil {
+		l = m.Kustomize.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Directory != nil {
+		l = m.Directory.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Plugin != nil {
+		l = m.Plugin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17909,6 +19361,32 @@ func (m *HydrateOperation) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.SourceHydrator.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ApprovedBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ApprovedAt != nil {
+		l = m.ApprovedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.AdditionalDrySHAs) > 0 {
+		for _, s := range m.AdditionalDrySHAs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HydratePullRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.API)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -17920,6 +19398,34 @@ func (m *HydrateTo) Size() (n int) {
 	_ = l
 	l = len(m.TargetBranch)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *HydratedManifestLayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *HydratedPullRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Number))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -19288,6 +20794,11 @@ func (m *RevisionHistory) Size() (n int) {
 	}
 	l = m.InitiatedBy.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.Revert != nil {
+		l = m.Revert.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19579,6 +21090,19 @@ func (m *SCMProviderGeneratorGitlab) Size() (n int) {
 	return n
 }
 
+func (m *ScheduledOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Operation.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.At.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *SecretRef) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19617,6 +21141,13 @@ func (m *SourceHydrator) Size() (n int) {
 		l = m.HydrateTo.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.AdditionalDrySources) > 0 {
+		for _, e := range m.AdditionalDrySources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
 	return n
 }
 
@@ -19634,6 +21165,10 @@ func (m *SourceHydratorStatus) Size() (n int) {
 		l = m.CurrentOperation.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19649,6 +21184,12 @@ func (m *SuccessfulHydrateOperation) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.SourceHydrator.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.AdditionalDrySHAs) > 0 {
+		for _, s := range m.AdditionalDrySHAs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19701,6 +21242,10 @@ func (m *SyncOperation) Size() (n int) {
 		}
 	}
 	n += 1 + sovGenerated(uint64(m.SelfHealAttemptsCount))
+	if m.Revert != nil {
+		l = m.Revert.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19753,6 +21298,10 @@ func (m *SyncOperationResult) Size() (n int) {
 		l = m.ManagedNamespaceMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.WaveProgress != nil {
+		l = m.WaveProgress.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19780,6 +21329,10 @@ func (m *SyncPolicy) Size() (n int) {
 		l = m.ManagedNamespaceMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Waves != nil {
+		l = m.Waves.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19795,6 +21348,32 @@ func (m *SyncPolicyAutomated) Size() (n int) {
 	if m.Enabled != nil {
 		n += 2
 	}
+	if m.Rollback != nil {
+		l = m.Rollback.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncRevert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ID))
 	return n
 }
 
@@ -19808,6 +21387,12 @@ func (m *SyncSource) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Layout != nil {
+		l = m.Layout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19870,6 +21455,41 @@ func (m *SyncStrategyHook) Size() (n int) {
 	return n
 }
 
+func (m *SyncWaveProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Wave))
+	n += 2
+	l = m.AppliedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CompletedAt != nil {
+		l = m.CompletedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.NextWaveAt != nil {
+		l = m.NextWaveAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncWavesStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MaxParallelism))
+	l = len(m.Delay)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *SyncWindow) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19906,6 +21526,35 @@ func (m *SyncWindow) Size() (n int) {
 	n += 2
 	l = len(m.Description)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Start != nil {
+		l = m.Start.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.End != nil {
+		l = m.End.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Calendar != nil {
+		l = m.Calendar.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncWindowCalendar) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Dates) > 0 {
+		for _, s := range m.Dates {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -20068,6 +21717,7 @@ func (this *AppProjectSpec) String() string {
 		`SourceNamespaces:` + fmt.Sprintf("%v", this.SourceNamespaces) + `,`,
 		`PermitOnlyProjectScopedClusters:` + fmt.Sprintf("%v", this.PermitOnlyProjectScopedClusters) + `,`,
 		`DestinationServiceAccounts:` + repeatedStringForDestinationServiceAccounts + `,`,
+		`RequireHydrationApproval:` + fmt.Sprintf("%v", this.RequireHydrationApproval) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20101,6 +21751,7 @@ func (this *Application) String() string {
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ApplicationSpec", "ApplicationSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ApplicationStatus", "ApplicationStatus", 1), `&`, ``, 1) + `,`,
 		`Operation:` + strings.Replace(this.Operation.String(), "Operation", "Operation", 1) + `,`,
+		`ScheduledOperation:` + strings.Replace(this.ScheduledOperation.String(), "ScheduledOperation", "ScheduledOperation", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20117,6 +21768,18 @@ func (this *ApplicationCondition) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationDependency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationDependency{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationDestination) String() string {
 	if this == nil {
 		return "nil"
@@ -20673,6 +22336,11 @@ func (this *ApplicationSpec) String() string {
 		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForSources += "}"
+	repeatedStringForDependsOn := "[]ApplicationDependency{"
+	for _, f := range this.DependsOn {
+		repeatedStringForDependsOn += strings.Replace(strings.Replace(f.String(), "ApplicationDependency", "ApplicationDependency", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDependsOn += "}"
 	s := strings.Join([]string{`&ApplicationSpec{`,
 		`Source:` + strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1) + `,`,
 		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
@@ -20683,6 +22351,7 @@ func (this *ApplicationSpec) String() string {
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
 		`Sources:` + repeatedStringForSources + `,`,
 		`SourceHydrator:` + strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1) + `,`,
+		`DependsOn:` + repeatedStringForDependsOn + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20721,6 +22390,7 @@ func (this *ApplicationStatus) String() string {
 		`SourceTypes:` + fmt.Sprintf("%v", this.SourceTypes) + `,`,
 		`ControllerNamespace:` + fmt.Sprintf("%v", this.ControllerNamespace) + `,`,
 		`SourceHydrator:` + strings.Replace(strings.Replace(this.SourceHydrator.String(), "SourceHydratorStatus", "SourceHydratorStatus", 1), `&`, ``, 1) + `,`,
+		`AutomatedRollback:` + strings.Replace(this.AutomatedRollback.String(), "AutomatedRollbackStatus", "AutomatedRollbackStatus", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20775,6 +22445,28 @@ func (this *ApplicationWatchEvent) String() string {
 	}, "")
 	return s
 }
+func (this *AutomatedRollback) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AutomatedRollback{`,
+		`Window:` + fmt.Sprintf("%v", this.Window) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AutomatedRollbackStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AutomatedRollbackStatus{`,
+		`RolledBackAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.RolledBackAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Backoff) String() string {
 	if this == nil {
 		return "nil"
@@ -20868,6 +22560,7 @@ func (this *Cluster) String() string {
 		`Project:` + fmt.Sprintf("%v", this.Project) + `,`,
 		`Labels:` + mapStringForLabels + `,`,
 		`Annotations:` + mapStringForAnnotations + `,`,
+		`Maintenance:` + strings.Replace(this.Maintenance.String(), "ClusterMaintenance", "ClusterMaintenance", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20954,6 +22647,18 @@ func (this *ClusterList) String() string {
 	}, "")
 	return s
 }
+func (this *ClusterMaintenance) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterMaintenance{`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Time", "v1.Time", 1) + `,`,
+		`PauseWatch:` + fmt.Sprintf("%v", this.PauseWatch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Command) String() string {
 	if this == nil {
 		return "nil"
@@ -21043,7 +22748,27 @@ func (this *ConnectionState) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForHistory := "[]ConnectionStateHistoryEntry{"
+	for _, f := range this.History {
+		repeatedStringForHistory += strings.Replace(strings.Replace(f.String(), "ConnectionStateHistoryEntry", "ConnectionStateHistoryEntry", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHistory += "}"
 	s := strings.Join([]string{`&ConnectionState{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`ModifiedAt:` + strings.Replace(fmt.Sprintf("%v", this.ModifiedAt), "Time", "v1.Time", 1) + `,`,
+		`ConsecutiveFailures:` + fmt.Sprintf("%v", this.ConsecutiveFailures) + `,`,
+		`QuarantinedUntil:` + strings.Replace(fmt.Sprintf("%v", this.QuarantinedUntil), "Time", "v1.Time", 1) + `,`,
+		`History:` + repeatedStringForHistory + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConnectionStateHistoryEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConnectionStateHistoryEntry{`,
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`ModifiedAt:` + strings.Replace(fmt.Sprintf("%v", this.ModifiedAt), "Time", "v1.Time", 1) + `,`,
@@ -21059,6 +22784,10 @@ func (this *DrySource) String() string {
 		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
 		`TargetRevision:` + fmt.Sprintf("%v", this.TargetRevision) + `,`,
 		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Helm:` + strings.Replace(this.Helm.String(), "ApplicationSourceHelm", "ApplicationSourceHelm", 1) + `,`,
+		`Kustomize:` + strings.Replace(this.Kustomize.String(), "ApplicationSourceKustomize", "ApplicationSourceKustomize", 1) + `,`,
+		`Directory:` + strings.Replace(this.Directory.String(), "ApplicationSourceDirectory", "ApplicationSourceDirectory", 1) + `,`,
+		`Plugin:` + strings.Replace(this.Plugin.String(), "ApplicationSourcePlugin", "ApplicationSourcePlugin", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21311,6 +23040,21 @@ func (this *HydrateOperation) String() string {
 		`DrySHA:` + fmt.Sprintf("%v", this.DrySHA) + `,`,
 		`HydratedSHA:` + fmt.Sprintf("%v", this.HydratedSHA) + `,`,
 		`SourceHydrator:` + strings.Replace(strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1), `&`, ``, 1) + `,`,
+		`ApprovedBy:` + fmt.Sprintf("%v", this.ApprovedBy) + `,`,
+		`ApprovedAt:` + strings.Replace(fmt.Sprintf("%v", this.ApprovedAt), "Time", "v1.Time", 1) + `,`,
+		`AdditionalDrySHAs:` + fmt.Sprintf("%v", this.AdditionalDrySHAs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HydratePullRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HydratePullRequest{`,
+		`Provider:` + fmt.Sprintf("%v", this.Provider) + `,`,
+		`API:` + fmt.Sprintf("%v", this.API) + `,`,
+		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21321,6 +23065,29 @@ func (this *HydrateTo) String() string {
 	}
 	s := strings.Join([]string{`&HydrateTo{`,
 		`TargetBranch:` + fmt.Sprintf("%v", this.TargetBranch) + `,`,
+		`PullRequest:` + strings.Replace(this.PullRequest.String(), "HydratePullRequest", "HydratePullRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HydratedManifestLayout) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HydratedManifestLayout{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Kustomization:` + fmt.Sprintf("%v", this.Kustomization) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HydratedPullRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HydratedPullRequest{`,
+		`Number:` + fmt.Sprintf("%v", this.Number) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22357,6 +24124,8 @@ func (this *RevisionHistory) String() string {
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
+		`Healthy:` + fmt.Sprintf("%v", this.Healthy) + `,`,
+		`Revert:` + strings.Replace(this.Revert.String(), "SyncRevert", "SyncRevert", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22548,6 +24317,17 @@ func (this *SCMProviderGeneratorGitlab) String() string {
 	}, "")
 	return s
 }
+func (this *ScheduledOperation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledOperation{`,
+		`Operation:` + strings.Replace(strings.Replace(this.Operation.String(), "Operation", "Operation", 1), `&`, ``, 1) + `,`,
+		`At:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.At), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SecretRef) String() string {
 	if this == nil {
 		return "nil"
@@ -22573,10 +24353,17 @@ func (this *SourceHydrator) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForAdditionalDrySources := "[]ApplicationSource{"
+	for _, f := range this.AdditionalDrySources {
+		repeatedStringForAdditionalDrySources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAdditionalDrySources += "}"
 	s := strings.Join([]string{`&SourceHydrator{`,
 		`DrySource:` + strings.Replace(strings.Replace(this.DrySource.String(), "DrySource", "DrySource", 1), `&`, ``, 1) + `,`,
 		`SyncSource:` + strings.Replace(strings.Replace(this.SyncSource.String(), "SyncSource", "SyncSource", 1), `&`, ``, 1) + `,`,
 		`HydrateTo:` + strings.Replace(this.HydrateTo.String(), "HydrateTo", "HydrateTo", 1) + `,`,
+		`AdditionalDrySources:` + repeatedStringForAdditionalDrySources + `,`,
+		`RequireApproval:` + fmt.Sprintf("%v", this.RequireApproval) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22588,6 +24375,7 @@ func (this *SourceHydratorStatus) String() string {
 	s := strings.Join([]string{`&SourceHydratorStatus{`,
 		`LastSuccessfulOperation:` + strings.Replace(this.LastSuccessfulOperation.String(), "SuccessfulHydrateOperation", "SuccessfulHydrateOperation", 1) + `,`,
 		`CurrentOperation:` + strings.Replace(this.CurrentOperation.String(), "HydrateOperation", "HydrateOperation", 1) + `,`,
+		`PullRequest:` + strings.Replace(this.PullRequest.String(), "HydratedPullRequest", "HydratedPullRequest", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22600,6 +24388,7 @@ func (this *SuccessfulHydrateOperation) String() string {
 		`DrySHA:` + fmt.Sprintf("%v", this.DrySHA) + `,`,
 		`HydratedSHA:` + fmt.Sprintf("%v", this.HydratedSHA) + `,`,
 		`SourceHydrator:` + strings.Replace(strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1), `&`, ``, 1) + `,`,
+		`AdditionalDrySHAs:` + fmt.Sprintf("%v", this.AdditionalDrySHAs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22630,6 +24419,7 @@ func (this *SyncOperation) String() string {
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`SelfHealAttemptsCount:` + fmt.Sprintf("%v", this.SelfHealAttemptsCount) + `,`,
+		`Revert:` + strings.Replace(this.Revert.String(), "SyncRevert", "SyncRevert", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22668,6 +24458,7 @@ func (this *SyncOperationResult) String() string {
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`ManagedNamespaceMetadata:` + strings.Replace(this.ManagedNamespaceMetadata.String(), "ManagedNamespaceMetadata", "ManagedNamespaceMetadata", 1) + `,`,
+		`WaveProgress:` + strings.Replace(this.WaveProgress.String(), "SyncWaveProgress", "SyncWaveProgress", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22681,6 +24472,7 @@ func (this *SyncPolicy) String() string {
 		`SyncOptions:` + fmt.Sprintf("%v", this.SyncOptions) + `,`,
 		`Retry:` + strings.Replace(this.Retry.String(), "RetryStrategy", "RetryStrategy", 1) + `,`,
 		`ManagedNamespaceMetadata:` + strings.Replace(this.ManagedNamespaceMetadata.String(), "ManagedNamespaceMetadata", "ManagedNamespaceMetadata", 1) + `,`,
+		`Waves:` + strings.Replace(this.Waves.String(), "SyncWavesStrategy", "SyncWavesStrategy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22694,6 +24486,21 @@ func (this *SyncPolicyAutomated) String() string {
 		`SelfHeal:` + fmt.Sprintf("%v", this.SelfHeal) + `,`,
 		`AllowEmpty:` + fmt.Sprintf("%v", this.AllowEmpty) + `,`,
 		`Enabled:` + valueToStringGenerated(this.Enabled) + `,`,
+		`Rollback:` + strings.Replace(this.Rollback.String(), "AutomatedRollback", "AutomatedRollback", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncRevert) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncRevert{`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22705,6 +24512,8 @@ func (this *SyncSource) String() string {
 	s := strings.Join([]string{`&SyncSource{`,
 		`TargetBranch:` + fmt.Sprintf("%v", this.TargetBranch) + `,`,
 		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Layout:` + strings.Replace(this.Layout.String(), "HydratedManifestLayout", "HydratedManifestLayout", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22753,6 +24562,32 @@ func (this *SyncStrategyHook) String() string {
 	}, "")
 	return s
 }
+func (this *SyncWaveProgress) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncWaveProgress{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Wave:` + fmt.Sprintf("%v", this.Wave) + `,`,
+		`Final:` + fmt.Sprintf("%v", this.Final) + `,`,
+		`AppliedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.AppliedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`CompletedAt:` + strings.Replace(fmt.Sprintf("%v", this.CompletedAt), "Time", "v1.Time", 1) + `,`,
+		`NextWaveAt:` + strings.Replace(fmt.Sprintf("%v", this.NextWaveAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncWavesStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncWavesStrategy{`,
+		`MaxParallelism:` + fmt.Sprintf("%v", this.MaxParallelism) + `,`,
+		`Delay:` + fmt.Sprintf("%v", this.Delay) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SyncWindow) String() string {
 	if this == nil {
 		return "nil"
@@ -22768,6 +24603,20 @@ func (this *SyncWindow) String() string {
 		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
 		`UseAndOperator:` + fmt.Sprintf("%v", this.UseAndOperator) + `,`,
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`Start:` + strings.Replace(fmt.Sprintf("%v", this.Start), "Time", "v1.Time", 1) + `,`,
+		`End:` + strings.Replace(fmt.Sprintf("%v", this.End), "Time", "v1.Time", 1) + `,`,
+		`Calendar:` + strings.Replace(this.Calendar.String(), "SyncWindowCalendar", "SyncWindowCalendar", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncWindowCalendar) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncWindowCalendar{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Dates:` + fmt.Sprintf("%v", this.Dates) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23854,6 +25703,26 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireHydrationApproval", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireHydrationApproval = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24218,6 +26087,42 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledOperation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledOperation == nil {
+				m.ScheduledOperation = &ScheduledOperation{}
+			}
+			if err := m.ScheduledOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24389,7 +26294,7 @@ func (m *ApplicationCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
+func (m *ApplicationDependency) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24412,15 +26317,15 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationDestination: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDependency: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDependency: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24448,7 +26353,7 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Server = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -24484,9 +26389,9 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24496,23 +26401,27 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24535,7 +26444,7 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
+func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24558,10 +26467,10 @@ func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDestination: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDestination: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -24630,7 +26539,7 @@ func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultServiceAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24658,124 +26567,7 @@ func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DefaultServiceAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Items = append(m.Items, Application{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24798,7 +26590,7 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
+func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24821,15 +26613,15 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationMatchExpression: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationMatchExpression: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24857,11 +26649,11 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Server = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24889,11 +26681,11 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultServiceAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24921,7 +26713,7 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			m.DefaultServiceAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24944,7 +26736,7 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
+func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24967,17 +26759,17 @@ func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationPreservedFields: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationPreservedFields: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24987,29 +26779,30 @@ func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Annotations = append(m.Annotations, string(dAtA[iNdEx:postIndex]))
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25019,23 +26812,25 @@ func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			m.Items = append(m.Items, Application{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25058,7 +26853,7 @@ func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
+func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25081,17 +26876,17 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationMatchExpression: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationMatchExpression: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25101,30 +26896,29 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25134,30 +26928,29 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25167,24 +26960,286 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationPreservedFields: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationPreservedFields: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotations = append(m.Annotations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31251,6 +33306,40 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, ApplicationDependency{})
+			if err := m.DependsOn[len(m.DependsOn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31771,6 +33860,42 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutomatedRollback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutomatedRollback == nil {
+				m.AutomatedRollback = &AutomatedRollbackStatus{}
+			}
+			if err := m.AutomatedRollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32192,7 +34317,7 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Backoff) Unmarshal(dAtA []byte) error {
+func (m *AutomatedRollback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32215,15 +34340,15 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutomatedRollback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutomatedRollback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32251,13 +34376,96 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
+			m.Window = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutomatedRollbackStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutomatedRollbackStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutomatedRollbackStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolledBackAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RolledBackAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var v int64
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32267,15 +34475,14 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				m.ID |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Factor = &v
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32303,7 +34510,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32326,7 +34533,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
+func (m *Backoff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32349,15 +34556,15 @@ func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BasicAuthBitbucketServer: wiretype end group for non-group")
+			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BasicAuthBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32385,13 +34592,33 @@ func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Factor = &v
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32401,27 +34628,23 @@ func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordRef == nil {
-				m.PasswordRef = &SecretRef{}
-			}
-			if err := m.PasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32444,7 +34667,7 @@ func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BearerTokenBitbucket) Unmarshal(dAtA []byte) error {
+func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32467,15 +34690,47 @@ func (m *BearerTokenBitbucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BearerTokenBitbucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: BasicAuthBitbucketServer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BearerTokenBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BasicAuthBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32502,10 +34757,10 @@ func (m *BearerTokenBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
+			if m.PasswordRef == nil {
+				m.PasswordRef = &SecretRef{}
 			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -32530,7 +34785,7 @@ func (m *BearerTokenBitbucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
+func (m *BearerTokenBitbucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32553,10 +34808,96 @@ func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BearerTokenBitbucketCloud: wiretype end group for non-group")
+			return fmt.Errorf("proto: BearerTokenBitbucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BearerTokenBitbucketCloud: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BearerTokenBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BearerTokenBitbucketCloud: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BearerTokenBitbucketCloud: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -33380,6 +35721,42 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			}
 			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Maintenance == nil {
+				m.Maintenance = &ClusterMaintenance{}
+			}
+			if err := m.Maintenance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34407,7 +36784,7 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Command) Unmarshal(dAtA []byte) error {
+func (m *ClusterMaintenance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34430,15 +36807,15 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Command: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterMaintenance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34466,13 +36843,13 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34482,24 +36859,48 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = &v1.Time{}
+			}
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseWatch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PauseWatch = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34521,7 +36922,7 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
+func (m *Command) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34544,15 +36945,15 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: Command: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34580,11 +36981,11 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Author = string(dAtA[iNdEx:postIndex])
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34612,43 +37013,61 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Date = string(dAtA[iNdEx:postIndex])
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34676,11 +37095,11 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Body = string(dAtA[iNdEx:postIndex])
+			m.Author = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SHA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34708,11 +37127,107 @@ func (m *CommitMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SHA = string(dAtA[iNdEx:postIndex])
+			m.Date = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SHA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SHA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35507,6 +38022,95 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuarantinedUntil == nil {
+				m.QuarantinedUntil = &v1.Time{}
+			}
+			if err := m.QuarantinedUntil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, ConnectionStateHistoryEntry{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35528,7 +38132,7 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DrySource) Unmarshal(dAtA []byte) error {
+func (m *ConnectionStateHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35551,15 +38155,15 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DrySource: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectionStateHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DrySource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectionStateHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35587,11 +38191,11 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35619,13 +38223,13 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetRevision = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35635,23 +38239,27 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			if m.ModifiedAt == nil {
+				m.ModifiedAt = &v1.Time{}
+			}
+			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35674,7 +38282,7 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
+func (m *DrySource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35697,15 +38305,15 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DuckTypeGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: DrySource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DuckTypeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DrySource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35733,11 +38341,11 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigMapRef = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35765,13 +38373,13 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var v int64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35781,15 +38389,27 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequeueAfterSeconds = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35816,13 +38436,16 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Helm == nil {
+				m.Helm = &ApplicationSourceHelm{}
+			}
+			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35849,13 +38472,288 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Kustomize == nil {
+				m.Kustomize = &ApplicationSourceKustomize{}
+			}
+			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Directory == nil {
+				m.Directory = &ApplicationSourceDirectory{}
+			}
+			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plugin == nil {
+				m.Plugin = &ApplicationSourcePlugin{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DuckTypeGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DuckTypeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMapRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequeueAfterSeconds = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38531,59 +41429,77 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedBy", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HydrateTo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.ApprovedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ApprovedAt == nil {
+				m.ApprovedAt = &v1.Time{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HydrateTo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HydrateTo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.ApprovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalDrySHAs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38611,7 +41527,7 @@ func (m *HydrateTo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetBranch = string(dAtA[iNdEx:postIndex])
+			m.AdditionalDrySHAs = append(m.AdditionalDrySHAs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38634,7 +41550,7 @@ func (m *HydrateTo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Info) Unmarshal(dAtA []byte) error {
+func (m *HydratePullRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38657,15 +41573,15 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydratePullRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydratePullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38693,11 +41609,11 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Provider = HydratePullRequestProvider(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38725,8 +41641,28 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38748,7 +41684,7 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfoItem) Unmarshal(dAtA []byte) error {
+func (m *HydrateTo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38771,15 +41707,15 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydrateTo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydrateTo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38807,13 +41743,13 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.TargetBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38823,23 +41759,27 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if m.PullRequest == nil {
+				m.PullRequest = &HydratePullRequest{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38862,7 +41802,7 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTToken) Unmarshal(dAtA []byte) error {
+func (m *HydratedManifestLayout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38885,17 +41825,17 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydratedManifestLayout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydratedManifestLayout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.IssuedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38905,35 +41845,29 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ExpiresAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpiresAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			m.Type = HydratedManifestLayoutType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomization", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38943,24 +41877,12 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Kustomization = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38982,7 +41904,7 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTTokens) Unmarshal(dAtA []byte) error {
+func (m *HydratedPullRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39005,17 +41927,36 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydratedPullRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydratedPullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39025,25 +41966,23 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, JWTToken{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39066,7 +42005,7 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
+func (m *Info) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39089,10 +42028,10 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
+			return fmt.Errorf("proto: Info: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -39159,26 +42098,6 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39200,7 +42119,7 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
+func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39223,15 +42142,15 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39259,11 +42178,11 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39291,7 +42210,7 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39314,7 +42233,7 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
+func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39337,17 +42256,17 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeGvk: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeGvk: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
 			}
-			var stringLen uint64
+			m.IssuedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39357,29 +42276,16 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.IssuedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Group = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var stringLen uint64
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39389,27 +42295,14 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpiresAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39437,7 +42330,7 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39460,7 +42353,7 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
+func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39483,79 +42376,15 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BuildOptions = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BinaryPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39582,8 +42411,8 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Versions = append(m.Versions, KustomizeVersion{})
-			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, JWTToken{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -39608,7 +42437,7 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
+func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39631,15 +42460,15 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizePatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizePatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39667,11 +42496,11 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39699,49 +42528,13 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Patch = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Target == nil {
-				m.Target = &KustomizeSelector{}
-			}
-			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39751,107 +42544,12 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Options == nil {
-				m.Options = make(map[string]bool)
-			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Options[mapkey] = mapvalue
-			iNdEx = postIndex
+			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39873,7 +42571,7 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
+func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39896,15 +42594,15 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeReplica: wiretype end group for non-group")
+			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeReplica: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39932,13 +42630,13 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39948,24 +42646,23 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39988,7 +42685,7 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
+func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40011,17 +42708,17 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeResId: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeGvk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeResId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeGvk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeGvk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40031,28 +42728,27 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KustomizeGvk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40080,11 +42776,11 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40112,7 +42808,7 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -40135,7 +42831,7 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
+func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40158,17 +42854,17 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeSelector: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeResId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40178,28 +42874,27 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KustomizeResId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BuildOptions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40227,13 +42922,13 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnnotationSelector = string(dAtA[iNdEx:postIndex])
+			m.BinaryPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40243,23 +42938,25 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LabelSelector = string(dAtA[iNdEx:postIndex])
+			m.Versions = append(m.Versions, KustomizeVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -40282,7 +42979,7 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeVersion) Unmarshal(dAtA []byte) error {
+func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40305,45 +43002,13 @@ func (m *KustomizeVersion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeVersion: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizePatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizePatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
@@ -40375,158 +43040,9 @@ func (m *KustomizeVersion) Unmarshal(dAtA []byte) error {
 			}
 			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BuildOptions = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListGenerator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListGenerator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Elements = append(m.Elements, v11.JSON{})
-			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElementsYaml", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40554,61 +43070,11 @@ func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ElementsYaml = string(dAtA[iNdEx:postIndex])
+			m.Patch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ManagedNamespaceMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagedNamespaceMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40635,107 +43101,16 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.Target == nil {
+				m.Target = &KustomizeSelector{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40762,11 +43137,11 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.Options == nil {
+				m.Options = make(map[string]bool)
 			}
 			var mapkey string
-			var mapvalue string
+			var mapvalue bool
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -40815,7 +43190,7 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapvaluetemp int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -40825,24 +43200,12 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapvaluetemp |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = bool(mapvaluetemp != 0)
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -40858,7 +43221,7 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			m.Options[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -40881,7 +43244,7 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
+func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40904,17 +43267,17 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MatrixGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeReplica: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeReplica: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -40924,29 +43287,27 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40973,7 +43334,7 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -40998,7 +43359,7 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
+func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41021,15 +43382,15 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeResId: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeResId: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeGvk", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41056,14 +43417,13 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KustomizeGvk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41091,13 +43451,13 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41107,24 +43467,23 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -41147,7 +43506,7 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
+func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41170,15 +43529,15 @@ func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NestedMatrixGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeSelector: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NestedMatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeSelector: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeResId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41205,98 +43564,45 @@ func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KustomizeResId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationSelector", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NestedMergeGenerator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NestedMergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AnnotationSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41324,7 +43630,7 @@ func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -41347,7 +43653,7 @@ func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
+func (m *KustomizeVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41370,15 +43676,15 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OCIMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OCIMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41406,11 +43712,11 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41438,11 +43744,11 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authors = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41470,45 +43776,63 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageURL = string(dAtA[iNdEx:postIndex])
+			m.BuildOptions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocsURL", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocsURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41518,29 +43842,31 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceURL = string(dAtA[iNdEx:postIndex])
+			m.Elements = append(m.Elements, v11.JSON{})
+			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41550,27 +43876,28 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ElementsYaml", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41598,7 +43925,7 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ElementsYaml = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -41621,7 +43948,7 @@ func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation) Unmarshal(dAtA []byte) error {
+func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41644,15 +43971,15 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagedNamespaceMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagedNamespaceMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41679,16 +44006,107 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sync == nil {
-				m.Sync = &SyncOperation{}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41715,13 +44133,157 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MatrixGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41748,14 +44310,14 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, &Info{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41782,7 +44344,7 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -41807,7 +44369,7 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
+func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41830,17 +44392,17 @@ func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationInitiator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationInitiator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41850,29 +44412,31 @@ func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -41882,19 +44446,64 @@ func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Automated = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if (iNdEx + skippy) > l {
@@ -41909,7 +44518,7 @@ func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationState) Unmarshal(dAtA []byte) error {
+func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41932,15 +44541,15 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
+			return fmt.Errorf("proto: NestedMatrixGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NestedMatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41967,13 +44576,98 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NestedMergeGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NestedMergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42001,11 +44695,125 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
+			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OCIMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OCIMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OCIMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authors = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42033,11 +44841,189 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.ImageURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocsURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocsURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Operation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42064,16 +45050,16 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncResult == nil {
-				m.SyncResult = &SyncOperationResult{}
+			if m.Sync == nil {
+				m.Sync = &SyncOperation{}
 			}
-			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42100,13 +45086,1327 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = append(m.Info, &Info{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperationInitiator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperationInitiator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Automated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperationState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncResult == nil {
+				m.SyncResult = &SyncOperationResult{}
+			}
+			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+			}
+			m.RetryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OptionalArray) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptionalArray: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptionalArray: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Array", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Array = append(m.Array, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OptionalMap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptionalMap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptionalMap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Map == nil {
+				m.Map = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Map[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrphanedResourceKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrphanedResourceKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Warn = &b
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ignore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ignore = append(m.Ignore, OrphanedResourceKey{})
+			if err := m.Ignore[len(m.Ignore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OverrideIgnoreDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OverrideIgnoreDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedFieldsManagers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagedFieldsManagers = append(m.ManagedFieldsManagers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PluginConfigMapRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PluginConfigMapRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PluginConfigMapRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PluginGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PluginGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConfigMapRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequeueAfterSeconds = &v
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42133,87 +46433,15 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
-			}
-			m.RetryCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RetryCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OptionalArray) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OptionalArray: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OptionalArray: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Array", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42223,23 +46451,118 @@ func (m *OptionalArray) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Array = append(m.Array, string(dAtA[iNdEx:postIndex]))
+			if m.Values == nil {
+				m.Values = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42262,7 +46585,7 @@ func (m *OptionalArray) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OptionalMap) Unmarshal(dAtA []byte) error {
+func (m *PluginInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42285,15 +46608,15 @@ func (m *OptionalMap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OptionalMap: wiretype end group for non-group")
+			return fmt.Errorf("proto: PluginInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OptionalMap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PluginInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42320,11 +46643,11 @@ func (m *OptionalMap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Map == nil {
-				m.Map = make(map[string]string)
+			if m.Parameters == nil {
+				m.Parameters = make(PluginParameters)
 			}
 			var mapkey string
-			var mapvalue string
+			mapvalue := &v11.JSON{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -42373,7 +46696,7 @@ func (m *OptionalMap) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -42383,24 +46706,26 @@ func (m *OptionalMap) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &v11.JSON{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -42416,7 +46741,7 @@ func (m *OptionalMap) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Map[mapkey] = mapvalue
+			m.Parameters[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42439,7 +46764,7 @@ func (m *OptionalMap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
+func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42462,15 +46787,15 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrphanedResourceKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrphanedResourceKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42498,11 +46823,11 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42530,11 +46855,11 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42562,63 +46887,13 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Warn", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokens", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42628,18 +46903,31 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Warn = &b
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JWTTokens = append(m.JWTTokens, JWTToken{})
+			if err := m.JWTTokens[len(m.JWTTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ignore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42649,25 +46937,23 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ignore = append(m.Ignore, OrphanedResourceKey{})
-			if err := m.Ignore[len(m.Ignore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42690,7 +46976,7 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42713,17 +46999,17 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OverrideIgnoreDiff: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OverrideIgnoreDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Github", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42733,61 +47019,33 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Github == nil {
+				m.Github = &PullRequestGeneratorGithub{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Github.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedFieldsManagers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitLab", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42797,79 +47055,33 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ManagedFieldsManagers = append(m.ManagedFieldsManagers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PluginConfigMapRef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.GitLab == nil {
+				m.GitLab = &PullRequestGeneratorGitLab{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.GitLab.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PluginConfigMapRef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PluginConfigMapRef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gitea", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -42879,77 +47091,31 @@ func (m *PluginConfigMapRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Gitea == nil {
+				m.Gitea = &PullRequestGeneratorGitea{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PluginGenerator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PluginGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Gitea.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BitbucketServer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42976,13 +47142,16 @@ func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConfigMapRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BitbucketServer == nil {
+				m.BitbucketServer = &PullRequestGeneratorBitbucketServer{}
+			}
+			if err := m.BitbucketServer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43009,11 +47178,12 @@ func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Filters = append(m.Filters, PullRequestGeneratorFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
 			}
@@ -43033,7 +47203,7 @@ func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RequeueAfterSeconds = &v
-		case 4:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
@@ -43066,7 +47236,79 @@ func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitbucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bitbucket == nil {
+				m.Bitbucket = &PullRequestGeneratorBitbucket{}
+			}
+			if err := m.Bitbucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureDevOps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AzureDevOps == nil {
+				m.AzureDevOps = &PullRequestGeneratorAzureDevOps{}
+			}
+			if err := m.AzureDevOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
@@ -43193,6 +47435,26 @@ func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 			}
 			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOnRepoNotFoundError", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ContinueOnRepoNotFoundError = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -43214,7 +47476,7 @@ func (m *PluginGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PluginInput) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43237,15 +47499,143 @@ func (m *PluginInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PluginInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGeneratorAzureDevOps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PluginInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGeneratorAzureDevOps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Organization = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.API = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43272,105 +47662,44 @@ func (m *PluginInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parameters == nil {
-				m.Parameters = make(PluginParameters)
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
 			}
-			var mapkey string
-			mapvalue := &v11.JSON{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v11.JSON{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Parameters[mapkey] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -43393,7 +47722,7 @@ func (m *PluginInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectRole) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43416,15 +47745,15 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGeneratorBitbucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGeneratorBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43452,11 +47781,11 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43484,11 +47813,11 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43516,11 +47845,11 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43547,16 +47876,18 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JWTTokens = append(m.JWTTokens, JWTToken{})
-			if err := m.JWTTokens[len(m.JWTTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BasicAuth == nil {
+				m.BasicAuth = &BasicAuthBitbucketServer{}
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43566,23 +47897,27 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			if m.BearerToken == nil {
+				m.BearerToken = &BearerTokenBitbucketCloud{}
+			}
+			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -43605,7 +47940,7 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43628,17 +47963,17 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGeneratorBitbucketServer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGeneratorBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Github", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43648,33 +47983,29 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Github == nil {
-				m.Github = &PullRequestGeneratorGithub{}
-			}
-			if err := m.Github.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitLab", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43684,33 +48015,29 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GitLab == nil {
-				m.GitLab = &PullRequestGeneratorGitLab{}
-			}
-			if err := m.GitLab.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gitea", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43720,31 +48047,27 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gitea == nil {
-				m.Gitea = &PullRequestGeneratorGitea{}
-			}
-			if err := m.Gitea.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BitbucketServer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43771,16 +48094,16 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BitbucketServer == nil {
-				m.BitbucketServer = &PullRequestGeneratorBitbucketServer{}
+			if m.BasicAuth == nil {
+				m.BasicAuth = &BasicAuthBitbucketServer{}
 			}
-			if err := m.BitbucketServer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43807,16 +48130,18 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filters = append(m.Filters, PullRequestGeneratorFilter{})
-			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BearerToken == nil {
+				m.BearerToken = &BearerTokenBitbucket{}
+			}
+			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
-			var v int64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43826,15 +48151,15 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequeueAfterSeconds = &v
+			m.Insecure = bool(v != 0)
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CARef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43861,15 +48186,68 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CARef == nil {
+				m.CARef = &ConfigMapKeyRef{}
+			}
+			if err := m.CARef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullRequestGeneratorFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullRequestGeneratorFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bitbucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchMatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43879,33 +48257,30 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Bitbucket == nil {
-				m.Bitbucket = &PullRequestGeneratorBitbucket{}
-			}
-			if err := m.Bitbucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.BranchMatch = &s
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AzureDevOps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranchMatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43915,33 +48290,30 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AzureDevOps == nil {
-				m.AzureDevOps = &PullRequestGeneratorAzureDevOps{}
-			}
-			if err := m.AzureDevOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TargetBranchMatch = &s
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TitleMatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -43951,139 +48323,25 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Values == nil {
-				m.Values = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Values[mapkey] = mapvalue
+			s := string(dAtA[iNdEx:postIndex])
+			m.TitleMatch = &s
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOnRepoNotFoundError", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ContinueOnRepoNotFoundError = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -44105,7 +48363,7 @@ func (m *PullRequestGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44128,15 +48386,15 @@ func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorAzureDevOps: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGeneratorGitLab: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorAzureDevOps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGeneratorGitLab: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44164,11 +48422,11 @@ func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Organization = string(dAtA[iNdEx:postIndex])
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44196,13 +48454,13 @@ func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44212,27 +48470,31 @@ func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44260,13 +48522,13 @@ func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestState", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44276,33 +48538,49 @@ func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PullRequestState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CARef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44312,23 +48590,27 @@ func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			if m.CARef == nil {
+				m.CARef = &ConfigMapKeyRef{}
+			}
+			if err := m.CARef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -44351,7 +48633,7 @@ func (m *PullRequestGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44374,10 +48656,10 @@ func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorBitbucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGeneratorGitea: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGeneratorGitea: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -44478,7 +48760,7 @@ func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -44505,18 +48787,38 @@ func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BasicAuth == nil {
-				m.BasicAuth = &BasicAuthBitbucketServer{}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
 			}
-			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44526,27 +48828,23 @@ func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BearerToken == nil {
-				m.BearerToken = &BearerTokenBitbucketCloud{}
-			}
-			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -44569,7 +48867,7 @@ func (m *PullRequestGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
+func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44592,15 +48890,15 @@ func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorBitbucketServer: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestGeneratorGithub: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestGeneratorGithub: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44628,7 +48926,7 @@ func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -44696,7 +48994,7 @@ func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -44723,18 +49021,18 @@ func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BasicAuth == nil {
-				m.BasicAuth = &BasicAuthBitbucketServer{}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
 			}
-			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppSecretName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44744,53 +49042,29 @@ func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BearerToken == nil {
-				m.BearerToken = &BearerTokenBitbucket{}
-			}
-			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AppSecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Insecure = bool(v != 0)
-		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CARef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44800,27 +49074,23 @@ func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CARef == nil {
-				m.CARef = &ConfigMapKeyRef{}
-			}
-			if err := m.CARef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -44843,7 +49113,7 @@ func (m *PullRequestGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
+func (m *RefTarget) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44866,17 +49136,17 @@ func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefTarget: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefTarget: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -44886,28 +49156,28 @@ func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.BranchMatch = &s
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranchMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44935,12 +49205,11 @@ func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.TargetBranchMatch = &s
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TitleMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44968,8 +49237,7 @@ func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.TitleMatch = &s
+			m.Chart = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -44992,7 +49260,7 @@ func (m *PullRequestGeneratorFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
+func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45015,15 +49283,15 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorGitLab: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepoCreds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorGitLab: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepoCreds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45051,11 +49319,11 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45083,13 +49351,13 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45099,33 +49367,157 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
 			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppPrivateKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GithubAppPrivateKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppId", wireType)
 			}
-			var stringLen uint64
+			m.GithubAppId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45135,27 +49527,33 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GithubAppId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppInstallationId", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.GithubAppInstallationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GithubAppInstallationId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitHubAppEnterpriseBaseURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45183,11 +49581,11 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PullRequestState = string(dAtA[iNdEx:postIndex])
+			m.GitHubAppEnterpriseBaseURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableOCI", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -45204,12 +49602,12 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Insecure = bool(v != 0)
-		case 7:
+			m.EnableOCI = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CARef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45219,81 +49617,27 @@ func (m *PullRequestGeneratorGitLab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CARef == nil {
-				m.CARef = &ConfigMapKeyRef{}
-			}
-			if err := m.CARef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorGitea: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorGitea: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCPServiceAccountKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45321,11 +49665,11 @@ func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.GCPServiceAccountKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45353,13 +49697,13 @@ func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.Proxy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceHttpBasicAuth", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45369,29 +49713,17 @@ func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.API = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.ForceHttpBasicAuth = bool(v != 0)
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoProxy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45401,31 +49733,27 @@ func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NoProxy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 24:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UseAzureWorkloadIdentity", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -45442,10 +49770,10 @@ func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Insecure = bool(v != 0)
-		case 6:
+			m.UseAzureWorkloadIdentity = bool(v != 0)
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45473,8 +49801,28 @@ func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			m.BearerToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureOCIForceHttp", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureOCIForceHttp = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -45496,7 +49844,7 @@ func (m *PullRequestGeneratorGitea) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
+func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45519,17 +49867,17 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestGeneratorGithub: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepoCredsList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestGeneratorGithub: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepoCredsList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45539,29 +49887,30 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45571,27 +49920,79 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, RepoCreds{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Repository) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Repository: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Repository: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45619,13 +50020,13 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45635,31 +50036,27 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppSecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45687,11 +50084,11 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppSecretName = string(dAtA[iNdEx:postIndex])
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45719,61 +50116,11 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RefTarget) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RefTarget: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefTarget: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -45800,15 +50147,15 @@ func (m *RefTarget) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45818,29 +50165,17 @@ func (m *RefTarget) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TargetRevision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+			m.InsecureIgnoreHostKey = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45850,79 +50185,17 @@ func (m *RefTarget) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Chart = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RepoCreds) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RepoCreds: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoCreds: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			m.Insecure = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableLFS", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -45932,27 +50205,15 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.EnableLFS = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertData", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45980,11 +50241,11 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46012,11 +50273,11 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46044,11 +50305,11 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46076,13 +50337,13 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InheritedCreds", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46092,25 +50353,33 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.InheritedCreds = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableOCI", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			m.EnableOCI = bool(v != 0)
+		case 15:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppPrivateKey", wireType)
 			}
@@ -46142,7 +50411,7 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			}
 			m.GithubAppPrivateKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 16:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppId", wireType)
 			}
@@ -46161,7 +50430,7 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 9:
+		case 17:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppInstallationId", wireType)
 			}
@@ -46180,7 +50449,7 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 10:
+		case 18:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GitHubAppEnterpriseBaseURL", wireType)
 			}
@@ -46212,29 +50481,9 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			}
 			m.GitHubAppEnterpriseBaseURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableOCI", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableOCI = bool(v != 0)
-		case 12:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46262,11 +50511,11 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Proxy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCPServiceAccountKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46294,11 +50543,11 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GCPServiceAccountKey = string(dAtA[iNdEx:postIndex])
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCPServiceAccountKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46326,9 +50575,9 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proxy = string(dAtA[iNdEx:postIndex])
+			m.GCPServiceAccountKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 22:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ForceHttpBasicAuth", wireType)
 			}
@@ -46473,7 +50722,7 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
+func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46496,17 +50745,17 @@ func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoCredsList: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepositoryCertificate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoCredsList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepositoryCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46516,30 +50765,29 @@ func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46549,79 +50797,27 @@ func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, RepoCreds{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CertType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Repository) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Repository: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Repository: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertSubType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46649,13 +50845,13 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.CertSubType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertData", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46665,27 +50861,29 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.CertData = append(m.CertData[:0], dAtA[iNdEx:postIndex]...)
+			if m.CertData == nil {
+				m.CertData = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertInfo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46713,13 +50911,63 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.CertInfo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepositoryCertificateList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepositoryCertificateList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46729,27 +50977,28 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46776,75 +51025,66 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, RepositoryCertificate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.InsecureIgnoreHostKey = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepositoryList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			m.Insecure = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableLFS", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.EnableLFS = bool(v != 0)
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepositoryList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepositoryList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46854,29 +51094,30 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46886,27 +51127,79 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+			m.Items = append(m.Items, &Repository{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46934,13 +51227,13 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46950,47 +51243,29 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InheritedCreds", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Params = append(m.Params, ResourceActionParam{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.InheritedCreds = bool(v != 0)
-		case 14:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableOCI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -47007,10 +51282,10 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.EnableOCI = bool(v != 0)
-		case 15:
+			m.Disabled = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppPrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IconClass", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47038,49 +51313,11 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GithubAppPrivateKey = string(dAtA[iNdEx:postIndex])
+			m.IconClass = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppId", wireType)
-			}
-			m.GithubAppId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GithubAppId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubAppInstallationId", wireType)
-			}
-			m.GithubAppInstallationId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GithubAppInstallationId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 18:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitHubAppEnterpriseBaseURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47108,11 +51345,61 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitHubAppEnterpriseBaseURL = string(dAtA[iNdEx:postIndex])
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActionDefinition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActionDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47140,11 +51427,11 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proxy = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionLua", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47172,11 +51459,61 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.ActionLua = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActionParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActionParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCPServiceAccountKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47204,31 +51541,61 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GCPServiceAccountKey = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceHttpBasicAuth", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ForceHttpBasicAuth = bool(v != 0)
-		case 23:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoProxy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionDiscoveryLua", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47256,33 +51623,13 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoProxy = string(dAtA[iNdEx:postIndex])
+			m.ActionDiscoveryLua = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 24:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseAzureWorkloadIdentity", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UseAzureWorkloadIdentity = bool(v != 0)
-		case 25:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47292,27 +51639,29 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BearerToken = string(dAtA[iNdEx:postIndex])
+			m.Definitions = append(m.Definitions, ResourceActionDefinition{})
+			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 26:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureOCIForceHttp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeBuiltinActions", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -47329,7 +51678,7 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.InsecureOCIForceHttp = bool(v != 0)
+			m.MergeBuiltinActions = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -47351,7 +51700,7 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
+func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47374,15 +51723,15 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryCertificate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47410,11 +51759,11 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerName = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47442,11 +51791,11 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertType = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertSubType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47474,13 +51823,13 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertSubType = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47490,29 +51839,27 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertData = append(m.CertData[:0], dAtA[iNdEx:postIndex]...)
-			if m.CertData == nil {
-				m.CertData = []byte{}
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47540,63 +51887,13 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertInfo = string(dAtA[iNdEx:postIndex])
+			m.TargetState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryCertificateList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryCertificateList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47606,30 +51903,29 @@ func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LiveState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47639,81 +51935,81 @@ func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, RepositoryCertificate{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Diff = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hook = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedLiveState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RepositoryList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.NormalizedLiveState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PredictedLiveState", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47723,30 +52019,29 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PredictedLiveState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47756,26 +52051,44 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &Repository{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Modified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -47797,7 +52110,7 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceAction) Unmarshal(dAtA []byte) error {
+func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47820,15 +52133,15 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47856,13 +52169,13 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47872,31 +52185,29 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Params = append(m.Params, ResourceActionParam{})
-			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -47906,15 +52217,27 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Disabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IconClass", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47942,11 +52265,11 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IconClass = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47974,61 +52297,11 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionDefinition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48056,11 +52329,11 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedFieldsManagers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48088,7 +52361,7 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionLua = string(dAtA[iNdEx:postIndex])
+			m.ManagedFieldsManagers = append(m.ManagedFieldsManagers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -48111,7 +52384,7 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
+func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48134,17 +52407,17 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceNetworkingInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceNetworkingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetLabels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48154,79 +52427,158 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.TargetLabels == nil {
+				m.TargetLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TargetLabels[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRefs", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceActions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TargetRefs = append(m.TargetRefs, ResourceRef{})
+			if err := m.TargetRefs[len(m.TargetRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionDiscoveryLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48236,27 +52588,122 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionDiscoveryLua = string(dAtA[iNdEx:postIndex])
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48283,16 +52730,16 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Definitions = append(m.Definitions, ResourceActionDefinition{})
-			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ingress = append(m.Ingress, v12.LoadBalancerIngress{})
+			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeBuiltinActions", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48302,12 +52749,24 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MergeBuiltinActions = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -48329,7 +52788,7 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
+func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48352,17 +52811,17 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceDiff: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48372,29 +52831,30 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentRefs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48404,29 +52864,31 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.ParentRefs = append(m.ParentRefs, ResourceRef{})
+			if err := m.ParentRefs[len(m.ParentRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48436,29 +52898,31 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Info = append(m.Info, InfoItem{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkingInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48468,27 +52932,31 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.NetworkingInfo == nil {
+				m.NetworkingInfo = &ResourceNetworkingInfo{}
+			}
+			if err := m.NetworkingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48516,11 +52984,11 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetState = string(dAtA[iNdEx:postIndex])
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48548,13 +53016,13 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiveState = string(dAtA[iNdEx:postIndex])
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48564,81 +53032,33 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Diff = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Hook = bool(v != 0)
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedLiveState", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Health == nil {
+				m.Health = &HealthStatus{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NormalizedLiveState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredictedLiveState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48648,76 +53068,28 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PredictedLiveState = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.CreatedAt == nil {
+				m.CreatedAt = &v1.Time{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Modified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -48739,7 +53111,7 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
+func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48762,15 +53134,15 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceIgnoreDifferences: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthLua", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48798,13 +53170,13 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.HealthLua = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48814,27 +53186,28 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if err := m.IgnoreDifferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48862,13 +53235,13 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Actions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KnownTypeFields", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48878,29 +53251,31 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.KnownTypeFields = append(m.KnownTypeFields, KnownTypeField{})
+			if err := m.KnownTypeFields[len(m.KnownTypeFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseOpenLibs", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48910,29 +53285,17 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.UseOpenLibs = bool(v != 0)
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreResourceUpdates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48942,55 +53305,24 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedFieldsManagers", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.IgnoreResourceUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ManagedFieldsManagers = append(m.ManagedFieldsManagers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -49013,7 +53345,7 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
+func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49036,17 +53368,17 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceNetworkingInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceNetworkingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetLabels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49056,124 +53388,61 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TargetLabels == nil {
-				m.TargetLabels = make(map[string]string)
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.TargetLabels[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRefs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49183,31 +53452,29 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetRefs = append(m.TargetRefs, ResourceRef{})
-			if err := m.TargetRefs[len(m.TargetRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49217,124 +53484,29 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Labels[mapkey] = mapvalue
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49344,29 +53516,27 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ingress = append(m.Ingress, v12.LoadBalancerIngress{})
-			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49394,7 +53564,7 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
+			m.UID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -49417,7 +53587,7 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceNode) Unmarshal(dAtA []byte) error {
+func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49440,17 +53610,17 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49460,30 +53630,29 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentRefs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49493,31 +53662,29 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentRefs = append(m.ParentRefs, ResourceRef{})
-			if err := m.ParentRefs[len(m.ParentRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49527,31 +53694,29 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, InfoItem{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkingInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49561,31 +53726,27 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NetworkingInfo == nil {
-				m.NetworkingInfo = &ResourceNetworkingInfo{}
-			}
-			if err := m.NetworkingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49613,11 +53774,11 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49645,13 +53806,13 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			m.Status = github_com_argoproj_gitops_engine_pkg_sync_common.ResultCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49661,33 +53822,61 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Health == nil {
-				m.Health = &HealthStatus{}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
 			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.HookType = github_com_argoproj_gitops_engine_pkg_sync_common.HookType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HookPhase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49697,27 +53886,87 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &v1.Time{}
+			m.HookPhase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhase", wireType)
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncPhase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -49740,7 +53989,7 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
+func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49763,15 +54012,15 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceOverride: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49799,13 +54048,13 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HealthLua = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49815,28 +54064,27 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IgnoreDifferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49864,13 +54112,13 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actions = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KnownTypeFields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49880,51 +54128,29 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KnownTypeFields = append(m.KnownTypeFields, KnownTypeField{})
-			if err := m.KnownTypeFields[len(m.KnownTypeFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseOpenLibs", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UseOpenLibs = bool(v != 0)
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreResourceUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49934,78 +54160,27 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IgnoreResourceUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceRef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceRef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceRef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50033,13 +54208,13 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50049,29 +54224,33 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if m.Health == nil {
+				m.Health = &HealthStatus{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50081,29 +54260,17 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			m.Hook = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiresPruning", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50113,29 +54280,17 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			m.RequiresPruning = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWave", wireType)
 			}
-			var stringLen uint64
+			m.SyncWave = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50145,29 +54300,16 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SyncWave |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiresDeletionConfirmation", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50177,24 +54319,12 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.RequiresDeletionConfirmation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -50216,7 +54346,7 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceResult) Unmarshal(dAtA []byte) error {
+func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -50239,17 +54369,17 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50259,29 +54389,16 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Limit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Group = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50291,29 +54408,33 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refresh", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50323,27 +54444,65 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Refresh = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50371,13 +54530,13 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50387,29 +54546,49 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.DeployedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50419,29 +54598,30 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = github_com_argoproj_gitops_engine_pkg_sync_common.ResultCode(dAtA[iNdEx:postIndex])
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployStartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50451,29 +54631,33 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.DeployStartedAt == nil {
+				m.DeployStartedAt = &v1.Time{}
+			}
+			if err := m.DeployStartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50483,27 +54667,29 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HookType = github_com_argoproj_gitops_engine_pkg_sync_common.HookType(dAtA[iNdEx:postIndex])
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookPhase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50531,13 +54717,13 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HookPhase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50547,29 +54733,50 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncPhase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
+			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Healthy = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revert", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50579,23 +54786,27 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			if m.Revert == nil {
+				m.Revert = &SyncRevert{}
+			}
+			if err := m.Revert.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -50618,7 +54829,7 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
+func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -50641,15 +54852,15 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevisionMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevisionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50677,13 +54888,13 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Author = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50693,27 +54904,31 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if m.Date == nil {
+				m.Date = &v1.Time{}
+			}
+			if err := m.Date.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50741,11 +54956,11 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50773,153 +54988,13 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Health == nil {
-				m.Health = &HealthStatus{}
-			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Hook = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiresPruning", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RequiresPruning = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncWave", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureInfo", wireType)
 			}
-			m.SyncWave = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50929,16 +55004,29 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SyncWave |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiresDeletionConfirmation", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureInfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50948,12 +55036,26 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequiresDeletionConfirmation = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.References = append(m.References, RevisionReference{})
+			if err := m.References[len(m.References)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -50975,7 +55077,7 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
+func (m *RevisionReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -50998,34 +55100,15 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevisionReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevisionReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51052,33 +55135,13 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Backoff == nil {
-				m.Backoff = &Backoff{}
+			if m.Commit == nil {
+				m.Commit = &CommitMetadata{}
 			}
-			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Refresh", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Refresh = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -51100,7 +55163,7 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -51123,17 +55186,53 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevisionHistory: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevisionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Github", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Github == nil {
+				m.Github = &SCMProviderGeneratorGithub{}
+			}
+			if err := m.Github.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gitlab", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51143,27 +55242,31 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			if m.Gitlab == nil {
+				m.Gitlab = &SCMProviderGeneratorGitlab{}
+			}
+			if err := m.Gitlab.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitbucket", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51190,15 +55293,18 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DeployedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Bitbucket == nil {
+				m.Bitbucket = &SCMProviderGeneratorBitbucket{}
+			}
+			if err := m.Bitbucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BitbucketServer", wireType)
 			}
-			m.ID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51208,14 +55314,31 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BitbucketServer == nil {
+				m.BitbucketServer = &SCMProviderGeneratorBitbucketServer{}
+			}
+			if err := m.BitbucketServer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gitea", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51242,13 +55365,16 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Gitea == nil {
+				m.Gitea = &SCMProviderGeneratorGitea{}
+			}
+			if err := m.Gitea.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployStartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureDevOps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51275,16 +55401,16 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeployStartedAt == nil {
-				m.DeployStartedAt = &v1.Time{}
+			if m.AzureDevOps == nil {
+				m.AzureDevOps = &SCMProviderGeneratorAzureDevOps{}
 			}
-			if err := m.DeployStartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AzureDevOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51311,14 +55437,14 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Filters = append(m.Filters, SCMProviderGeneratorFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloneProtocol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -51346,11 +55472,31 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			m.CloneProtocol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequeueAfterSeconds = &v
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51377,7 +55523,170 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Values == nil {
+				m.Values = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Values[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSCodeCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AWSCodeCommit == nil {
+				m.AWSCodeCommit = &SCMProviderGeneratorAWSCodeCommit{}
+			}
+			if err := m.AWSCodeCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -51402,7 +55711,7 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -51425,15 +55734,49 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevisionMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorAWSCodeCommit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevisionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorAWSCodeCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TagFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagFilters = append(m.TagFilters, &TagFilter{})
+			if err := m.TagFilters[len(m.TagFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -51461,13 +55804,13 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Author = string(dAtA[iNdEx:postIndex])
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51477,31 +55820,97 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllBranches = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Date == nil {
-				m.Date = &v1.Time{}
-			}
-			if err := m.Date.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SCMProviderGeneratorAzureDevOps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SCMProviderGeneratorAzureDevOps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -51529,11 +55938,11 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -51561,11 +55970,11 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignatureInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamProject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -51593,11 +56002,11 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignatureInfo = string(dAtA[iNdEx:postIndex])
+			m.TeamProject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessTokenRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51624,11 +56033,33 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.References = append(m.References, RevisionReference{})
-			if err := m.References[len(m.References)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AccessTokenRef == nil {
+				m.AccessTokenRef = &SecretRef{}
+			}
+			if err := m.AccessTokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllBranches = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -51650,7 +56081,7 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RevisionReference) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -51673,15 +56104,79 @@ func (m *RevisionReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevisionReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevisionReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppPasswordRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51708,13 +56203,33 @@ func (m *RevisionReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &CommitMetadata{}
+			if m.AppPasswordRef == nil {
+				m.AppPasswordRef = &SecretRef{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AppPasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllBranches = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -51736,7 +56251,7 @@ func (m *RevisionReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -51759,17 +56274,17 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Github", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51779,33 +56294,29 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Github == nil {
-				m.Github = &SCMProviderGeneratorGithub{}
-			}
-			if err := m.Github.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gitlab", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51815,31 +56326,27 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gitlab == nil {
-				m.Gitlab = &SCMProviderGeneratorGitlab{}
-			}
-			if err := m.Gitlab.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bitbucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51866,18 +56373,18 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Bitbucket == nil {
-				m.Bitbucket = &SCMProviderGeneratorBitbucket{}
+			if m.BasicAuth == nil {
+				m.BasicAuth = &BasicAuthBitbucketServer{}
 			}
-			if err := m.Bitbucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BitbucketServer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51887,31 +56394,15 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BitbucketServer == nil {
-				m.BitbucketServer = &SCMProviderGeneratorBitbucketServer{}
-			}
-			if err := m.BitbucketServer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.AllBranches = bool(v != 0)
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gitea", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51938,18 +56429,18 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gitea == nil {
-				m.Gitea = &SCMProviderGeneratorGitea{}
+			if m.BearerToken == nil {
+				m.BearerToken = &BearerTokenBitbucket{}
 			}
-			if err := m.Gitea.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AzureDevOps", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51959,31 +56450,15 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AzureDevOps == nil {
-				m.AzureDevOps = &SCMProviderGeneratorAzureDevOps{}
-			}
-			if err := m.AzureDevOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Insecure = bool(v != 0)
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CARef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -52010,14 +56485,66 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filters = append(m.Filters, SCMProviderGeneratorFilter{})
-			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CARef == nil {
+				m.CARef = &ConfigMapKeyRef{}
+			}
+			if err := m.CARef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SCMProviderGeneratorFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SCMProviderGeneratorFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CloneProtocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryMatch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -52045,13 +56572,14 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CloneProtocol = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.RepositoryMatch = &s
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathsExist", wireType)
 			}
-			var v int64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52061,17 +56589,29 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequeueAfterSeconds = &v
-		case 10:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathsExist = append(m.PathsExist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathsDoNotExist", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52081,30 +56621,29 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PathsDoNotExist = append(m.PathsDoNotExist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelMatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52114,124 +56653,30 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Values == nil {
-				m.Values = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Values[mapkey] = mapvalue
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.LabelMatch = &s
 			iNdEx = postIndex
-		case 12:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AWSCodeCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchMatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52241,27 +56686,24 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AWSCodeCommit == nil {
-				m.AWSCodeCommit = &SCMProviderGeneratorAWSCodeCommit{}
-			}
-			if err := m.AWSCodeCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.BranchMatch = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -52284,7 +56726,7 @@ func (m *SCMProviderGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -52307,17 +56749,17 @@ func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorAWSCodeCommit: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorGitea: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorAWSCodeCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorGitea: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TagFilters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52327,29 +56769,27 @@ func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TagFilters = append(m.TagFilters, &TagFilter{})
-			if err := m.TagFilters[len(m.TagFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -52377,13 +56817,13 @@ func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52393,23 +56833,27 @@ func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
@@ -52431,6 +56875,26 @@ func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllBranches = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -52452,7 +56916,7 @@ func (m *SCMProviderGeneratorAWSCodeCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -52475,13 +56939,13 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorAzureDevOps: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorGithub: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorAzureDevOps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorGithub: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 5:
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
 			}
@@ -52513,7 +56977,7 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 			}
 			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
@@ -52545,11 +57009,11 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 			}
 			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TeamProject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52559,29 +57023,33 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TeamProject = string(dAtA[iNdEx:postIndex])
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessTokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppSecretName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52591,29 +57059,25 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessTokenRef == nil {
-				m.AccessTokenRef = &SecretRef{}
-			}
-			if err := m.AccessTokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AppSecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
@@ -52654,7 +57118,7 @@ func (m *SCMProviderGeneratorAzureDevOps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
+func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -52677,15 +57141,15 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -52713,11 +57177,31 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSubgroups", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeSubgroups = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -52745,11 +57229,11 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppPasswordRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -52776,14 +57260,14 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AppPasswordRef == nil {
-				m.AppPasswordRef = &SecretRef{}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
 			}
-			if err := m.AppPasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
 			}
@@ -52803,6 +57287,115 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllBranches = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSharedProjects", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.IncludeSharedProjects = &b
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CARef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CARef == nil {
+				m.CARef = &ConfigMapKeyRef{}
+			}
+			if err := m.CARef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -52824,7 +57417,7 @@ func (m *SCMProviderGeneratorBitbucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
+func (m *ScheduledOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -52847,17 +57440,17 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52867,29 +57460,30 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field At", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52899,29 +57493,80 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
+			if err := m.At.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52931,53 +57576,29 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BasicAuth == nil {
-				m.BasicAuth = &BasicAuthBitbucketServer{}
-			}
-			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllBranches = bool(v != 0)
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -52987,53 +57608,79 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BearerToken == nil {
-				m.BearerToken = &BearerTokenBitbucket{}
-			}
-			if err := m.BearerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Insecure = bool(v != 0)
-		case 7:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignatureKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignatureKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignatureKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CARef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53043,27 +57690,23 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CARef == nil {
-				m.CARef = &ConfigMapKeyRef{}
-			}
-			if err := m.CARef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KeyID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -53086,7 +57729,7 @@ func (m *SCMProviderGeneratorBitbucketServer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
+func (m *SourceHydrator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53109,17 +57752,17 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: SourceHydrator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SourceHydrator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySource", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53129,30 +57772,30 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.RepositoryMatch = &s
+			if err := m.DrySource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathsExist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncSource", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53162,29 +57805,30 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathsExist = append(m.PathsExist, string(dAtA[iNdEx:postIndex]))
+			if err := m.SyncSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathsDoNotExist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HydrateTo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53194,29 +57838,33 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathsDoNotExist = append(m.PathsDoNotExist, string(dAtA[iNdEx:postIndex]))
+			if m.HydrateTo == nil {
+				m.HydrateTo = &HydrateTo{}
+			}
+			if err := m.HydrateTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalDrySources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53226,30 +57874,31 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.LabelMatch = &s
+			m.AdditionalDrySources = append(m.AdditionalDrySources, ApplicationSource{})
+			if err := m.AdditionalDrySources[len(m.AdditionalDrySources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchMatch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireApproval", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53259,25 +57908,12 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.BranchMatch = &s
-			iNdEx = postIndex
+			m.RequireApproval = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -53299,7 +57935,7 @@ func (m *SCMProviderGeneratorFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
+func (m *SourceHydratorStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53322,17 +57958,17 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitea: wiretype end group for non-group")
+			return fmt.Errorf("proto: SourceHydratorStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitea: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SourceHydratorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSuccessfulOperation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53342,29 +57978,33 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if m.LastSuccessfulOperation == nil {
+				m.LastSuccessfulOperation = &SuccessfulHydrateOperation{}
+			}
+			if err := m.LastSuccessfulOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOperation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53374,27 +58014,31 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
+			if m.CurrentOperation == nil {
+				m.CurrentOperation = &HydrateOperation{}
+			}
+			if err := m.CurrentOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -53421,53 +58065,13 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
+			if m.PullRequest == nil {
+				m.PullRequest = &HydratedPullRequest{}
 			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllBranches = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Insecure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -53489,7 +58093,7 @@ func (m *SCMProviderGeneratorGitea) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
+func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53512,15 +58116,15 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGithub: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuccessfulHydrateOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGithub: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuccessfulHydrateOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySHA", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53548,11 +58152,11 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Organization = string(dAtA[iNdEx:postIndex])
+			m.DrySHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53580,11 +58184,11 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.API = string(dAtA[iNdEx:postIndex])
+			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -53611,16 +58215,13 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppSecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalDrySHAs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53648,28 +58249,8 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppSecretName = string(dAtA[iNdEx:postIndex])
+			m.AdditionalDrySHAs = append(m.AdditionalDrySHAs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllBranches = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -53691,7 +58272,7 @@ func (m *SCMProviderGeneratorGithub) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
+func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -53714,15 +58295,15 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCMProviderGeneratorGitlab: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53750,11 +58331,11 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSubgroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -53771,12 +58352,12 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IncludeSubgroups = bool(v != 0)
+			m.Prune = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53786,27 +58367,15 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.API = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.DryRun = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -53833,18 +58402,18 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
+			if m.SyncStrategy == nil {
+				m.SyncStrategy = &SyncStrategy{}
 			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllBranches", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53854,37 +58423,31 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllBranches = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Insecure = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, SyncOperationResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSharedProjects", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53894,16 +58457,31 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.IncludeSharedProjects = &b
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &ApplicationSource{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -53931,13 +58509,13 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CARef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53947,83 +58525,29 @@ func (m *SCMProviderGeneratorGitlab) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CARef == nil {
-				m.CARef = &ConfigMapKeyRef{}
-			}
-			if err := m.CARef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SecretRef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SecretRef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretRef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54033,27 +58557,29 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecretName = string(dAtA[iNdEx:postIndex])
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -54081,63 +58607,32 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SignatureKey) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfHealAttemptsCount", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SignatureKey: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignatureKey: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.SelfHealAttemptsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfHealAttemptsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revert", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54147,23 +58642,27 @@ func (m *SignatureKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = string(dAtA[iNdEx:postIndex])
+			if m.Revert == nil {
+				m.Revert = &SyncRevert{}
+			}
+			if err := m.Revert.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -54186,7 +58685,7 @@ func (m *SignatureKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SourceHydrator) Unmarshal(dAtA []byte) error {
+func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -54209,17 +58708,17 @@ func (m *SourceHydrator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SourceHydrator: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SourceHydrator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrySource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54229,30 +58728,29 @@ func (m *SourceHydrator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DrySource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54262,30 +58760,29 @@ func (m *SourceHydrator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SyncSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HydrateTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54295,27 +58792,55 @@ func (m *SourceHydrator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HydrateTo == nil {
-				m.HydrateTo = &HydrateTo{}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			if err := m.HydrateTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -54338,7 +58863,7 @@ func (m *SourceHydrator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SourceHydratorStatus) Unmarshal(dAtA []byte) error {
+func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -54361,15 +58886,15 @@ func (m *SourceHydratorStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SourceHydratorStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SourceHydratorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSuccessfulOperation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -54396,16 +58921,46 @@ func (m *SourceHydratorStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastSuccessfulOperation == nil {
-				m.LastSuccessfulOperation = &SuccessfulHydrateOperation{}
-			}
-			if err := m.LastSuccessfulOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Resources = append(m.Resources, &ResourceResult{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOperation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -54432,66 +58987,47 @@ func (m *SourceHydratorStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentOperation == nil {
-				m.CurrentOperation = &HydrateOperation{}
-			}
-			if err := m.CurrentOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SuccessfulHydrateOperation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuccessfulHydrateOperation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrySHA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -54519,13 +59055,13 @@ func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DrySHA = string(dAtA[iNdEx:postIndex])
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54535,27 +59071,31 @@ func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
+			if m.ManagedNamespaceMetadata == nil {
+				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			}
+			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WaveProgress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -54582,7 +59122,10 @@ func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.WaveProgress == nil {
+				m.WaveProgress = &SyncWaveProgress{}
+			}
+			if err := m.WaveProgress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -54607,7 +59150,7 @@ func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperation) Unmarshal(dAtA []byte) error {
+func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -54630,17 +59173,17 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54650,29 +59193,33 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			if m.Automated == nil {
+				m.Automated = &SyncPolicyAutomated{}
+			}
+			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54682,35 +59229,27 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Prune = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.DryRun = bool(v != 0)
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -54737,16 +59276,16 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncStrategy == nil {
-				m.SyncStrategy = &SyncStrategy{}
+			if m.Retry == nil {
+				m.Retry = &RetryStrategy{}
 			}
-			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -54773,14 +59312,16 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, SyncOperationResource{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ManagedNamespaceMetadata == nil {
+				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			}
+			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waves", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -54807,18 +59348,68 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Source == nil {
-				m.Source = &ApplicationSource{}
+			if m.Waves == nil {
+				m.Waves = &SyncWavesStrategy{}
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Waves.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncPolicyAutomated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncPolicyAutomated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54828,29 +59419,17 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+			m.Prune = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfHeal", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54860,29 +59439,17 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			m.SelfHeal = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowEmpty", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54892,31 +59459,17 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			m.AllowEmpty = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54926,29 +59479,18 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelfHealAttemptsCount", wireType)
+			b := bool(v != 0)
+			m.Enabled = &b
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
 			}
-			m.SelfHealAttemptsCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54958,11 +59500,28 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SelfHealAttemptsCount |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rollback == nil {
+				m.Rollback = &AutomatedRollback{}
+			}
+			if err := m.Rollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -54984,7 +59543,7 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
+func (m *SyncRevert) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -55007,15 +59566,15 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncRevert: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncRevert: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55043,11 +59602,11 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55075,11 +59634,11 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55107,13 +59666,13 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55123,24 +59682,44 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -55162,7 +59741,7 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
+func (m *SyncSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -55185,17 +59764,17 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55205,29 +59784,27 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, &ResourceResult{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TargetBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55255,78 +59832,11 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55354,11 +59864,11 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -55385,10 +59895,10 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ManagedNamespaceMetadata == nil {
-				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			if m.Layout == nil {
+				m.Layout = &HydratedManifestLayout{}
 			}
-			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Layout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -55413,7 +59923,7 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
+func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -55436,17 +59946,17 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55456,33 +59966,29 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Automated == nil {
-				m.Automated = &SyncPolicyAutomated{}
-			}
-			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ComparedTo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55492,29 +59998,30 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			if err := m.ComparedTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55524,33 +60031,29 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Retry == nil {
-				m.Retry = &RetryStrategy{}
-			}
-			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55560,27 +60063,23 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ManagedNamespaceMetadata == nil {
-				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
-			}
-			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -55603,7 +60102,7 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
+func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -55626,17 +60125,17 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncPolicyAutomated: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncPolicyAutomated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apply", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55646,17 +60145,33 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Prune = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Apply == nil {
+				m.Apply = &SyncStrategyApply{}
+			}
+			if err := m.Apply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelfHeal", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55666,53 +60181,28 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SelfHeal = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowEmpty", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.AllowEmpty = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Hook == nil {
+				m.Hook = &SyncStrategyHook{}
 			}
-			b := bool(v != 0)
-			m.Enabled = &b
+			if err := m.Hook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -55734,7 +60224,7 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncSource) Unmarshal(dAtA []byte) error {
+func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -55757,17 +60247,17 @@ func (m *SyncSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncStrategyApply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncStrategyApply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55777,29 +60267,67 @@ func (m *SyncSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetBranch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncStrategyHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncStrategyHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategyApply", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55809,23 +60337,24 @@ func (m *SyncSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			if err := m.SyncStrategyApply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -55848,7 +60377,7 @@ func (m *SyncSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncStatus) Unmarshal(dAtA []byte) error {
+func (m *SyncWaveProgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -55871,15 +60400,15 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncWaveProgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncWaveProgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55907,13 +60436,13 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
+			m.Phase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComparedTo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wave", wireType)
 			}
-			var msglen int
+			m.Wave = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55923,30 +60452,16 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Wave |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ComparedTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Final", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55956,29 +60471,17 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Final = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55988,77 +60491,28 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.AppliedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Apply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -56085,16 +60539,16 @@ func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Apply == nil {
-				m.Apply = &SyncStrategyApply{}
+			if m.CompletedAt == nil {
+				m.CompletedAt = &v1.Time{}
 			}
-			if err := m.Apply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CompletedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextWaveAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -56121,10 +60575,10 @@ func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hook == nil {
-				m.Hook = &SyncStrategyHook{}
+			if m.NextWaveAt == nil {
+				m.NextWaveAt = &v1.Time{}
 			}
-			if err := m.Hook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NextWaveAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -56149,7 +60603,7 @@ func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
+func (m *SyncWavesStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -56172,17 +60626,17 @@ func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStrategyApply: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncWavesStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStrategyApply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncWavesStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxParallelism", wireType)
 			}
-			var v int
+			m.MaxParallelism = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -56192,67 +60646,16 @@ func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxParallelism |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStrategyHook: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStrategyHook: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategyApply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -56262,24 +60665,23 @@ func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SyncStrategyApply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Delay = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -56627,6 +61029,228 @@ func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Start == nil {
+				m.Start = &v1.Time{}
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.End == nil {
+				m.End = &v1.Time{}
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calendar", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Calendar == nil {
+				m.Calendar = &SyncWindowCalendar{}
+			}
+			if err := m.Calendar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWindowCalendar) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindowCalendar: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindowCalendar: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dates = append(m.Dates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -66,6 +66,8 @@ type Application struct {
 	Spec              ApplicationSpec   `json:"spec" protobuf:"bytes,2,opt,name=spec"`
 	Status            ApplicationStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 	Operation         *Operation        `json:"operation,omitempty" protobuf:"bytes,4,opt,name=operation"`
+	// ScheduledOperation is an operation which the controller starts once it is due
+	ScheduledOperation *ScheduledOperation `json:"scheduledOperation,omitempty" protobuf:"bytes,5,opt,name=scheduledOperation"`
 }
 
 // ApplicationSpec represents desired application state. Contains link to repository with application definition and additional parameters link definition revision.
@@ -95,6 +97,20 @@ type ApplicationSpec struct {
 
 	// SourceHydrator provides a way to push hydrated manifests back to git before syncing them to the cluster.
 	SourceHydrator *SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,9,opt,name=sourceHydrator"`
+
+	// DependsOn lists other Applications which must be Synced and Healthy before this application is synced, either
+	// automatically or manually.
+	DependsOn []ApplicationDependency `json:"dependsOn,omitempty" protobuf:"bytes,10,rep,name=dependsOn"`
+}
+
+// ApplicationDependency identifies the Applications an application depends on, either by name or by label selector.
+type ApplicationDependency struct {
+	// Name is the name of the Application depended on. Mutually exclusive with Selector.
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Namespace is the namespace of the Applications depended on. Defaults to the namespace of the application.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// Selector selects the Applications depended on by their labels. Mutually exclusive with Name.
+	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,3,opt,name=selector"`
 }
 
 type IgnoreDifferences []ResourceIgnoreDifferences
@@ -246,14 +262,13 @@ func (spec *ApplicationSpec) GetSource() ApplicationSource {
 // GetHydrateToSource returns the hydrateTo source if it exists, otherwise returns the sync source.
 func (spec *ApplicationSpec) GetHydrateToSource() ApplicationSource {
 	if spec.SourceHydrator != nil {
-		targetRevision := spec.SourceHydrator.SyncSource.TargetBranch
-		if spec.SourceHydrator.HydrateTo != nil {
-			targetRevision = spec.SourceHydrator.HydrateTo.TargetBranch
+		if spec.SourceHydrator.HydrateTo == nil {
+			return spec.SourceHydrator.GetSyncSource()
 		}
 		return ApplicationSource{
 			RepoURL:        spec.SourceHydrator.DrySource.RepoURL,
 			Path:           spec.SourceHydrator.SyncSource.Path,
-			TargetRevision: targetRevision,
+			TargetRevision: spec.SourceHydrator.HydrateTo.TargetBranch,
 		}
 	}
 	return ApplicationSource{}
@@ -404,16 +419,39 @@ type SourceHydrator struct {
 	// HydrateTo specifies an optional "staging" location to push hydrated manifests to. An external system would then
 	// have to move manifests to the SyncSource, e.g. by pull request.
 	HydrateTo *HydrateTo `json:"hydrateTo,omitempty" protobuf:"bytes,3,opt,name=hydrateTo"`
+	// AdditionalDrySources specifies further dry sources which are hydrated together with the DrySource, e.g. a `ref`
+	// source providing Helm value files from another repository. The manifests rendered from all dry sources are
+	// written to the same hydrated path.
+	AdditionalDrySources ApplicationSources `json:"additionalDrySources,omitempty" protobuf:"bytes,4,rep,name=additionalDrySources"`
+	// RequireApproval specifies whether hydration waits for an explicit approval before the hydrated manifests are
+	// committed. Approval is also required if the project's RequireHydrationApproval is set.
+	RequireApproval bool `json:"requireApproval,omitempty" protobuf:"varint,5,opt,name=requireApproval"`
 }
 
 // GetSyncSource gets the source from which we should sync when a source hydrator is configured.
 func (s SourceHydrator) GetSyncSource() ApplicationSource {
-	return ApplicationSource{
-		// Pull the RepoURL from the dry source. The SyncSource's RepoURL is assumed to be the same.
-		RepoURL:        s.DrySource.RepoURL,
-		Path:           s.SyncSource.Path,
-		TargetRevision: s.SyncSource.TargetBranch,
+	var source ApplicationSource
+	if s.SyncSource.IsOCI() {
+		// Each path is pushed as a separate artifact, which contains the hydrated manifests at its root.
+		source = ApplicationSource{
+			RepoURL:        s.SyncSource.OCIRepoURL(),
+			Path:           ".",
+			TargetRevision: s.SyncSource.TargetBranch,
+		}
+	} else {
+		source = ApplicationSource{
+			// Pull the RepoURL from the dry source. The SyncSource's RepoURL is assumed to be the same.
+			RepoURL:        s.DrySource.RepoURL,
+			Path:           s.SyncSource.Path,
+			TargetRevision: s.SyncSource.TargetBranch,
+		}
+	}
+	layout := s.SyncSource.Layout
+	if layout.GetType() == HydratedManifestLayoutPerKind && !layout.Kustomization {
+		// The manifests are written to a directory per kind, which are only read if the directory is recursed.
+		source.Directory = &ApplicationSourceDirectory{Recurse: true}
 	}
+	return source
 }
 
 // GetDrySource gets the dry source when a source hydrator is configured.
@@ -422,12 +460,27 @@ func (s SourceHydrator) GetDrySource() ApplicationSource {
 		RepoURL:        s.DrySource.RepoURL,
 		Path:           s.DrySource.Path,
 		TargetRevision: s.DrySource.TargetRevision,
+		Helm:           s.DrySource.Helm,
+		Kustomize:      s.DrySource.Kustomize,
+		Directory:      s.DrySource.Directory,
+		Plugin:         s.DrySource.Plugin,
 	}
 }
 
+// GetDrySources gets the dry source, followed by the additional dry sources, when a source hydrator is configured.
+func (s SourceHydrator) GetDrySources() ApplicationSources {
+	return append(ApplicationSources{s.GetDrySource()}, s.AdditionalDrySources...)
+}
+
+// HasMultipleDrySources returns true if the source hydrator has additional dry sources.
+func (s SourceHydrator) HasMultipleDrySources() bool {
+	return len(s.AdditionalDrySources) > 0
+}
+
 // DeepEquals returns true if the SourceHydrator is deeply equal to the given SourceHydrator.
 func (s SourceHydrator) DeepEquals(hydrator SourceHydrator) bool {
-	return s.DrySource == hydrator.DrySource && s.SyncSource == hydrator.SyncSource && s.HydrateTo.DeepEquals(hydrator.HydrateTo)
+	return s.DrySource.DeepEquals(hydrator.DrySource) && s.SyncSource.DeepEquals(hydrator.SyncSource) && s.HydrateTo.DeepEquals(hydrator.HydrateTo) &&
+		s.AdditionalDrySources.Equals(hydrator.AdditionalDrySources) && s.RequireApproval == hydrator.RequireApproval
 }
 
 // DrySource specifies a location for dry "don't repeat yourself" manifest source information.
@@ -438,12 +491,28 @@ type DrySource struct {
 	TargetRevision string `json:"targetRevision" protobuf:"bytes,2,name=targetRevision"`
 	// Path is a directory path within the Git repository where the manifests are located
 	Path string `json:"path" protobuf:"bytes,3,name=path"`
+	// Helm holds helm specific options
+	Helm *ApplicationSourceHelm `json:"helm,omitempty" protobuf:"bytes,4,opt,name=helm"`
+	// Kustomize holds kustomize specific options
+	Kustomize *ApplicationSourceKustomize `json:"kustomize,omitempty" protobuf:"bytes,5,opt,name=kustomize"`
+	// Directory holds path/directory specific options
+	Directory *ApplicationSourceDirectory `json:"directory,omitempty" protobuf:"bytes,6,opt,name=directory"`
+	// Plugin holds config management plugin specific options
+	Plugin *ApplicationSourcePlugin `json:"plugin,omitempty" protobuf:"bytes,7,opt,name=plugin"`
+}
+
+// DeepEquals returns true if the DrySource is deeply equal to the given DrySource.
+func (in DrySource) DeepEquals(to DrySource) bool {
+	source := SourceHydrator{DrySource: in}.GetDrySource()
+	toSource := SourceHydrator{DrySource: to}.GetDrySource()
+	return source.Equals(&toSource)
 }
 
-// SyncSource specifies a location from which hydrated manifests may be synced. RepoURL is assumed based on the
-// associated DrySource config in the SourceHydrator.
+// SyncSource specifies a location from which hydrated manifests may be synced. Unless an OCI RepoURL is set, the
+// repository is assumed based on the associated DrySource config in the SourceHydrator.
 type SyncSource struct {
-	// TargetBranch is the branch to which hydrated manifests should be committed
+	// TargetBranch is the branch to which hydrated manifests should be committed. If RepoURL is set, it is the tag with
+	// which the hydrated manifests are pushed.
 	TargetBranch string `json:"targetBranch" protobuf:"bytes,1,name=targetBranch"`
 	// Path is a directory path within the git repository where hydrated manifests should be committed to and synced
 	// from. The Path should never point to the root of the repo. If hydrateTo is set, this is just the path from which
@@ -453,6 +522,73 @@ type SyncSource struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:Pattern=`^.{2,}|[^./]$`
 	Path string `json:"path" protobuf:"bytes,2,name=path"`
+	// RepoURL is the URL of an OCI repository, e.g. oci://registry.example.com/hydrated, to which hydrated manifests
+	// should be pushed as OCI artifacts instead of being committed to the dry source's git repository. The manifests of
+	// the Path are pushed to a repository named after the Path below RepoURL.
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,3,opt,name=repoURL"`
+	// Layout specifies how the hydrated manifests are split into files within the Path. By default, all manifests are
+	// written to a single manifest.yaml file.
+	Layout *HydratedManifestLayout `json:"layout,omitempty" protobuf:"bytes,4,opt,name=layout"`
+}
+
+// DeepEquals returns true if the SyncSource is deeply equal to the given SyncSource.
+func (s SyncSource) DeepEquals(to SyncSource) bool {
+	return s.TargetBranch == to.TargetBranch && s.Path == to.Path && s.RepoURL == to.RepoURL && s.Layout.DeepEquals(to.Layout)
+}
+
+// IsOCI returns true if hydrated manifests are pushed to an OCI registry rather than committed to a git branch.
+func (s SyncSource) IsOCI() bool {
+	return s.RepoURL != ""
+}
+
+// OCIRepoURL returns the URL of the OCI repository to which the hydrated manifests of the sync source's Path are
+// pushed.
+func (s SyncSource) OCIRepoURL() string {
+	return HydratedOCIRepoURL(s.RepoURL, s.Path)
+}
+
+// HydratedOCIRepoURL returns the URL of the OCI repository below repoURL to which the hydrated manifests of path are
+// pushed.
+func HydratedOCIRepoURL(repoURL, path string) string {
+	return strings.TrimSuffix(repoURL, "/") + "/" + strings.Trim(filepath.ToSlash(filepath.Clean(path)), "/")
+}
+
+// HydratedManifestLayoutType is the way the hydrated manifests of a path are split into files.
+// +kubebuilder:validation:Enum=SingleFile;PerResource;PerKind
+type HydratedManifestLayoutType string
+
+const (
+	// HydratedManifestLayoutSingleFile writes all manifests to a single manifest.yaml file.
+	HydratedManifestLayoutSingleFile HydratedManifestLayoutType = "SingleFile"
+	// HydratedManifestLayoutPerResource writes each manifest to its own <kind>-<name>.yaml file.
+	HydratedManifestLayoutPerResource HydratedManifestLayoutType = "PerResource"
+	// HydratedManifestLayoutPerKind writes each manifest to a <name>.yaml file in a directory named after its kind.
+	HydratedManifestLayoutPerKind HydratedManifestLayoutType = "PerKind"
+)
+
+// HydratedManifestLayout specifies how the hydrated manifests are written to the sync source path. The hydrator.metadata
+// and README.md files are written regardless of the layout.
+type HydratedManifestLayout struct {
+	// Type is the way the manifests are split into files. Defaults to SingleFile.
+	Type HydratedManifestLayoutType `json:"type,omitempty" protobuf:"bytes,1,opt,name=type,casttype=HydratedManifestLayoutType"`
+	// Kustomization specifies whether a kustomization.yaml file listing the manifest files is generated.
+	Kustomization bool `json:"kustomization,omitempty" protobuf:"varint,2,opt,name=kustomization"`
+}
+
+// GetType returns the layout type, defaulting to SingleFile.
+func (in *HydratedManifestLayout) GetType() HydratedManifestLayoutType {
+	if in == nil || in.Type == "" {
+		return HydratedManifestLayoutSingleFile
+	}
+	return in.Type
+}
+
+// DeepEquals returns true if the HydratedManifestLayout is deeply equal to the given HydratedManifestLayout.
+func (in *HydratedManifestLayout) DeepEquals(to *HydratedManifestLayout) bool {
+	if in == nil || to == nil {
+		return in == to
+	}
+	return *in == *to
 }
 
 // HydrateTo specifies a location to which hydrated manifests should be pushed as a "staging area" before being moved to
@@ -460,6 +596,9 @@ type SyncSource struct {
 type HydrateTo struct {
 	// TargetBranch is the branch to which hydrated manifests should be committed
 	TargetBranch string `json:"targetBranch" protobuf:"bytes,1,name=targetBranch"`
+	// PullRequest configures the commit server to open, or update, a pull request from the hydrateTo branch into the
+	// syncSource branch after each hydration.
+	PullRequest *HydratePullRequest `json:"pullRequest,omitempty" protobuf:"bytes,2,opt,name=pullRequest"`
 }
 
 // DeepEquals returns true if the HydrateTo is deeply equal to the given HydrateTo.
@@ -471,7 +610,40 @@ func (in *HydrateTo) DeepEquals(to *HydrateTo) bool {
 		// We already know in is not nil.
 		return false
 	}
-	// Compare de-referenced structs.
+	return in.TargetBranch == to.TargetBranch && in.PullRequest.DeepEquals(to.PullRequest)
+}
+
+// HydratePullRequestProvider is the SCM provider used to open pull requests for hydrated manifests
+// +kubebuilder:validation:Enum=github;gitlab;gitea;bitbucketServer
+type HydratePullRequestProvider string
+
+const (
+	HydratePullRequestProviderGitHub          HydratePullRequestProvider = "github"
+	HydratePullRequestProviderGitLab          HydratePullRequestProvider = "gitlab"
+	HydratePullRequestProviderGitea           HydratePullRequestProvider = "gitea"
+	HydratePullRequestProviderBitbucketServer HydratePullRequestProvider = "bitbucketServer"
+)
+
+// HydratePullRequest specifies how to open a pull request from the hydrateTo branch into the syncSource branch. The
+// pull request is opened with the repository write credentials used to push the hydrated manifests.
+type HydratePullRequest struct {
+	// Provider is the SCM provider hosting the repository
+	Provider HydratePullRequestProvider `json:"provider" protobuf:"bytes,1,name=provider"`
+	// API is the base URL of the SCM provider's API. If empty, the public API is used for GitHub and GitLab. It is
+	// required for Gitea and Bitbucket Server.
+	API string `json:"api,omitempty" protobuf:"bytes,2,opt,name=api"`
+	// Insecure specifies whether to skip TLS verification when talking to the SCM provider's API
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,3,opt,name=insecure"`
+}
+
+// DeepEquals returns true if the HydratePullRequest is deeply equal to the given HydratePullRequest.
+func (in *HydratePullRequest) DeepEquals(to *HydratePullRequest) bool {
+	if in == nil {
+		return to == nil
+	}
+	if to == nil {
+		return false
+	}
 	return *in == *to
 }
 
@@ -1175,6 +1347,19 @@ type ApplicationStatus struct {
 	ControllerNamespace string `json:"controllerNamespace,omitempty" protobuf:"bytes,13,opt,name=controllerNamespace"`
 	// SourceHydrator stores information about the current state of source hydration
 	SourceHydrator SourceHydratorStatus `json:"sourceHydrator,omitempty" protobuf:"bytes,14,opt,name=sourceHydrator"`
+	// AutomatedRollback contains information about the last automated rollback of the application. Automated sync is
+	// disabled while it is set, and is re-enabled by acknowledging the rollback.
+	AutomatedRollback *AutomatedRollbackStatus `json:"automatedRollback,omitempty" protobuf:"bytes,15,opt,name=automatedRollback"`
+}
+
+// AutomatedRollbackStatus contains information about an automated rollback of an application
+type AutomatedRollbackStatus struct {
+	// RolledBackAt is the time the rollback was initiated
+	RolledBackAt metav1.Time `json:"rolledBackAt" protobuf:"bytes,1,opt,name=rolledBackAt"`
+	// ID is the identifier of the RevisionHistory entry the application was rolled back to
+	ID int64 `json:"id" protobuf:"bytes,2,opt,name=id"`
+	// Message explains why the application was rolled back
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
 }
 
 // SourceHydratorStatus contains information about the current state of source hydration
@@ -1183,6 +1368,16 @@ type SourceHydratorStatus struct {
 	LastSuccessfulOperation *SuccessfulHydrateOperation `json:"lastSuccessfulOperation,omitempty" protobuf:"bytes,1,opt,name=lastSuccessfulOperation"`
 	// CurrentOperation holds the status of the hydrate operation
 	CurrentOperation *HydrateOperation `json:"currentOperation,omitempty" protobuf:"bytes,2,opt,name=currentOperation"`
+	// PullRequest holds the pull request most recently opened or updated for the hydrateTo branch
+	PullRequest *HydratedPullRequest `json:"pullRequest,omitempty" protobuf:"bytes,3,opt,name=pullRequest"`
+}
+
+// HydratedPullRequest contains information about a pull request opened for hydrated manifests
+type HydratedPullRequest struct {
+	// Number is the number of the pull request
+	Number int64 `json:"number" protobuf:"varint,1,opt,name=number"`
+	// URL is the web URL of the pull request
+	URL string `json:"url,omitempty" protobuf:"bytes,2,opt,name=url"`
 }
 
 func (status *ApplicationStatus) FindResource(key kube.ResourceKey) (*ResourceStatus, bool) {
@@ -1211,6 +1406,12 @@ type HydrateOperation struct {
 	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,6,opt,name=hydratedSHA"`
 	// SourceHydrator holds the hydrator config used for the hydrate operation
 	SourceHydrator SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,7,opt,name=sourceHydrator"`
+	// ApprovedBy holds the name of the user who approved the hydrate operation, if approval is required
+	ApprovedBy string `json:"approvedBy,omitempty" protobuf:"bytes,8,opt,name=approvedBy"`
+	// ApprovedAt indicates when the hydrate operation was approved
+	ApprovedAt *metav1.Time `json:"approvedAt,omitempty" protobuf:"bytes,9,opt,name=approvedAt"`
+	// AdditionalDrySHAs holds the resolved revisions of the additional dry sources, in the order of the sources
+	AdditionalDrySHAs []string `json:"additionalDrySHAs,omitempty" protobuf:"bytes,10,rep,name=additionalDrySHAs"`
 }
 
 // SuccessfulHydrateOperation contains information about the most recent successful hydrate operation
@@ -1221,16 +1422,19 @@ type SuccessfulHydrateOperation struct {
 	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,6,opt,name=hydratedSHA"`
 	// SourceHydrator holds the hydrator config used for the hydrate operation
 	SourceHydrator SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,7,opt,name=sourceHydrator"`
+	// AdditionalDrySHAs holds the resolved revisions of the additional dry sources, in the order of the sources
+	AdditionalDrySHAs []string `json:"additionalDrySHAs,omitempty" protobuf:"bytes,8,rep,name=additionalDrySHAs"`
 }
 
 // HydrateOperationPhase indicates the status of a hydrate operation
-// +kubebuilder:validation:Enum=Hydrating;Failed;Hydrated
+// +kubebuilder:validation:Enum=Hydrating;Failed;Hydrated;AwaitingApproval
 type HydrateOperationPhase string
 
 const (
-	HydrateOperationPhaseHydrating HydrateOperationPhase = "Hydrating"
-	HydrateOperationPhaseFailed    HydrateOperationPhase = "Failed"
-	HydrateOperationPhaseHydrated  HydrateOperationPhase = "Hydrated"
+	HydrateOperationPhaseHydrating        HydrateOperationPhase = "Hydrating"
+	HydrateOperationPhaseFailed           HydrateOperationPhase = "Failed"
+	HydrateOperationPhaseHydrated         HydrateOperationPhase = "Hydrated"
+	HydrateOperationPhaseAwaitingApproval HydrateOperationPhase = "AwaitingApproval"
 )
 
 // GetRevisions will return the current revision associated with the Application.
@@ -1287,6 +1491,19 @@ type Operation struct {
 	Retry RetryStrategy `json:"retry,omitempty" protobuf:"bytes,4,opt,name=retry"`
 }
 
+// ScheduledOperation contains an operation which is started at a specific time rather than immediately
+type ScheduledOperation struct {
+	// Operation is the operation which is started once it is due
+	Operation Operation `json:"operation" protobuf:"bytes,1,opt,name=operation"`
+	// At is the time at which the operation is started
+	At metav1.Time `json:"at" protobuf:"bytes,2,opt,name=at"`
+}
+
+// IsDue returns true if the scheduled operation should be started at the given time
+func (o *ScheduledOperation) IsDue(now time.Time) bool {
+	return !o.At.After(now)
+}
+
 // DryRun returns true if an operation was requested to be performed in dry run mode
 func (o *Operation) DryRun() bool {
 	if o.Sync != nil {
@@ -1371,6 +1588,22 @@ type SyncOperation struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,11,opt,name=revisions"`
 	// SelfHealAttemptsCount contains the number of auto-heal attempts
 	SelfHealAttemptsCount int64 `json:"autoHealAttemptsCount,omitempty" protobuf:"bytes,12,opt,name=autoHealAttemptsCount"`
+	// Revert is set if the sync reverts the resources changed by a failed atomic sync operation
+	Revert *SyncRevert `json:"revert,omitempty" protobuf:"bytes,13,opt,name=revert"`
+}
+
+// SyncRevert contains information about a failed atomic sync operation whose changes are reverted
+type SyncRevert struct {
+	// Revision is the revision the failed sync operation synced to
+	Revision string `json:"revision,omitempty" protobuf:"bytes,1,opt,name=revision"`
+	// Revisions are the revisions the failed sync operation synced to, for applications with multiple sources
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,2,opt,name=revisions"`
+	// Message is the message of the failed sync operation
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// StartedAt is the time the failed sync operation started
+	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,4,opt,name=startedAt"`
+	// ID is the ID of the revision history entry which is re-applied
+	ID int64 `json:"id" protobuf:"bytes,5,opt,name=id"`
 }
 
 // IsApplyStrategy returns true if the sync strategy is "apply"
@@ -1450,6 +1683,8 @@ type SyncPolicy struct {
 	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
 	// ManagedNamespaceMetadata controls metadata in the given namespace (if CreateNamespace=true)
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,4,opt,name=managedNamespaceMetadata"`
+	// Waves controls how the resources of each sync wave are applied
+	Waves *SyncWavesStrategy `json:"waves,omitempty" protobuf:"bytes,5,opt,name=waves"`
 	// If you add a field here, be sure to update IsZero.
 }
 
@@ -1463,7 +1698,23 @@ func (p *SyncPolicy) IsAutomatedSyncEnabled() bool {
 
 // IsZero returns true if the sync policy is empty
 func (p *SyncPolicy) IsZero() bool {
-	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil)
+	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil && p.Waves == nil)
+}
+
+// SyncWavesStrategy controls how the resources of each sync wave are applied
+type SyncWavesStrategy struct {
+	// MaxParallelism is the maximum number of resources of a sync wave which are applied concurrently. All resources of a wave are applied concurrently if not set.
+	MaxParallelism int64 `json:"maxParallelism,omitempty" protobuf:"varint,1,opt,name=maxParallelism"`
+	// Delay is the amount of time to wait after all resources of a sync wave are healthy before the next wave is applied. Default unit is seconds, but could also be a duration (e.g. "2m", "1h")
+	Delay string `json:"delay,omitempty" protobuf:"bytes,2,opt,name=delay"`
+}
+
+// GetDelay returns the amount of time to wait between sync waves
+func (s *SyncWavesStrategy) GetDelay() (time.Duration, error) {
+	if s == nil || s.Delay == "" {
+		return 0, nil
+	}
+	return parseStringToDuration(s.Delay)
 }
 
 // RetryStrategy contains information about the strategy to apply when a sync failed
@@ -1540,6 +1791,26 @@ type SyncPolicyAutomated struct {
 	AllowEmpty bool `json:"allowEmpty,omitempty" protobuf:"bytes,3,opt,name=allowEmpty"`
 	// Enable allows apps to explicitly control automated sync
 	Enabled *bool `json:"enabled,omitempty" protobuf:"bytes,4,opt,name=enabled"`
+	// Rollback configures the controller to roll back to the last healthy revision if the application becomes Degraded shortly after an automated sync
+	Rollback *AutomatedRollback `json:"rollback,omitempty" protobuf:"bytes,5,opt,name=rollback"`
+}
+
+// AutomatedRollback controls the automatic rollback of an application which becomes Degraded after an automated sync
+type AutomatedRollback struct {
+	// Window is the amount of time after an automated sync during which the application becoming Degraded triggers a rollback.
+	// Default unit is seconds, but could also be a duration (e.g. "2m", "1h"). Defaults to 5m.
+	Window string `json:"window,omitempty" protobuf:"bytes,1,opt,name=window"`
+}
+
+// DefaultAutomatedRollbackWindow is the default window after an automated sync during which a Degraded application is rolled back
+const DefaultAutomatedRollbackWindow = 5 * time.Minute
+
+// GetWindow returns the rollback window, or the default window if none is configured
+func (r *AutomatedRollback) GetWindow() (time.Duration, error) {
+	if r == nil || r.Window == "" {
+		return DefaultAutomatedRollbackWindow, nil
+	}
+	return parseStringToDuration(r.Window)
 }
 
 // SyncStrategy controls the manner in which a sync is performed
@@ -1666,6 +1937,24 @@ type SyncOperationResult struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,5,opt,name=revisions"`
 	// ManagedNamespaceMetadata contains the current sync state of managed namespace metadata
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,6,opt,name=managedNamespaceMetadata"`
+	// WaveProgress contains the progress of the sync waves of the operation
+	WaveProgress *SyncWaveProgress `json:"waveProgress,omitempty" protobuf:"bytes,7,opt,name=waveProgress"`
+}
+
+// SyncWaveProgress contains the progress of the sync waves of a sync operation
+type SyncWaveProgress struct {
+	// Phase is the sync phase of the most recently applied sync wave
+	Phase synccommon.SyncPhase `json:"phase" protobuf:"bytes,1,opt,name=phase"`
+	// Wave is the most recently applied sync wave
+	Wave int64 `json:"wave" protobuf:"varint,2,opt,name=wave"`
+	// Final is true if the wave is the last wave of the sync operation
+	Final bool `json:"final,omitempty" protobuf:"varint,3,opt,name=final"`
+	// AppliedAt is the time the wave was applied
+	AppliedAt metav1.Time `json:"appliedAt" protobuf:"bytes,4,opt,name=appliedAt"`
+	// CompletedAt is the time all resources of the wave were found healthy
+	CompletedAt *metav1.Time `json:"completedAt,omitempty" protobuf:"bytes,5,opt,name=completedAt"`
+	// NextWaveAt is the time after which the next wave is applied, if the application has a delay between sync waves
+	NextWaveAt *metav1.Time `json:"nextWaveAt,omitempty" protobuf:"bytes,6,opt,name=nextWaveAt"`
 }
 
 // ResourceResult holds the operation result details of a specific resource
@@ -1746,6 +2035,10 @@ type RevisionHistory struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,9,opt,name=revisions"`
 	// InitiatedBy contains information about who initiated the operations
 	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,10,opt,name=initiatedBy"`
+	// Healthy indicates that the application was observed to be Healthy while the revision was deployed
+	Healthy bool `json:"healthy,omitempty" protobuf:"bytes,11,opt,name=healthy"`
+	// Revert is set if the revision was deployed to revert the resources changed by a failed atomic sync operation
+	Revert *SyncRevert `json:"revert,omitempty" protobuf:"bytes,12,opt,name=revert"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -1797,6 +2090,8 @@ type ApplicationConditionType = string
 const (
 	// ApplicationConditionDeletionError indicates that controller failed to delete application
 	ApplicationConditionDeletionError = "DeletionError"
+	// ApplicationConditionDeletionWarning indicates that the deletion of the application is waiting, e.g. for pre-delete hooks to complete
+	ApplicationConditionDeletionWarning = "DeletionWarning"
 	// ApplicationConditionInvalidSpecError indicates that application source is invalid
 	ApplicationConditionInvalidSpecError = "InvalidSpecError"
 	// ApplicationConditionComparisonError indicates controller failed to compare application state
@@ -1813,6 +2108,14 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionDependencyError indicates that the dependencies of the application can't be resolved or form a cycle
+	ApplicationConditionDependencyError = "DependencyError"
+	// ApplicationConditionDependencyWarning indicates that the application is waiting for its dependencies to be Synced and Healthy
+	ApplicationConditionDependencyWarning = "DependencyWarning"
+	// ApplicationConditionAutomatedRollbackWarning indicates that the application was automatically rolled back and automated sync is disabled
+	ApplicationConditionAutomatedRollbackWarning = "AutomatedRollbackWarning"
+	// ApplicationConditionClusterMaintenanceWarning indicates that the destination cluster is in maintenance mode and automated sync is paused
+	ApplicationConditionClusterMaintenanceWarning = "ClusterMaintenanceWarning"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -2208,6 +2511,23 @@ type ConnectionState struct {
 	Message string `json:"message" protobuf:"bytes,2,opt,name=message"`
 	// ModifiedAt contains the timestamp when this connection status has been determined
 	ModifiedAt *metav1.Time `json:"attemptedAt" protobuf:"bytes,3,opt,name=attemptedAt"`
+	// ConsecutiveFailures contains the number of connection attempts which failed since the last successful one
+	ConsecutiveFailures int64 `json:"consecutiveFailures,omitempty" protobuf:"varint,4,opt,name=consecutiveFailures"`
+	// QuarantinedUntil contains the time until which the reconciliation of applications is paused because of repeated
+	// connection failures. The connection is probed again once it has passed.
+	QuarantinedUntil *metav1.Time `json:"quarantinedUntil,omitempty" protobuf:"bytes,5,opt,name=quarantinedUntil"`
+	// History contains the most recent changes of the connection status, oldest first
+	History []ConnectionStateHistoryEntry `json:"history,omitempty" protobuf:"bytes,6,rep,name=history"`
+}
+
+// ConnectionStateHistoryEntry contains a past connection status
+type ConnectionStateHistoryEntry struct {
+	// Status contains the status indicator for the connection
+	Status ConnectionStatus `json:"status" protobuf:"bytes,1,opt,name=status"`
+	// Message contains human readable information about the connection status
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// ModifiedAt contains the timestamp when the connection status has been determined
+	ModifiedAt *metav1.Time `json:"attemptedAt,omitempty" protobuf:"bytes,3,opt,name=attemptedAt"`
 }
 
 // Cluster is the definition of a cluster resource
@@ -2242,6 +2562,33 @@ type Cluster struct {
 	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,12,opt,name=labels"`
 	// Annotations for cluster secret metadata
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,13,opt,name=annotations"`
+	// Maintenance puts the cluster into maintenance mode, which pauses automated syncs of the applications deployed to it
+	Maintenance *ClusterMaintenance `json:"maintenance,omitempty" protobuf:"bytes,14,opt,name=maintenance"`
+}
+
+// ClusterMaintenance contains the settings of a cluster in maintenance mode
+type ClusterMaintenance struct {
+	// Message explains why the cluster is in maintenance mode
+	Message string `json:"message,omitempty" protobuf:"bytes,1,opt,name=message"`
+	// ExpiresAt is the time at which maintenance mode ends. Maintenance mode doesn't expire if not set.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty" protobuf:"bytes,2,opt,name=expiresAt"`
+	// PauseWatch stops watching the live state of the cluster while it is in maintenance mode
+	PauseWatch bool `json:"pauseWatch,omitempty" protobuf:"varint,3,opt,name=pauseWatch"`
+}
+
+// IsActive returns true if the maintenance mode hasn't expired at the given time
+func (m *ClusterMaintenance) IsActive(now time.Time) bool {
+	return m != nil && (m.ExpiresAt == nil || now.Before(m.ExpiresAt.Time))
+}
+
+// IsInMaintenance returns true if the cluster is in maintenance mode at the given time
+func (c *Cluster) IsInMaintenance(now time.Time) bool {
+	return c.Maintenance.IsActive(now)
+}
+
+// IsWatchPaused returns true if the live state of the cluster must not be watched at the given time
+func (c *Cluster) IsWatchPaused(now time.Time) bool {
+	return c.IsInMaintenance(now) && c.Maintenance.PauseWatch
 }
 
 func (c *Cluster) Sanitized() *Cluster {
@@ -2255,6 +2602,7 @@ func (c *Cluster) Sanitized() *Cluster {
 		Labels:             c.Labels,
 		Annotations:        c.Annotations,
 		ClusterResources:   c.ClusterResources,
+		Maintenance:        c.Maintenance,
 		ConnectionState:    c.ConnectionState,
 		ServerVersion:      c.ServerVersion,
 		Info:               c.Info,
@@ -2305,6 +2653,10 @@ func (c *Cluster) Equals(other *Cluster) bool {
 		return false
 	}
 
+	if !reflect.DeepEqual(c.Maintenance, other.Maintenance) {
+		return false
+	}
+
 	return reflect.DeepEqual(c.Config, other.Config)
 }
 
@@ -2573,6 +2925,7 @@ var validActions = map[string]bool{
 	"delete":   true,
 	"sync":     true,
 	"override": true,
+	"approve":  true,
 	"*":        true,
 }
 
@@ -2726,6 +3079,9 @@ type AppProjectSpec struct {
 	PermitOnlyProjectScopedClusters bool `json:"permitOnlyProjectScopedClusters,omitempty" protobuf:"bytes,13,opt,name=permitOnlyProjectScopedClusters"`
 	// DestinationServiceAccounts holds information about the service accounts to be impersonated for the application sync operation for each destination.
 	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,14,name=destinationServiceAccounts"`
+	// RequireHydrationApproval determines whether the hydration of applications using the source hydrator waits for an
+	// explicit approval before the hydrated manifests are committed
+	RequireHydrationApproval bool `json:"requireHydrationApproval,omitempty" protobuf:"bytes,15,opt,name=requireHydrationApproval"`
 }
 
 // SyncWindows is a collection of sync windows in this project
@@ -2753,8 +3109,26 @@ type SyncWindow struct {
 	UseAndOperator bool `json:"andOperator,omitempty" protobuf:"bytes,9,opt,name=andOperator"`
 	// Description of the sync that will be applied to the schedule, can be used to add any information such as a ticket number for example
 	Description string `json:"description,omitempty" protobuf:"bytes,10,opt,name=description"`
+	// Start is the time a one-off sync window begins, used instead of a recurring schedule and duration
+	Start *metav1.Time `json:"start,omitempty" protobuf:"bytes,11,opt,name=start"`
+	// End is the time a one-off sync window ends. The window expires and is no longer evaluated once it has ended.
+	End *metav1.Time `json:"end,omitempty" protobuf:"bytes,12,opt,name=end"`
+	// Calendar is a named list of dates the sync window is active on, used instead of a recurring schedule and duration.
+	// The window expires and is no longer evaluated once its last date has passed.
+	Calendar *SyncWindowCalendar `json:"calendar,omitempty" protobuf:"bytes,13,opt,name=calendar"`
+}
+
+// SyncWindowCalendar is a named list of dates, such as public holidays
+type SyncWindowCalendar struct {
+	// Name of the calendar
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Dates is a list of dates in YYYY-MM-DD format. Each date spans the whole day in the time zone of the sync window.
+	Dates []string `json:"dates,omitempty" protobuf:"bytes,2,opt,name=dates"`
 }
 
+// syncWindowDateLayout is the format of the dates of a sync window calendar
+const syncWindowDateLayout = "2006-01-02"
+
 // HasWindows returns true if SyncWindows has one or more SyncWindow
 func (w *SyncWindows) HasWindows() bool {
 	return w != nil && len(*w) > 0
@@ -2766,27 +3140,17 @@ func (w *SyncWindows) Active() (*SyncWindows, error) {
 }
 
 func (w *SyncWindows) active(currentTime time.Time) (*SyncWindows, error) {
-	// If SyncWindows.Active() is called outside of a UTC locale, it should be
-	// first converted to UTC before we scan through the SyncWindows.
-	currentTime = currentTime.In(time.UTC)
-
 	if w.HasWindows() {
 		var active SyncWindows
-		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *w {
-			schedule, sErr := specParser.Parse(w.Schedule)
-			if sErr != nil {
-				return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
+			if w.expired(currentTime) {
+				continue
 			}
-			duration, dErr := time.ParseDuration(w.Duration)
-			if dErr != nil {
-				return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+			isActive, err := w.active(currentTime)
+			if err != nil {
+				return nil, err
 			}
-
-			// Offset the nextWindow time to consider the timeZone of the sync window
-			timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-			nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
-			if nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)) {
+			if isActive {
 				active = append(active, w)
 			}
 		}
@@ -2805,30 +3169,17 @@ func (w *SyncWindows) InactiveAllows() (*SyncWindows, error) {
 }
 
 func (w *SyncWindows) inactiveAllows(currentTime time.Time) (*SyncWindows, error) {
-	// If SyncWindows.InactiveAllows() is called outside of a UTC locale, it should be
-	// first converted to UTC before we scan through the SyncWindows.
-	currentTime = currentTime.In(time.UTC)
-
 	if w.HasWindows() {
 		var inactive SyncWindows
-		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *w {
-			if w.Kind != "allow" {
+			if w.Kind != "allow" || w.expired(currentTime) {
 				continue
 			}
-			schedule, sErr := specParser.Parse(w.Schedule)
-			if sErr != nil {
-				return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
-			}
-			duration, dErr := time.ParseDuration(w.Duration)
-			if dErr != nil {
-				return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+			isActive, err := w.active(currentTime)
+			if err != nil {
+				return nil, err
 			}
-			// Offset the nextWindow time to consider the timeZone of the sync window
-			timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-			nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
-
-			if !nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)) {
+			if !isActive {
 				inactive = append(inactive, w)
 			}
 		}
@@ -2875,6 +3226,11 @@ func (spec *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []
 		window.Clusters = cl
 	}
 
+	return spec.AddSyncWindow(window)
+}
+
+// AddSyncWindow validates the given sync window and adds it to the AppProject
+func (spec *AppProjectSpec) AddSyncWindow(window *SyncWindow) error {
 	err := window.Validate()
 	if err != nil {
 		return err
@@ -3075,6 +3431,25 @@ func (w SyncWindow) active(currentTime time.Time) (bool, error) {
 	// first converted to UTC before search
 	currentTime = currentTime.UTC()
 
+	switch {
+	case w.Calendar != nil:
+		today := currentTime.In(w.location()).Format(syncWindowDateLayout)
+		for _, date := range w.Calendar.Dates {
+			if _, err := time.Parse(syncWindowDateLayout, date); err != nil {
+				return false, fmt.Errorf("cannot parse date '%s' of calendar '%s': %w", date, w.Calendar.Name, err)
+			}
+			if date == today {
+				return true, nil
+			}
+		}
+		return false, nil
+	case w.Start != nil || w.End != nil:
+		if w.Start == nil || w.End == nil {
+			return false, errors.New("one-off sync window requires both start and end")
+		}
+		return !currentTime.Before(w.Start.Time) && currentTime.Before(w.End.Time), nil
+	}
+
 	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 	schedule, sErr := specParser.Parse(w.Schedule)
 	if sErr != nil {
@@ -3092,6 +3467,39 @@ func (w SyncWindow) active(currentTime time.Time) (bool, error) {
 	return nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)), nil
 }
 
+// Expired returns true if the sync window is a one-off or calendar window which will not be active anymore
+func (w *SyncWindow) Expired() bool {
+	return w.expired(time.Now())
+}
+
+func (w *SyncWindow) expired(currentTime time.Time) bool {
+	switch {
+	case w.Calendar != nil:
+		if len(w.Calendar.Dates) == 0 {
+			return false
+		}
+		for _, date := range w.Calendar.Dates {
+			day, err := time.ParseInLocation(syncWindowDateLayout, date, w.location())
+			if err != nil || currentTime.Before(day.AddDate(0, 0, 1)) {
+				return false
+			}
+		}
+		return true
+	case w.End != nil:
+		return !currentTime.Before(w.End.Time)
+	}
+	return false
+}
+
+// location returns the time zone of the sync window, defaulting to UTC
+func (w *SyncWindow) location() *time.Location {
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
 // Update updates a sync window's settings with the given parameter
 func (w *SyncWindow) Update(s string, d string, a []string, n []string, c []string, tz string, description string) error {
 	if s == "" && d == "" && len(a) == 0 && len(n) == 0 && len(c) == 0 && description == "" {
@@ -3143,14 +3551,39 @@ func (w *SyncWindow) Validate() error {
 	if w.Kind != "allow" && w.Kind != "deny" {
 		return fmt.Errorf("kind '%s' mismatch: can only be allow or deny", w.Kind)
 	}
-	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
-	_, err := specParser.Parse(w.Schedule)
-	if err != nil {
-		return fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, err)
-	}
-	_, err = time.ParseDuration(w.Duration)
-	if err != nil {
-		return fmt.Errorf("cannot parse duration '%s': %w", w.Duration, err)
+	switch {
+	case w.Calendar != nil:
+		if w.Schedule != "" || w.Duration != "" || w.Start != nil || w.End != nil {
+			return errors.New("a sync window with a calendar cannot have a schedule, duration, start or end")
+		}
+		if len(w.Calendar.Dates) == 0 {
+			return fmt.Errorf("calendar '%s' requires one or more dates", w.Calendar.Name)
+		}
+		for _, date := range w.Calendar.Dates {
+			if _, err := time.Parse(syncWindowDateLayout, date); err != nil {
+				return fmt.Errorf("cannot parse date '%s' of calendar '%s': %w", date, w.Calendar.Name, err)
+			}
+		}
+	case w.Start != nil || w.End != nil:
+		if w.Schedule != "" || w.Duration != "" {
+			return errors.New("a one-off sync window cannot have a schedule or duration")
+		}
+		if w.Start == nil || w.End == nil {
+			return errors.New("a one-off sync window requires both start and end")
+		}
+		if !w.End.After(w.Start.Time) {
+			return errors.New("the end of a one-off sync window must be after its start")
+		}
+	default:
+		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+		_, err := specParser.Parse(w.Schedule)
+		if err != nil {
+			return fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, err)
+		}
+		_, err = time.ParseDuration(w.Duration)
+		if err != nil {
+			return fmt.Errorf("cannot parse duration '%s': %w", w.Duration, err)
+		}
 	}
 
 	if len(w.Description) > 255 {
@@ -3172,6 +3605,9 @@ func (w *SyncWindow) HashIdentity() (uint64, error) {
 		Clusters:       w.Clusters,
 		TimeZone:       w.TimeZone,
 		UseAndOperator: w.UseAndOperator,
+		Start:          w.Start,
+		End:            w.End,
+		Calendar:       w.Calendar,
 		// ManualSync and Description are excluded as they don't affect window identity
 	}
 
@@ -3315,6 +3751,26 @@ func (app *Application) IsHydrateRequested() bool {
 	return false
 }
 
+func (app *Application) HasPreDeleteFinalizer(stage ...string) bool {
+	return getFinalizerIndex(app.ObjectMeta, strings.Join(append([]string{PreDeleteFinalizerName}, stage...), "/")) > -1
+}
+
+func (app *Application) SetPreDeleteFinalizer(stage ...string) {
+	setFinalizer(&app.ObjectMeta, strings.Join(append([]string{PreDeleteFinalizerName}, stage...), "/"), true)
+}
+
+func (app *Application) UnSetPreDeleteFinalizerAll() {
+	for _, finalizer := range app.Finalizers {
+		if strings.HasPrefix(finalizer, PreDeleteFinalizerName) {
+			setFinalizer(&app.ObjectMeta, finalizer, false)
+		}
+	}
+}
+
+func (app *Application) UnSetPreDeleteFinalizer(stage ...string) {
+	setFinalizer(&app.ObjectMeta, strings.Join(append([]string{PreDeleteFinalizerName}, stage...), "/"), false)
+}
+
 func (app *Application) HasPostDeleteFinalizer(stage ...string) bool {
 	return getFinalizerIndex(app.ObjectMeta, strings.Join(append([]string{PostDeleteFinalizerName}, stage...), "/")) > -1
 }
@@ -3120,6 +3120,85 @@ func TestSyncWindow_Validate(t *testing.T) {
 	})
 }
 
+func TestSyncWindow_Validate_OneOffAndCalendar(t *testing.T) {
+	start := metav1.NewTime(time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC))
+	end := metav1.NewTime(time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name   string
+		window SyncWindow
+		errMsg string
+	}{
+		{name: "one-off", window: SyncWindow{Kind: "deny", Start: &start, End: &end}},
+		{name: "one-off without end", window: SyncWindow{Kind: "deny", Start: &start}, errMsg: "a one-off sync window requires both start and end"},
+		{name: "one-off ending before start", window: SyncWindow{Kind: "deny", Start: &end, End: &start}, errMsg: "the end of a one-off sync window must be after its start"},
+		{name: "one-off with schedule", window: SyncWindow{Kind: "deny", Schedule: "* * * * *", Start: &start, End: &end}, errMsg: "a one-off sync window cannot have a schedule or duration"},
+		{name: "calendar", window: SyncWindow{Kind: "deny", Calendar: &SyncWindowCalendar{Name: "holidays", Dates: []string{"2024-12-25"}}}},
+		{name: "calendar without dates", window: SyncWindow{Kind: "deny", Calendar: &SyncWindowCalendar{Name: "holidays"}}, errMsg: "calendar 'holidays' requires one or more dates"},
+		{name: "calendar with invalid date", window: SyncWindow{Kind: "deny", Calendar: &SyncWindowCalendar{Name: "holidays", Dates: []string{"25/12/2024"}}}, errMsg: "cannot parse date '25/12/2024' of calendar 'holidays'"},
+		{name: "calendar with start", window: SyncWindow{Kind: "deny", Start: &start, Calendar: &SyncWindowCalendar{Dates: []string{"2024-12-25"}}}, errMsg: "a sync window with a calendar cannot have a schedule, duration, start or end"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.window.Validate()
+			if tt.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestSyncWindow_Active_OneOffAndCalendar(t *testing.T) {
+	start := metav1.NewTime(time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC))
+	end := metav1.NewTime(time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC))
+	oneOff := SyncWindow{Kind: "deny", Start: &start, End: &end}
+	calendar := SyncWindow{Kind: "deny", TimeZone: "America/New_York", Calendar: &SyncWindowCalendar{Name: "holidays", Dates: []string{"2024-12-25", "2025-01-01"}}}
+
+	tests := []struct {
+		name        string
+		window      SyncWindow
+		currentTime time.Time
+		active      bool
+		expired     bool
+	}{
+		{name: "before one-off window", window: oneOff, currentTime: start.Add(-time.Minute)},
+		{name: "during one-off window", window: oneOff, currentTime: start.Time, active: true},
+		{name: "after one-off window", window: oneOff, currentTime: end.Time, expired: true},
+		{name: "calendar date in time zone", window: calendar, currentTime: time.Date(2024, 12, 26, 3, 0, 0, 0, time.UTC), active: true},
+		{name: "calendar date outside time zone", window: calendar, currentTime: time.Date(2024, 12, 26, 6, 0, 0, 0, time.UTC)},
+		{name: "last calendar date", window: calendar, currentTime: time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC), active: true},
+		{name: "after last calendar date", window: calendar, currentTime: time.Date(2025, 1, 2, 6, 0, 0, 0, time.UTC), expired: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			active, err := tt.window.active(tt.currentTime)
+			require.NoError(t, err)
+			assert.Equal(t, tt.active, active)
+			assert.Equal(t, tt.expired, tt.window.expired(tt.currentTime))
+		})
+	}
+}
+
+func TestSyncWindows_ExpiredWindowsAreIgnored(t *testing.T) {
+	start := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	end := metav1.NewTime(time.Now().Add(-time.Hour))
+	windows := SyncWindows{
+		{Kind: "allow", Start: &start, End: &end, Applications: []string{"*"}},
+		{Kind: "deny", Calendar: &SyncWindowCalendar{Name: "past", Dates: []string{"2020-01-01"}}, Applications: []string{"*"}},
+	}
+
+	active, err := windows.Active()
+	require.NoError(t, err)
+	assert.Nil(t, active)
+	inactiveAllows, err := windows.InactiveAllows()
+	require.NoError(t, err)
+	assert.Nil(t, inactiveAllows)
+	canSync, err := windows.CanSync(false)
+	require.NoError(t, err)
+	assert.True(t, canSync)
+}
+
 func TestApplicationStatus_GetConditions(t *testing.T) {
 	status := ApplicationStatus{
 		Conditions: []ApplicationCondition{
@@ -4742,6 +4821,18 @@ func TestSyncWindow_Hash(t *testing.T) {
 	})
 }
 
+func TestCluster_IsInMaintenance(t *testing.T) {
+	now := time.Now()
+	expiresAt := metav1.NewTime(now.Add(time.Hour))
+
+	assert.False(t, (&Cluster{}).IsInMaintenance(now))
+	assert.True(t, (&Cluster{Maintenance: &ClusterMaintenance{}}).IsInMaintenance(now))
+	assert.True(t, (&Cluster{Maintenance: &ClusterMaintenance{ExpiresAt: &expiresAt}}).IsInMaintenance(now))
+	assert.False(t, (&Cluster{Maintenance: &ClusterMaintenance{ExpiresAt: &expiresAt}}).IsInMaintenance(now.Add(2*time.Hour)))
+	assert.False(t, (&Cluster{Maintenance: &ClusterMaintenance{}}).IsWatchPaused(now))
+	assert.True(t, (&Cluster{Maintenance: &ClusterMaintenance{PauseWatch: true}}).IsWatchPaused(now))
+}
+
 func TestSanitized(t *testing.T) {
 	now := metav1.Now()
 	cluster := &Cluster{
@@ -4796,3 +4887,108 @@ func TestSanitized(t *testing.T) {
 		},
 	}, cluster.Sanitized())
 }
+
+func TestSourceHydrator_DeepEquals(t *testing.T) {
+	newHydrator := func() SourceHydrator {
+		return SourceHydrator{
+			DrySource: DrySource{
+				RepoURL:        "https://example.com/repo",
+				TargetRevision: "main",
+				Path:           "guestbook",
+				Helm:           &ApplicationSourceHelm{ValueFiles: []string{"values.yaml"}},
+				Plugin:         &ApplicationSourcePlugin{Name: "my-plugin"},
+			},
+			SyncSource: SyncSource{TargetBranch: "env/prod", Path: "guestbook-prod"},
+		}
+	}
+
+	assert.True(t, newHydrator().DeepEquals(newHydrator()))
+
+	changedHelm := newHydrator()
+	changedHelm.DrySource.Helm.ValueFiles = []string{"values-prod.yaml"}
+	assert.False(t, newHydrator().DeepEquals(changedHelm))
+
+	addedKustomize := newHydrator()
+	addedKustomize.DrySource.Kustomize = &ApplicationSourceKustomize{NamePrefix: "prod-"}
+	assert.False(t, newHydrator().DeepEquals(addedKustomize))
+
+	changedSyncSource := newHydrator()
+	changedSyncSource.SyncSource.Path = "guestbook-staging"
+	assert.False(t, newHydrator().DeepEquals(changedSyncSource))
+
+	addedLayout := newHydrator()
+	addedLayout.SyncSource.Layout = &HydratedManifestLayout{Type: HydratedManifestLayoutPerResource}
+	assert.False(t, newHydrator().DeepEquals(addedLayout))
+	assert.True(t, addedLayout.DeepEquals(*addedLayout.DeepCopy()))
+
+	addedDrySource := newHydrator()
+	addedDrySource.AdditionalDrySources = ApplicationSources{{RepoURL: "https://example.com/values", Ref: "values"}}
+	assert.False(t, newHydrator().DeepEquals(addedDrySource))
+	assert.True(t, addedDrySource.DeepEquals(*addedDrySource.DeepCopy()))
+}
+
+func TestSourceHydrator_GetDrySources(t *testing.T) {
+	hydrator := SourceHydrator{
+		DrySource: DrySource{RepoURL: "https://example.com/repo", TargetRevision: "main", Path: "guestbook"},
+	}
+	assert.Equal(t, ApplicationSources{hydrator.GetDrySource()}, hydrator.GetDrySources())
+	assert.False(t, hydrator.HasMultipleDrySources())
+
+	hydrator.AdditionalDrySources = ApplicationSources{{RepoURL: "https://example.com/values", TargetRevision: "main", Ref: "values"}}
+	assert.Equal(t, ApplicationSources{
+		{RepoURL: "https://example.com/repo", TargetRevision: "main", Path: "guestbook"},
+		{RepoURL: "https://example.com/values", TargetRevision: "main", Ref: "values"},
+	}, hydrator.GetDrySources())
+	assert.True(t, hydrator.HasMultipleDrySources())
+}
+
+func TestSourceHydrator_GetDrySource(t *testing.T) {
+	hydrator := SourceHydrator{
+		DrySource: DrySource{
+			RepoURL:        "https://example.com/repo",
+			TargetRevision: "main",
+			Path:           "guestbook",
+			Helm:           &ApplicationSourceHelm{ValueFiles: []string{"values.yaml"}},
+			Kustomize:      &ApplicationSourceKustomize{NamePrefix: "prod-"},
+			Directory:      &ApplicationSourceDirectory{Recurse: true},
+			Plugin:         &ApplicationSourcePlugin{Name: "my-plugin"},
+		},
+	}
+
+	assert.Equal(t, ApplicationSource{
+		RepoURL:        "https://example.com/repo",
+		TargetRevision: "main",
+		Path:           "guestbook",
+		Helm:           &ApplicationSourceHelm{ValueFiles: []string{"values.yaml"}},
+		Kustomize:      &ApplicationSourceKustomize{NamePrefix: "prod-"},
+		Directory:      &ApplicationSourceDirectory{Recurse: true},
+		Plugin:         &ApplicationSourcePlugin{Name: "my-plugin"},
+	}, hydrator.GetDrySource())
+}
+
+func TestSourceHydrator_GetSyncSource(t *testing.T) {
+	hydrator := SourceHydrator{
+		DrySource:  DrySource{RepoURL: "https://example.com/repo", TargetRevision: "main", Path: "guestbook"},
+		SyncSource: SyncSource{TargetBranch: "env/prod", Path: "envs/prod/guestbook"},
+	}
+	assert.False(t, hydrator.SyncSource.IsOCI())
+	assert.Equal(t, ApplicationSource{RepoURL: "https://example.com/repo", TargetRevision: "env/prod", Path: "envs/prod/guestbook"}, hydrator.GetSyncSource())
+
+	hydrator.SyncSource = SyncSource{RepoURL: "oci://registry.example.com/hydrated/", TargetBranch: "prod", Path: "envs/prod/guestbook/"}
+	assert.True(t, hydrator.SyncSource.IsOCI())
+	assert.Equal(t, ApplicationSource{RepoURL: "oci://registry.example.com/hydrated/envs/prod/guestbook", TargetRevision: "prod", Path: "."}, hydrator.GetSyncSource())
+
+	spec := ApplicationSpec{SourceHydrator: &hydrator}
+	assert.Equal(t, hydrator.GetSyncSource(), spec.GetHydrateToSource())
+
+	hydrator.SyncSource = SyncSource{TargetBranch: "env/prod", Path: "envs/prod/guestbook", Layout: &HydratedManifestLayout{Type: HydratedManifestLayoutPerKind}}
+	assert.Equal(t, ApplicationSource{
+		RepoURL:        "https://example.com/repo",
+		TargetRevision: "env/prod",
+		Path:           "envs/prod/guestbook",
+		Directory:      &ApplicationSourceDirectory{Recurse: true},
+	}, hydrator.GetSyncSource())
+
+	hydrator.SyncSource.Layout.Kustomization = true
+	assert.Nil(t, hydrator.GetSyncSource().Directory)
+}
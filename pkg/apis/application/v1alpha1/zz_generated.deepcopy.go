@@ -242,6 +242,11 @@ func (in *Application) DeepCopyInto(out *Application) {
 		*out = new(Operation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ScheduledOperation != nil {
+		in, out := &in.ScheduledOperation, &out.ScheduledOperation
+		*out = new(ScheduledOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -283,6 +288,27 @@ func (in *ApplicationCondition) DeepCopy() *ApplicationCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDependency) DeepCopyInto(out *ApplicationDependency) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDependency.
+func (in *ApplicationDependency) DeepCopy() *ApplicationDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationDestination) DeepCopyInto(out *ApplicationDestination) {
 	*out = *in
@@ -1377,6 +1403,13 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		*out = new(SourceHydrator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ApplicationDependency, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1436,6 +1469,11 @@ func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 		copy(*out, *in)
 	}
 	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.AutomatedRollback != nil {
+		in, out := &in.AutomatedRollback, &out.AutomatedRollback
+		*out = new(AutomatedRollbackStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1529,6 +1567,39 @@ func (in *ApplicationWatchEvent) DeepCopy() *ApplicationWatchEvent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutomatedRollback) DeepCopyInto(out *AutomatedRollback) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutomatedRollback.
+func (in *AutomatedRollback) DeepCopy() *AutomatedRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(AutomatedRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutomatedRollbackStatus) DeepCopyInto(out *AutomatedRollbackStatus) {
+	*out = *in
+	in.RolledBackAt.DeepCopyInto(&out.RolledBackAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutomatedRollbackStatus.
+func (in *AutomatedRollbackStatus) DeepCopy() *AutomatedRollbackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutomatedRollbackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backoff) DeepCopyInto(out *Backoff) {
 	*out = *in
@@ -1668,6 +1739,11 @@ func (in *Cluster) DeepCopyInto(out *Cluster) {
 			(*out)[key] = val
 		}
 	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(ClusterMaintenance)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1800,6 +1876,26 @@ func (in *ClusterList) DeepCopy() *ClusterList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterMaintenance) DeepCopyInto(out *ClusterMaintenance) {
+	*out = *in
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMaintenance.
+func (in *ClusterMaintenance) DeepCopy() *ClusterMaintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterMaintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Command) DeepCopyInto(out *Command) {
 	*out = *in
@@ -1935,6 +2031,17 @@ func (in *ConnectionState) DeepCopyInto(out *ConnectionState) {
 		in, out := &in.ModifiedAt, &out.ModifiedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.QuarantinedUntil != nil {
+		in, out := &in.QuarantinedUntil, &out.QuarantinedUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]ConnectionStateHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1948,9 +2055,49 @@ func (in *ConnectionState) DeepCopy() *ConnectionState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionStateHistoryEntry) DeepCopyInto(out *ConnectionStateHistoryEntry) {
+	*out = *in
+	if in.ModifiedAt != nil {
+		in, out := &in.ModifiedAt, &out.ModifiedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionStateHistoryEntry.
+func (in *ConnectionStateHistoryEntry) DeepCopy() *ConnectionStateHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionStateHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DrySource) DeepCopyInto(out *DrySource) {
 	*out = *in
+	if in.Helm != nil {
+		in, out := &in.Helm, &out.Helm
+		*out = new(ApplicationSourceHelm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kustomize != nil {
+		in, out := &in.Kustomize, &out.Kustomize
+		*out = new(ApplicationSourceKustomize)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Directory != nil {
+		in, out := &in.Directory, &out.Directory
+		*out = new(ApplicationSourceDirectory)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(ApplicationSourcePlugin)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2300,6 +2447,15 @@ func (in *HydrateOperation) DeepCopyInto(out *HydrateOperation) {
 		*out = (*in).DeepCopy()
 	}
 	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.ApprovedAt != nil {
+		in, out := &in.ApprovedAt, &out.ApprovedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.AdditionalDrySHAs != nil {
+		in, out := &in.AdditionalDrySHAs, &out.AdditionalDrySHAs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2313,9 +2469,30 @@ func (in *HydrateOperation) DeepCopy() *HydrateOperation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydratePullRequest) DeepCopyInto(out *HydratePullRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydratePullRequest.
+func (in *HydratePullRequest) DeepCopy() *HydratePullRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(HydratePullRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HydrateTo) DeepCopyInto(out *HydrateTo) {
 	*out = *in
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(HydratePullRequest)
+		**out = **in
+	}
 	return
 }
 
@@ -2329,6 +2506,38 @@ func (in *HydrateTo) DeepCopy() *HydrateTo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydratedManifestLayout) DeepCopyInto(out *HydratedManifestLayout) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydratedManifestLayout.
+func (in *HydratedManifestLayout) DeepCopy() *HydratedManifestLayout {
+	if in == nil {
+		return nil
+	}
+	out := new(HydratedManifestLayout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydratedPullRequest) DeepCopyInto(out *HydratedPullRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydratedPullRequest.
+func (in *HydratedPullRequest) DeepCopy() *HydratedPullRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(HydratedPullRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in IgnoreDifferences) DeepCopyInto(out *IgnoreDifferences) {
 	{
@@ -3987,6 +4196,11 @@ func (in *RevisionHistory) DeepCopyInto(out *RevisionHistory) {
 		copy(*out, *in)
 	}
 	out.InitiatedBy = in.InitiatedBy
+	if in.Revert != nil {
+		in, out := &in.Revert, &out.Revert
+		*out = new(SyncRevert)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4338,6 +4552,24 @@ func (in *SCMProviderGeneratorGitlab) DeepCopy() *SCMProviderGeneratorGitlab {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledOperation) DeepCopyInto(out *ScheduledOperation) {
+	*out = *in
+	in.Operation.DeepCopyInto(&out.Operation)
+	in.At.DeepCopyInto(&out.At)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledOperation.
+func (in *ScheduledOperation) DeepCopy() *ScheduledOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretRef) DeepCopyInto(out *SecretRef) {
 	*out = *in
@@ -4373,12 +4605,19 @@ func (in *SignatureKey) DeepCopy() *SignatureKey {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SourceHydrator) DeepCopyInto(out *SourceHydrator) {
 	*out = *in
-	out.DrySource = in.DrySource
-	out.SyncSource = in.SyncSource
+	in.DrySource.DeepCopyInto(&out.DrySource)
+	in.SyncSource.DeepCopyInto(&out.SyncSource)
 	if in.HydrateTo != nil {
 		in, out := &in.HydrateTo, &out.HydrateTo
 		*out = new(HydrateTo)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalDrySources != nil {
+		in, out := &in.AdditionalDrySources, &out.AdditionalDrySources
+		*out = make(ApplicationSources, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -4406,6 +4645,11 @@ func (in *SourceHydratorStatus) DeepCopyInto(out *SourceHydratorStatus) {
 		*out = new(HydrateOperation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(HydratedPullRequest)
+		**out = **in
+	}
 	return
 }
 
@@ -4423,6 +4667,11 @@ func (in *SourceHydratorStatus) DeepCopy() *SourceHydratorStatus {
 func (in *SuccessfulHydrateOperation) DeepCopyInto(out *SuccessfulHydrateOperation) {
 	*out = *in
 	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.AdditionalDrySHAs != nil {
+		in, out := &in.AdditionalDrySHAs, &out.AdditionalDrySHAs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4476,6 +4725,11 @@ func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Revert != nil {
+		in, out := &in.Revert, &out.Revert
+		*out = new(SyncRevert)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4537,6 +4791,11 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WaveProgress != nil {
+		in, out := &in.WaveProgress, &out.WaveProgress
+		*out = new(SyncWaveProgress)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4593,6 +4852,11 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = new(SyncWavesStrategy)
+		**out = **in
+	}
 	return
 }
 
@@ -4614,6 +4878,11 @@ func (in *SyncPolicyAutomated) DeepCopyInto(out *SyncPolicyAutomated) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(AutomatedRollback)
+		**out = **in
+	}
 	return
 }
 
@@ -4627,9 +4896,36 @@ func (in *SyncPolicyAutomated) DeepCopy() *SyncPolicyAutomated {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncRevert) DeepCopyInto(out *SyncRevert) {
+	*out = *in
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncRevert.
+func (in *SyncRevert) DeepCopy() *SyncRevert {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncRevert)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncSource) DeepCopyInto(out *SyncSource) {
 	*out = *in
+	if in.Layout != nil {
+		in, out := &in.Layout, &out.Layout
+		*out = new(HydratedManifestLayout)
+		**out = **in
+	}
 	return
 }
 
@@ -4724,6 +5020,47 @@ func (in *SyncStrategyHook) DeepCopy() *SyncStrategyHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWaveProgress) DeepCopyInto(out *SyncWaveProgress) {
+	*out = *in
+	in.AppliedAt.DeepCopyInto(&out.AppliedAt)
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.NextWaveAt != nil {
+		in, out := &in.NextWaveAt, &out.NextWaveAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWaveProgress.
+func (in *SyncWaveProgress) DeepCopy() *SyncWaveProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWaveProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWavesStrategy) DeepCopyInto(out *SyncWavesStrategy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWavesStrategy.
+func (in *SyncWavesStrategy) DeepCopy() *SyncWavesStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWavesStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncWindow) DeepCopyInto(out *SyncWindow) {
 	*out = *in
@@ -4742,6 +5079,19 @@ func (in *SyncWindow) DeepCopyInto(out *SyncWindow) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = (*in).DeepCopy()
+	}
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+	if in.Calendar != nil {
+		in, out := &in.Calendar, &out.Calendar
+		*out = new(SyncWindowCalendar)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4755,6 +5105,27 @@ func (in *SyncWindow) DeepCopy() *SyncWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWindowCalendar) DeepCopyInto(out *SyncWindowCalendar) {
+	*out = *in
+	if in.Dates != nil {
+		in, out := &in.Dates, &out.Dates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWindowCalendar.
+func (in *SyncWindowCalendar) DeepCopy() *SyncWindowCalendar {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWindowCalendar)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in SyncWindows) DeepCopyInto(out *SyncWindows) {
 	{
@@ -2001,12 +2001,22 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 
 	s.inferResourcesStatusHealth(a)
 
-	canSync, err := proj.Spec.SyncWindows.Matches(a).CanSync(true)
-	if err != nil {
-		return a, status.Errorf(codes.PermissionDenied, "cannot sync: invalid sync window: %v", err)
-	}
-	if !canSync {
-		return a, status.Errorf(codes.PermissionDenied, "cannot sync: blocked by sync window")
+	// Sync windows of scheduled syncs are checked by the controller once the sync is due
+	if syncReq.ScheduledAt == nil {
+		canSync, err := proj.Spec.SyncWindows.Matches(a).CanSync(true)
+		if err != nil {
+			return a, status.Errorf(codes.PermissionDenied, "cannot sync: invalid sync window: %v", err)
+		}
+		if !canSync {
+			return a, status.Errorf(codes.PermissionDenied, "cannot sync: blocked by sync window")
+		}
+	} else {
+		if !syncReq.ScheduledAt.After(time.Now()) {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot schedule sync: %s is not in the future", syncReq.ScheduledAt.Format(time.RFC3339))
+		}
+		if syncReq.Manifests != nil || syncReq.GetDryRun() {
+			return nil, status.Error(codes.InvalidArgument, "cannot schedule a local or dry run sync")
+		}
 	}
 
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionSync, a.RBACName(s.ns)); err != nil {
@@ -2089,20 +2099,29 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	appName := syncReq.GetName()
 	appNs := s.appNamespaceOrDefault(syncReq.GetAppNamespace())
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
-	a, err = argo.SetAppOperation(appIf, appName, &op)
-	if err != nil {
-		return nil, fmt.Errorf("error setting app operation: %w", err)
-	}
 	partial := ""
 	if len(syncReq.Resources) > 0 {
 		partial = "partial "
 	}
-	var reason string
+	target := displayRevision
 	if a.Spec.HasMultipleSources() {
-		reason = fmt.Sprintf("initiated %ssync to %s", partial, strings.Join(displayRevisions, ","))
-	} else {
-		reason = fmt.Sprintf("initiated %ssync to %s", partial, displayRevision)
+		target = strings.Join(displayRevisions, ",")
 	}
+
+	if syncReq.ScheduledAt != nil {
+		a, err = argo.SetAppScheduledOperation(appIf, appName, &v1alpha1.ScheduledOperation{Operation: op, At: *syncReq.ScheduledAt})
+		if err != nil {
+			return nil, fmt.Errorf("error setting app scheduled operation: %w", err)
+		}
+		s.logAppEvent(ctx, a, argo.EventReasonOperationScheduled, fmt.Sprintf("scheduled %ssync to %s at %s", partial, target, syncReq.ScheduledAt.Format(time.RFC3339)))
+		return a, nil
+	}
+
+	a, err = argo.SetAppOperation(appIf, appName, &op)
+	if err != nil {
+		return nil, fmt.Errorf("error setting app operation: %w", err)
+	}
+	reason := fmt.Sprintf("initiated %ssync to %s", partial, target)
 	if syncReq.Manifests != nil {
 		reason = fmt.Sprintf("initiated %ssync locally", partial)
 	}
@@ -2189,23 +2208,11 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 	}
 
 	// Rollback is just a convenience around Sync
-	op := v1alpha1.Operation{
-		Sync: &v1alpha1.SyncOperation{
-			Revision:     deploymentInfo.Revision,
-			Revisions:    deploymentInfo.Revisions,
-			DryRun:       rollbackReq.GetDryRun(),
-			Prune:        rollbackReq.GetPrune(),
-			SyncOptions:  syncOptions,
-			SyncStrategy: &v1alpha1.SyncStrategy{Apply: &v1alpha1.SyncStrategyApply{}},
-			Source:       &deploymentInfo.Source,
-			Sources:      deploymentInfo.Sources,
-		},
-		InitiatedBy: v1alpha1.OperationInitiator{Username: session.Username(ctx)},
-	}
+	op := argo.NewRollbackOperation(deploymentInfo, syncOptions, rollbackReq.GetPrune(), rollbackReq.GetDryRun(), v1alpha1.OperationInitiator{Username: session.Username(ctx)})
 	appName := rollbackReq.GetName()
 	appNs := s.appNamespaceOrDefault(rollbackReq.GetAppNamespace())
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
-	a, err = argo.SetAppOperation(appIf, appName, &op)
+	a, err = argo.SetAppOperation(appIf, appName, op)
 	if err != nil {
 		return nil, fmt.Errorf("error setting app operation: %w", err)
 	}
@@ -2213,6 +2220,72 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 	return a, nil
 }
 
+// AcknowledgeRollback acknowledges the automated rollback of an application, which re-enables automated sync.
+func (s *Server) AcknowledgeRollback(ctx context.Context, q *application.ApplicationRollbackAcknowledgeRequest) (*v1alpha1.Application, error) {
+	appName := q.GetName()
+	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionSync, q.GetProject(), appNs, appName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < 10; i++ {
+		if a.Status.AutomatedRollback == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "application %s was not rolled back automatically", a.QualifiedName())
+		}
+		id := a.Status.AutomatedRollback.ID
+		a.Status.AutomatedRollback = nil
+		updated, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Update(ctx, a, metav1.UpdateOptions{})
+		if err == nil {
+			s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, fmt.Sprintf("acknowledged automated rollback to %d", id))
+			return updated, nil
+		}
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("error updating application: %w", err)
+		}
+		log.Warnf("failed to acknowledge rollback of app %q due to update conflict. retrying again...", a.QualifiedName())
+		time.Sleep(100 * time.Millisecond)
+		a, err = s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting application by name: %w", err)
+		}
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to acknowledge rollback. Too many conflicts")
+}
+
+// CancelScheduledOperation cancels the scheduled operation of an application
+func (s *Server) CancelScheduledOperation(ctx context.Context, q *application.ScheduledOperationCancelRequest) (*v1alpha1.Application, error) {
+	appName := q.GetName()
+	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionSync, q.GetProject(), appNs, appName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < 10; i++ {
+		if a.ScheduledOperation == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "application %s has no scheduled operation", a.QualifiedName())
+		}
+		at := a.ScheduledOperation.At
+		a.ScheduledOperation = nil
+		updated, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Update(ctx, a, metav1.UpdateOptions{})
+		if err == nil {
+			s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, fmt.Sprintf("canceled sync scheduled at %s", at.Format(time.RFC3339)))
+			return updated, nil
+		}
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("error updating application: %w", err)
+		}
+		log.Warnf("failed to cancel scheduled operation of app %q due to update conflict. retrying again...", a.QualifiedName())
+		time.Sleep(100 * time.Millisecond)
+		a, err = s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting application by name: %w", err)
+		}
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to cancel scheduled operation. Too many conflicts")
+}
+
 func (s *Server) ListLinks(ctx context.Context, req *application.ListAppLinksRequest) (*application.LinksResponse, error) {
 	a, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, req.GetProject(), req.GetNamespace(), req.GetName(), "")
 	if err != nil {
@@ -2804,6 +2877,12 @@ func convertSyncWindows(w *v1alpha1.SyncWindows) []*application.ApplicationSyncW
 				Schedule:   &w.Schedule,
 				Duration:   &w.Duration,
 				ManualSync: &w.ManualSync,
+				Start:      w.Start,
+				End:        w.End,
+				Calendar:   w.Calendar,
+			}
+			if w.TimeZone != "" {
+				nw.TimeZone = &w.TimeZone
 			}
 			windows = append(windows, nw)
 		}
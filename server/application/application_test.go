@@ -2154,6 +2154,40 @@ func TestSync_WithRefresh(t *testing.T) {
 	assert.True(t, app.Operation.Retry.Refresh)
 }
 
+func TestSync_Scheduled(t *testing.T) {
+	ctx := t.Context()
+	appServer := newTestAppServer(t)
+	testApp := newTestApp()
+	testApp.Spec.Source.RepoURL = "https://github.com/argoproj/argo-cd.git"
+	app, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: testApp})
+	require.NoError(t, err)
+
+	t.Run("in the past", func(t *testing.T) {
+		at := metav1.NewTime(time.Now().Add(-time.Hour))
+		_, err := appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &app.Name, ScheduledAt: &at})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("in the future", func(t *testing.T) {
+		at := metav1.NewTime(time.Now().Add(time.Hour))
+		app, err := appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &app.Name, ScheduledAt: &at})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+		require.NotNil(t, app.ScheduledOperation)
+		assert.True(t, app.ScheduledOperation.At.Equal(&at))
+		assert.Equal(t, testApp.Spec.GetSource(), *app.ScheduledOperation.Operation.Sync.Source)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		app, err := appServer.CancelScheduledOperation(ctx, &application.ScheduledOperationCancelRequest{Name: &app.Name})
+		require.NoError(t, err)
+		assert.Nil(t, app.ScheduledOperation)
+
+		_, err = appServer.CancelScheduledOperation(ctx, &application.ScheduledOperationCancelRequest{Name: &app.Name})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
 func TestRollbackApp(t *testing.T) {
 	testApp := newTestApp()
 	testApp.Status.History = []v1alpha1.RevisionHistory{{
@@ -2208,6 +2242,19 @@ func TestRollbackApp_WithRefresh(t *testing.T) {
 	assert.False(t, updatedApp.Operation.Retry.Refresh, "refresh should never be set on rollback")
 }
 
+func TestAcknowledgeRollback(t *testing.T) {
+	testApp := newTestApp()
+	testApp.Status.AutomatedRollback = &v1alpha1.AutomatedRollbackStatus{ID: 1, Message: "rolled back"}
+	appServer := newTestAppServer(t, testApp)
+
+	updatedApp, err := appServer.AcknowledgeRollback(t.Context(), &application.ApplicationRollbackAcknowledgeRequest{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Nil(t, updatedApp.Status.AutomatedRollback)
+
+	_, err = appServer.AcknowledgeRollback(t.Context(), &application.ApplicationRollbackAcknowledgeRequest{Name: &testApp.Name})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
 func TestUpdateAppProject(t *testing.T) {
 	testApp := newTestApp()
 	ctx := t.Context()
@@ -2331,6 +2378,36 @@ func TestServer_GetApplicationSyncWindowsState(t *testing.T) {
 		require.NoError(t, err)
 		assert.Empty(t, active.ActiveWindows)
 	})
+	t.Run("OneOffAndCalendar", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Spec.Project = "proj-one-off"
+		start := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+		end := metav1.NewTime(time.Now().Add(time.Hour).Truncate(time.Second))
+		calendar := &v1alpha1.SyncWindowCalendar{Name: "holidays", Dates: []string{"2099-12-25"}}
+		testProj := &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "proj-one-off", Namespace: testNamespace},
+			Spec: v1alpha1.AppProjectSpec{
+				SourceRepos:  []string{"*"},
+				Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				SyncWindows: v1alpha1.SyncWindows{
+					{Kind: "deny", Start: &start, End: &end, Applications: []string{"*"}},
+					{Kind: "deny", Calendar: calendar, TimeZone: "Europe/Berlin", Applications: []string{"*"}},
+				},
+			},
+		}
+		appServer := newTestAppServer(t, testApp, testProj)
+
+		state, err := appServer.GetApplicationSyncWindows(t.Context(), &application.ApplicationSyncWindowsQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		require.Len(t, state.ActiveWindows, 1)
+		assert.Equal(t, &start, state.ActiveWindows[0].Start)
+		assert.Equal(t, &end, state.ActiveWindows[0].End)
+		require.Len(t, state.AssignedWindows, 2)
+		assert.Nil(t, state.AssignedWindows[0].TimeZone)
+		assert.Equal(t, calendar, state.AssignedWindows[1].Calendar)
+		assert.Equal(t, "Europe/Berlin", state.AssignedWindows[1].GetTimeZone())
+		assert.False(t, state.GetCanSync())
+	})
 	t.Run("ProjectDoesNotExist", func(t *testing.T) {
 		testApp := newTestApp()
 		testApp.Spec.Project = "none"
@@ -0,0 +1,431 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	kubecache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/pmezard/go-difflib/difflib"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/commitserver/commit"
+	"github.com/argoproj/argo-cd/v3/controller/hydrator"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/security"
+	"github.com/argoproj/argo-cd/v3/util/session"
+)
+
+const (
+	hydratePreviewFileAdded     = "added"
+	hydratePreviewFileModified  = "modified"
+	hydratePreviewFileDeleted   = "deleted"
+	hydratePreviewFileUnchanged = "unchanged"
+)
+
+// ApproveHydration approves the hydration of an application which is awaiting approval. The approver is recorded in
+// the hydrate operation, and the application controller proceeds with the hydration.
+func (s *Server) ApproveHydration(ctx context.Context, q *application.ApplicationHydrateApprovalRequest) (*v1alpha1.Application, error) {
+	appName := q.GetName()
+	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionApprove, q.GetProject(), appNs, appName, "")
+	if err != nil {
+		return nil, err
+	}
+	user := session.Username(ctx)
+	if user == "" {
+		return nil, status.Error(codes.PermissionDenied, "approving hydration requires an authenticated user")
+	}
+
+	for i := 0; i < 10; i++ {
+		op := a.Status.SourceHydrator.CurrentOperation
+		if a.Spec.SourceHydrator == nil || op == nil || op.Phase != v1alpha1.HydrateOperationPhaseAwaitingApproval {
+			return nil, status.Errorf(codes.FailedPrecondition, "hydration of application %s is not awaiting approval", a.QualifiedName())
+		}
+		now := metav1.Now()
+		op.ApprovedBy = user
+		op.ApprovedAt = &now
+		updated, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Update(ctx, a, metav1.UpdateOptions{})
+		if err == nil {
+			s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, "approved hydration")
+			return updated, nil
+		}
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("error updating application: %w", err)
+		}
+		log.Warnf("failed to approve hydration of app %q due to update conflict. retrying again...", a.QualifiedName())
+		time.Sleep(100 * time.Millisecond)
+		a, err = s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting application by name: %w", err)
+		}
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to approve hydration. Too many conflicts")
+}
+
+// HydratePreview renders the dry sources of the application, or of all applications which are hydrated together with
+// it, and returns the changes the source hydrator would make to their hydrated paths on the sync branch. Nothing is
+// committed.
+func (s *Server) HydratePreview(ctx context.Context, q *application.ApplicationHydratePreviewRequest) (*application.ApplicationHydratePreviewResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if !s.isNamespaceEnabled(a.Namespace) {
+		return nil, security.NamespaceNotPermittedError(a.Namespace)
+	}
+	if a.Spec.SourceHydrator == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "application %s does not use the source hydrator", a.QualifiedName())
+	}
+	if a.Spec.SourceHydrator.SyncSource.IsOCI() {
+		return nil, status.Error(codes.InvalidArgument, "previewing hydration is not supported for OCI sync sources")
+	}
+
+	apps := []*v1alpha1.Application{a}
+	projects := map[string]*v1alpha1.AppProject{a.Spec.Project: proj}
+	if q.GetAll() {
+		others, err := s.getHydrationGroup(ctx, a, projects)
+		if err != nil {
+			return nil, err
+		}
+		apps = append(apps, others...)
+	}
+
+	revision := q.GetRevision()
+	if revision == "" {
+		revision = a.Spec.SourceHydrator.DrySource.TargetRevision
+	}
+	resp := &application.ApplicationHydratePreviewResponse{
+		SyncBranch: ptr.To(a.Spec.SourceHydrator.SyncSource.TargetBranch),
+	}
+	for _, app := range apps {
+		drySHA, preview, err := s.previewHydratedPath(ctx, app, projects[app.Spec.Project], revision)
+		if err != nil {
+			return nil, fmt.Errorf("failed to preview hydration of application %q: %w", app.QualifiedName(), err)
+		}
+		// Render all applications from the same revision, like the hydrator does.
+		revision = drySHA
+		resp.DrySha = ptr.To(drySHA)
+		resp.Applications = append(resp.Applications, preview)
+	}
+	return resp, nil
+}
+
+// getHydrationGroup returns the other applications which are hydrated together with the application, and which the
+// user is permitted to get, sorted by name. The projects of the applications are added to projects.
+func (s *Server) getHydrationGroup(ctx context.Context, a *v1alpha1.Application, projects map[string]*v1alpha1.AppProject) ([]*v1alpha1.Application, error) {
+	all, err := s.appLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("error listing apps with selectors: %w", err)
+	}
+	key := hydrator.GetHydrationQueueKey(a)
+	var group []*v1alpha1.Application
+	for _, app := range all {
+		if app.Spec.SourceHydrator == nil || (app.Name == a.Name && app.Namespace == a.Namespace) {
+			continue
+		}
+		if !s.isNamespaceEnabled(app.Namespace) || hydrator.GetHydrationQueueKey(app) != key {
+			continue
+		}
+		if !s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, app.RBACName(s.ns)) {
+			continue
+		}
+		if _, ok := projects[app.Spec.Project]; !ok {
+			proj, err := s.getAppProject(ctx, app, log.WithFields(applog.GetAppLogFields(app)))
+			if err != nil {
+				return nil, err
+			}
+			projects[app.Spec.Project] = proj
+		}
+		group = append(group, app)
+	}
+	sort.Slice(group, func(i, j int) bool {
+		return group[i].QualifiedName() < group[j].QualifiedName()
+	})
+	return group, nil
+}
+
+// previewHydratedPath renders the dry sources of the application at the given revision of the dry source, and
+// compares the files which would be written to its hydrated path with the files currently on the sync branch. It
+// returns the resolved revision of the dry source.
+func (s *Server) previewHydratedPath(ctx context.Context, a *v1alpha1.Application, proj *v1alpha1.AppProject, revision string) (string, *application.HydratePreviewApplication, error) {
+	var drySHA string
+	var files []*application.HydratePreviewFile
+	err := s.queryRepoServer(ctx, proj, func(
+		client apiclient.RepoServerServiceClient, helmRepos []*v1alpha1.Repository, helmCreds []*v1alpha1.RepoCreds, ociRepos []*v1alpha1.Repository, ociCreds []*v1alpha1.RepoCreds, helmOptions *v1alpha1.HelmOptions, enabledSourceTypes map[string]bool,
+	) error {
+		var pathDetails *commitclient.PathDetails
+		var err error
+		drySHA, pathDetails, err = s.renderDrySources(ctx, client, a, proj, revision, helmRepos, helmCreds, ociRepos, ociCreds, helmOptions, enabledSourceTypes)
+		if err != nil {
+			return err
+		}
+		hydrated, err := commit.RenderPathFiles(a.Spec.SourceHydrator.DrySource.RepoURL, drySHA, pathDetails)
+		if err != nil {
+			return fmt.Errorf("error rendering hydrated files: %w", err)
+		}
+
+		repo, err := s.db.GetRepository(ctx, a.Spec.SourceHydrator.DrySource.RepoURL, proj.Name)
+		if err != nil {
+			return fmt.Errorf("error getting repository: %w", err)
+		}
+		// Only match files, including the manifests in the directories of the per-kind layout. The hydrator.metadata
+		// files of subdirectories identify the hydrated paths of other applications nested in this one.
+		current, err := client.GetGitFiles(ctx, &apiclient.GitFilesRequest{
+			Repo:                      repo,
+			Revision:                  a.Spec.SourceHydrator.SyncSource.TargetBranch,
+			Path:                      path.Join(pathDetails.Path, "{*.*,*/*.yaml,*/hydrator.metadata}"),
+			NewGitFileGlobbingEnabled: true,
+			NoRevisionCache:           true,
+		})
+		if err != nil {
+			return fmt.Errorf("error getting files of sync branch: %w", err)
+		}
+
+		files, err = diffHydratedFiles(pathDetails.Path, current.GetMap(), hydrated)
+		return err
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return drySHA, &application.HydratePreviewApplication{
+		Name:      ptr.To(a.Name),
+		Namespace: ptr.To(a.Namespace),
+		Path:      ptr.To(a.Spec.SourceHydrator.SyncSource.Path),
+		Files:     files,
+	}, nil
+}
+
+// renderDrySources generates the manifests of the application's dry sources, the way the hydrator does, and returns
+// the resolved revision of the dry source along with the details of the hydrated path.
+func (s *Server) renderDrySources(
+	ctx context.Context,
+	client apiclient.RepoServerServiceClient,
+	a *v1alpha1.Application,
+	proj *v1alpha1.AppProject,
+	revision string,
+	helmRepos []*v1alpha1.Repository,
+	helmCreds []*v1alpha1.RepoCreds,
+	ociRepos []*v1alpha1.Repository,
+	ociCreds []*v1alpha1.RepoCreds,
+	helmOptions *v1alpha1.HelmOptions,
+	enabledSourceTypes map[string]bool,
+) (string, *commitclient.PathDetails, error) {
+	appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting app instance label key from settings: %w", err)
+	}
+	trackingMethod, err := s.settingsMgr.GetTrackingMethod()
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting trackingMethod from settings: %w", err)
+	}
+	kustomizeSettings, err := s.settingsMgr.GetKustomizeSettings()
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting kustomize settings: %w", err)
+	}
+	installationID, err := s.settingsMgr.GetInstallationID()
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting installation ID: %w", err)
+	}
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting application cluster config: %w", err)
+	}
+	serverVersion, err := s.kubectl.GetServerVersion(config)
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting server version: %w", err)
+	}
+	apiResources, err := s.kubectl.GetAPIResources(config, false, kubecache.NewNoopSettings())
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting API resources: %w", err)
+	}
+
+	drySources := a.Spec.SourceHydrator.GetDrySources()
+	drySources[0].TargetRevision = revision
+	refSources, err := argo.GetRefSources(ctx, drySources, proj.Name, s.db.GetRepository, []string{})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get ref sources: %w", err)
+	}
+
+	pathDetails := &commitclient.PathDetails{Path: a.Spec.SourceHydrator.SyncSource.Path}
+	if layout := a.Spec.SourceHydrator.SyncSource.Layout; layout != nil {
+		pathDetails.Layout = string(layout.Type)
+		pathDetails.Kustomization = layout.Kustomization
+	}
+	var drySHA string
+	for i, source := range drySources {
+		repo, err := s.db.GetRepository(ctx, source.RepoURL, proj.Name)
+		if err != nil {
+			return "", nil, fmt.Errorf("error getting repository: %w", err)
+		}
+		repos := helmRepos
+		repoCreds := helmCreds
+		if source.IsOCI() {
+			repos = append(slices.Clone(helmRepos), ociRepos...)
+			repoCreds = append(slices.Clone(helmCreds), ociCreds...)
+		}
+		manifestInfo, err := client.GenerateManifest(ctx, &apiclient.ManifestRequest{
+			Repo:               repo,
+			Revision:           source.TargetRevision,
+			AppLabelKey:        appInstanceLabelKey,
+			AppName:            a.InstanceName(s.ns),
+			Namespace:          a.Spec.Destination.Namespace,
+			ApplicationSource:  &source,
+			Repos:              repos,
+			KustomizeOptions:   kustomizeSettings,
+			KubeVersion:        serverVersion,
+			ApiVersions:        argo.APIResourcesToStrings(apiResources, true),
+			HelmRepoCreds:      repoCreds,
+			HelmOptions:        helmOptions,
+			TrackingMethod:     trackingMethod,
+			EnabledSourceTypes: enabledSourceTypes,
+			ProjectName:        proj.Name,
+			ProjectSourceRepos: proj.Spec.SourceRepos,
+			HasMultipleSources: a.Spec.SourceHydrator.HasMultipleDrySources(),
+			RefSources:         refSources,
+			InstallationID:     installationID,
+			NoRevisionCache:    true,
+		})
+		if err != nil {
+			return "", nil, fmt.Errorf("error generating manifests: %w", err)
+		}
+
+		for _, manifest := range manifestInfo.Manifests {
+			obj := &unstructured.Unstructured{}
+			if err := json.Unmarshal([]byte(manifest), obj); err != nil {
+				return "", nil, fmt.Errorf("error unmarshaling manifest: %w", err)
+			}
+			// Hydrated manifests are committed without the tracking metadata of the application.
+			if err := argo.NewResourceTracking().RemoveAppInstance(obj, string(trackingMethod)); err != nil {
+				return "", nil, fmt.Errorf("failed to remove the app instance value: %w", err)
+			}
+			objJSON, err := json.Marshal(obj)
+			if err != nil {
+				return "", nil, fmt.Errorf("failed to marshal object: %w", err)
+			}
+			pathDetails.Manifests = append(pathDetails.Manifests, &commitclient.HydratedManifestDetails{ManifestJSON: string(objJSON)})
+		}
+		pathDetails.Commands = append(pathDetails.Commands, manifestInfo.Commands...)
+		if i == 0 {
+			drySHA = manifestInfo.Revision
+			continue
+		}
+		pathDetails.DrySources = append(pathDetails.DrySources, &commitclient.DrySourceRevision{
+			RepoURL:  source.RepoURL,
+			Path:     source.Path,
+			Chart:    source.Chart,
+			Ref:      source.Ref,
+			Revision: manifestInfo.Revision,
+		})
+	}
+	return drySHA, pathDetails, nil
+}
+
+// diffHydratedFiles compares the files currently in the hydrated path, keyed by their path relative to the root of the
+// repository, with the hydrated files, keyed by their path relative to the hydrated path. Files which would no longer
+// be written are deleted by the hydrator. Subdirectories with their own hydrator.metadata file are the hydrated paths
+// of other applications, so their files are skipped.
+func diffHydratedFiles(hydratedPath string, current map[string][]byte, hydrated map[string][]byte) ([]*application.HydratePreviewFile, error) {
+	hydratedPath = path.Clean(hydratedPath)
+	nestedPaths := map[string]bool{}
+	for filePath := range current {
+		if dir, file := path.Split(filePath); file == "hydrator.metadata" && path.Clean(dir) != hydratedPath {
+			nestedPaths[path.Clean(dir)] = true
+		}
+	}
+	currentByName := make(map[string][]byte, len(current))
+	for filePath, content := range current {
+		name, ok := strings.CutPrefix(filePath, hydratedPath+"/")
+		if !ok || nestedPaths[path.Dir(filePath)] {
+			continue
+		}
+		currentByName[name] = content
+	}
+
+	var files []*application.HydratePreviewFile
+	for name, content := range hydrated {
+		currentContent, ok := currentByName[name]
+		status := hydratePreviewFileModified
+		switch {
+		case !ok:
+			status = hydratePreviewFileAdded
+		case string(currentContent) == string(content):
+			status = hydratePreviewFileUnchanged
+		}
+		file, err := newHydratePreviewFile(path.Join(hydratedPath, name), status, currentContent, content)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	for name, currentContent := range currentByName {
+		if _, ok := hydrated[name]; ok {
+			continue
+		}
+		file, err := newHydratePreviewFile(path.Join(hydratedPath, name), hydratePreviewFileDeleted, currentContent, nil)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].GetPath() < files[j].GetPath()
+	})
+	return files, nil
+}
+
+// newHydratePreviewFile returns the preview of a file, including the unified diff of its content unless it is
+// unchanged.
+func newHydratePreviewFile(filePath, status string, current, hydrated []byte) (*application.HydratePreviewFile, error) {
+	file := &application.HydratePreviewFile{
+		Path:   ptr.To(filePath),
+		Status: ptr.To(status),
+	}
+	if status == hydratePreviewFileUnchanged {
+		return file, nil
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(current),
+		B:        splitLines(hydrated),
+		FromFile: "a/" + filePath,
+		ToFile:   "b/" + filePath,
+		Context:  3,
+	})
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("error computing diff of %s", filePath), err)
+	}
+	file.Diff = ptr.To(diff)
+	return file, nil
+}
+
+// splitLines splits content into lines which keep their line endings. Unlike difflib.SplitLines, no empty line is
+// added after the last line.
+func splitLines(content []byte) []string {
+	if len(content) == 0 {
+		return nil
+	}
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
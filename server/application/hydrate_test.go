@@ -0,0 +1,255 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
+	"github.com/argoproj/argo-cd/v3/util/assets"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+)
+
+func newHydratorTestApp(name, syncBranch, syncPath string) *v1alpha1.Application {
+	return newTestApp(func(app *v1alpha1.Application) {
+		app.Name = name
+		app.Spec.Source = nil
+		app.Spec.SourceHydrator = &v1alpha1.SourceHydrator{
+			DrySource: v1alpha1.DrySource{
+				RepoURL:        fakeRepoURL,
+				TargetRevision: "main",
+				Path:           "guestbook",
+			},
+			SyncSource: v1alpha1.SyncSource{
+				TargetBranch: syncBranch,
+				Path:         syncPath,
+			},
+		}
+	})
+}
+
+func newPerKindApp() *v1alpha1.Application {
+	app := newHydratorTestApp("per-kind", "env/dev", "dev/guestbook")
+	app.Spec.SourceHydrator.SyncSource.Layout = &v1alpha1.HydratedManifestLayout{Type: v1alpha1.HydratedManifestLayoutPerKind}
+	return app
+}
+
+func TestHydratePreview(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(t *testing.T, generateManifest func(*apiclient.ManifestRequest) bool) *Server {
+		t.Helper()
+		appServer := newTestAppServer(t,
+			newHydratorTestApp("guestbook", "env/prod", "prod/guestbook"),
+			newHydratorTestApp("other", "env/prod", "prod/other"),
+			newHydratorTestApp("staging", "env/staging", "staging/guestbook"),
+			newPerKindApp(),
+		)
+		mockRepoServiceClient := &mocks.RepoServerServiceClient{}
+		mockRepoServiceClient.On("GenerateManifest", mock.Anything, mock.MatchedBy(generateManifest)).Return(&apiclient.ManifestResponse{
+			Manifests: []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"guestbook","annotations":{"argocd.argoproj.io/tracking-id":"guestbook:/ConfigMap:default/guestbook"}}}`},
+			Revision:  "abc123",
+			Commands:  []string{"kustomize build ."},
+		}, nil)
+		mockRepoServiceClient.On("GetGitFiles", mock.Anything, mock.MatchedBy(func(req *apiclient.GitFilesRequest) bool {
+			return req.Revision == "env/prod" && req.Path == "prod/guestbook/{*.*,*/*.yaml,*/hydrator.metadata}" && req.NoRevisionCache
+		})).Return(&apiclient.GitFilesResponse{Map: map[string][]byte{
+			"prod/guestbook/manifest.yaml": []byte("old: manifest\n"),
+			"prod/guestbook/stale.yaml":    []byte("stale: manifest\n"),
+		}}, nil)
+		mockRepoServiceClient.On("GetGitFiles", mock.Anything, mock.MatchedBy(func(req *apiclient.GitFilesRequest) bool {
+			return req.Revision == "env/prod" && req.Path == "prod/other/{*.*,*/*.yaml,*/hydrator.metadata}"
+		})).Return(&apiclient.GitFilesResponse{}, nil)
+		mockRepoServiceClient.On("GetGitFiles", mock.Anything, mock.MatchedBy(func(req *apiclient.GitFilesRequest) bool {
+			return req.Revision == "env/dev" && req.Path == "dev/guestbook/{*.*,*/*.yaml,*/hydrator.metadata}"
+		})).Return(&apiclient.GitFilesResponse{Map: map[string][]byte{
+			"dev/guestbook/configmap/guestbook.yaml": []byte("old: manifest\n"),
+			"dev/guestbook/configmap/stale.yaml":     []byte("stale: manifest\n"),
+			"dev/guestbook/README.md":                []byte("readme\n"),
+			// The hydrated path of another application, nested in this one.
+			"dev/guestbook/nested/hydrator.metadata": []byte("{}\n"),
+			"dev/guestbook/nested/manifest.yaml":     []byte("nested: manifest\n"),
+		}}, nil)
+		appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: mockRepoServiceClient}
+		return appServer
+	}
+
+	t.Run("renders the application", func(t *testing.T) {
+		t.Parallel()
+
+		appServer := newServer(t, func(req *apiclient.ManifestRequest) bool {
+			return req.Revision == "main" && req.NoRevisionCache
+		})
+		resp, err := appServer.HydratePreview(t.Context(), &application.ApplicationHydratePreviewRequest{Name: ptr.To("guestbook")})
+		require.NoError(t, err)
+
+		assert.Equal(t, "abc123", resp.GetDrySha())
+		assert.Equal(t, "env/prod", resp.GetSyncBranch())
+		require.Len(t, resp.Applications, 1)
+		app := resp.Applications[0]
+		assert.Equal(t, "guestbook", app.GetName())
+		assert.Equal(t, "prod/guestbook", app.GetPath())
+
+		statuses := map[string]string{}
+		for _, file := range app.Files {
+			statuses[file.GetPath()] = file.GetStatus()
+		}
+		assert.Equal(t, map[string]string{
+			"prod/guestbook/README.md":         hydratePreviewFileAdded,
+			"prod/guestbook/hydrator.metadata": hydratePreviewFileAdded,
+			"prod/guestbook/manifest.yaml":     hydratePreviewFileModified,
+			"prod/guestbook/stale.yaml":        hydratePreviewFileDeleted,
+		}, statuses)
+
+		manifest := app.Files[2]
+		require.Equal(t, "prod/guestbook/manifest.yaml", manifest.GetPath())
+		assert.Contains(t, manifest.GetDiff(), "-old: manifest")
+		assert.Contains(t, manifest.GetDiff(), "+  name: guestbook")
+		assert.NotContains(t, manifest.GetDiff(), "tracking-id")
+	})
+
+	t.Run("renders the requested revision of all applications hydrated together", func(t *testing.T) {
+		t.Parallel()
+
+		appServer := newServer(t, func(req *apiclient.ManifestRequest) bool {
+			return req.Revision == "v1.0.0" || req.Revision == "abc123"
+		})
+		resp, err := appServer.HydratePreview(t.Context(), &application.ApplicationHydratePreviewRequest{
+			Name:     ptr.To("guestbook"),
+			Revision: ptr.To("v1.0.0"),
+			All:      ptr.To(true),
+		})
+		require.NoError(t, err)
+
+		require.Len(t, resp.Applications, 2)
+		assert.Equal(t, "guestbook", resp.Applications[0].GetName())
+		assert.Equal(t, "other", resp.Applications[1].GetName())
+		for _, file := range resp.Applications[1].Files {
+			assert.Equal(t, hydratePreviewFileAdded, file.GetStatus())
+		}
+	})
+
+	t.Run("renders the per-kind layout", func(t *testing.T) {
+		t.Parallel()
+
+		appServer := newServer(t, func(req *apiclient.ManifestRequest) bool {
+			return req.Revision == "main"
+		})
+		resp, err := appServer.HydratePreview(t.Context(), &application.ApplicationHydratePreviewRequest{Name: ptr.To("per-kind")})
+		require.NoError(t, err)
+
+		require.Len(t, resp.Applications, 1)
+		statuses := map[string]string{}
+		for _, file := range resp.Applications[0].Files {
+			statuses[file.GetPath()] = file.GetStatus()
+		}
+		assert.Equal(t, map[string]string{
+			"dev/guestbook/README.md":                hydratePreviewFileModified,
+			"dev/guestbook/configmap/guestbook.yaml": hydratePreviewFileModified,
+			"dev/guestbook/configmap/stale.yaml":     hydratePreviewFileDeleted,
+			"dev/guestbook/hydrator.metadata":        hydratePreviewFileAdded,
+		}, statuses)
+	})
+
+	t.Run("application does not use the source hydrator", func(t *testing.T) {
+		t.Parallel()
+
+		appServer := newTestAppServer(t, newTestApp())
+		_, err := appServer.HydratePreview(t.Context(), &application.ApplicationHydratePreviewRequest{Name: ptr.To("test-app")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestApproveHydration(t *testing.T) {
+	t.Parallel()
+
+	//nolint:staticcheck
+	ctx := context.WithValue(t.Context(), "claims", &jwt.RegisteredClaims{Subject: "approver"})
+	newAwaitingApp := func(name string, phase v1alpha1.HydrateOperationPhase) *v1alpha1.Application {
+		app := newHydratorTestApp(name, "env/prod", "prod/"+name)
+		app.Spec.SourceHydrator.RequireApproval = true
+		app.Status.SourceHydrator.CurrentOperation = &v1alpha1.HydrateOperation{
+			Phase:          phase,
+			DrySHA:         "abc123",
+			SourceHydrator: *app.Spec.SourceHydrator,
+		}
+		return app
+	}
+
+	t.Run("approves hydration awaiting approval", func(t *testing.T) {
+		t.Parallel()
+
+		appServer := newTestAppServer(t, newAwaitingApp("guestbook", v1alpha1.HydrateOperationPhaseAwaitingApproval))
+		app, err := appServer.ApproveHydration(ctx, &application.ApplicationHydrateApprovalRequest{Name: ptr.To("guestbook")})
+		require.NoError(t, err)
+
+		op := app.Status.SourceHydrator.CurrentOperation
+		assert.Equal(t, v1alpha1.HydrateOperationPhaseAwaitingApproval, op.Phase)
+		assert.Equal(t, "approver", op.ApprovedBy)
+		assert.NotNil(t, op.ApprovedAt)
+	})
+
+	t.Run("hydration not awaiting approval", func(t *testing.T) {
+		t.Parallel()
+
+		appServer := newTestAppServer(t, newAwaitingApp("guestbook", v1alpha1.HydrateOperationPhaseHydrated))
+		_, err := appServer.ApproveHydration(ctx, &application.ApplicationHydrateApprovalRequest{Name: ptr.To("guestbook")})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("approve action is denied", func(t *testing.T) {
+		t.Parallel()
+
+		appServer := newTestAppServerWithEnforcerConfigure(t, func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("role:readonly")
+		}, map[string]string{}, newAwaitingApp("guestbook", v1alpha1.HydrateOperationPhaseAwaitingApproval))
+		_, err := appServer.ApproveHydration(ctx, &application.ApplicationHydrateApprovalRequest{Name: ptr.To("guestbook")})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("anonymous user", func(t *testing.T) {
+		t.Parallel()
+
+		appServer := newTestAppServer(t, newAwaitingApp("guestbook", v1alpha1.HydrateOperationPhaseAwaitingApproval))
+		_, err := appServer.ApproveHydration(t.Context(), &application.ApplicationHydrateApprovalRequest{Name: ptr.To("guestbook")})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func Test_diffHydratedFiles(t *testing.T) {
+	t.Parallel()
+
+	files, err := diffHydratedFiles("prod", map[string][]byte{
+		"prod/manifest.yaml":           []byte("a: b\n"),
+		"prod/README.md":               []byte("readme\n"),
+		"prod/nested/file.yaml":        []byte("nested\n"),
+		"prod/other/app.yaml":          []byte("other\n"),
+		"prod/other/hydrator.metadata": []byte("{}\n"),
+		"staging/manifest.yaml":        []byte("staging\n"),
+	}, map[string][]byte{
+		"manifest.yaml": []byte("a: c\n"),
+		"README.md":     []byte("readme\n"),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, files, 3)
+	assert.Equal(t, "prod/README.md", files[0].GetPath())
+	assert.Equal(t, hydratePreviewFileUnchanged, files[0].GetStatus())
+	assert.Empty(t, files[0].GetDiff())
+	assert.Equal(t, "prod/manifest.yaml", files[1].GetPath())
+	assert.Equal(t, hydratePreviewFileModified, files[1].GetStatus())
+	assert.Equal(t, "--- a/prod/manifest.yaml\n+++ b/prod/manifest.yaml\n@@ -1 +1 @@\n-a: b\n+a: c\n", files[1].GetDiff())
+	assert.Equal(t, "prod/nested/file.yaml", files[2].GetPath())
+	assert.Equal(t, hydratePreviewFileDeleted, files[2].GetStatus())
+}
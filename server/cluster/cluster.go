@@ -291,6 +291,9 @@ var clusterFieldsByPath = map[string]func(updated *appv1.Cluster, existing *appv
 	"project": func(updated *appv1.Cluster, existing *appv1.Cluster) {
 		updated.Project = existing.Project
 	},
+	"maintenance": func(updated *appv1.Cluster, existing *appv1.Cluster) {
+		updated.Maintenance = existing.Maintenance
+	},
 }
 
 // Update updates a cluster
@@ -319,6 +322,16 @@ func (s *Server) Update(ctx context.Context, q *cluster.ClusterUpdateRequest) (*
 		}
 		q.Cluster = c
 	}
+
+	// Clusters in maintenance are often unreachable, so the connection is not tested if only the maintenance changes
+	if len(q.UpdatedFields) == 1 && q.UpdatedFields[0] == "maintenance" {
+		clust, err := s.db.UpdateCluster(ctx, q.Cluster)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update cluster in database: %w", err)
+		}
+		return s.toAPIResponse(clust), nil
+	}
+
 	clusterRESTConfig, err := q.Cluster.RESTConfig()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get REST config for cluster: %w", err)
@@ -453,6 +453,20 @@ func TestUpdateCluster_FieldsPathSet(t *testing.T) {
 	assert.Equal(t, "minikube", updated.Name)
 	assert.Equal(t, []string{"default", "kube-system"}, updated.Namespaces)
 	assert.Equal(t, "new-project", updated.Project)
+
+	_, err = server.Update(t.Context(), &cluster.ClusterUpdateRequest{
+		Cluster: &v1alpha1.Cluster{
+			Server:      "https://127.0.0.1",
+			Maintenance: &v1alpha1.ClusterMaintenance{Message: "upgrading"},
+		},
+		UpdatedFields: []string{"maintenance"},
+	})
+
+	require.NoError(t, err)
+
+	assert.Equal(t, "minikube", updated.Name)
+	assert.Equal(t, []string{"default", "kube-system"}, updated.Namespaces)
+	assert.Equal(t, &v1alpha1.ClusterMaintenance{Message: "upgrading"}, updated.Maintenance)
 }
 
 func TestDeleteClusterByName(t *testing.T) {
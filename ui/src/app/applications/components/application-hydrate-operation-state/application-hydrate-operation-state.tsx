@@ -45,6 +45,9 @@ export const ApplicationHydrateOperationState: React.FunctionComponent<Props> =
             </div>
         )
     });
+    if (hydrateOperationState.approvedBy) {
+        operationAttributes.push({title: 'APPROVED BY', value: hydrateOperationState.approvedBy});
+    }
     if (hydrateOperationState.finishedAt) {
         operationAttributes.push({
             title: 'HYDRATED REVISION',
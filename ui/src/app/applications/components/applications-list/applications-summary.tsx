@@ -23,6 +23,7 @@ const hydratorColors = new Map<string, string>();
 hydratorColors.set('Hydrating', COLORS.operation.running);
 hydratorColors.set('Hydrated', COLORS.operation.success);
 hydratorColors.set('Failed', COLORS.operation.failed);
+hydratorColors.set('AwaitingApproval', COLORS.sync.out_of_sync);
 hydratorColors.set('None', COLORS.sync.unknown);
 
 export const ApplicationsSummary = ({applications}: {applications: models.Application[]}) => {
@@ -259,6 +259,10 @@ export const HydrateOperationPhaseIcon = ({operationState, isButton}: {operation
             className = `fa fa-times-circle${isButton ? ' status-button' : ''}`;
             color = COLORS.operation.failed;
             break;
+        case appModels.HydrateOperationPhases.AwaitingApproval:
+            className = `fa fa-pause-circle${isButton ? ' status-button' : ''}`;
+            color = COLORS.sync.out_of_sync;
+            break;
         default:
             className = 'fa fa-circle-notch fa-spin';
             color = COLORS.operation.running;
@@ -929,6 +933,7 @@ export function hydrationStatusMessage(app: appModels.Application) {
                 </span>
             );
         case appModels.HydrateOperationPhases.Failed:
+        case appModels.HydrateOperationPhases.AwaitingApproval:
             return (
                 <span>
                     from{' '}
@@ -33,6 +33,15 @@ export interface SyncOperation {
     prune: boolean;
     dryRun: boolean;
     resources?: SyncOperationResource[];
+    revert?: SyncRevert;
+}
+
+export interface SyncRevert {
+    revision?: string;
+    revisions?: string[];
+    message?: string;
+    startedAt: models.Time;
+    id: number;
 }
 
 export interface RetryBackoff {
@@ -63,6 +72,11 @@ export interface Operation {
     initiatedBy: OperationInitiator;
 }
 
+export interface ScheduledOperation {
+    operation: Operation;
+    at: models.Time;
+}
+
 export type OperationPhase = 'Running' | 'Error' | 'Failed' | 'Succeeded' | 'Terminating' | 'Progressing' | 'Pending' | 'Waiting';
 
 export const OperationPhases = {
@@ -117,6 +131,16 @@ export interface SyncOperationResult {
     resources: ResourceResult[];
     revision: string;
     revisions: string[];
+    waveProgress?: SyncWaveProgress;
+}
+
+export interface SyncWaveProgress {
+    phase: string;
+    wave: number;
+    final?: boolean;
+    appliedAt: models.Time;
+    completedAt?: models.Time;
+    nextWaveAt?: models.Time;
 }
 
 export type ResultCode = 'Synced' | 'SyncFailed' | 'Pruned' | 'PruneSkipped';
@@ -159,6 +183,7 @@ export interface Application {
     spec: ApplicationSpec;
     status: ApplicationStatus;
     operation?: Operation;
+    scheduledOperation?: ScheduledOperation;
     isAppOfAppsPattern?: boolean;
 }
 
@@ -233,17 +258,30 @@ export interface SourceHydrator {
     drySource: DrySource;
     syncSource: SyncSource;
     hydrateTo?: HydrateTo;
+    additionalDrySources?: ApplicationSource[];
+    requireApproval?: boolean;
 }
 
 export interface DrySource {
     repoURL: string;
     targetRevision: string;
     path: string;
+    helm?: ApplicationSourceHelm;
+    kustomize?: ApplicationSourceKustomize;
+    plugin?: ApplicationSourcePlugin;
+    directory?: ApplicationSourceDirectory;
 }
 
 export interface SyncSource {
     targetBranch: string;
     path: string;
+    repoURL?: string;
+    layout?: HydratedManifestLayout;
+}
+
+export interface HydratedManifestLayout {
+    type?: 'SingleFile' | 'PerResource' | 'PerKind';
+    kustomization?: boolean;
 }
 
 export interface HydrateTo {
@@ -305,12 +343,23 @@ export interface Automated {
     prune: boolean;
     selfHeal: boolean;
     enabled: boolean;
+    rollback?: AutomatedRollback;
+}
+
+export interface AutomatedRollback {
+    window?: string;
 }
 
 export interface SyncPolicy {
     automated?: Automated;
     syncOptions?: string[];
     retry?: RetryStrategy;
+    waves?: SyncWavesStrategy;
+}
+
+export interface SyncWavesStrategy {
+    maxParallelism?: number;
+    delay?: string;
 }
 
 export interface Info {
@@ -328,6 +377,16 @@ export interface ApplicationSpec {
     ignoreDifferences?: ResourceIgnoreDifferences[];
     info?: Info[];
     revisionHistoryLimit?: number;
+    dependsOn?: ApplicationDependency[];
+}
+
+export interface ApplicationDependency {
+    name?: string;
+    namespace?: string;
+    selector?: {
+        matchLabels?: {[name: string]: string};
+        matchExpressions?: {key: string; operator: string; values?: string[]}[];
+    };
 }
 
 export interface ResourceIgnoreDifferences {
@@ -351,6 +410,8 @@ export interface RevisionHistory {
     deployStartedAt: models.Time;
     deployedAt: models.Time;
     initiatedBy: OperationInitiator;
+    healthy?: boolean;
+    revert?: SyncRevert;
 }
 
 export type SyncStatusCode = 'Unknown' | 'Synced' | 'OutOfSync';
@@ -499,6 +560,13 @@ export interface ApplicationStatus {
     operationState?: OperationState;
     summary?: ApplicationSummary;
     sourceHydrator?: SourceHydratorStatus;
+    automatedRollback?: AutomatedRollbackStatus;
+}
+
+export interface AutomatedRollbackStatus {
+    rolledBackAt: models.Time;
+    id: number;
+    message?: string;
 }
 
 export interface SourceHydratorStatus {
@@ -514,20 +582,25 @@ export interface HydrateOperation {
     drySHA: string;
     hydratedSHA: string;
     sourceHydrator: SourceHydrator;
+    approvedBy?: string;
+    approvedAt?: models.Time;
+    additionalDrySHAs?: string[];
 }
 
 export interface SuccessfulHydrateOperation {
     drySHA: string;
     hydratedSHA: string;
     sourceHydrator: SourceHydrator;
+    additionalDrySHAs?: string[];
 }
 
-export type HydrateOperationPhase = 'Hydrating' | 'Failed' | 'Hydrated';
+export type HydrateOperationPhase = 'Hydrating' | 'Failed' | 'Hydrated' | 'AwaitingApproval';
 
 export const HydrateOperationPhases = {
     Hydrating: 'Hydrating' as OperationPhase,
     Failed: 'Failed' as OperationPhase,
-    Hydrated: 'Hydrated' as OperationPhase
+    Hydrated: 'Hydrated' as OperationPhase,
+    AwaitingApproval: 'AwaitingApproval' as OperationPhase
 };
 
 export interface JwtTokens {
@@ -670,6 +743,13 @@ export interface Cluster {
     };
     annotations?: {[name: string]: string};
     labels?: {[name: string]: string};
+    maintenance?: ClusterMaintenance;
+}
+
+export interface ClusterMaintenance {
+    message?: string;
+    expiresAt?: models.Time;
+    pauseWatch?: boolean;
 }
 
 export interface ClusterCacheInfo {
@@ -828,6 +908,7 @@ export interface ProjectSpec {
     signatureKeys: ProjectSignatureKey[];
     orphanedResources?: {warn?: boolean; ignore: OrphanedResource[]};
     syncWindows?: SyncWindows;
+    requireHydrationApproval?: boolean;
 }
 
 export type SyncWindows = SyncWindow[];
@@ -843,6 +924,14 @@ export interface SyncWindow {
     timeZone: string;
     andOperator: boolean;
     description: string;
+    start?: models.Time;
+    end?: models.Time;
+    calendar?: SyncWindowCalendar;
+}
+
+export interface SyncWindowCalendar {
+    name: string;
+    dates: string[];
 }
 
 export interface Project {
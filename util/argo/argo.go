@@ -23,6 +23,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"oras.land/oras-go/v2/registry"
 
 	"github.com/argoproj/argo-cd/v3/util/gpg"
 
@@ -443,10 +444,10 @@ func validateRepo(ctx context.Context,
 		}
 	}
 
-	// If using the source hydrator, check the dry source instead of the sync source, since the sync source branch may
+	// If using the source hydrator, check the dry sources instead of the sync source, since the sync source branch may
 	// not exist yet.
 	if app.Spec.SourceHydrator != nil {
-		sources = []argoappv1.ApplicationSource{app.Spec.SourceHydrator.GetDrySource()}
+		sources = app.Spec.SourceHydrator.GetDrySources()
 	}
 
 	refSources, err := GetRefSources(ctx, sources, app.Spec.Project, db.GetRepository, []string{})
@@ -566,18 +567,96 @@ func validateSourceHydrator(hydrator *argoappv1.SourceHydrator) []argoappv1.Appl
 			Message: "spec.sourceHydrator.syncSource.targetBranch is required",
 		})
 	}
+	for i, source := range hydrator.AdditionalDrySources {
+		if source.RepoURL == "" {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.sourceHydrator.additionalDrySources[%d].repoURL is required", i),
+			})
+		}
+	}
 	if hydrator.HydrateTo != nil && hydrator.HydrateTo.TargetBranch == "" {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
 			Message: "when spec.sourceHydrator.hydrateTo is set, spec.sourceHydrator.hydrateTo.path is required",
 		})
 	}
+	if hydrator.SyncSource.IsOCI() {
+		conditions = append(conditions, validateOCISyncSource(hydrator)...)
+	}
 	return conditions
 }
 
+// validateOCISyncSource validates a sync source which pushes hydrated manifests to an OCI repository.
+func validateOCISyncSource(hydrator *argoappv1.SourceHydrator) []argoappv1.ApplicationCondition {
+	var conditions []argoappv1.ApplicationCondition
+	if !strings.HasPrefix(hydrator.SyncSource.RepoURL, "oci://") {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: "spec.sourceHydrator.syncSource.repoURL must be an OCI repository URL starting with oci://",
+		})
+	} else if hydrator.SyncSource.TargetBranch != "" {
+		// The target branch is used as the tag of the pushed artifact.
+		ref := strings.TrimPrefix(hydrator.SyncSource.OCIRepoURL(), "oci://") + ":" + hydrator.SyncSource.TargetBranch
+		if _, err := registry.ParseReference(ref); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.sourceHydrator.syncSource does not result in a valid OCI reference %q: %v", ref, err),
+			})
+		}
+	}
+	if hydrator.HydrateTo != nil {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: "spec.sourceHydrator.hydrateTo is not supported when spec.sourceHydrator.syncSource.repoURL is set",
+		})
+	}
+	return conditions
+}
+
+// validateDependsOn validates that each dependency of the application either names an Application or selects
+// Applications by a valid label selector.
+func validateDependsOn(dependencies []argoappv1.ApplicationDependency) []argoappv1.ApplicationCondition {
+	var conditions []argoappv1.ApplicationCondition
+	for i, dependency := range dependencies {
+		if (dependency.Name == "") == (dependency.Selector == nil) {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.dependsOn[%d] must specify exactly one of name or selector", i),
+			})
+			continue
+		}
+		if dependency.Selector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(dependency.Selector); err != nil {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("spec.dependsOn[%d].selector is invalid: %v", i, err),
+				})
+			}
+		}
+	}
+	return conditions
+}
+
+// validateAutomatedRollback validates the rollback window of the automated sync policy.
+func validateAutomatedRollback(syncPolicy *argoappv1.SyncPolicy) []argoappv1.ApplicationCondition {
+	if syncPolicy == nil || syncPolicy.Automated == nil || syncPolicy.Automated.Rollback == nil {
+		return nil
+	}
+	if _, err := syncPolicy.Automated.Rollback.GetWindow(); err != nil {
+		return []argoappv1.ApplicationCondition{{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("spec.syncPolicy.automated.rollback.window is invalid: %v", err),
+		}}
+	}
+	return nil
+}
+
 // ValidatePermissions ensures that the referenced cluster has been added to Argo CD and the app source repo and destination namespace/cluster are permitted in app project
 func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject, db db.ArgoDB) ([]argoappv1.ApplicationCondition, error) {
 	conditions := make([]argoappv1.ApplicationCondition, 0)
+	conditions = append(conditions, validateDependsOn(spec.DependsOn)...)
+	conditions = append(conditions, validateAutomatedRollback(spec.SyncPolicy)...)
 
 	switch {
 	case spec.SourceHydrator != nil:
@@ -586,11 +665,17 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 			conditions = append(conditions, condition...)
 			return conditions, nil
 		}
-		if !proj.IsSourcePermitted(spec.SourceHydrator.GetDrySource()) {
-			conditions = append(conditions, argoappv1.ApplicationCondition{
-				Type:    argoappv1.ApplicationConditionInvalidSpecError,
-				Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.SourceHydrator.GetDrySource().RepoURL, proj.Name),
-			})
+		sources := spec.SourceHydrator.GetDrySources()
+		if spec.SourceHydrator.SyncSource.IsOCI() {
+			sources = append(sources, spec.SourceHydrator.GetSyncSource())
+		}
+		for _, source := range sources {
+			if !proj.IsSourcePermitted(source) {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", source.RepoURL, proj.Name),
+				})
+			}
 		}
 	case spec.HasMultipleSources():
 		for _, source := range spec.Sources {
@@ -608,8 +693,9 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 			}
 		}
 	default:
-		conditions = validateSourcePermissions(spec.GetSource(), spec.HasMultipleSources())
-		if len(conditions) > 0 {
+		condition := validateSourcePermissions(spec.GetSource(), spec.HasMultipleSources())
+		if len(condition) > 0 {
+			conditions = append(conditions, condition...)
 			return conditions, nil
 		}
 
@@ -754,6 +840,8 @@ func verifyGenerateManifests(
 	refSources argoappv1.RefTargetRevisionMapping,
 ) []argoappv1.ApplicationCondition {
 	var conditions []argoappv1.ApplicationCondition
+	// Additional dry sources of the source hydrator are rendered like the sources of a multi-source app.
+	hasMultipleSources := app.Spec.HasMultipleSources() || (app.Spec.SourceHydrator != nil && app.Spec.SourceHydrator.HasMultipleDrySources())
 	// If source is Kustomize add build options
 	kustomizeSettings, err := settingsMgr.GetKustomizeSettings()
 	if err != nil {
@@ -840,7 +928,7 @@ func verifyGenerateManifests(
 			TrackingMethod:                  trackingMethod,
 			EnabledSourceTypes:              enableGenerateManifests,
 			NoRevisionCache:                 true,
-			HasMultipleSources:              app.Spec.HasMultipleSources(),
+			HasMultipleSources:              hasMultipleSources,
 			RefSources:                      refSources,
 			ProjectName:                     proj.Name,
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
@@ -892,6 +980,48 @@ func SetAppOperation(appIf v1alpha1.ApplicationInterface, appName string, op *ar
 	}
 }
 
+// SetAppScheduledOperation schedules an operation which the controller starts once it is due. An operation which was
+// scheduled before is replaced.
+func SetAppScheduledOperation(appIf v1alpha1.ApplicationInterface, appName string, scheduledOp *argoappv1.ScheduledOperation) (*argoappv1.Application, error) {
+	if scheduledOp.Operation.Sync == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Operation unspecified")
+	}
+	for {
+		a, err := appIf.Get(context.Background(), appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting application %q: %w", appName, err)
+		}
+		a = a.DeepCopy()
+		a.ScheduledOperation = scheduledOp
+		a, err = appIf.Update(context.Background(), a, metav1.UpdateOptions{})
+		if err == nil {
+			return a, nil
+		}
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("error updating application %q: %w", appName, err)
+		}
+		log.Warnf("Failed to set scheduled operation for app '%s' due to update conflict. Retrying again...", appName)
+	}
+}
+
+// NewRollbackOperation returns the sync operation which rolls an application back to the revision and sources of the
+// given deployment from its revision history.
+func NewRollbackOperation(deploymentInfo *argoappv1.RevisionHistory, syncOptions argoappv1.SyncOptions, prune bool, dryRun bool, initiatedBy argoappv1.OperationInitiator) *argoappv1.Operation {
+	return &argoappv1.Operation{
+		Sync: &argoappv1.SyncOperation{
+			Revision:     deploymentInfo.Revision,
+			Revisions:    deploymentInfo.Revisions,
+			DryRun:       dryRun,
+			Prune:        prune,
+			SyncOptions:  syncOptions,
+			SyncStrategy: &argoappv1.SyncStrategy{Apply: &argoappv1.SyncStrategyApply{}},
+			Source:       &deploymentInfo.Source,
+			Sources:      deploymentInfo.Sources,
+		},
+		InitiatedBy: initiatedBy,
+	}
+}
+
 // ContainsSyncResource determines if the given resource exists in the provided slice of sync operation resources.
 func ContainsSyncResource(name string, namespace string, gvk schema.GroupVersionKind, rr []argoappv1.SyncOperationResource) bool {
 	for _, r := range rr {
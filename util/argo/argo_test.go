@@ -304,6 +304,24 @@ func TestValidatePermissionsEmptyDestination(t *testing.T) {
 	assert.ElementsMatch(t, conditions, []argoappv1.ApplicationCondition{{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "Destination server missing from app spec"}})
 }
 
+func TestValidatePermissionsInvalidDependsOn(t *testing.T) {
+	conditions, err := ValidatePermissions(t.Context(), &argoappv1.ApplicationSpec{
+		Source:    &argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd", Path: "."},
+		DependsOn: []argoappv1.ApplicationDependency{{Namespace: "infra"}},
+	}, &argoappv1.AppProject{
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}, nil)
+	require.NoError(t, err)
+	// The other validations still run.
+	assert.ElementsMatch(t, conditions, []argoappv1.ApplicationCondition{
+		{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "spec.dependsOn[0] must specify exactly one of name or selector"},
+		{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "Destination server missing from app spec"},
+	})
+}
+
 func TestValidateChartWithoutRevision(t *testing.T) {
 	appSpec := &argoappv1.ApplicationSpec{
 		Source: &argoappv1.ApplicationSource{RepoURL: "https://charts.helm.sh/incubator/", Chart: "myChart", TargetRevision: ""},
@@ -1705,3 +1723,70 @@ func TestGetAppEventLabels(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSourceHydrator_OCISyncSource(t *testing.T) {
+	newHydrator := func(repoURL, targetBranch string) *argoappv1.SourceHydrator {
+		return &argoappv1.SourceHydrator{
+			DrySource:  argoappv1.DrySource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+			SyncSource: argoappv1.SyncSource{RepoURL: repoURL, TargetBranch: targetBranch, Path: "guestbook"},
+		}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		assert.Empty(t, validateSourceHydrator(newHydrator("oci://localhost:5000/hydrated", "prod")))
+	})
+	t.Run("not an OCI URL", func(t *testing.T) {
+		conditions := validateSourceHydrator(newHydrator("https://github.com/argoproj/hydrated", "prod"))
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "must be an OCI repository URL")
+	})
+	t.Run("invalid tag", func(t *testing.T) {
+		conditions := validateSourceHydrator(newHydrator("oci://localhost:5000/hydrated", "environments/prod"))
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "does not result in a valid OCI reference")
+	})
+	t.Run("hydrateTo", func(t *testing.T) {
+		hydrator := newHydrator("oci://localhost:5000/hydrated", "prod")
+		hydrator.HydrateTo = &argoappv1.HydrateTo{TargetBranch: "prod-next"}
+		conditions := validateSourceHydrator(hydrator)
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "hydrateTo is not supported")
+	})
+}
+
+func TestValidateDependsOn(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		assert.Empty(t, validateDependsOn([]argoappv1.ApplicationDependency{
+			{Name: "database"},
+			{Namespace: "infra", Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "cache"}}},
+		}))
+	})
+	t.Run("neither name nor selector", func(t *testing.T) {
+		conditions := validateDependsOn([]argoappv1.ApplicationDependency{{Namespace: "infra"}})
+		require.Len(t, conditions, 1)
+		assert.Equal(t, "spec.dependsOn[0] must specify exactly one of name or selector", conditions[0].Message)
+	})
+	t.Run("name and selector", func(t *testing.T) {
+		conditions := validateDependsOn([]argoappv1.ApplicationDependency{{Name: "database", Selector: &metav1.LabelSelector{}}})
+		require.Len(t, conditions, 1)
+		assert.Equal(t, "spec.dependsOn[0] must specify exactly one of name or selector", conditions[0].Message)
+	})
+	t.Run("invalid selector", func(t *testing.T) {
+		conditions := validateDependsOn([]argoappv1.ApplicationDependency{{Selector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Invalid"}},
+		}}})
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "spec.dependsOn[0].selector is invalid")
+	})
+}
+
+func TestValidateAutomatedRollback(t *testing.T) {
+	assert.Empty(t, validateAutomatedRollback(nil))
+	assert.Empty(t, validateAutomatedRollback(&argoappv1.SyncPolicy{Automated: &argoappv1.SyncPolicyAutomated{}}))
+	assert.Empty(t, validateAutomatedRollback(&argoappv1.SyncPolicy{Automated: &argoappv1.SyncPolicyAutomated{Rollback: &argoappv1.AutomatedRollback{}}}))
+	assert.Empty(t, validateAutomatedRollback(&argoappv1.SyncPolicy{Automated: &argoappv1.SyncPolicyAutomated{Rollback: &argoappv1.AutomatedRollback{Window: "10m"}}}))
+
+	conditions := validateAutomatedRollback(&argoappv1.SyncPolicy{Automated: &argoappv1.SyncPolicyAutomated{Rollback: &argoappv1.AutomatedRollback{Window: "soon"}}})
+	require.Len(t, conditions, 1)
+	assert.Equal(t, "spec.syncPolicy.automated.rollback.window is invalid: unable to parse soon as a duration", conditions[0].Message)
+}
@@ -74,6 +74,14 @@ func (b *DiffConfigBuilder) WithCache(s *appstatecache.Cache, appName string) *D
 	return b
 }
 
+// WithResourceDiffCache sets the ResourceDiffCache and the appName in the diff config, so
+// that only the diffs of the resources whose inputs changed are calculated.
+func (b *DiffConfigBuilder) WithResourceDiffCache(c *ResourceDiffCache, appName string) *DiffConfigBuilder {
+	b.diffConfig.resourceDiffCache = c
+	b.diffConfig.appName = appName
+	return b
+}
+
 // WithLogger sets the logger in the diff config.
 func (b *DiffConfigBuilder) WithLogger(l logr.Logger) *DiffConfigBuilder {
 	b.diffConfig.logger = &l
@@ -147,6 +155,8 @@ type DiffConfig interface {
 	NoCache() bool
 	// StateCache is used when retrieving the diff from the cache.
 	StateCache() *appstatecache.Cache
+	// ResourceDiffCache is used to reuse the diffs of the resources whose inputs did not change.
+	ResourceDiffCache() *ResourceDiffCache
 	IgnoreAggregatedRoles() bool
 	// Logger used during the diff.
 	Logger() *logr.Logger
@@ -177,6 +187,7 @@ type diffConfig struct {
 	appName               string
 	noCache               bool
 	stateCache            *appstatecache.Cache
+	resourceDiffCache     *ResourceDiffCache
 	ignoreAggregatedRoles bool
 	logger                *logr.Logger
 	gvkParser             *k8smanagedfields.GvkParser
@@ -216,6 +227,10 @@ func (c *diffConfig) StateCache() *appstatecache.Cache {
 	return c.stateCache
 }
 
+func (c *diffConfig) ResourceDiffCache() *ResourceDiffCache {
+	return c.resourceDiffCache
+}
+
 func (c *diffConfig) IgnoreAggregatedRoles() bool {
 	return c.ignoreAggregatedRoles
 }
@@ -324,6 +339,13 @@ func StateDiffs(lives, configs []*unstructured.Unstructured, diffConfig DiffConf
 	}
 
 	useCache, cachedDiff := diffConfig.DiffFromCache(diffConfig.AppName())
+	if resourceDiffCache := diffConfig.ResourceDiffCache(); resourceDiffCache != nil && diffConfig.AppName() != "" {
+		array, err := resourceDiffCache.diffArray(diffConfig, normResults.Targets, normResults.Lives, cachedDiff, diffOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate diff: %w", err)
+		}
+		return array, nil
+	}
 	if useCache && cachedDiff != nil {
 		cached, err := diffArrayCached(normResults.Targets, normResults.Lives, cachedDiff, diffOpts...)
 		if err != nil {
@@ -0,0 +1,226 @@
+package diff
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// ResourceDiffCache keeps the diff results of the resources of applications in memory, so that the diff of a resource
+// is only calculated again once its target manifest, its live state or the diff settings of its application change.
+// The diff result of a resource is keyed on the hash of its normalized target manifest and on the resourceVersion of
+// its live state. Once the cache holds more than the maximum number of resource diffs, the diff results of the least
+// recently diffed applications are evicted.
+type ResourceDiffCache struct {
+	lock sync.Mutex
+	// maxResources is the maximum number of resource diffs which are kept, 0 means no limit
+	maxResources int
+	// resources is the number of resource diffs which are kept
+	resources int
+	apps      map[string]*list.Element
+	// lru orders the diff results of the applications from the most to the least recently diffed
+	lru *list.List
+	// onLookup is called with the number of diff results which were found in the cache and which were calculated
+	onLookup func(hits int, misses int)
+}
+
+type appResourceDiffs struct {
+	appName      string
+	settingsHash string
+	diffs        map[kube.ResourceKey]cachedResourceDiff
+}
+
+type cachedResourceDiff struct {
+	targetHash      string
+	resourceVersion string
+	result          diff.DiffResult
+}
+
+// NewResourceDiffCache returns a new ResourceDiffCache which keeps at most maxResources resource diffs, or any number
+// of them if maxResources is 0. The onLookup function, if not nil, is called after each diff of an application with
+// the number of diff results which were found in the cache and which were calculated.
+func NewResourceDiffCache(maxResources int, onLookup func(hits int, misses int)) *ResourceDiffCache {
+	return &ResourceDiffCache{
+		maxResources: maxResources,
+		apps:         map[string]*list.Element{},
+		lru:          list.New(),
+		onLookup:     onLookup,
+	}
+}
+
+// Delete removes the diff results of the given application.
+func (c *ResourceDiffCache) Delete(appName string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.remove(appName)
+}
+
+func (c *ResourceDiffCache) remove(appName string) {
+	elem, ok := c.apps[appName]
+	if !ok {
+		return
+	}
+	c.resources -= len(elem.Value.(*appResourceDiffs).diffs)
+	c.lru.Remove(elem)
+	delete(c.apps, appName)
+}
+
+func (c *ResourceDiffCache) get(appName string, settingsHash string) map[kube.ResourceKey]cachedResourceDiff {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	elem, ok := c.apps[appName]
+	if !ok {
+		return nil
+	}
+	c.lru.MoveToFront(elem)
+	app := elem.Value.(*appResourceDiffs)
+	if app.settingsHash != settingsHash {
+		return nil
+	}
+	return app.diffs
+}
+
+func (c *ResourceDiffCache) set(appName string, settingsHash string, diffs map[kube.ResourceKey]cachedResourceDiff) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.remove(appName)
+	if c.maxResources > 0 && len(diffs) > c.maxResources {
+		// The application alone has more resources than the cache may keep
+		return
+	}
+	c.apps[appName] = c.lru.PushFront(&appResourceDiffs{appName: appName, settingsHash: settingsHash, diffs: diffs})
+	c.resources += len(diffs)
+	for c.maxResources > 0 && c.resources > c.maxResources {
+		c.remove(c.lru.Back().Value.(*appResourceDiffs).appName)
+	}
+}
+
+// diffArray calculates the diffs of the given resources of an application, reusing the diff results of the resources
+// whose inputs did not change since the previous diff. The diff results cached in Redis, which are only given if the
+// target manifests of the application did not change, are used for the resources which are not in the cache.
+func (c *ResourceDiffCache) diffArray(diffConfig DiffConfig, configArray []*unstructured.Unstructured, liveArray []*unstructured.Unstructured, redisDiff []*v1alpha1.ResourceDiff, opts ...diff.Option) (*diff.DiffResultList, error) {
+	numItems := len(configArray)
+	if len(liveArray) != numItems {
+		return nil, errors.New("left and right arrays have mismatched lengths")
+	}
+	settingsHash, err := diffSettingsHash(diffConfig)
+	if err != nil {
+		return nil, err
+	}
+	cached := c.get(diffConfig.AppName(), settingsHash)
+	redisDiffByKey := map[kube.ResourceKey]*v1alpha1.ResourceDiff{}
+	for _, res := range redisDiff {
+		redisDiffByKey[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = res
+	}
+
+	diffResultList := diff.DiffResultList{
+		Diffs: make([]diff.DiffResult, numItems),
+	}
+	diffs := make(map[kube.ResourceKey]cachedResourceDiff, numItems)
+	hits, misses := 0, 0
+	for i := 0; i < numItems; i++ {
+		config := configArray[i]
+		live := liveArray[i]
+		resourceVersion := ""
+		var key kube.ResourceKey
+		if live != nil {
+			key = kube.GetResourceKey(live)
+			resourceVersion = live.GetResourceVersion()
+		} else {
+			key = kube.GetResourceKey(config)
+		}
+		targetHash, err := objectHash(config)
+		if err != nil {
+			return nil, err
+		}
+
+		var dr diff.DiffResult
+		if entry, ok := cached[key]; ok && entry.targetHash == targetHash && entry.resourceVersion == resourceVersion {
+			dr = entry.result
+			hits++
+		} else if redisEntry, ok := redisDiffByKey[key]; ok && redisEntry.ResourceVersion == resourceVersion {
+			dr = diff.DiffResult{
+				NormalizedLive: []byte(redisEntry.NormalizedLiveState),
+				PredictedLive:  []byte(redisEntry.PredictedLiveState),
+				Modified:       redisEntry.Modified,
+			}
+			hits++
+		} else {
+			res, err := diff.Diff(config, live, opts...)
+			if err != nil {
+				return nil, err
+			}
+			dr = *res
+			misses++
+		}
+		diffResultList.Diffs[i] = dr
+		if dr.Modified {
+			diffResultList.Modified = true
+		}
+		// the changes of a live state without resourceVersion can't be detected
+		if live == nil || resourceVersion != "" {
+			diffs[key] = cachedResourceDiff{targetHash: targetHash, resourceVersion: resourceVersion, result: dr}
+		}
+	}
+
+	c.set(diffConfig.AppName(), settingsHash, diffs)
+	if c.onLookup != nil {
+		c.onLookup(hits, misses)
+	}
+	return &diffResultList, nil
+}
+
+// diffSettingsHash returns the hash of the diff settings, which invalidates all cached diff results of an application
+// when it changes.
+func diffSettingsHash(diffConfig DiffConfig) (string, error) {
+	data, err := json.Marshal(struct {
+		Ignores               []v1alpha1.ResourceIgnoreDifferences
+		Overrides             map[string]v1alpha1.ResourceOverride
+		AppLabelKey           string
+		TrackingMethod        string
+		IgnoreAggregatedRoles bool
+		StructuredMergeDiff   bool
+		Manager               string
+		ServerSideDiff        bool
+		IgnoreMutationWebhook bool
+		IgnoreNormalizerOpts  any
+	}{
+		Ignores:               diffConfig.Ignores(),
+		Overrides:             diffConfig.Overrides(),
+		AppLabelKey:           diffConfig.AppLabelKey(),
+		TrackingMethod:        diffConfig.TrackingMethod(),
+		IgnoreAggregatedRoles: diffConfig.IgnoreAggregatedRoles(),
+		StructuredMergeDiff:   diffConfig.StructuredMergeDiff(),
+		Manager:               diffConfig.Manager(),
+		ServerSideDiff:        diffConfig.ServerSideDiff(),
+		IgnoreMutationWebhook: diffConfig.IgnoreMutationWebhook(),
+		IgnoreNormalizerOpts:  diffConfig.IgnoreNormalizerOpts(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal diff settings: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func objectHash(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(obj.Object)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,174 @@
+package diff_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	argo "github.com/argoproj/argo-cd/v3/util/argo/diff"
+	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
+)
+
+func newConfigMap(name string, resourceVersion string, value string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": "default",
+		},
+		"data": map[string]any{
+			"key": value,
+		},
+	}}
+	if resourceVersion != "" {
+		obj.SetResourceVersion(resourceVersion)
+	}
+	return obj
+}
+
+func TestStateDiffs_ResourceDiffCache(t *testing.T) {
+	var hits, misses int
+	resourceDiffCache := argo.NewResourceDiffCache(0, func(h int, m int) {
+		hits, misses = h, m
+	})
+	diffConfig := func(t *testing.T, ignores []v1alpha1.ResourceIgnoreDifferences) argo.DiffConfig {
+		t.Helper()
+		diffConfig, err := argo.NewDiffConfigBuilder().
+			WithDiffSettings(ignores, map[string]v1alpha1.ResourceOverride{}, true, normalizers.IgnoreNormalizerOpts{}).
+			WithTracking("", "").
+			WithNoCache().
+			WithResourceDiffCache(resourceDiffCache, "argocd/my-app").
+			Build()
+		require.NoError(t, err)
+		return diffConfig
+	}
+	stateDiffs := func(t *testing.T, lives, targets []*unstructured.Unstructured, ignores []v1alpha1.ResourceIgnoreDifferences) []bool {
+		t.Helper()
+		results, err := argo.StateDiffs(lives, targets, diffConfig(t, ignores))
+		require.NoError(t, err)
+		require.Len(t, results.Diffs, len(targets))
+		modified := make([]bool, len(results.Diffs))
+		for i, res := range results.Diffs {
+			modified[i] = res.Modified
+		}
+		return modified
+	}
+
+	targets := []*unstructured.Unstructured{newConfigMap("cm1", "", "a"), newConfigMap("cm2", "", "b")}
+	lives := []*unstructured.Unstructured{newConfigMap("cm1", "1", "a"), newConfigMap("cm2", "1", "b")}
+
+	assert.Equal(t, []bool{false, false}, stateDiffs(t, lives, targets, nil))
+	assert.Equal(t, 0, hits)
+	assert.Equal(t, 2, misses)
+
+	assert.Equal(t, []bool{false, false}, stateDiffs(t, lives, targets, nil))
+	assert.Equal(t, 2, hits)
+	assert.Equal(t, 0, misses)
+
+	t.Run("LiveStateChanged", func(t *testing.T) {
+		lives[0] = newConfigMap("cm1", "2", "changed")
+		assert.Equal(t, []bool{true, false}, stateDiffs(t, lives, targets, nil))
+		assert.Equal(t, 1, hits)
+		assert.Equal(t, 1, misses)
+	})
+
+	t.Run("TargetChanged", func(t *testing.T) {
+		targets[1] = newConfigMap("cm2", "", "changed")
+		assert.Equal(t, []bool{true, true}, stateDiffs(t, lives, targets, nil))
+		assert.Equal(t, 1, hits)
+		assert.Equal(t, 1, misses)
+	})
+
+	t.Run("SettingsChanged", func(t *testing.T) {
+		ignores := []v1alpha1.ResourceIgnoreDifferences{{Kind: "ConfigMap", JSONPointers: []string{"/data"}}}
+		assert.Equal(t, []bool{false, false}, stateDiffs(t, lives, targets, ignores))
+		assert.Equal(t, 0, hits)
+		assert.Equal(t, 2, misses)
+	})
+
+	t.Run("ResourceAdded", func(t *testing.T) {
+		targets = append(targets, newConfigMap("cm3", "", "c"))
+		lives = append(lives, nil)
+		assert.Equal(t, []bool{true, true, true}, stateDiffs(t, lives, targets, nil))
+		assert.Equal(t, 0, hits)
+		assert.Equal(t, 3, misses)
+
+		assert.Equal(t, []bool{true, true, true}, stateDiffs(t, lives, targets, nil))
+		assert.Equal(t, 3, hits)
+		assert.Equal(t, 0, misses)
+	})
+
+	t.Run("NoResourceVersion", func(t *testing.T) {
+		lives[1] = newConfigMap("cm2", "", "changed")
+		assert.Equal(t, []bool{true, false, true}, stateDiffs(t, lives, targets, nil))
+		assert.Equal(t, 2, hits)
+		assert.Equal(t, 1, misses)
+
+		assert.Equal(t, []bool{true, false, true}, stateDiffs(t, lives, targets, nil))
+		assert.Equal(t, 2, hits)
+		assert.Equal(t, 1, misses)
+	})
+
+	t.Run("Deleted", func(t *testing.T) {
+		resourceDiffCache.Delete("argocd/my-app")
+		assert.Equal(t, []bool{true, false, true}, stateDiffs(t, lives, targets, nil))
+		assert.Equal(t, 0, hits)
+		assert.Equal(t, 3, misses)
+	})
+}
+
+func TestStateDiffs_ResourceDiffCacheBounded(t *testing.T) {
+	var hits, misses int
+	resourceDiffCache := argo.NewResourceDiffCache(3, func(h int, m int) {
+		hits, misses = h, m
+	})
+	stateDiffs := func(t *testing.T, appName string, resources int) {
+		t.Helper()
+		var lives, targets []*unstructured.Unstructured
+		for i := 0; i < resources; i++ {
+			name := fmt.Sprintf("cm%d", i)
+			targets = append(targets, newConfigMap(name, "", "a"))
+			lives = append(lives, newConfigMap(name, "1", "a"))
+		}
+		diffConfig, err := argo.NewDiffConfigBuilder().
+			WithDiffSettings(nil, map[string]v1alpha1.ResourceOverride{}, true, normalizers.IgnoreNormalizerOpts{}).
+			WithTracking("", "").
+			WithNoCache().
+			WithResourceDiffCache(resourceDiffCache, appName).
+			Build()
+		require.NoError(t, err)
+		_, err = argo.StateDiffs(lives, targets, diffConfig)
+		require.NoError(t, err)
+	}
+
+	stateDiffs(t, "argocd/app1", 2)
+	stateDiffs(t, "argocd/app1", 2)
+	assert.Equal(t, 2, hits)
+
+	t.Run("LeastRecentlyUsedEvicted", func(t *testing.T) {
+		stateDiffs(t, "argocd/app2", 1)
+		stateDiffs(t, "argocd/app1", 2)
+		assert.Equal(t, 2, hits)
+		stateDiffs(t, "argocd/app3", 1)
+		stateDiffs(t, "argocd/app2", 1)
+		assert.Equal(t, 0, hits)
+		assert.Equal(t, 1, misses)
+		stateDiffs(t, "argocd/app1", 2)
+		assert.Equal(t, 0, hits)
+		assert.Equal(t, 2, misses)
+	})
+
+	t.Run("AppLargerThanBoundNotCached", func(t *testing.T) {
+		stateDiffs(t, "argocd/app4", 4)
+		stateDiffs(t, "argocd/app4", 4)
+		assert.Equal(t, 0, hits)
+		assert.Equal(t, 4, misses)
+		stateDiffs(t, "argocd/app1", 2)
+		assert.Equal(t, 2, hits)
+	})
+}
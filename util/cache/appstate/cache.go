@@ -22,6 +22,20 @@ const (
 	clusterInfoCacheExpiration = 10 * time.Minute
 )
 
+// ClusterShardClaim is the claim of an application controller shard on a cluster. The shard processes the
+// applications of the cluster as long as it renews the claim.
+type ClusterShardClaim struct {
+	Shard     int       `json:"shard"`
+	RenewedAt time.Time `json:"renewedAt"`
+}
+
+// ClusterShardHandoff is stored by the application controller shard a cluster is handed off to, once the shard has
+// synced its cache of the cluster and is ready to take over the cluster.
+type ClusterShardHandoff struct {
+	Shard   int       `json:"shard"`
+	ReadyAt time.Time `json:"readyAt"`
+}
+
 type Cache struct {
 	Cache                   *cacheutil.Cache
 	appStateCacheExpiration time.Duration
@@ -83,6 +97,18 @@ func clusterInfoKey(server string) string {
 	return "cluster|info|" + server
 }
 
+func clusterLoadKey(server string) string {
+	return "cluster|load|" + server
+}
+
+func clusterShardClaimKey(server string) string {
+	return "cluster|shard-claim|" + server
+}
+
+func clusterShardHandoffKey(server string) string {
+	return "cluster|shard-handoff|" + server
+}
+
 func (c *Cache) GetAppResourcesTree(appName string, res *appv1.ApplicationTree) error {
 	err := c.GetItem(appResourcesTreeKey(appName, 0), &res)
 	if res.ShardsCount > 1 {
@@ -129,3 +155,31 @@ func (c *Cache) GetClusterInfo(server string, res *appv1.ClusterInfo) error {
 	err := c.GetItem(clusterInfoKey(server), &res)
 	return err
 }
+
+// SetClusterLoad stores the load of a cluster used to distribute clusters across the controller shards.
+func (c *Cache) SetClusterLoad(server string, load int64) error {
+	return c.SetItem(clusterLoadKey(server), load, clusterInfoCacheExpiration, false)
+}
+
+func (c *Cache) GetClusterLoad(server string, res *int64) error {
+	return c.GetItem(clusterLoadKey(server), res)
+}
+
+// SetClusterShardClaim stores the claim of a shard on a cluster, which expires unless it is renewed. A nil claim
+// removes the claim.
+func (c *Cache) SetClusterShardClaim(server string, claim *ClusterShardClaim, expiration time.Duration) error {
+	return c.SetItem(clusterShardClaimKey(server), claim, expiration, claim == nil)
+}
+
+func (c *Cache) GetClusterShardClaim(server string, res *ClusterShardClaim) error {
+	return c.GetItem(clusterShardClaimKey(server), res)
+}
+
+// SetClusterShardHandoff stores that a shard is ready to take over a cluster. A nil hand-off removes it.
+func (c *Cache) SetClusterShardHandoff(server string, handoff *ClusterShardHandoff, expiration time.Duration) error {
+	return c.SetItem(clusterShardHandoffKey(server), handoff, expiration, handoff == nil)
+}
+
+func (c *Cache) GetClusterShardHandoff(server string, res *ClusterShardHandoff) error {
+	return c.GetItem(clusterShardHandoffKey(server), res)
+}
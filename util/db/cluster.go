@@ -380,6 +380,13 @@ func clusterToSecret(c *appv1.Cluster, secret *corev1.Secret) error {
 	if c.Project != "" {
 		data["project"] = []byte(c.Project)
 	}
+	if c.Maintenance != nil {
+		maintenanceBytes, err := json.Marshal(c.Maintenance)
+		if err != nil {
+			return err
+		}
+		data["maintenance"] = maintenanceBytes
+	}
 	secret.Data = data
 
 	secret.Labels = c.Labels
@@ -435,6 +442,15 @@ func SecretToCluster(s *corev1.Secret) (*appv1.Cluster, error) {
 		}
 	}
 
+	var maintenance *appv1.ClusterMaintenance
+	if len(s.Data["maintenance"]) > 0 {
+		maintenance = &appv1.ClusterMaintenance{}
+		if err := json.Unmarshal(s.Data["maintenance"], maintenance); err != nil {
+			log.Warnf("Error while parsing maintenance in cluster secret '%s': %v", s.Name, err)
+			maintenance = nil
+		}
+	}
+
 	// copy labels and annotations excluding system ones
 	labels := map[string]string{}
 	if s.Labels != nil {
@@ -461,6 +477,7 @@ func SecretToCluster(s *corev1.Secret) (*appv1.Cluster, error) {
 		Project:            string(s.Data["project"]),
 		Labels:             labels,
 		Annotations:        annotations,
+		Maintenance:        maintenance,
 	}
 	return &cluster, nil
 }
@@ -117,6 +117,34 @@ func TestClusterToSecret(t *testing.T) {
 	assert.Equal(t, cluster.Labels, s.Labels)
 }
 
+func TestClusterToSecret_Maintenance(t *testing.T) {
+	expiresAt := metav1.NewTime(time.Date(2025, 1, 31, 2, 0, 0, 0, time.UTC))
+	cluster := &v1alpha1.Cluster{
+		Server: "server",
+		Name:   "test",
+		Maintenance: &v1alpha1.ClusterMaintenance{
+			Message:    "upgrading to 1.32",
+			ExpiresAt:  &expiresAt,
+			PauseWatch: true,
+		},
+	}
+	s := &corev1.Secret{}
+	err := clusterToSecret(cluster, s)
+	require.NoError(t, err)
+
+	converted, err := SecretToCluster(s)
+	require.NoError(t, err)
+	require.NotNil(t, converted.Maintenance)
+	assert.Equal(t, "upgrading to 1.32", converted.Maintenance.Message)
+	assert.True(t, converted.Maintenance.ExpiresAt.Equal(&expiresAt))
+	assert.True(t, converted.Maintenance.PauseWatch)
+
+	cluster.Maintenance = nil
+	err = clusterToSecret(cluster, s)
+	require.NoError(t, err)
+	assert.NotContains(t, s.Data, "maintenance")
+}
+
 func TestClusterToSecret_LastAppliedConfigurationRejected(t *testing.T) {
 	cluster := &v1alpha1.Cluster{
 		Server:      "server",
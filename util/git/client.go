@@ -130,8 +130,14 @@ type Client interface {
 	CheckoutOrNew(branch, base string, submoduleEnabled bool) (string, error)
 	// RemoveContents removes all files from the given paths in the git repository.
 	RemoveContents(paths []string) (string, error)
+	// DeleteLocalBranches detaches HEAD and deletes all local branches, so that a subsequent checkout of a branch starts
+	// from its remote-tracking branch again.
+	DeleteLocalBranches() (string, error)
 	// CommitAndPush commits and pushes changes to the target branch.
 	CommitAndPush(branch, message string) (string, error)
+	// AddAndPushNote adds the note to the given commit in the given notes namespace, replacing the existing note of the
+	// commit, and pushes the notes of the namespace.
+	AddAndPushNote(sha, namespace, note string) (string, error)
 }
 
 type EventHandlers struct {
@@ -162,6 +168,8 @@ type nativeGitClient struct {
 	proxy string
 	// list of targets that shouldn't use the proxy, applies only if the proxy is set
 	noProxy string
+	// commitSigner is used to sign commits created by CommitAndPush, if set
+	commitSigner *CommitSigner
 }
 
 type runOpts struct {
@@ -207,6 +215,33 @@ func WithEventHandlers(handlers EventHandlers) ClientOpts {
 	}
 }
 
+// SigningFormat is the format of a key used to sign commits.
+type SigningFormat string
+
+const (
+	// SigningFormatOpenPGP signs commits using a GnuPG private key.
+	SigningFormatOpenPGP SigningFormat = "openpgp"
+	// SigningFormatSSH signs commits using an SSH private key.
+	SigningFormatSSH SigningFormat = "ssh"
+)
+
+// CommitSigner holds the configuration git needs to sign commits.
+type CommitSigner struct {
+	// Format is the format of the signing key
+	Format SigningFormat
+	// Key is the ID of the GnuPG key for the openpgp format, or the path to the private key file for the ssh format
+	Key string
+	// GnuPGHome is the GnuPG home directory holding the private key. It is only used for the openpgp format.
+	GnuPGHome string
+}
+
+// WithCommitSigner sets the signer used to sign commits created by CommitAndPush
+func WithCommitSigner(signer *CommitSigner) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.commitSigner = signer
+	}
+}
+
 func NewClient(rawRepoURL string, creds Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...ClientOpts) (Client, error) {
 	r := regexp.MustCompile(`([/:])`)
 	normalizedGitURL := NormalizeGitURL(rawRepoURL)
@@ -1022,6 +1057,31 @@ func (m *nativeGitClient) CheckoutOrNew(branch, base string, submoduleEnabled bo
 	return "", nil
 }
 
+// DeleteLocalBranches detaches HEAD and deletes all local branches, so that a subsequent checkout of a branch starts
+// from its remote-tracking branch again.
+func (m *nativeGitClient) DeleteLocalBranches() (string, error) {
+	out, err := m.runCmd("for-each-ref", "--format=%(refname:short)", "refs/heads/")
+	if err != nil {
+		return out, fmt.Errorf("failed to list local branches: %w", err)
+	}
+	branches := strings.Fields(out)
+	if len(branches) == 0 {
+		return "", nil
+	}
+	// git refuses to delete the branch which is checked out. HEAD may not point to a commit, e.g. right after an
+	// orphan branch was created, in which case there is nothing to detach from.
+	if _, err := m.runCmdOutput(exec.Command("git", "rev-parse", "--verify", "--quiet", "HEAD"), runOpts{SkipErrorLogging: true}); err == nil {
+		if out, err := m.runCmd("checkout", "--detach", "--force"); err != nil {
+			return out, fmt.Errorf("failed to detach HEAD: %w", err)
+		}
+	}
+	out, err = m.runCmd(append([]string{"branch", "--delete", "--force"}, branches...)...)
+	if err != nil {
+		return out, fmt.Errorf("failed to delete local branches: %w", err)
+	}
+	return "", nil
+}
+
 // RemoveContents removes all files from the path of git repository.
 func (m *nativeGitClient) RemoveContents(paths []string) (string, error) {
 	if len(paths) == 0 {
@@ -1042,7 +1102,7 @@ func (m *nativeGitClient) CommitAndPush(branch, message string) (string, error)
 		return out, fmt.Errorf("failed to add files: %w", err)
 	}
 
-	out, err = m.runCmd("commit", "-m", message)
+	out, err = m.commit(message)
 	if err != nil {
 		if strings.Contains(out, "nothing to commit, working tree clean") {
 			return out, nil
@@ -1063,6 +1123,49 @@ func (m *nativeGitClient) CommitAndPush(branch, message string) (string, error)
 	return "", nil
 }
 
+// AddAndPushNote adds the note to the given commit in the given notes namespace, replacing the existing note of the
+// commit, and pushes the notes of the namespace. The notes are fetched first, so that the notes of other commits are
+// kept.
+func (m *nativeGitClient) AddAndPushNote(sha, namespace, note string) (string, error) {
+	ref := "refs/notes/" + namespace
+	// A glob refspec doesn't fail if the remote has no notes yet.
+	err := m.runCredentialedCmd("fetch", "origin", "--force", "+refs/notes/*:refs/notes/*")
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch notes: %w", err)
+	}
+
+	out, err := m.runCmd("notes", "--ref="+ref, "add", "--force", "-m", note, sha)
+	if err != nil {
+		return out, fmt.Errorf("failed to add note: %w", err)
+	}
+
+	if m.OnPush != nil {
+		done := m.OnPush(m.repoURL)
+		defer done()
+	}
+
+	err = m.runCredentialedCmd("push", "origin", ref)
+	if err != nil {
+		return "", fmt.Errorf("failed to push notes: %w", err)
+	}
+	return "", nil
+}
+
+// commit records the staged changes with the given message, signing the commit if a commit signer is configured.
+func (m *nativeGitClient) commit(message string) (string, error) {
+	if m.commitSigner == nil {
+		return m.runCmd("commit", "-m", message)
+	}
+	cmd := exec.Command("git",
+		"-c", "gpg.format="+string(m.commitSigner.Format),
+		"-c", "user.signingKey="+m.commitSigner.Key,
+		"commit", "-S", "-m", message)
+	if m.commitSigner.Format == SigningFormatOpenPGP {
+		cmd.Env = append(cmd.Env, "GNUPGHOME="+m.commitSigner.GnuPGHome)
+	}
+	return m.runCmdOutput(cmd, runOpts{})
+}
+
 // runWrapper runs a custom command with all the semantics of running the Git client
 func (m *nativeGitClient) runGnuPGWrapper(wrapper string, args ...string) (string, error) {
 	cmd := exec.Command(wrapper, args...)
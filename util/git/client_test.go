@@ -846,6 +846,48 @@ func Test_nativeGitClient_RemoveContents_SpecificPath(t *testing.T) {
 	require.Equal(t, "README.md", strings.TrimSpace(string(ls)))
 }
 
+func Test_nativeGitClient_DeleteLocalBranches(t *testing.T) {
+	originDir, err := _createEmptyGitRepo()
+	require.NoError(t, err)
+	gitCurrentBranch, err := outputCmd(originDir, "git", "rev-parse", "--abbrev-ref", "HEAD")
+	require.NoError(t, err)
+	baseBranch := strings.TrimSpace(string(gitCurrentBranch))
+
+	client, err := NewClientExt("file://"+originDir, t.TempDir(), NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+	require.NoError(t, client.Init())
+	require.NoError(t, client.Fetch(""))
+
+	t.Run("no local branches", func(t *testing.T) {
+		out, err := client.DeleteLocalBranches()
+		require.NoError(t, err, "error output: %s", out)
+	})
+
+	t.Run("stale local branch is reset to its remote branch", func(t *testing.T) {
+		out, err := client.Checkout(baseBranch, false)
+		require.NoError(t, err, "error output: %s", out)
+		err = runCmd(client.Root(), "git", "commit", "--allow-empty", "-m", "Unpushed commit")
+		require.NoError(t, err)
+		err = runCmd(client.Root(), "git", "checkout", "-b", "other")
+		require.NoError(t, err)
+
+		out, err = client.DeleteLocalBranches()
+		require.NoError(t, err, "error output: %s", out)
+
+		branches, err := outputCmd(client.Root(), "git", "for-each-ref", "--format=%(refname:short)", "refs/heads/")
+		require.NoError(t, err)
+		assert.Empty(t, strings.TrimSpace(string(branches)))
+
+		out, err = client.Checkout(baseBranch, false)
+		require.NoError(t, err, "error output: %s", out)
+		localSHA, err := client.CommitSHA()
+		require.NoError(t, err)
+		originSHA, err := outputCmd(originDir, "git", "rev-parse", "HEAD")
+		require.NoError(t, err)
+		assert.Equal(t, strings.TrimSpace(string(originSHA)), localSHA)
+	})
+}
+
 func Test_nativeGitClient_CommitAndPush(t *testing.T) {
 	tempDir, err := _createEmptyGitRepo()
 	require.NoError(t, err)
@@ -893,6 +935,79 @@ func Test_nativeGitClient_CommitAndPush(t *testing.T) {
 	require.Equal(t, expectedCommitHash, actualCommitHash)
 }
 
+func Test_nativeGitClient_AddAndPushNote(t *testing.T) {
+	originDir, err := _createEmptyGitRepo()
+	require.NoError(t, err)
+	originSHA, err := outputCmd(originDir, "git", "rev-parse", "HEAD")
+	require.NoError(t, err)
+	sha := strings.TrimSpace(string(originSHA))
+
+	newClient := func(t *testing.T) Client {
+		t.Helper()
+		client, err := NewClientExt("file://"+originDir, t.TempDir(), NopCreds{}, true, false, "", "")
+		require.NoError(t, err)
+		require.NoError(t, client.Init())
+		out, err := client.SetAuthor("test", "test@example.com")
+		require.NoError(t, err, "error output: %s", out)
+		require.NoError(t, client.Fetch(""))
+		return client
+	}
+
+	out, err := newClient(t).AddAndPushNote(sha, "hydrator.metadata", "first")
+	require.NoError(t, err, "error output: %s", out)
+	note, err := outputCmd(originDir, "git", "notes", "--ref=hydrator.metadata", "show", sha)
+	require.NoError(t, err)
+	assert.Equal(t, "first", strings.TrimSpace(string(note)))
+
+	// a working copy which did not fetch the notes replaces the note
+	out, err = newClient(t).AddAndPushNote(sha, "hydrator.metadata", "second")
+	require.NoError(t, err, "error output: %s", out)
+	note, err = outputCmd(originDir, "git", "notes", "--ref=hydrator.metadata", "show", sha)
+	require.NoError(t, err)
+	assert.Equal(t, "second", strings.TrimSpace(string(note)))
+}
+
+func Test_nativeGitClient_CommitAndPush_Signed(t *testing.T) {
+	tempDir, err := _createEmptyGitRepo()
+	require.NoError(t, err)
+
+	err = runCmd(tempDir, "git", "config", "--local", "receive.denyCurrentBranch", "updateInstead")
+	require.NoError(t, err)
+
+	gitCurrentBranch, err := outputCmd(tempDir, "git", "rev-parse", "--abbrev-ref", "HEAD")
+	require.NoError(t, err)
+	branch := strings.TrimSpace(string(gitCurrentBranch))
+
+	keyPath := filepath.Join(t.TempDir(), "signing_key")
+	err = runCmd(tempDir, "ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-f", keyPath)
+	require.NoError(t, err)
+
+	client, err := NewClient("file://"+tempDir, NopCreds{}, true, false, "", "", WithCommitSigner(&CommitSigner{Format: SigningFormatSSH, Key: keyPath}))
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	out, err := client.SetAuthor("test", "test@example.com")
+	require.NoError(t, err, "error output: ", out)
+
+	err = client.Fetch(branch)
+	require.NoError(t, err)
+
+	out, err = client.Checkout(branch, false)
+	require.NoError(t, err, "error output: ", out)
+
+	err = runCmd(client.Root(), "touch", "README.md")
+	require.NoError(t, err)
+
+	out, err = client.CommitAndPush(branch, "docs: README")
+	require.NoError(t, err, "error output: %s", out)
+
+	commit, err := outputCmd(tempDir, "git", "cat-file", "commit", "HEAD")
+	require.NoError(t, err)
+	assert.Contains(t, string(commit), "-----BEGIN SSH SIGNATURE-----")
+}
+
 func Test_newAuth_AzureWorkloadIdentity(t *testing.T) {
 	tokenprovider := new(mocks.TokenProvider)
 	tokenprovider.On("GetToken", azureDevopsEntraResourceId).Return(&workloadidentity.Token{AccessToken: "accessToken"}, nil)
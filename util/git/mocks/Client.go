@@ -36,6 +36,78 @@ func (_m *Client) EXPECT() *Client_Expecter {
 	return &Client_Expecter{mock: &_m.Mock}
 }
 
+// AddAndPushNote provides a mock function for the type Client
+func (_mock *Client) AddAndPushNote(sha string, namespace string, note string) (string, error) {
+	ret := _mock.Called(sha, namespace, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAndPushNote")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) (string, error)); ok {
+		return returnFunc(sha, namespace, note)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = returnFunc(sha, namespace, note)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = returnFunc(sha, namespace, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_AddAndPushNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAndPushNote'
+type Client_AddAndPushNote_Call struct {
+	*mock.Call
+}
+
+// AddAndPushNote is a helper method to define mock.On call
+//   - sha string
+//   - namespace string
+//   - note string
+func (_e *Client_Expecter) AddAndPushNote(sha interface{}, namespace interface{}, note interface{}) *Client_AddAndPushNote_Call {
+	return &Client_AddAndPushNote_Call{Call: _e.mock.On("AddAndPushNote", sha, namespace, note)}
+}
+
+func (_c *Client_AddAndPushNote_Call) Run(run func(sha string, namespace string, note string)) *Client_AddAndPushNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Client_AddAndPushNote_Call) Return(s string, err error) *Client_AddAndPushNote_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Client_AddAndPushNote_Call) RunAndReturn(run func(sha string, namespace string, note string) (string, error)) *Client_AddAndPushNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ChangedFiles provides a mock function for the type Client
 func (_mock *Client) ChangedFiles(revision string, targetRevision string) ([]string, error) {
 	ret := _mock.Called(revision, targetRevision)
@@ -427,6 +499,59 @@ func (_c *Client_CommitSHA_Call) RunAndReturn(run func() (string, error)) *Clien
 	return _c
 }
 
+// DeleteLocalBranches provides a mock function for the type Client
+func (_mock *Client) DeleteLocalBranches() (string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLocalBranches")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (string, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_DeleteLocalBranches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLocalBranches'
+type Client_DeleteLocalBranches_Call struct {
+	*mock.Call
+}
+
+// DeleteLocalBranches is a helper method to define mock.On call
+func (_e *Client_Expecter) DeleteLocalBranches() *Client_DeleteLocalBranches_Call {
+	return &Client_DeleteLocalBranches_Call{Call: _e.mock.On("DeleteLocalBranches")}
+}
+
+func (_c *Client_DeleteLocalBranches_Call) Run(run func()) *Client_DeleteLocalBranches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Client_DeleteLocalBranches_Call) Return(s string, err error) *Client_DeleteLocalBranches_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Client_DeleteLocalBranches_Call) RunAndReturn(run func() (string, error)) *Client_DeleteLocalBranches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Fetch provides a mock function for the type Client
 func (_mock *Client) Fetch(revision string) error {
 	ret := _mock.Called(revision)
@@ -24,6 +24,20 @@ type HydratorCommitMetadata struct {
 	// Known Argocd- trailers with valid values are removed, but all other trailers are kept.
 	Body       string                    `json:"body,omitempty"`
 	References []appv1.RevisionReference `json:"references,omitempty"`
+	// DrySources lists the additional dry sources, besides RepoURL, which the manifests were hydrated from.
+	DrySources []DrySourceMetadata `json:"drySources,omitempty"`
+	// ApprovedBy is the user who approved the hydration of the manifests, if approval was required.
+	ApprovedBy string `json:"approvedBy,omitempty"`
+}
+
+// DrySourceMetadata identifies an additional dry source and the revision the manifests were hydrated from.
+type DrySourceMetadata struct {
+	RepoURL string `json:"repoURL"`
+	Path    string `json:"path,omitempty"`
+	Chart   string `json:"chart,omitempty"`
+	Ref     string `json:"ref,omitempty"`
+	// DrySHA is the resolved revision of the dry source. For Helm charts, this is the chart version.
+	DrySHA string `json:"drySha"`
 }
 
 // GetCommitMetadata takes repo, drySha and commitMetadata and returns a HydratorCommitMetadata which is a
@@ -117,6 +117,36 @@ func NewClient(repoURL string, creds Creds, proxy, noProxy string, layerMediaTyp
 }
 
 func NewClientWithLock(repoURL string, creds Creds, repoLock sync.KeyLock, proxyURL, noProxy string, layerMediaTypes []string, opts ...ClientOpts) (Client, error) {
+	repo, err := newRepository(repoURL, creds, proxyURL, noProxy)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := url.Parse(repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse oci repo url: %w", err)
+	}
+
+	reg, err := remote.NewRegistry(parsed.Host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup registry config: %w", err)
+	}
+	reg.PlainHTTP = repo.PlainHTTP
+	reg.Client = repo.Client
+	return newClientWithLock(strings.TrimPrefix(repoURL, "oci://"), repoLock, repo, func(ctx context.Context, last string) ([]string, error) {
+		var t []string
+
+		err := repo.Tags(ctx, last, func(tags []string) error {
+			t = append(t, tags...)
+			return nil
+		})
+
+		return t, err
+	}, reg.Ping, layerMediaTypes, opts...), nil
+}
+
+// newRepository returns a client for the remote OCI repository at repoURL, which authenticates with creds.
+func newRepository(repoURL string, creds Creds, proxyURL, noProxy string) (*remote.Repository, error) {
 	ociRepo := strings.TrimPrefix(repoURL, "oci://")
 	repo, err := remote.NewRepository(ociRepo)
 	if err != nil {
@@ -153,28 +183,7 @@ func NewClientWithLock(repoURL string, creds Creds, repoLock sync.KeyLock, proxy
 			Password: creds.Password,
 		}),
 	}
-
-	parsed, err := url.Parse(repoURL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse oci repo url: %w", err)
-	}
-
-	reg, err := remote.NewRegistry(parsed.Host)
-	if err != nil {
-		return nil, fmt.Errorf("failed to setup registry config: %w", err)
-	}
-	reg.PlainHTTP = repo.PlainHTTP
-	reg.Client = repo.Client
-	return newClientWithLock(ociRepo, repoLock, repo, func(ctx context.Context, last string) ([]string, error) {
-		var t []string
-
-		err := repo.Tags(ctx, last, func(tags []string) error {
-			t = append(t, tags...)
-			return nil
-		})
-
-		return t, err
-	}, reg.Ping, layerMediaTypes, opts...), nil
+	return repo, nil
 }
 
 func newClientWithLock(repoURL string, repoLock sync.KeyLock, repo oras.ReadOnlyTarget, tagsFunc func(context.Context, string) ([]string, error), pingFunc func(ctx context.Context) error, layerMediaTypes []string, opts ...ClientOpts) Client {
@@ -0,0 +1,68 @@
+package oci
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content/memory"
+
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+)
+
+// HydratedManifestsArtifactType is the artifact type of the OCI artifacts which contain hydrated manifests.
+const HydratedManifestsArtifactType = "application/vnd.argoproj.argo-cd.hydrated-manifests.v1"
+
+// Pusher pushes the contents of local directories as OCI artifacts to a repository.
+type Pusher interface {
+	// Push packages the contents of dir into a single tar+gzip layer, pushes it as an artifact with the given manifest
+	// annotations, and tags it with tag. It returns the digest of the artifact's manifest.
+	Push(ctx context.Context, dir string, tag string, annotations map[string]string) (string, error)
+}
+
+var _ Pusher = &nativeOCIPusher{}
+
+// nativeOCIPusher implements the Pusher interface using oras-go
+type nativeOCIPusher struct {
+	repo oras.Target
+}
+
+// NewPusher returns a Pusher for the OCI repository at repoURL.
+func NewPusher(repoURL string, creds Creds, proxyURL, noProxy string) (Pusher, error) {
+	repo, err := newRepository(repoURL, creds, proxyURL, noProxy)
+	if err != nil {
+		return nil, err
+	}
+	return &nativeOCIPusher{repo: repo}, nil
+}
+
+func (p *nativeOCIPusher) Push(ctx context.Context, dir string, tag string, annotations map[string]string) (string, error) {
+	var layer bytes.Buffer
+	if _, err := files.Tgz(dir, nil, nil, &layer); err != nil {
+		return "", fmt.Errorf("failed to archive %s: %w", dir, err)
+	}
+
+	// The artifact is assembled in memory first, so that only complete artifacts are copied to the remote repository.
+	store := memory.New()
+	layerDesc, err := oras.PushBytes(ctx, store, imagev1.MediaTypeImageLayerGzip, layer.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("failed to store layer: %w", err)
+	}
+	manifestDesc, err := oras.PackManifest(ctx, store, oras.PackManifestVersion1_1, HydratedManifestsArtifactType, oras.PackManifestOptions{
+		Layers:              []imagev1.Descriptor{layerDesc},
+		ManifestAnnotations: annotations,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to pack manifest: %w", err)
+	}
+	if err = store.Tag(ctx, manifestDesc, tag); err != nil {
+		return "", fmt.Errorf("failed to tag manifest: %w", err)
+	}
+
+	if _, err = oras.Copy(ctx, store, tag, p.repo, tag, oras.DefaultCopyOptions); err != nil {
+		return "", fmt.Errorf("failed to push artifact: %w", err)
+	}
+	return manifestDesc.Digest.String(), nil
+}
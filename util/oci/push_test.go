@@ -0,0 +1,158 @@
+package oci
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// testRegistry is a minimal in-memory implementation of the parts of the OCI distribution API which are needed to
+// push and pull artifacts.
+type testRegistry struct {
+	lock      sync.Mutex
+	blobs     map[string][]byte
+	manifests map[string][]byte
+	types     map[string]string
+	tags      map[string]map[string]string
+}
+
+func newTestRegistry(t *testing.T) *httptest.Server {
+	t.Helper()
+	reg := &testRegistry{
+		blobs:     map[string][]byte{},
+		manifests: map[string][]byte{},
+		types:     map[string]string{},
+		tags:      map[string]map[string]string{},
+	}
+	server := httptest.NewServer(reg)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (r *testRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	path := strings.TrimPrefix(req.URL.Path, "/v2/")
+	if path == "" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	switch {
+	case strings.HasSuffix(path, "/blobs/uploads/") && req.Method == http.MethodPost:
+		w.Header().Set("Location", req.URL.Path+"upload")
+		w.WriteHeader(http.StatusAccepted)
+	case strings.HasSuffix(path, "/blobs/uploads/upload") && req.Method == http.MethodPut:
+		data, _ := io.ReadAll(req.Body)
+		r.blobs[req.URL.Query().Get("digest")] = data
+		w.WriteHeader(http.StatusCreated)
+	case strings.Contains(path, "/blobs/"):
+		data, ok := r.blobs[path[strings.LastIndex(path, "/")+1:]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", fmt.Sprint(len(data)))
+		if req.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	case strings.Contains(path, "/manifests/"):
+		name, ref, _ := strings.Cut(path, "/manifests/")
+		if req.Method == http.MethodPut {
+			data, _ := io.ReadAll(req.Body)
+			sum := sha256.Sum256(data)
+			digest := "sha256:" + hex.EncodeToString(sum[:])
+			r.manifests[digest] = data
+			r.types[digest] = req.Header.Get("Content-Type")
+			if !strings.HasPrefix(ref, "sha256:") {
+				if r.tags[name] == nil {
+					r.tags[name] = map[string]string{}
+				}
+				r.tags[name][ref] = digest
+			}
+			w.Header().Set("Docker-Content-Digest", digest)
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		digest := ref
+		if tagged, ok := r.tags[name][ref]; ok {
+			digest = tagged
+		}
+		data, ok := r.manifests[digest]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", r.types[digest])
+		w.Header().Set("Content-Length", fmt.Sprint(len(data)))
+		w.Header().Set("Docker-Content-Digest", digest)
+		if req.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	case strings.HasSuffix(path, "/tags/list"):
+		name := strings.TrimSuffix(path, "/tags/list")
+		var tags []string
+		for tag := range r.tags[name] {
+			tags = append(tags, tag)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"name": name, "tags": tags})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func Test_nativeOCIPusher_Push(t *testing.T) {
+	server := newTestRegistry(t)
+	repoURL := "oci://" + strings.TrimPrefix(server.URL, "http://") + "/hydrated/guestbook"
+	creds := Creds{InsecureHTTPOnly: true}
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "manifest.yaml"), []byte("kind: ConfigMap\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "hydrator.metadata"), []byte("{}"), 0o644))
+
+	pusher, err := NewPusher(repoURL, creds, "", "")
+	require.NoError(t, err)
+	digest, err := pusher.Push(t.Context(), dir, "environments-prod", map[string]string{
+		imagev1.AnnotationRevision: "abc123",
+	})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(digest, "sha256:"))
+
+	client, err := NewClient(repoURL, creds, "", "", []string{imagev1.MediaTypeImageLayerGzip},
+		WithImagePaths(utilio.NewRandomizedTempPaths(t.TempDir())),
+		WithManifestMaxExtractedSize(1<<20))
+	require.NoError(t, err)
+
+	resolved, err := client.ResolveRevision(t.Context(), "environments-prod", true)
+	require.NoError(t, err)
+	assert.Equal(t, digest, resolved)
+
+	path, closer, err := client.Extract(t.Context(), digest)
+	require.NoError(t, err)
+	defer utilio.Close(closer)
+	data, err := os.ReadFile(filepath.Join(path, "manifest.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "kind: ConfigMap\n", string(data))
+
+	manifest, err := client.DigestMetadata(t.Context(), digest)
+	require.NoError(t, err)
+	assert.Equal(t, HydratedManifestsArtifactType, manifest.ArtifactType)
+	assert.Equal(t, "abc123", manifest.Annotations[imagev1.AnnotationRevision])
+	require.Len(t, manifest.Layers, 1)
+	assert.Equal(t, imagev1.MediaTypeImageLayerGzip, manifest.Layers[0].MediaType)
+}
@@ -79,6 +79,7 @@ const (
 	ActionOverride = "override"
 	ActionAction   = "action"
 	ActionInvoke   = "invoke"
+	ActionApprove  = "approve"
 )
 
 var (
@@ -106,6 +107,7 @@ var (
 		ActionOverride,
 		ActionAction,
 		ActionInvoke,
+		ActionApprove,
 	}
 )
 
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -353,8 +354,11 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload any) {
 		}
 		for _, app := range filteredApps {
 			if app.Spec.SourceHydrator != nil {
-				drySource := app.Spec.SourceHydrator.GetDrySource()
-				if sourceRevisionHasChanged(drySource, revision, touchedHead) && sourceUsesURL(drySource, webURL, repoRegexp) {
+				// Hydration is triggered by a change to any of the dry sources.
+				drySourceChanged := slices.ContainsFunc(app.Spec.SourceHydrator.GetDrySources(), func(drySource v1alpha1.ApplicationSource) bool {
+					return sourceRevisionHasChanged(drySource, revision, touchedHead) && sourceUsesURL(drySource, webURL, repoRegexp)
+				})
+				if drySourceChanged {
 					refreshPaths := path.GetAppRefreshPaths(&app)
 					if path.AppFilesHaveChanged(refreshPaths, changedFiles) {
 						namespacedAppInterface := a.appClientset.ArgoprojV1alpha1().Applications(app.Namespace)